@@ -5,10 +5,14 @@ import (
 	context "golang.org/x/net/context"
 )
 
-// GenericStreamer is a subset of methods to allow streaming responses from the backend
+// PlanStreamer allows streaming plan responses from the backend.  Next returns false when the
+// list is exhausted or iteration fails; check Err to tell the two apart.  Close stops iteration
+// early.
 type PlanStreamer interface {
 	Next() bool
 	Current() *pb.PlanResponse
+	Err() error
+	Close()
 }
 
 // PlanClient is an interface for actions related to CRUD operations on a backend (e.g. Stripe)
@@ -19,3 +23,150 @@ type PlanClient interface {
 	Get(ctx context.Context, req *pb.GetPlanRequest) (*pb.PlanResponse, error)
 	List(ctx context.Context, req *pb.ListPlansRequest) (PlanStreamer, error)
 }
+
+// ProductStreamer allows streaming product responses from the backend
+type ProductStreamer interface {
+	Next() bool
+	Current() *pb.ProductResponse
+	Err() error
+	Close()
+}
+
+// ProductClient is an interface for CRUD operations on products, which group the plans that
+// price them
+type ProductClient interface {
+	Create(ctx context.Context, req *pb.CreateProductRequest) (*pb.ProductResponse, error)
+	Update(ctx context.Context, req *pb.UpdateProductRequest) (*pb.ProductResponse, error)
+	Delete(ctx context.Context, req *pb.DeleteProductRequest) (*pb.DeleteProductResponse, error)
+	Get(ctx context.Context, req *pb.GetProductRequest) (*pb.ProductResponse, error)
+	List(ctx context.Context, req *pb.ListProductsRequest) (ProductStreamer, error)
+}
+
+// CustomerStreamer allows streaming customer responses from the backend
+type CustomerStreamer interface {
+	Next() bool
+	Current() *pb.CustomerResponse
+	Err() error
+	Close()
+}
+
+// CustomerClient is an interface for actions related to CRUD operations on customers
+type CustomerClient interface {
+	Create(ctx context.Context, req *pb.CreateCustomerRequest) (*pb.CustomerResponse, error)
+	Update(ctx context.Context, req *pb.UpdateCustomerRequest) (*pb.CustomerResponse, error)
+	Delete(ctx context.Context, req *pb.DeleteCustomerRequest) (*pb.DeleteCustomerResponse, error)
+	Get(ctx context.Context, req *pb.GetCustomerRequest) (*pb.CustomerResponse, error)
+	List(ctx context.Context, req *pb.ListCustomersRequest) (CustomerStreamer, error)
+}
+
+// SubscriptionStreamer allows streaming subscription responses from the backend
+type SubscriptionStreamer interface {
+	Next() bool
+	Current() *pb.SubscriptionResponse
+	Err() error
+	Close()
+}
+
+// SubscriptionClient is an interface for actions related to the lifecycle of a subscription
+type SubscriptionClient interface {
+	Create(ctx context.Context, req *pb.CreateSubscriptionRequest) (*pb.SubscriptionResponse, error)
+	Update(ctx context.Context, req *pb.UpdateSubscriptionRequest) (*pb.SubscriptionResponse, error)
+	Cancel(ctx context.Context, req *pb.CancelSubscriptionRequest) (*pb.SubscriptionResponse, error)
+	Reactivate(ctx context.Context, req *pb.ReactivateSubscriptionRequest) (*pb.SubscriptionResponse, error)
+	Get(ctx context.Context, req *pb.GetSubscriptionRequest) (*pb.SubscriptionResponse, error)
+	List(ctx context.Context, req *pb.ListSubscriptionsRequest) (SubscriptionStreamer, error)
+}
+
+// InvoiceStreamer allows streaming invoice responses from the backend
+type InvoiceStreamer interface {
+	Next() bool
+	Current() *pb.InvoiceResponse
+	Err() error
+	Close()
+}
+
+// InvoiceClient is an interface for retrieving and collecting invoices.  Invoices are created by
+// the backend as subscriptions renew, so there is no create.
+type InvoiceClient interface {
+	Get(ctx context.Context, req *pb.GetInvoiceRequest) (*pb.InvoiceResponse, error)
+	Upcoming(ctx context.Context, req *pb.UpcomingInvoiceRequest) (*pb.InvoiceResponse, error)
+	Pay(ctx context.Context, req *pb.PayInvoiceRequest) (*pb.InvoiceResponse, error)
+	Void(ctx context.Context, req *pb.VoidInvoiceRequest) (*pb.InvoiceResponse, error)
+	MarkUncollectible(ctx context.Context, req *pb.MarkUncollectibleInvoiceRequest) (*pb.InvoiceResponse, error)
+	List(ctx context.Context, req *pb.ListInvoicesRequest) (InvoiceStreamer, error)
+}
+
+// CouponStreamer allows streaming coupon responses from the backend
+type CouponStreamer interface {
+	Next() bool
+	Current() *pb.CouponResponse
+	Err() error
+	Close()
+}
+
+// CouponClient is an interface for managing coupons and applying them as discounts to
+// customers or subscriptions
+type CouponClient interface {
+	Create(ctx context.Context, req *pb.CreateCouponRequest) (*pb.CouponResponse, error)
+	Update(ctx context.Context, req *pb.UpdateCouponRequest) (*pb.CouponResponse, error)
+	Delete(ctx context.Context, req *pb.DeleteCouponRequest) (*pb.DeleteCouponResponse, error)
+	Get(ctx context.Context, req *pb.GetCouponRequest) (*pb.CouponResponse, error)
+	List(ctx context.Context, req *pb.ListCouponsRequest) (CouponStreamer, error)
+	Apply(ctx context.Context, req *pb.ApplyCouponRequest) (*pb.DiscountResponse, error)
+	RemoveDiscount(ctx context.Context, req *pb.RemoveDiscountRequest) (*pb.RemoveDiscountResponse, error)
+}
+
+// SourceStreamer allows streaming payment source responses from the backend
+type SourceStreamer interface {
+	Next() bool
+	Current() *pb.SourceResponse
+	Err() error
+	Close()
+}
+
+// SourceClient is an interface for managing the payment sources attached to a customer.  Sources
+// are attached from tokens created client side so that card numbers never reach the backend.
+type SourceClient interface {
+	Attach(ctx context.Context, req *pb.AttachSourceRequest) (*pb.SourceResponse, error)
+	List(ctx context.Context, req *pb.ListSourcesRequest) (SourceStreamer, error)
+	SetDefault(ctx context.Context, req *pb.SetDefaultSourceRequest) (*pb.CustomerResponse, error)
+	Detach(ctx context.Context, req *pb.DetachSourceRequest) (*pb.DetachSourceResponse, error)
+}
+
+// EventStreamer allows streaming event responses from the backend
+type EventStreamer interface {
+	Next() bool
+	Current() *pb.EventResponse
+	Err() error
+	Close()
+}
+
+// EventClient is an interface for retrieving past events, e.g. to backfill events that were
+// missed while a webhook endpoint was unavailable
+type EventClient interface {
+	Get(ctx context.Context, req *pb.GetEventRequest) (*pb.EventResponse, error)
+	List(ctx context.Context, req *pb.ListEventsRequest) (EventStreamer, error)
+}
+
+// UsageRecordSummaryStreamer allows streaming usage record summary responses from the backend
+type UsageRecordSummaryStreamer interface {
+	Next() bool
+	Current() *pb.UsageRecordSummaryResponse
+	Err() error
+	Close()
+}
+
+// UsageClient is an interface for reporting the usage of metered subscriptions.  Usage is reported
+// against the subscription item of a subscription and billed at the end of each period.
+type UsageClient interface {
+	Create(ctx context.Context, req *pb.CreateUsageRecordRequest) (*pb.UsageRecordResponse, error)
+	ListSummaries(ctx context.Context, req *pb.ListUsageRecordSummariesRequest) (UsageRecordSummaryStreamer, error)
+}
+
+// EventSubscriber is implemented by sources of billing events, such as a webhook receiver.
+// Subscribe returns a channel of events whose type matches one of the patterns, or every event
+// when no patterns are given.  The channel is closed when the context is done or when the
+// subscriber falls too far behind.
+type EventSubscriber interface {
+	Subscribe(ctx context.Context, types []string) <-chan *pb.Event
+}
@@ -0,0 +1,197 @@
+// Package braintree implements the plan, customer and subscription backends on the Braintree
+// XML gateway API.  Braintree's model differs from Stripe in a few ways that callers will notice:
+// plans are billed in whole months, cannot be deleted and carry no metadata, and subscriptions
+// are charged to the customer's default payment method with a quantity of one.  Requests using
+// fields that Braintree cannot store return an InvalidRequest error rather than dropping them.
+package braintree
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/BTBurke/recur/backend"
+	"github.com/BTBurke/recur/pb"
+	"github.com/cenkalti/backoff"
+	log "github.com/sirupsen/logrus"
+	context "golang.org/x/net/context"
+)
+
+// Environment is the base URL of a Braintree gateway
+type Environment string
+
+const (
+	Sandbox    Environment = "https://api.sandbox.braintreegateway.com:443"
+	Production Environment = "https://api.braintreegateway.com:443"
+)
+
+// apiVersion is the version of the XML API the gateway speaks
+const apiVersion = "6"
+
+// DefaultRetryPolicy is used when no policy is set
+var DefaultRetryPolicy = backend.RetryPolicy{
+	MaxElapsed:  30 * time.Second,
+	MaxAttempts: 5,
+}
+
+// Option configures optional behavior of the gateway
+type Option func(o *options)
+
+type options struct {
+	retry  backend.RetryPolicy
+	client *http.Client
+}
+
+// WithRetryPolicy sets the policy used to retry failed requests.  Braintree has no idempotency
+// keys, so only reads, updates and searches are retried; creates are attempted once.
+func WithRetryPolicy(r backend.RetryPolicy) Option {
+	return func(o *options) {
+		o.retry = r
+	}
+}
+
+// WithHTTPClient sets the HTTP client used to call the gateway
+func WithHTTPClient(c *http.Client) Option {
+	return func(o *options) {
+		o.client = c
+	}
+}
+
+func newOptions(opts []Option) options {
+	o := options{retry: DefaultRetryPolicy, client: &http.Client{Timeout: 60 * time.Second}}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// Gateway sends authenticated requests to the Braintree API for a single merchant.  It is safe
+// for concurrent use and is shared by the resource clients.
+type Gateway struct {
+	baseURL    string
+	publicKey  string
+	privateKey string
+	livemode   bool
+	logger     log.StdLogger
+	opts       options
+}
+
+// NewGateway returns a gateway for the merchant in the environment.  Any URL may be used as the
+// environment, which allows testing against a local stand-in.
+func NewGateway(env Environment, merchantID string, publicKey string, privateKey string, logger log.StdLogger, opts ...Option) *Gateway {
+	return &Gateway{
+		baseURL:    strings.TrimSuffix(string(env), "/") + "/merchants/" + merchantID,
+		publicKey:  publicKey,
+		privateKey: privateKey,
+		livemode:   env == Production,
+		logger:     logger,
+		opts:       newOptions(opts),
+	}
+}
+
+// ParseKey splits a backend key of the form environment:merchant_id:public_key:private_key, where
+// environment is sandbox or production
+func ParseKey(key string) (env Environment, merchantID string, publicKey string, privateKey string, err error) {
+	parts := strings.Split(key, ":")
+	if len(parts) != 4 {
+		return "", "", "", "", fmt.Errorf("braintree: key must be environment:merchant_id:public_key:private_key")
+	}
+	switch parts[0] {
+	case "sandbox":
+		env = Sandbox
+	case "production":
+		env = Production
+	default:
+		return "", "", "", "", fmt.Errorf("braintree: unknown environment %q, must be sandbox or production", parts[0])
+	}
+	for _, p := range parts[1:] {
+		if len(p) == 0 {
+			return "", "", "", "", fmt.Errorf("braintree: key must be environment:merchant_id:public_key:private_key")
+		}
+	}
+	return env, parts[1], parts[2], parts[3], nil
+}
+
+// do sends the request and decodes the response body into out.  Error responses from the
+// gateway are returned as a *pb.Error.  Transient failures are retried when retry is true.
+func (g *Gateway) do(ctx context.Context, method string, path string, in interface{}, out interface{}, retry bool) error {
+	var body []byte
+	if in != nil {
+		var err error
+		if body, err = xml.Marshal(in); err != nil {
+			return err
+		}
+	}
+	op := func() error {
+		return classify(g.send(ctx, method, path, body, out))
+	}
+	if !retry {
+		return unwrapPermanent(op())
+	}
+	return unwrapPermanent(backoff.Retry(op, g.opts.retry.BackOff(ctx)))
+}
+
+func (g *Gateway) send(ctx context.Context, method string, path string, body []byte, out interface{}) error {
+	var r io.Reader
+	if body != nil {
+		r = bytes.NewReader(append([]byte(xml.Header), body...))
+	}
+	req, err := http.NewRequest(method, g.baseURL+path, r)
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(g.publicKey, g.privateKey)
+	req.Header.Set("X-ApiVersion", apiVersion)
+	req.Header.Set("Accept", "application/xml")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/xml")
+	}
+	if headers, ok := backend.Headers(ctx); ok {
+		for k, v := range headers {
+			req.Header.Set(k, v)
+		}
+	}
+
+	resp, err := g.opts.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode >= 300 {
+		pbErr := respToError(resp.StatusCode, data)
+		if pbErr.HttpStatusCode >= 500 && g.logger != nil {
+			g.logger.Printf("braintree: %s %s failed with status %d", method, path, resp.StatusCode)
+		}
+		return pbErr
+	}
+	if out == nil || len(bytes.TrimSpace(data)) == 0 {
+		return nil
+	}
+	return xml.Unmarshal(data, out)
+}
+
+// classify marks errors that should not be retried as permanent.  Rate limits, server errors
+// and failures to reach the gateway are transient.
+func classify(err error) error {
+	pbErr, ok := err.(*pb.Error)
+	if !ok || pbErr.HttpStatusCode == 429 || pbErr.HttpStatusCode >= 500 {
+		return err
+	}
+	return backoff.Permanent(err)
+}
+
+func unwrapPermanent(err error) error {
+	if p, ok := err.(*backoff.PermanentError); ok {
+		return p.Err
+	}
+	return err
+}
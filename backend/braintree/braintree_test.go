@@ -0,0 +1,262 @@
+package braintree
+
+import (
+	"io/ioutil"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/BTBurke/recur/backend"
+	"github.com/BTBurke/recur/pb"
+	"github.com/stretchr/testify/assert"
+	context "golang.org/x/net/context"
+)
+
+// route is a recorded response served by the test gateway
+type route struct {
+	status  int
+	fixture string
+}
+
+type request struct {
+	method string
+	path   string
+	body   string
+}
+
+// testGateway stands in for the Braintree API, serving recorded XML fixtures from testdata for
+// each "METHOD /path" under the merchant.  Unknown routes return 404.
+type testGateway struct {
+	t      *testing.T
+	routes map[string]route
+
+	mu       sync.Mutex
+	requests []request
+}
+
+func newTestGateway(t *testing.T, routes map[string]route) (*Gateway, *testGateway, func()) {
+	tg := &testGateway{t: t, routes: routes}
+	srv := httptest.NewServer(tg)
+	gw := NewGateway(Environment(srv.URL), "merchant", "public", "private", nil,
+		WithRetryPolicy(backend.RetryPolicy{MaxElapsed: time.Second, MaxAttempts: 3}))
+	return gw, tg, srv.Close
+}
+
+func (tg *testGateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := ioutil.ReadAll(r.Body)
+	path := strings.TrimPrefix(r.URL.Path, "/merchants/merchant")
+	tg.mu.Lock()
+	tg.requests = append(tg.requests, request{method: r.Method, path: path, body: string(body)})
+	tg.mu.Unlock()
+
+	if user, pass, ok := r.BasicAuth(); !ok || user != "public" || pass != "private" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	if r.Header.Get("X-ApiVersion") != apiVersion {
+		w.WriteHeader(http.StatusUpgradeRequired)
+		return
+	}
+	rt, ok := tg.routes[r.Method+" "+path]
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	if rt.status == 0 {
+		rt.status = http.StatusOK
+	}
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(rt.status)
+	if len(rt.fixture) > 0 {
+		data, err := ioutil.ReadFile(filepath.Join("testdata", rt.fixture))
+		if err != nil {
+			tg.t.Fatalf("missing fixture %s: %v", rt.fixture, err)
+		}
+		w.Write(data)
+	}
+}
+
+// sent returns the requests made to the gateway as "METHOD /path"
+func (tg *testGateway) sent() []string {
+	tg.mu.Lock()
+	defer tg.mu.Unlock()
+	var out []string
+	for _, r := range tg.requests {
+		out = append(out, r.method+" "+r.path)
+	}
+	return out
+}
+
+// body returns the body of the last request to "METHOD /path"
+func (tg *testGateway) body(route string) string {
+	tg.mu.Lock()
+	defer tg.mu.Unlock()
+	for i := len(tg.requests) - 1; i >= 0; i-- {
+		if r := tg.requests[i]; r.method+" "+r.path == route {
+			return r.body
+		}
+	}
+	return ""
+}
+
+func TestParseKey(t *testing.T) {
+	env, merchant, pub, priv, err := ParseKey("sandbox:m1:pub:priv")
+	assert.NoError(t, err)
+	assert.Equal(t, Sandbox, env)
+	assert.Equal(t, []string{"m1", "pub", "priv"}, []string{merchant, pub, priv})
+
+	env, _, _, _, err = ParseKey("production:m1:pub:priv")
+	assert.NoError(t, err)
+	assert.Equal(t, Production, env)
+
+	for _, key := range []string{"", "sk_test_123", "sandbox:m1:pub", "staging:m1:pub:priv", "sandbox::pub:priv"} {
+		_, _, _, _, err := ParseKey(key)
+		assert.Error(t, err, key)
+	}
+}
+
+func TestRegistered(t *testing.T) {
+	assert.Contains(t, backend.Registered(), Name)
+
+	clients, err := backend.New(Name, backend.Config{Key: "sandbox:m1:pub:priv"})
+	assert.NoError(t, err)
+	assert.NotNil(t, clients.Plan)
+	assert.NotNil(t, clients.Customer)
+	assert.NotNil(t, clients.Subscription)
+	assert.Nil(t, clients.Invoice)
+
+	_, err = backend.New(Name, backend.Config{Key: "sk_test_123"})
+	assert.Error(t, err)
+}
+
+func TestErrorResponses(t *testing.T) {
+	tt := []struct {
+		name    string
+		fixture string
+		status  int
+		want    pb.Error
+	}{
+		{name: "validation", fixture: "validation_error.xml", status: 422, want: pb.Error{
+			Type: pb.ErrorType_InvalidRequest, Message: "Email is an invalid format.", HttpStatusCode: 422, Param: "email",
+		}},
+		{name: "card validation", fixture: "card_validation_error.xml", status: 422, want: pb.Error{
+			Type: pb.ErrorType_Card, Message: "Credit card number is invalid.", HttpStatusCode: 422, Param: "number", Code: pb.CardErrors_InvalidNumber,
+		}},
+		{name: "processor declined", fixture: "processor_declined.xml", status: 422, want: pb.Error{
+			Type: pb.ErrorType_Card, Message: "Insufficient Funds", HttpStatusCode: 422, ChargeId: "txn1", Code: pb.CardErrors_Declined,
+		}},
+		{name: "gateway rejected", fixture: "gateway_rejected.xml", status: 422, want: pb.Error{
+			Type: pb.ErrorType_Card, Message: "Gateway Rejected: cvv", HttpStatusCode: 422, ChargeId: "ver1", Code: pb.CardErrors_IncorrectCvc,
+		}},
+		{name: "forbidden", status: 403, want: pb.Error{
+			Type: pb.ErrorType_Permission, Message: "The Braintree API keys are not authorized to perform this request.", HttpStatusCode: 403,
+		}},
+		{name: "rate limited", status: 429, want: pb.Error{
+			Type: pb.ErrorType_RateLimit, Message: "Too many requests made to the Braintree API too quickly.", HttpStatusCode: 429,
+		}},
+		{name: "server error", status: 503, want: pb.Error{
+			Type: pb.ErrorType_API, Message: "Braintree API request failed with status 503.", HttpStatusCode: 503,
+		}},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			gw, _, done := newTestGateway(t, map[string]route{
+				"GET /customers/cus1": {status: tc.status, fixture: tc.fixture},
+			})
+			defer done()
+			err := gw.do(context.Background(), http.MethodGet, "/customers/cus1", nil, nil, false)
+			assert.Equal(t, &tc.want, err)
+		})
+	}
+}
+
+func TestProcessorCardErrors(t *testing.T) {
+	tt := []struct {
+		result processorResult
+		want   pb.CardErrors
+	}{
+		{processorResult{Status: "processor_declined", ProcessorResponseCode: "2000"}, pb.CardErrors_Declined},
+		{processorResult{Status: "processor_declined", ProcessorResponseCode: "2004"}, pb.CardErrors_Expired},
+		{processorResult{Status: "processor_declined", ProcessorResponseCode: "2005"}, pb.CardErrors_InvalidNumber},
+		{processorResult{Status: "processor_declined", ProcessorResponseCode: "2010"}, pb.CardErrors_IncorrectCvc},
+		{processorResult{Status: "processor_declined", ProcessorResponseCode: "2059"}, pb.CardErrors_IncorrectZip},
+		{processorResult{Status: "processor_declined", ProcessorResponseCode: "2046"}, pb.CardErrors_Declined},
+		{processorResult{Status: "failed", ProcessorResponseCode: "3000"}, pb.CardErrors_ProcessingError},
+		{processorResult{Status: "gateway_rejected", GatewayRejectionReason: "avs"}, pb.CardErrors_IncorrectZip},
+		{processorResult{Status: "gateway_rejected", GatewayRejectionReason: "fraud"}, pb.CardErrors_Declined},
+	}
+	for _, tc := range tt {
+		assert.Equal(t, tc.want, processorToCardError(&tc.result), tc.result.ProcessorResponseCode+tc.result.GatewayRejectionReason)
+	}
+}
+
+func TestRetry(t *testing.T) {
+	gw, tg, done := newTestGateway(t, map[string]route{
+		"GET /plans/gold": {status: 503},
+		"POST /plans":     {status: 503},
+		"PUT /plans/gold": {status: 422, fixture: "validation_error.xml"},
+	})
+	defer done()
+	ctx := context.Background()
+
+	err := gw.do(ctx, http.MethodGet, "/plans/gold", nil, nil, true)
+	assert.Equal(t, int32(503), err.(*pb.Error).HttpStatusCode)
+	assert.Len(t, tg.sent(), 3, "reads are retried up to the maximum attempts")
+
+	tg.requests = nil
+	err = gw.do(ctx, http.MethodPost, "/plans", nil, nil, false)
+	assert.Error(t, err)
+	assert.Len(t, tg.sent(), 1, "creates are not retried")
+
+	tg.requests = nil
+	err = gw.do(ctx, http.MethodPut, "/plans/gold", nil, nil, true)
+	assert.Equal(t, pb.ErrorType_InvalidRequest, err.(*pb.Error).Type)
+	assert.Len(t, tg.sent(), 1, "invalid requests are not retried")
+
+	tg.requests = nil
+	gw.opts.retry = backend.RetryPolicy{MaxElapsed: time.Second, MaxAttempts: 1}
+	err = gw.do(ctx, http.MethodGet, "/plans/gold", nil, nil, true)
+	assert.Equal(t, int32(503), err.(*pb.Error).HttpStatusCode)
+	assert.Len(t, tg.sent(), 1, "a single attempt is never retried")
+}
+
+func TestAuthentication(t *testing.T) {
+	srv := httptest.NewServer(&testGateway{t: t})
+	defer srv.Close()
+
+	gw := NewGateway(Environment(srv.URL), "merchant", "public", "wrong", nil)
+	err := gw.do(context.Background(), http.MethodGet, "/plans", nil, nil, true)
+	assert.Equal(t, pb.ErrorType_Authentication, err.(*pb.Error).Type)
+}
+
+func TestMoney(t *testing.T) {
+	for amount, want := range map[uint64]string{2000: "20.00", 5: "0.05"} {
+		price, pbErr := amountToPrice(amount, pb.Currency_USD)
+		assert.Nil(t, pbErr)
+		assert.Equal(t, want, price)
+	}
+	price, pbErr := amountToPrice(1200, pb.Currency_JPY)
+	assert.Nil(t, pbErr)
+	assert.Equal(t, "1200", price)
+	_, pbErr = amountToPrice(math.MaxUint64, pb.Currency_USD)
+	assert.Equal(t, "amount", pbErr.GetParam())
+
+	for price, want := range map[string]uint64{"20.00": 2000, "25.5": 2550, "7": 700, "0.05": 5} {
+		amount, err := priceToAmount(price, pb.Currency_USD)
+		assert.NoError(t, err)
+		assert.Equal(t, want, amount, price)
+	}
+	amount, err := priceToAmount("12000", pb.Currency_JPY)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(12000), amount)
+
+	_, err = priceToAmount("1.005", pb.Currency_USD)
+	assert.Error(t, err)
+	_, err = priceToAmount("12000.50", pb.Currency_JPY)
+	assert.Error(t, err)
+}
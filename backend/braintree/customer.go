@@ -0,0 +1,134 @@
+package braintree
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/BTBurke/recur/backend"
+	"github.com/BTBurke/recur/pb"
+	context "golang.org/x/net/context"
+)
+
+type BraintreeCustomerClient struct {
+	gw *Gateway
+}
+
+func NewCustomerClient(gw *Gateway) *BraintreeCustomerClient {
+	return &BraintreeCustomerClient{gw: gw}
+}
+
+func (c *BraintreeCustomerClient) Create(ctx context.Context, req *pb.CreateCustomerRequest) (*pb.CustomerResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	in, pbErr := customerCreateToXML(req)
+	if pbErr != nil {
+		return respToCustomerError(pbErr), nil
+	}
+
+	out := new(customerXML)
+	err := c.gw.do(ctx, http.MethodPost, "/customers", in, out, false)
+	return c.customerResponse(out, err)
+}
+
+func (c *BraintreeCustomerClient) Update(ctx context.Context, req *pb.UpdateCustomerRequest) (*pb.CustomerResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	in, pbErr := customerUpdateToXML(req)
+	if pbErr != nil {
+		return respToCustomerError(pbErr), nil
+	}
+
+	out := new(customerXML)
+	err := c.gw.do(ctx, http.MethodPut, "/customers/"+url.PathEscape(req.Id), in, out, true)
+	return c.customerResponse(out, notFound(err, "customer", req.Id))
+}
+
+func (c *BraintreeCustomerClient) Delete(ctx context.Context, req *pb.DeleteCustomerRequest) (*pb.DeleteCustomerResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	err := notFound(c.gw.do(ctx, http.MethodDelete, "/customers/"+url.PathEscape(req.Id), nil, nil, true), "customer", req.Id)
+	if pbErr, ok := err.(*pb.Error); ok {
+		return &pb.DeleteCustomerResponse{Responses: &pb.DeleteCustomerResponse_Error{Error: pbErr}}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DeleteCustomerResponse{Responses: &pb.DeleteCustomerResponse_Success{
+		Success: &pb.DeleteCustomerSuccess{Deleted: true, Id: req.Id},
+	}}, nil
+}
+
+func (c *BraintreeCustomerClient) Get(ctx context.Context, req *pb.GetCustomerRequest) (*pb.CustomerResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	out := new(customerXML)
+	err := c.gw.do(ctx, http.MethodGet, "/customers/"+url.PathEscape(req.Id), nil, out, true)
+	return c.customerResponse(out, notFound(err, "customer", req.Id))
+}
+
+// customerResponse converts the result of a customer request to a CustomerResponse.  Braintree
+// errors are returned in the response.
+func (c *BraintreeCustomerClient) customerResponse(out *customerXML, err error) (*pb.CustomerResponse, error) {
+	if pbErr, ok := err.(*pb.Error); ok {
+		return respToCustomerError(pbErr), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CustomerResponse{Responses: &pb.CustomerResponse_Success{
+		Success: xmlToCustomer(out, c.gw.livemode),
+	}}, nil
+}
+
+func respToCustomerError(err *pb.Error) *pb.CustomerResponse {
+	return &pb.CustomerResponse{Responses: &pb.CustomerResponse_Error{Error: err}}
+}
+
+// customerStreamer implements the CustomerStreamer interface
+type customerStreamer struct {
+	*listIter
+}
+
+func (s *customerStreamer) Current() *pb.CustomerResponse {
+	if e := s.errorResponse(); e != nil {
+		return respToCustomerError(e)
+	}
+	return &pb.CustomerResponse{Responses: &pb.CustomerResponse_Success{Success: s.cur.(*pb.Customer)}}
+}
+
+// List returns customers in the order of Braintree's search results
+func (c *BraintreeCustomerClient) List(ctx context.Context, req *pb.ListCustomersRequest) (backend.CustomerStreamer, error) {
+	ids, err := c.gw.searchIDs(ctx, "customers", &searchRequest{CreatedAt: createdCriteria(req.GetCreated())})
+	if pbErr, ok := err.(*pb.Error); ok {
+		return &customerStreamer{&listIter{ctx: ctx, err: pbErr}}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &customerStreamer{newListIter(ctx, ids, req.GetStartingAfter(), req.GetEndingBefore(), req.GetLimit(), c.fetch)}, nil
+}
+
+// fetch returns a page of customers in the order of the ids
+func (c *BraintreeCustomerClient) fetch(ctx context.Context, ids []string) ([]interface{}, error) {
+	var out customersXML
+	if err := c.gw.do(ctx, http.MethodPost, "/customers/advanced_search", &searchRequest{IDs: newSearchArray(ids)}, &out, true); err != nil {
+		return nil, err
+	}
+	byID := make(map[string]*customerXML, len(out.Customers))
+	for i := range out.Customers {
+		byID[out.Customers[i].ID] = &out.Customers[i]
+	}
+	page := make([]interface{}, 0, len(ids))
+	for _, id := range ids {
+		if cust, ok := byID[id]; ok {
+			page = append(page, xmlToCustomer(cust, c.gw.livemode))
+		}
+	}
+	return page, nil
+}
@@ -0,0 +1,94 @@
+package braintree
+
+import (
+	"testing"
+
+	"github.com/BTBurke/recur/pb"
+	"github.com/stretchr/testify/assert"
+	context "golang.org/x/net/context"
+)
+
+func TestCustomerCreate(t *testing.T) {
+	gw, tg, done := newTestGateway(t, map[string]route{
+		"POST /customers": {status: 201, fixture: "customer.xml"},
+	})
+	defer done()
+
+	resp, err := NewCustomerClient(gw).Create(context.Background(), &pb.CreateCustomerRequest{
+		Email:    "jane@example.com",
+		Metadata: map[string]string{"account_tier": "enterprise"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, &pb.Customer{
+		Id:            "cus1",
+		Created:       1502439300,
+		DefaultSource: "tok1",
+		Email:         "jane@example.com",
+		Metadata:      map[string]string{"account_tier": "enterprise"},
+	}, resp.GetSuccess())
+	assert.Contains(t, tg.body("POST /customers"), "<custom-fields><account-tier>enterprise</account-tier></custom-fields>")
+}
+
+func TestCustomerCreateInvalid(t *testing.T) {
+	gw, tg, done := newTestGateway(t, map[string]route{
+		"POST /customers": {status: 422, fixture: "validation_error.xml"},
+	})
+	defer done()
+	c := NewCustomerClient(gw)
+
+	resp, err := c.Create(context.Background(), &pb.CreateCustomerRequest{Email: "not-an-email"})
+	assert.NoError(t, err)
+	assert.Equal(t, "email", resp.GetError().GetParam())
+	assert.Equal(t, "Email is an invalid format.", resp.GetError().GetMessage())
+
+	for param, req := range map[string]*pb.CreateCustomerRequest{
+		"account_balance": {AccountBalance: 100},
+		"description":     {Description: "vip"},
+		"business_vat_id": {BusinessVatId: "GB123"},
+		"metadata":        {Metadata: map[string]string{"not a field": "x"}},
+	} {
+		resp, err := c.Create(context.Background(), req)
+		assert.NoError(t, err)
+		assert.Equal(t, param, resp.GetError().GetParam())
+	}
+	assert.Len(t, tg.sent(), 1)
+}
+
+func TestCustomerDelete(t *testing.T) {
+	gw, _, done := newTestGateway(t, map[string]route{
+		"DELETE /customers/cus1": {},
+	})
+	defer done()
+	c := NewCustomerClient(gw)
+
+	resp, err := c.Delete(context.Background(), &pb.DeleteCustomerRequest{Id: "cus1"})
+	assert.NoError(t, err)
+	assert.Equal(t, &pb.DeleteCustomerSuccess{Deleted: true, Id: "cus1"}, resp.GetSuccess())
+
+	resp, err = c.Delete(context.Background(), &pb.DeleteCustomerRequest{Id: "cus9"})
+	assert.NoError(t, err)
+	assert.Equal(t, "No such customer: cus9", resp.GetError().GetMessage())
+}
+
+func TestCustomerList(t *testing.T) {
+	gw, tg, done := newTestGateway(t, map[string]route{
+		"POST /customers/advanced_search_ids": {fixture: "customer_search_ids.xml"},
+		"POST /customers/advanced_search":     {fixture: "customers.xml"},
+	})
+	defer done()
+
+	s, err := NewCustomerClient(gw).List(context.Background(), &pb.ListCustomersRequest{
+		Created: &pb.ListFilter{Gt: 1502409600},
+		Limit:   3,
+	})
+	assert.NoError(t, err)
+	var ids []string
+	for s.Next() {
+		ids = append(ids, s.Current().GetSuccess().GetId())
+	}
+	assert.NoError(t, s.Err())
+	assert.Equal(t, []string{"cus1", "cus2"}, ids, "customers are returned in search order and deleted ones skipped")
+
+	assert.Contains(t, tg.body("POST /customers/advanced_search_ids"), `<created-at><min type="datetime">2017-08-11T00:00:01Z</min></created-at>`)
+	assert.Contains(t, tg.body("POST /customers/advanced_search"), `<ids type="array"><item>cus1</item><item>cus2</item><item>cus3</item></ids>`)
+}
@@ -0,0 +1,161 @@
+package braintree
+
+import (
+	"encoding/xml"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/BTBurke/recur/pb"
+)
+
+type customerXML struct {
+	XMLName        xml.Name           `xml:"customer"`
+	ID             string             `xml:"id,omitempty"`
+	Email          string             `xml:"email,omitempty"`
+	CustomFields   customFields       `xml:"custom-fields,omitempty"`
+	CreatedAt      *xmlValue          `xml:"created-at,omitempty"`
+	CreditCards    []paymentMethodXML `xml:"credit-cards>credit-card,omitempty"`
+	PayPalAccounts []paymentMethodXML `xml:"paypal-accounts>paypal-account,omitempty"`
+}
+
+type customersXML struct {
+	XMLName   xml.Name      `xml:"customers"`
+	Customers []customerXML `xml:"customer"`
+}
+
+// paymentMethodXML holds the fields shared by every type of Braintree payment method.  Each
+// payment method lists the subscriptions charged to it.
+type paymentMethodXML struct {
+	Token         string            `xml:"token"`
+	Default       *xmlValue         `xml:"default"`
+	CustomerID    string            `xml:"customer-id"`
+	Subscriptions []subscriptionXML `xml:"subscriptions>subscription"`
+}
+
+func (c *customerXML) paymentMethods() []paymentMethodXML {
+	return append(append([]paymentMethodXML{}, c.CreditCards...), c.PayPalAccounts...)
+}
+
+// defaultToken returns the token of the customer's default payment method, if any
+func (c *customerXML) defaultToken() string {
+	for _, pm := range c.paymentMethods() {
+		if pm.Default.bool() {
+			return pm.Token
+		}
+	}
+	return ""
+}
+
+// customFields holds custom fields, which are stored as elements named after the field.
+// Braintree names fields in snake case and dasherizes the names in XML.
+type customFields map[string]string
+
+func (f customFields) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if len(f) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(f))
+	for k := range f {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, k := range keys {
+		el := xml.StartElement{Name: xml.Name{Local: strings.Replace(k, "_", "-", -1)}}
+		if err := e.EncodeElement(f[k], el); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+func (f *customFields) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	fields := make(customFields)
+	for {
+		tok, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			var v string
+			if err := d.DecodeElement(&v, &t); err != nil {
+				return err
+			}
+			fields[strings.Replace(t.Name.Local, "-", "_", -1)] = v
+		case xml.EndElement:
+			*f = fields
+			return nil
+		}
+	}
+}
+
+// validFieldName reports whether a metadata key can be stored as a Braintree custom field
+func validFieldName(k string) bool {
+	if len(k) == 0 {
+		return false
+	}
+	for _, r := range k {
+		if !(r == '_' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9') {
+			return false
+		}
+	}
+	return true
+}
+
+// checkCustomer rejects customer fields that Braintree cannot store.  Metadata is stored in
+// custom fields, which must be created in the Braintree control panel before use.
+func checkCustomer(balance int64, description string, vatID string, metadata map[string]string) *pb.Error {
+	switch {
+	case balance != 0:
+		return errInvalid("account_balance", "Braintree customers do not have an account balance.")
+	case len(description) > 0:
+		return errInvalid("description", "Braintree customers do not support a description.")
+	case len(vatID) > 0:
+		return errInvalid("business_vat_id", "Braintree customers do not support a VAT ID.")
+	}
+	for k := range metadata {
+		if !validFieldName(k) {
+			return errInvalid("metadata", fmt.Sprintf("Metadata key %q is not a valid Braintree custom field name.", k))
+		}
+	}
+	return nil
+}
+
+// convert from a customer create request to a Braintree customer
+func customerCreateToXML(req *pb.CreateCustomerRequest) (*customerXML, *pb.Error) {
+	if err := checkCustomer(req.AccountBalance, req.Description, req.BusinessVatId, req.Metadata); err != nil {
+		return nil, err
+	}
+	return &customerXML{
+		Email:        req.Email,
+		CustomFields: req.Metadata,
+	}, nil
+}
+
+// convert from a customer update request to a Braintree customer
+func customerUpdateToXML(req *pb.UpdateCustomerRequest) (*customerXML, *pb.Error) {
+	if err := checkCustomer(req.AccountBalance, req.Description, req.BusinessVatId, req.Metadata); err != nil {
+		return nil, err
+	}
+	return &customerXML{
+		Email:        req.Email,
+		CustomFields: req.Metadata,
+	}, nil
+}
+
+// convert a Braintree customer to a pb.Customer.  Braintree customers have no currency; charges
+// are made in the currency of the merchant account.
+func xmlToCustomer(c *customerXML, livemode bool) *pb.Customer {
+	return &pb.Customer{
+		Id:            c.ID,
+		Created:       c.CreatedAt.unix(),
+		DefaultSource: c.defaultToken(),
+		Email:         c.Email,
+		Livemode:      livemode,
+		Metadata:      c.CustomFields,
+	}
+}
@@ -0,0 +1,207 @@
+package braintree
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/BTBurke/recur/pb"
+)
+
+// apiErrorResponse is returned by the gateway when a request fails validation or a charge is
+// declined.  Validation errors are nested under the resource they belong to, so they are collected
+// from anywhere below the errors element.
+type apiErrorResponse struct {
+	XMLName      xml.Name         `xml:"api-error-response"`
+	Message      string           `xml:"message"`
+	Errors       validationErrors `xml:"errors"`
+	Transaction  *processorResult `xml:"transaction"`
+	Verification *processorResult `xml:"verification"`
+}
+
+type validationError struct {
+	Code      string `xml:"code"`
+	Attribute string `xml:"attribute"`
+	Message   string `xml:"message"`
+}
+
+type validationErrors []validationError
+
+func (v *validationErrors) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for depth := 1; depth > 0; {
+		tok, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			if t.Name.Local != "error" {
+				depth++
+				continue
+			}
+			var e validationError
+			if err := d.DecodeElement(&e, &t); err != nil {
+				return err
+			}
+			*v = append(*v, e)
+		case xml.EndElement:
+			depth--
+		}
+	}
+	return nil
+}
+
+// processorResult is the outcome of a transaction or card verification that the gateway attempted
+// before failing the request
+type processorResult struct {
+	ID                     string `xml:"id"`
+	Status                 string `xml:"status"`
+	ProcessorResponseCode  string `xml:"processor-response-code"`
+	ProcessorResponseText  string `xml:"processor-response-text"`
+	GatewayRejectionReason string `xml:"gateway-rejection-reason"`
+}
+
+func (r *processorResult) failed() bool {
+	return r != nil && (r.Status == "processor_declined" || r.Status == "gateway_rejected" || r.Status == "failed")
+}
+
+// respToError converts an error response from the gateway to a pb.Error
+func respToError(status int, body []byte) *pb.Error {
+	var resp apiErrorResponse
+	if status != http.StatusUnprocessableEntity || xml.Unmarshal(body, &resp) != nil {
+		return statusToError(status)
+	}
+
+	for _, r := range []*processorResult{resp.Transaction, resp.Verification} {
+		if !r.failed() {
+			continue
+		}
+		msg := r.ProcessorResponseText
+		if len(msg) == 0 {
+			msg = resp.Message
+		}
+		return &pb.Error{
+			Type:           pb.ErrorType_Card,
+			ChargeId:       r.ID,
+			Message:        msg,
+			HttpStatusCode: int32(status),
+			Code:           processorToCardError(r),
+		}
+	}
+
+	pbErr := &pb.Error{
+		Type:           pb.ErrorType_InvalidRequest,
+		Message:        resp.Message,
+		HttpStatusCode: int32(status),
+	}
+	if len(resp.Errors) > 0 {
+		first := resp.Errors[0]
+		pbErr.Param = first.Attribute
+		if code, ok := validationCardErrors[first.Code]; ok {
+			pbErr.Type = pb.ErrorType_Card
+			pbErr.Code = code
+		}
+	}
+	return pbErr
+}
+
+// statusToError converts an HTTP status without an error body to a pb.Error
+func statusToError(status int) *pb.Error {
+	pbErr := &pb.Error{HttpStatusCode: int32(status)}
+	switch {
+	case status == http.StatusUnauthorized:
+		pbErr.Type = pb.ErrorType_Authentication
+		pbErr.Message = "Braintree authentication failed, check the public and private keys."
+	case status == http.StatusForbidden:
+		pbErr.Type = pb.ErrorType_Permission
+		pbErr.Message = "The Braintree API keys are not authorized to perform this request."
+	case status == http.StatusNotFound:
+		pbErr.Type = pb.ErrorType_InvalidRequest
+		pbErr.Message = "Resource not found."
+		pbErr.Param = "id"
+	case status == http.StatusTooManyRequests:
+		pbErr.Type = pb.ErrorType_RateLimit
+		pbErr.Message = "Too many requests made to the Braintree API too quickly."
+	default:
+		pbErr.Type = pb.ErrorType_API
+		pbErr.Message = fmt.Sprintf("Braintree API request failed with status %d.", status)
+	}
+	return pbErr
+}
+
+// notFound replaces a generic not found error with one naming the resource, in the same form as
+// Stripe.  Other errors are returned unchanged.
+func notFound(err error, resource string, id string) error {
+	if pbErr, ok := err.(*pb.Error); ok && pbErr.HttpStatusCode == http.StatusNotFound {
+		return &pb.Error{
+			Type:           pb.ErrorType_InvalidRequest,
+			Message:        fmt.Sprintf("No such %s: %s", resource, id),
+			HttpStatusCode: http.StatusNotFound,
+			Param:          "id",
+		}
+	}
+	return err
+}
+
+// errInvalid is returned for invalid requests, including those Braintree has no equivalent for
+func errInvalid(param string, msg string) *pb.Error {
+	return &pb.Error{
+		Type:           pb.ErrorType_InvalidRequest,
+		Message:        msg,
+		HttpStatusCode: http.StatusBadRequest,
+		Param:          param,
+	}
+}
+
+// validation errors on credit card fields that Stripe reports as card errors
+var validationCardErrors = map[string]pb.CardErrors{
+	"81707": pb.CardErrors_InvalidCvc,
+	"81709": pb.CardErrors_Missing,
+	"81712": pb.CardErrors_InvalidExpirationMonth,
+	"81713": pb.CardErrors_InvalidExpirationYear,
+	"81714": pb.CardErrors_Missing,
+	"81715": pb.CardErrors_InvalidNumber,
+	"81716": pb.CardErrors_InvalidNumber,
+	"81717": pb.CardErrors_InvalidNumber,
+	"81736": pb.CardErrors_InvalidCvc,
+}
+
+// processor response codes with a more specific card error than a decline
+var processorCardErrors = map[int]pb.CardErrors{
+	2004: pb.CardErrors_Expired,
+	2005: pb.CardErrors_InvalidNumber,
+	2006: pb.CardErrors_InvalidExpirationMonth,
+	2007: pb.CardErrors_IncorrectNumber,
+	2008: pb.CardErrors_InvalidNumber,
+	2010: pb.CardErrors_IncorrectCvc,
+	2059: pb.CardErrors_IncorrectZip,
+	2060: pb.CardErrors_IncorrectCvc,
+}
+
+// processorToCardError maps a declined transaction or verification to a card error.  Processor
+// codes from 2000 to 2999 are declines and 3000 and above are processor failures.  Gateway
+// rejections are made by the merchant's fraud rules rather than the issuer.
+func processorToCardError(r *processorResult) pb.CardErrors {
+	if r.Status == "gateway_rejected" {
+		switch r.GatewayRejectionReason {
+		case "cvv", "avs_and_cvv":
+			return pb.CardErrors_IncorrectCvc
+		case "avs":
+			return pb.CardErrors_IncorrectZip
+		default:
+			return pb.CardErrors_Declined
+		}
+	}
+	code, err := strconv.Atoi(r.ProcessorResponseCode)
+	switch {
+	case err != nil:
+		return pb.CardErrors_ProcessingError
+	case processorCardErrors[code] != pb.CardErrors_None:
+		return processorCardErrors[code]
+	case code >= 3000:
+		return pb.CardErrors_ProcessingError
+	default:
+		return pb.CardErrors_Declined
+	}
+}
@@ -0,0 +1,227 @@
+package braintree
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/BTBurke/recur/pb"
+	context "golang.org/x/net/context"
+)
+
+const defaultLimit = 10
+
+// searchRequest holds the criteria of an advanced search.  Braintree returns the ids of every
+// match at once; the records are then fetched by id a page at a time.
+type searchRequest struct {
+	XMLName   xml.Name       `xml:"search"`
+	IDs       *searchArray   `xml:"ids,omitempty"`
+	CreatedAt *rangeCriteria `xml:"created-at,omitempty"`
+	PlanID    *textCriteria  `xml:"plan-id,omitempty"`
+	Status    *searchArray   `xml:"status,omitempty"`
+}
+
+type searchArray struct {
+	Type  string   `xml:"type,attr"`
+	Items []string `xml:"item"`
+}
+
+func newSearchArray(items []string) *searchArray {
+	return &searchArray{Type: "array", Items: items}
+}
+
+type textCriteria struct {
+	Is string `xml:"is"`
+}
+
+type rangeCriteria struct {
+	Min *xmlTime `xml:"min,omitempty"`
+	Max *xmlTime `xml:"max,omitempty"`
+}
+
+type xmlTime struct {
+	Type  string `xml:"type,attr"`
+	Value string `xml:",chardata"`
+}
+
+func newXMLTime(unix int64) *xmlTime {
+	return &xmlTime{Type: "datetime", Value: time.Unix(unix, 0).UTC().Format(time.RFC3339)}
+}
+
+// createdCriteria converts a list filter to an inclusive range on created-at
+func createdCriteria(f *pb.ListFilter) *rangeCriteria {
+	if f == nil {
+		return nil
+	}
+	var r rangeCriteria
+	switch {
+	case f.Gte != 0:
+		r.Min = newXMLTime(f.Gte)
+	case f.Gt != 0:
+		r.Min = newXMLTime(f.Gt + 1)
+	}
+	switch {
+	case f.Lte != 0:
+		r.Max = newXMLTime(f.Lte)
+	case f.Lt != 0:
+		r.Max = newXMLTime(f.Lt - 1)
+	}
+	if r.Min == nil && r.Max == nil {
+		return nil
+	}
+	return &r
+}
+
+// inRange checks a created timestamp against a list filter
+func inRange(created int64, f *pb.ListFilter) bool {
+	switch {
+	case f == nil:
+		return true
+	case f.Gt != 0 && created <= f.Gt:
+		return false
+	case f.Gte != 0 && created < f.Gte:
+		return false
+	case f.Lt != 0 && created >= f.Lt:
+		return false
+	case f.Lte != 0 && created > f.Lte:
+		return false
+	default:
+		return true
+	}
+}
+
+type searchResults struct {
+	XMLName xml.Name `xml:"search-results"`
+	IDs     []string `xml:"ids>item"`
+}
+
+// searchIDs returns the ids of the resources matching the search, in the order returned by
+// Braintree
+func (g *Gateway) searchIDs(ctx context.Context, resource string, search *searchRequest) ([]string, error) {
+	var results searchResults
+	if err := g.do(ctx, http.MethodPost, "/"+resource+"/advanced_search_ids", search, &results, true); err != nil {
+		return nil, err
+	}
+	return results.IDs, nil
+}
+
+// listIter pages through a list of ids, fetching up to limit records at a time after (or
+// before) the cursor, in the same way as the Stripe iterators.  Iteration stops at the first
+// error, when the context is done, or when the stream is closed.
+type listIter struct {
+	ctx   context.Context
+	ids   []string
+	limit int
+	fetch func(ctx context.Context, ids []string) ([]interface{}, error)
+
+	buf    []interface{}
+	cur    interface{}
+	err    error
+	closed bool
+}
+
+// newListIter positions the iterator at the cursor.  When moving backward from ending_before, the
+// records before the cursor are returned nearest first.
+func newListIter(ctx context.Context, ids []string, start string, end string, limit int32, fetch func(ctx context.Context, ids []string) ([]interface{}, error)) *listIter {
+	l := &listIter{ctx: ctx, limit: int(limit), fetch: fetch}
+	if l.limit <= 0 {
+		l.limit = defaultLimit
+	}
+	switch {
+	case len(end) > 0:
+		idx := indexOf(ids, end)
+		if idx < 0 {
+			l.err = errInvalidCursor("ending_before", end)
+			return l
+		}
+		for i := idx - 1; i >= 0; i-- {
+			l.ids = append(l.ids, ids[i])
+		}
+	case len(start) > 0:
+		idx := indexOf(ids, start)
+		if idx < 0 {
+			l.err = errInvalidCursor("starting_after", start)
+			return l
+		}
+		l.ids = ids[idx+1:]
+	default:
+		l.ids = ids
+	}
+	return l
+}
+
+func (l *listIter) Next() bool {
+	if l.closed || l.err != nil {
+		return false
+	}
+	if err := l.ctx.Err(); err != nil {
+		l.err = err
+		return false
+	}
+	// records deleted since the search are missing from their page, so keep fetching until a
+	// page has records or the ids run out
+	for len(l.buf) == 0 && len(l.ids) > 0 {
+		n := l.limit
+		if n > len(l.ids) {
+			n = len(l.ids)
+		}
+		page, err := l.fetch(l.ctx, l.ids[:n])
+		if err != nil {
+			l.err = err
+			return false
+		}
+		l.ids = l.ids[n:]
+		l.buf = page
+	}
+	if len(l.buf) == 0 {
+		return false
+	}
+	l.cur = l.buf[0]
+	l.buf = l.buf[1:]
+	return true
+}
+
+// Err returns the error that stopped iteration, or nil if the list was exhausted.  Errors from
+// the Braintree API are returned as a *pb.Error.
+func (l *listIter) Err() error {
+	return l.err
+}
+
+// Close stops iteration.  No further pages are fetched.
+func (l *listIter) Close() {
+	l.closed = true
+}
+
+// errorResponse converts the iteration error to a pb.Error, or returns nil if there is no error
+func (l *listIter) errorResponse() *pb.Error {
+	switch err := l.err.(type) {
+	case nil:
+		return nil
+	case *pb.Error:
+		return err
+	default:
+		return &pb.Error{
+			Type:    pb.ErrorType_Unknown,
+			Message: err.Error(),
+		}
+	}
+}
+
+func indexOf(ids []string, id string) int {
+	for i, v := range ids {
+		if v == id {
+			return i
+		}
+	}
+	return -1
+}
+
+func errInvalidCursor(param string, id string) *pb.Error {
+	return &pb.Error{
+		Type:           pb.ErrorType_InvalidRequest,
+		Message:        fmt.Sprintf("Invalid %s: object %s not found in list", param, id),
+		HttpStatusCode: http.StatusBadRequest,
+		Param:          param,
+	}
+}
@@ -0,0 +1,30 @@
+package braintree
+
+import (
+	"fmt"
+
+	"github.com/BTBurke/recur/money"
+	"github.com/BTBurke/recur/pb"
+)
+
+// amountToPrice formats an amount in the minor unit of the currency, as used by Stripe, as the
+// decimal price Braintree expects
+func amountToPrice(amount uint64, currency pb.Currency) (string, *pb.Error) {
+	m, err := money.FromUnsigned(amount, currency)
+	if err != nil {
+		return "", errInvalid("amount", "Amount is too large.")
+	}
+	return m.Decimal(), nil
+}
+
+// priceToAmount parses a decimal price from Braintree into the minor unit of the currency
+func priceToAmount(price string, currency pb.Currency) (uint64, error) {
+	m, err := money.Parse(price, currency)
+	if err != nil {
+		return 0, fmt.Errorf("braintree: %v", err)
+	}
+	if m.Amount < 0 {
+		return 0, fmt.Errorf("braintree: negative price %q", price)
+	}
+	return uint64(m.Amount), nil
+}
@@ -0,0 +1,146 @@
+package braintree
+
+import (
+	"net/http"
+	"net/url"
+	"sort"
+
+	"github.com/BTBurke/recur/backend"
+	"github.com/BTBurke/recur/pb"
+	context "golang.org/x/net/context"
+)
+
+type BraintreePlanClient struct {
+	gw *Gateway
+}
+
+func NewPlanClient(gw *Gateway) *BraintreePlanClient {
+	return &BraintreePlanClient{gw: gw}
+}
+
+func (p *BraintreePlanClient) Create(ctx context.Context, req *pb.CreatePlanRequest) (*pb.PlanResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	in, pbErr := planCreateToXML(req)
+	if pbErr != nil {
+		return respToPlanError(pbErr), nil
+	}
+
+	out := new(planXML)
+	err := p.gw.do(ctx, http.MethodPost, "/plans", in, out, false)
+	return p.planResponse(out, err)
+}
+
+func (p *BraintreePlanClient) Update(ctx context.Context, req *pb.UpdatePlanRequest) (*pb.PlanResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	in, pbErr := planUpdateToXML(req)
+	if pbErr != nil {
+		return respToPlanError(pbErr), nil
+	}
+
+	out := new(planXML)
+	err := p.gw.do(ctx, http.MethodPut, "/plans/"+url.PathEscape(req.Id), in, out, true)
+	return p.planResponse(out, notFound(err, "plan", req.Id))
+}
+
+// Delete always fails because Braintree plans cannot be deleted.  Plans that should no longer
+// be used must be retired in the Braintree control panel.
+func (p *BraintreePlanClient) Delete(ctx context.Context, req *pb.DeletePlanRequest) (*pb.DeletePlanResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	return &pb.DeletePlanResponse{Responses: &pb.DeletePlanResponse_Error{
+		Error: errInvalid("id", "Braintree plans cannot be deleted."),
+	}}, nil
+}
+
+func (p *BraintreePlanClient) Get(ctx context.Context, req *pb.GetPlanRequest) (*pb.PlanResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	out := new(planXML)
+	err := p.gw.do(ctx, http.MethodGet, "/plans/"+url.PathEscape(req.Id), nil, out, true)
+	return p.planResponse(out, notFound(err, "plan", req.Id))
+}
+
+// planResponse converts the result of a plan request to a PlanResponse.  Braintree errors are
+// returned in the response.
+func (p *BraintreePlanClient) planResponse(out *planXML, err error) (*pb.PlanResponse, error) {
+	if pbErr, ok := err.(*pb.Error); ok {
+		return respToPlanError(pbErr), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	plan, err := xmlToPlan(out, p.gw.livemode)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.PlanResponse{Responses: &pb.PlanResponse_Success{Success: plan}}, nil
+}
+
+func respToPlanError(err *pb.Error) *pb.PlanResponse {
+	return &pb.PlanResponse{Responses: &pb.PlanResponse_Error{Error: err}}
+}
+
+// planStreamer implements the PlanStreamer interface
+type planStreamer struct {
+	*listIter
+}
+
+func (s *planStreamer) Current() *pb.PlanResponse {
+	if e := s.errorResponse(); e != nil {
+		return respToPlanError(e)
+	}
+	return &pb.PlanResponse{Responses: &pb.PlanResponse_Success{Success: s.cur.(*pb.Plan)}}
+}
+
+// List returns plans newest first.  Braintree returns every plan in a single response, so the
+// created filter and paging are applied to the complete list.  Braintree plans are always active
+// and never belong to a product, so filtering by product or for inactive plans returns no plans.
+func (p *BraintreePlanClient) List(ctx context.Context, req *pb.ListPlansRequest) (backend.PlanStreamer, error) {
+	var out plansXML
+	err := p.gw.do(ctx, http.MethodGet, "/plans", nil, &out, true)
+	if pbErr, ok := err.(*pb.Error); ok {
+		return &planStreamer{&listIter{ctx: ctx, err: pbErr}}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var plans []*pb.Plan
+	if len(req.GetProduct()) > 0 || req.GetActive() == pb.ActiveState_ActiveFalse {
+		out.Plans = nil
+	}
+	for i := range out.Plans {
+		plan, err := xmlToPlan(&out.Plans[i], p.gw.livemode)
+		if err != nil {
+			return nil, err
+		}
+		if inRange(plan.Created, req.GetCreated()) {
+			plans = append(plans, plan)
+		}
+	}
+	sort.SliceStable(plans, func(i, j int) bool {
+		return plans[i].Created > plans[j].Created
+	})
+
+	byID := make(map[string]*pb.Plan, len(plans))
+	ids := make([]string, 0, len(plans))
+	for _, plan := range plans {
+		byID[plan.Id] = plan
+		ids = append(ids, plan.Id)
+	}
+	fetch := func(ctx context.Context, ids []string) ([]interface{}, error) {
+		page := make([]interface{}, 0, len(ids))
+		for _, id := range ids {
+			page = append(page, byID[id])
+		}
+		return page, nil
+	}
+	return &planStreamer{newListIter(ctx, ids, req.GetStartingAfter(), req.GetEndingBefore(), req.GetLimit(), fetch)}, nil
+}
@@ -0,0 +1,189 @@
+package braintree
+
+import (
+	"testing"
+
+	"github.com/BTBurke/recur/pb"
+	"github.com/stretchr/testify/assert"
+	context "golang.org/x/net/context"
+)
+
+func TestPlanCreate(t *testing.T) {
+	gw, tg, done := newTestGateway(t, map[string]route{
+		"POST /plans": {status: 201, fixture: "plan.xml"},
+	})
+	defer done()
+	c := NewPlanClient(gw)
+
+	resp, err := c.Create(context.Background(), &pb.CreatePlanRequest{
+		Id:              "gold",
+		Name:            "Gold",
+		Amount:          2000,
+		Currency:        pb.Currency_USD,
+		Interval:        pb.Interval_Month,
+		TrialPeriodDays: 14,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, &pb.Plan{
+		Id:              "gold",
+		Amount:          2000,
+		Created:         1502387687,
+		Currency:        pb.Currency_USD,
+		Interval:        pb.Interval_Month,
+		IntervalCount:   1,
+		Name:            "Gold",
+		TrialPeriodDays: 14,
+		Active:          true,
+	}, resp.GetSuccess())
+
+	body := tg.body("POST /plans")
+	for _, el := range []string{
+		"<id>gold</id>",
+		"<price>20.00</price>",
+		"<currency-iso-code>USD</currency-iso-code>",
+		`<billing-frequency type="integer">1</billing-frequency>`,
+		`<trial-period type="boolean">true</trial-period>`,
+		`<trial-duration type="integer">14</trial-duration>`,
+		"<trial-duration-unit>day</trial-duration-unit>",
+	} {
+		assert.Contains(t, body, el)
+	}
+}
+
+func TestPlanCreateUnsupported(t *testing.T) {
+	gw, tg, done := newTestGateway(t, nil)
+	defer done()
+	c := NewPlanClient(gw)
+
+	tt := []struct {
+		req   *pb.CreatePlanRequest
+		param string
+	}{
+		{&pb.CreatePlanRequest{Id: "p", Name: "P", Currency: pb.Currency_USD, Interval: pb.Interval_Week}, "interval"},
+		{&pb.CreatePlanRequest{Id: "p", Name: "P", Currency: pb.Currency_USD, Interval: pb.Interval_Month, Metadata: map[string]string{"a": "b"}}, "metadata"},
+		{&pb.CreatePlanRequest{Id: "p", Name: "P", Currency: pb.Currency_USD, Interval: pb.Interval_Month, StatementDescriptor: "ACME"}, "statement_descriptor"},
+		{&pb.CreatePlanRequest{Id: "p", Name: "P", Currency: pb.Currency_USD, Interval: pb.Interval_Month, BillingScheme: pb.BillingScheme_Tiered, TiersMode: pb.TiersMode_Volume, Tiers: []*pb.PlanTier{{UnitAmount: 100}}}, "billing_scheme"},
+		{&pb.CreatePlanRequest{Id: "p", Name: "P", Currency: pb.Currency_USD, Interval: pb.Interval_Month, UsageType: pb.UsageType_Metered}, "usage_type"},
+		{&pb.CreatePlanRequest{Id: "p", Name: "P", Currency: pb.Currency_USD, Interval: pb.Interval_Month, Product: "gold"}, "product"},
+	}
+	for _, tc := range tt {
+		resp, err := c.Create(context.Background(), tc.req)
+		assert.NoError(t, err)
+		assert.Equal(t, pb.ErrorType_InvalidRequest, resp.GetError().GetType())
+		assert.Equal(t, tc.param, resp.GetError().GetParam())
+	}
+	assert.Empty(t, tg.sent())
+}
+
+func TestPlanYearly(t *testing.T) {
+	req := &pb.CreatePlanRequest{Id: "p", Name: "P", Amount: 12000, Currency: pb.Currency_JPY, Interval: pb.Interval_Year, IntervalCount: 2}
+	p, pbErr := planCreateToXML(req)
+	assert.Nil(t, pbErr)
+	assert.Equal(t, "24", p.BillingFrequency.Value)
+	assert.Equal(t, "12000", p.Price)
+	assert.False(t, p.TrialPeriod.bool())
+}
+
+func TestPlanGetNotFound(t *testing.T) {
+	gw, _, done := newTestGateway(t, nil)
+	defer done()
+
+	resp, err := NewPlanClient(gw).Get(context.Background(), &pb.GetPlanRequest{Id: "missing"})
+	assert.NoError(t, err)
+	assert.Equal(t, &pb.Error{
+		Type:           pb.ErrorType_InvalidRequest,
+		Message:        "No such plan: missing",
+		HttpStatusCode: 404,
+		Param:          "id",
+	}, resp.GetError())
+}
+
+func TestPlanUpdate(t *testing.T) {
+	gw, tg, done := newTestGateway(t, map[string]route{
+		"PUT /plans/gold": {fixture: "plan.xml"},
+	})
+	defer done()
+
+	resp, err := NewPlanClient(gw).Update(context.Background(), &pb.UpdatePlanRequest{Id: "gold", Name: "Gold"})
+	assert.NoError(t, err)
+	assert.Equal(t, "gold", resp.GetSuccess().GetId())
+	assert.Contains(t, tg.body("PUT /plans/gold"), "<name>Gold</name>")
+	assert.NotContains(t, tg.body("PUT /plans/gold"), "trial", "a zero trial leaves the trial unchanged")
+
+	resp, err = NewPlanClient(gw).Update(context.Background(), &pb.UpdatePlanRequest{Id: "gold", Active: pb.ActiveState_ActiveFalse})
+	assert.NoError(t, err)
+	assert.Equal(t, "active", resp.GetError().GetParam())
+}
+
+func TestPlanDelete(t *testing.T) {
+	gw, tg, done := newTestGateway(t, nil)
+	defer done()
+
+	resp, err := NewPlanClient(gw).Delete(context.Background(), &pb.DeletePlanRequest{Id: "gold"})
+	assert.NoError(t, err)
+	assert.Equal(t, pb.ErrorType_InvalidRequest, resp.GetError().GetType())
+	assert.Empty(t, tg.sent())
+}
+
+func TestPlanList(t *testing.T) {
+	gw, _, done := newTestGateway(t, map[string]route{
+		"GET /plans": {fixture: "plans.xml"},
+	})
+	defer done()
+	c := NewPlanClient(gw)
+
+	list := func(req *pb.ListPlansRequest) ([]*pb.Plan, error) {
+		s, err := c.List(context.Background(), req)
+		if err != nil {
+			return nil, err
+		}
+		var plans []*pb.Plan
+		for s.Next() {
+			plans = append(plans, s.Current().GetSuccess())
+		}
+		return plans, s.Err()
+	}
+	ids := func(plans []*pb.Plan) []string {
+		var out []string
+		for _, p := range plans {
+			out = append(out, p.Id)
+		}
+		return out
+	}
+
+	plans, err := list(&pb.ListPlansRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"platinum-jp", "gold", "silver"}, ids(plans))
+	assert.Equal(t, &pb.Plan{
+		Id:              "platinum-jp",
+		Amount:          12000,
+		Created:         1505478600,
+		Currency:        pb.Currency_JPY,
+		Interval:        pb.Interval_Year,
+		IntervalCount:   1,
+		Name:            "Platinum",
+		TrialPeriodDays: 30,
+		Active:          true,
+	}, plans[0])
+	assert.Equal(t, uint64(2550), plans[2].Amount)
+	assert.Equal(t, uint64(3), plans[2].IntervalCount)
+
+	plans, err = list(&pb.ListPlansRequest{StartingAfter: "platinum-jp", Limit: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"gold", "silver"}, ids(plans))
+
+	plans, err = list(&pb.ListPlansRequest{EndingBefore: "silver"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"gold", "platinum-jp"}, ids(plans))
+
+	plans, err = list(&pb.ListPlansRequest{Created: &pb.ListFilter{Gte: 1502387687}})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"platinum-jp", "gold"}, ids(plans))
+
+	plans, err = list(&pb.ListPlansRequest{Product: "gold"})
+	assert.NoError(t, err)
+	assert.Empty(t, plans, "Braintree plans do not belong to products")
+
+	_, err = list(&pb.ListPlansRequest{StartingAfter: "bronze"})
+	assert.Equal(t, "starting_after", err.(*pb.Error).Param)
+}
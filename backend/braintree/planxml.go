@@ -0,0 +1,145 @@
+package braintree
+
+import (
+	"encoding/xml"
+	"fmt"
+
+	"github.com/BTBurke/recur/pb"
+)
+
+type planXML struct {
+	XMLName           xml.Name  `xml:"plan"`
+	ID                string    `xml:"id,omitempty"`
+	Name              string    `xml:"name,omitempty"`
+	Price             string    `xml:"price,omitempty"`
+	CurrencyISOCode   string    `xml:"currency-iso-code,omitempty"`
+	BillingFrequency  *xmlValue `xml:"billing-frequency,omitempty"`
+	TrialPeriod       *xmlValue `xml:"trial-period,omitempty"`
+	TrialDuration     *xmlValue `xml:"trial-duration,omitempty"`
+	TrialDurationUnit string    `xml:"trial-duration-unit,omitempty"`
+	CreatedAt         *xmlValue `xml:"created-at,omitempty"`
+}
+
+type plansXML struct {
+	XMLName xml.Name  `xml:"plans"`
+	Plans   []planXML `xml:"plan"`
+}
+
+// checkPlan rejects plan fields that Braintree cannot store
+func checkPlan(metadata map[string]string, descriptor string) *pb.Error {
+	switch {
+	case len(metadata) > 0:
+		return errInvalid("metadata", "Braintree plans do not support metadata.")
+	case len(descriptor) > 0:
+		return errInvalid("statement_descriptor", "Braintree plans do not support a statement descriptor.")
+	default:
+		return nil
+	}
+}
+
+// billingFrequency converts an interval to Braintree's billing frequency in months
+func billingFrequency(interval pb.Interval, count uint64) (uint64, *pb.Error) {
+	if count == 0 {
+		count = 1
+	}
+	switch interval {
+	case pb.Interval_Month:
+		return count, nil
+	case pb.Interval_Year:
+		return 12 * count, nil
+	default:
+		return 0, errInvalid("interval", fmt.Sprintf("Braintree plans are billed in months, the %s interval is not supported.", interval))
+	}
+}
+
+// setTrial sets a trial in days, or removes the trial when days is zero
+func (p *planXML) setTrial(days uint64) {
+	p.TrialPeriod = boolValue(days > 0)
+	if days > 0 {
+		p.TrialDuration = intValue(days)
+		p.TrialDurationUnit = "day"
+	}
+}
+
+// convert from a plan create request to a Braintree plan
+func planCreateToXML(req *pb.CreatePlanRequest) (*planXML, *pb.Error) {
+	if err := checkPlan(req.Metadata, req.StatementDescriptor); err != nil {
+		return nil, err
+	}
+	switch {
+	case req.BillingScheme == pb.BillingScheme_Tiered:
+		return nil, errInvalid("billing_scheme", "Braintree plans do not support tiered pricing.")
+	case req.UsageType == pb.UsageType_Metered:
+		return nil, errInvalid("usage_type", "Braintree plans do not support metered usage.")
+	case len(req.Product) > 0:
+		return nil, errInvalid("product", "Braintree does not support products.")
+	}
+	freq, err := billingFrequency(req.Interval, req.IntervalCount)
+	if err != nil {
+		return nil, err
+	}
+	price, err := amountToPrice(req.Amount, req.Currency)
+	if err != nil {
+		return nil, err
+	}
+	p := &planXML{
+		ID:               req.Id,
+		Name:             req.Name,
+		Price:            price,
+		CurrencyISOCode:  req.Currency.String(),
+		BillingFrequency: intValue(freq),
+	}
+	p.setTrial(req.TrialPeriodDays)
+	return p, nil
+}
+
+// convert from a plan update request to a Braintree plan.  As with Stripe, a zero trial leaves
+// the trial unchanged.
+func planUpdateToXML(req *pb.UpdatePlanRequest) (*planXML, *pb.Error) {
+	if err := checkPlan(req.Metadata, req.StatementDescriptor); err != nil {
+		return nil, err
+	}
+	if req.Active == pb.ActiveState_ActiveFalse {
+		return nil, errInvalid("active", "Braintree plans cannot be deactivated.")
+	}
+	p := &planXML{Name: req.Name}
+	if req.TrialPeriodDays > 0 {
+		p.setTrial(req.TrialPeriodDays)
+	}
+	return p, nil
+}
+
+// convert a Braintree plan to a pb.Plan.  Billing frequencies that are a whole number of years
+// become a yearly interval.  Trials measured in months are converted at 30 days per month.
+func xmlToPlan(p *planXML, livemode bool) (*pb.Plan, error) {
+	currency, err := pb.ParseCurrency(p.CurrencyISOCode)
+	if err != nil {
+		return nil, fmt.Errorf("braintree: plan %s: %v", p.ID, err)
+	}
+	amount, err := priceToAmount(p.Price, currency)
+	if err != nil {
+		return nil, err
+	}
+	plan := &pb.Plan{
+		Id:            p.ID,
+		Amount:        amount,
+		Created:       p.CreatedAt.unix(),
+		Currency:      currency,
+		Interval:      pb.Interval_Month,
+		IntervalCount: p.BillingFrequency.uint(),
+		Livemode:      livemode,
+		Name:          p.Name,
+		Active:        true,
+	}
+	if plan.IntervalCount > 0 && plan.IntervalCount%12 == 0 {
+		plan.Interval = pb.Interval_Year
+		plan.IntervalCount /= 12
+	}
+	if p.TrialPeriod.bool() {
+		plan.TrialPeriodDays = p.TrialDuration.uint()
+		if p.TrialDurationUnit == "month" {
+			plan.TrialPeriodDays *= 30
+		}
+	}
+	return plan, nil
+}
@@ -0,0 +1,32 @@
+package braintree
+
+import (
+	"github.com/BTBurke/recur/backend"
+)
+
+// Name is the name the Braintree backend is registered under
+const Name = "braintree"
+
+func init() {
+	backend.Register(Name, newClients)
+}
+
+// newClients creates the Braintree clients sharing one gateway.  The key has the form
+// environment:merchant_id:public_key:private_key.  Braintree has no equivalent of the other
+// resources, so their clients are left nil.
+func newClients(cfg backend.Config) (*backend.Clients, error) {
+	env, merchantID, publicKey, privateKey, err := ParseKey(cfg.Key)
+	if err != nil {
+		return nil, err
+	}
+	var opts []Option
+	if cfg.Retry != nil {
+		opts = append(opts, WithRetryPolicy(*cfg.Retry))
+	}
+	gw := NewGateway(env, merchantID, publicKey, privateKey, cfg.Logger, opts...)
+	return &backend.Clients{
+		Plan:         NewPlanClient(gw),
+		Customer:     NewCustomerClient(gw),
+		Subscription: NewSubscriptionClient(gw),
+	}, nil
+}
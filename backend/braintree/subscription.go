@@ -0,0 +1,320 @@
+package braintree
+
+import (
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/BTBurke/recur/backend"
+	"github.com/BTBurke/recur/pb"
+	context "golang.org/x/net/context"
+)
+
+type BraintreeSubscriptionClient struct {
+	gw *Gateway
+	// now is used to convert a trial end to a trial duration
+	now func() time.Time
+}
+
+func NewSubscriptionClient(gw *Gateway) *BraintreeSubscriptionClient {
+	return &BraintreeSubscriptionClient{gw: gw, now: time.Now}
+}
+
+// Create subscribes the customer to the plan, charging the customer's default payment method
+func (s *BraintreeSubscriptionClient) Create(ctx context.Context, req *pb.CreateSubscriptionRequest) (*pb.SubscriptionResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	if pbErr := checkSubscription(req.Quantity, req.Metadata); pbErr != nil {
+		return respToSubscriptionError(pbErr), nil
+	}
+
+	cust := new(customerXML)
+	err := notFound(s.gw.do(ctx, http.MethodGet, "/customers/"+url.PathEscape(req.Customer), nil, cust, true), "customer", req.Customer)
+	if err != nil {
+		return s.subscriptionResponse(ctx, nil, nil, err)
+	}
+	token := cust.defaultToken()
+	if len(token) == 0 {
+		return respToSubscriptionError(errInvalid("customer", "This customer has no attached payment source")), nil
+	}
+	r := newResolver(s.gw)
+	r.customers[token] = req.Customer
+
+	out := new(subscriptionXML)
+	err = s.gw.do(ctx, http.MethodPost, "/subscriptions", subCreateToXML(req, token, s.now()), out, false)
+	return s.subscriptionResponse(ctx, r, out, err)
+}
+
+// Update changes the plan of the subscription.  The price is set from the new plan, since
+// Braintree otherwise keeps charging the price of the old plan.
+func (s *BraintreeSubscriptionClient) Update(ctx context.Context, req *pb.UpdateSubscriptionRequest) (*pb.SubscriptionResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	if pbErr := checkSubscription(req.Quantity, req.Metadata); pbErr != nil {
+		return respToSubscriptionError(pbErr), nil
+	}
+	if req.ProrationDate != 0 {
+		return respToSubscriptionError(errInvalid("proration_date", "Braintree does not support a proration date.")), nil
+	}
+	if len(req.Plan) == 0 {
+		return s.Get(ctx, &pb.GetSubscriptionRequest{Id: req.Id})
+	}
+
+	r := newResolver(s.gw)
+	plan, err := r.plan(ctx, req.Plan)
+	if err != nil {
+		return s.subscriptionResponse(ctx, r, nil, err)
+	}
+	price, pbErr := amountToPrice(plan.Amount, plan.Currency)
+	if pbErr != nil {
+		return respToSubscriptionError(pbErr), nil
+	}
+	in := &subscriptionXML{
+		PlanID:  plan.Id,
+		Price:   price,
+		Options: &subscriptionOpts{ProrateCharges: boolValue(!req.NoProrate)},
+	}
+
+	out := new(subscriptionXML)
+	err = s.gw.do(ctx, http.MethodPut, "/subscriptions/"+url.PathEscape(req.Id), in, out, true)
+	return s.subscriptionResponse(ctx, r, out, notFound(err, "subscription", req.Id))
+}
+
+// Cancel cancels the subscription immediately, or at the end of the current billing cycle by
+// limiting the number of billing cycles to the current one
+func (s *BraintreeSubscriptionClient) Cancel(ctx context.Context, req *pb.CancelSubscriptionRequest) (*pb.SubscriptionResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	path := "/subscriptions/" + url.PathEscape(req.Id)
+	r := newResolver(s.gw)
+
+	out := new(subscriptionXML)
+	if !req.AtPeriodEnd {
+		err := s.gw.do(ctx, http.MethodPut, path+"/cancel", nil, out, false)
+		return s.subscriptionResponse(ctx, r, out, notFound(err, "subscription", req.Id))
+	}
+
+	if err := s.gw.do(ctx, http.MethodGet, path, nil, out, true); err != nil {
+		return s.subscriptionResponse(ctx, r, nil, notFound(err, "subscription", req.Id))
+	}
+	if out.inTrial() {
+		return respToSubscriptionError(errInvalid("at_period_end", "Braintree cannot cancel a subscription at the end of its trial.")), nil
+	}
+	in := &subscriptionXML{
+		NeverExpires:          boolValue(false),
+		NumberOfBillingCycles: intValue(out.CurrentBillingCycle.uint()),
+	}
+	out = new(subscriptionXML)
+	err := s.gw.do(ctx, http.MethodPut, path, in, out, true)
+	return s.subscriptionResponse(ctx, r, out, err)
+}
+
+// Reactivate undoes a cancellation at the end of the billing cycle.  Braintree cannot
+// reactivate a subscription once it has been canceled.
+func (s *BraintreeSubscriptionClient) Reactivate(ctx context.Context, req *pb.ReactivateSubscriptionRequest) (*pb.SubscriptionResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	path := "/subscriptions/" + url.PathEscape(req.Id)
+	r := newResolver(s.gw)
+
+	out := new(subscriptionXML)
+	if err := s.gw.do(ctx, http.MethodGet, path, nil, out, true); err != nil {
+		return s.subscriptionResponse(ctx, r, nil, notFound(err, "subscription", req.Id))
+	}
+	switch {
+	case out.Status == statusCanceled || out.Status == statusExpired:
+		return respToSubscriptionError(errInvalid("id", "Braintree cannot reactivate a canceled subscription.")), nil
+	case !out.cancelsAtPeriodEnd():
+		return s.subscriptionResponse(ctx, r, out, nil)
+	}
+
+	in := &subscriptionXML{NeverExpires: boolValue(true)}
+	out = new(subscriptionXML)
+	err := s.gw.do(ctx, http.MethodPut, path, in, out, true)
+	return s.subscriptionResponse(ctx, r, out, err)
+}
+
+func (s *BraintreeSubscriptionClient) Get(ctx context.Context, req *pb.GetSubscriptionRequest) (*pb.SubscriptionResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	out := new(subscriptionXML)
+	err := s.gw.do(ctx, http.MethodGet, "/subscriptions/"+url.PathEscape(req.Id), nil, out, true)
+	return s.subscriptionResponse(ctx, newResolver(s.gw), out, notFound(err, "subscription", req.Id))
+}
+
+// subscriptionResponse converts the result of a subscription request to a SubscriptionResponse,
+// looking up the customer and plan.  Braintree errors are returned in the response.
+func (s *BraintreeSubscriptionClient) subscriptionResponse(ctx context.Context, r *resolver, out *subscriptionXML, err error) (*pb.SubscriptionResponse, error) {
+	if err == nil {
+		var sub *pb.Subscription
+		if sub, err = r.subscription(ctx, out); err == nil {
+			return &pb.SubscriptionResponse{Responses: &pb.SubscriptionResponse_Success{Success: sub}}, nil
+		}
+	}
+	if pbErr, ok := err.(*pb.Error); ok {
+		return respToSubscriptionError(pbErr), nil
+	}
+	return nil, err
+}
+
+func respToSubscriptionError(err *pb.Error) *pb.SubscriptionResponse {
+	return &pb.SubscriptionResponse{Responses: &pb.SubscriptionResponse_Error{Error: err}}
+}
+
+// subscriptionStreamer implements the SubscriptionStreamer interface
+type subscriptionStreamer struct {
+	*listIter
+}
+
+func (s *subscriptionStreamer) Current() *pb.SubscriptionResponse {
+	if e := s.errorResponse(); e != nil {
+		return respToSubscriptionError(e)
+	}
+	return &pb.SubscriptionResponse{Responses: &pb.SubscriptionResponse_Success{Success: s.cur.(*pb.Subscription)}}
+}
+
+// List returns subscriptions in the order of Braintree's search results.  Braintree cannot search
+// subscriptions by customer, so the customer's subscriptions are read from their payment
+// methods.
+func (s *BraintreeSubscriptionClient) List(ctx context.Context, req *pb.ListSubscriptionsRequest) (backend.SubscriptionStreamer, error) {
+	r := newResolver(s.gw)
+	ids, err := s.searchIDs(ctx, req, r)
+	if pbErr, ok := err.(*pb.Error); ok {
+		return &subscriptionStreamer{&listIter{ctx: ctx, err: pbErr}}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	fetch := func(ctx context.Context, ids []string) ([]interface{}, error) {
+		var out subscriptionsXML
+		if err := s.gw.do(ctx, http.MethodPost, "/subscriptions/advanced_search", &searchRequest{IDs: newSearchArray(ids)}, &out, true); err != nil {
+			return nil, err
+		}
+		byID := make(map[string]*subscriptionXML, len(out.Subscriptions))
+		for i := range out.Subscriptions {
+			byID[out.Subscriptions[i].ID] = &out.Subscriptions[i]
+		}
+		page := make([]interface{}, 0, len(ids))
+		for _, id := range ids {
+			bt, ok := byID[id]
+			if !ok {
+				continue
+			}
+			sub, err := r.subscription(ctx, bt)
+			if err != nil {
+				return nil, err
+			}
+			if req.GetStatus() != pb.SubscriptionStatus_UnknownStatus && sub.Status != req.GetStatus() {
+				continue
+			}
+			page = append(page, sub)
+		}
+		return page, nil
+	}
+	return &subscriptionStreamer{newListIter(ctx, ids, req.GetStartingAfter(), req.GetEndingBefore(), req.GetLimit(), fetch)}, nil
+}
+
+// searchIDs returns the ids of the subscriptions matching the list request.  The payment methods
+// of the customer filtered on are added to the resolver.
+func (s *BraintreeSubscriptionClient) searchIDs(ctx context.Context, req *pb.ListSubscriptionsRequest, r *resolver) ([]string, error) {
+	search := &searchRequest{CreatedAt: createdCriteria(req.GetCreated())}
+	if len(req.GetPlan()) > 0 {
+		search.PlanID = &textCriteria{Is: req.GetPlan()}
+	}
+	if req.GetStatus() != pb.SubscriptionStatus_UnknownStatus {
+		statuses := pbToBraintreeStatus(req.GetStatus())
+		if len(statuses) == 0 {
+			return nil, nil
+		}
+		search.Status = newSearchArray(statuses)
+	}
+	if len(req.GetCustomer()) > 0 {
+		cust := new(customerXML)
+		err := s.gw.do(ctx, http.MethodGet, "/customers/"+url.PathEscape(req.GetCustomer()), nil, cust, true)
+		if err != nil {
+			return nil, notFound(err, "customer", req.GetCustomer())
+		}
+		var ids []string
+		for _, pm := range cust.paymentMethods() {
+			r.customers[pm.Token] = req.GetCustomer()
+			for _, sub := range pm.Subscriptions {
+				ids = append(ids, sub.ID)
+			}
+		}
+		if len(ids) == 0 {
+			return nil, nil
+		}
+		search.IDs = newSearchArray(ids)
+	}
+	return s.gw.searchIDs(ctx, "subscriptions", search)
+}
+
+// resolver looks up the customer and plan of Braintree subscriptions, which only hold a payment
+// method token and plan id.  Lookups are cached so that a page of subscriptions fetches each
+// customer and plan once.
+type resolver struct {
+	gw        *Gateway
+	customers map[string]string
+	plans     map[string]*pb.Plan
+}
+
+func newResolver(gw *Gateway) *resolver {
+	return &resolver{
+		gw:        gw,
+		customers: make(map[string]string),
+		plans:     make(map[string]*pb.Plan),
+	}
+}
+
+func (r *resolver) subscription(ctx context.Context, s *subscriptionXML) (*pb.Subscription, error) {
+	customer, err := r.customer(ctx, s.PaymentMethodToken)
+	if err != nil {
+		return nil, err
+	}
+	plan, err := r.plan(ctx, s.PlanID)
+	if err != nil {
+		return nil, err
+	}
+	return xmlToSubscription(s, customer, plan), nil
+}
+
+// customer returns the id of the customer owning the payment method.  The customer is unknown if
+// the payment method has since been deleted.
+func (r *resolver) customer(ctx context.Context, token string) (string, error) {
+	if id, ok := r.customers[token]; ok {
+		return id, nil
+	}
+	var pm paymentMethodXML
+	err := r.gw.do(ctx, http.MethodGet, "/payment_methods/any/"+url.PathEscape(token), nil, &pm, true)
+	if pbErr, ok := err.(*pb.Error); ok && pbErr.HttpStatusCode == http.StatusNotFound {
+		err = nil
+	}
+	if err != nil {
+		return "", err
+	}
+	r.customers[token] = pm.CustomerID
+	return pm.CustomerID, nil
+}
+
+func (r *resolver) plan(ctx context.Context, id string) (*pb.Plan, error) {
+	if plan, ok := r.plans[id]; ok {
+		return plan, nil
+	}
+	out := new(planXML)
+	if err := r.gw.do(ctx, http.MethodGet, "/plans/"+url.PathEscape(id), nil, out, true); err != nil {
+		return nil, notFound(err, "plan", id)
+	}
+	plan, err := xmlToPlan(out, r.gw.livemode)
+	if err != nil {
+		return nil, err
+	}
+	r.plans[id] = plan
+	return plan, nil
+}
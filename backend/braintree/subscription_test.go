@@ -0,0 +1,229 @@
+package braintree
+
+import (
+	"testing"
+	"time"
+
+	"github.com/BTBurke/recur/pb"
+	"github.com/stretchr/testify/assert"
+	context "golang.org/x/net/context"
+)
+
+var goldPlan = &pb.Plan{
+	Id:              "gold",
+	Amount:          2000,
+	Created:         1502387687,
+	Currency:        pb.Currency_USD,
+	Interval:        pb.Interval_Month,
+	IntervalCount:   1,
+	Name:            "Gold",
+	TrialPeriodDays: 14,
+	Active:          true,
+}
+
+func TestSubscriptionCreate(t *testing.T) {
+	gw, tg, done := newTestGateway(t, map[string]route{
+		"GET /customers/cus1": {fixture: "customer.xml"},
+		"POST /subscriptions": {status: 201, fixture: "subscription_trial.xml"},
+		"GET /plans/gold":     {fixture: "plan.xml"},
+	})
+	defer done()
+	c := NewSubscriptionClient(gw)
+	c.now = func() time.Time { return time.Date(2017, 10, 1, 0, 0, 0, 0, time.UTC) }
+
+	resp, err := c.Create(context.Background(), &pb.CreateSubscriptionRequest{
+		Customer: "cus1",
+		Plan:     "gold",
+		TrialEnd: time.Date(2017, 10, 14, 12, 0, 0, 0, time.UTC).Unix(),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, &pb.Subscription{
+		Id:                 "sub3",
+		Customer:           "cus1",
+		Plan:               goldPlan,
+		Quantity:           1,
+		Status:             pb.SubscriptionStatus_Trialing,
+		Created:            1506816000,
+		CurrentPeriodStart: 1506816000,
+		CurrentPeriodEnd:   1508025600,
+		Start:              1506816000,
+		TrialStart:         1506816000,
+		TrialEnd:           1508025600,
+	}, resp.GetSuccess())
+
+	body := tg.body("POST /subscriptions")
+	assert.Contains(t, body, "<payment-method-token>tok1</payment-method-token>")
+	assert.Contains(t, body, `<trial-duration type="integer">14</trial-duration>`)
+	assert.NotContains(t, tg.sent(), "GET /payment_methods/any/tok1", "the customer is known from the request")
+}
+
+func TestSubscriptionCreateErrors(t *testing.T) {
+	gw, _, done := newTestGateway(t, map[string]route{
+		"GET /customers/cus1": {fixture: "customer.xml"},
+		"GET /customers/cus2": {fixture: "customer_no_payment.xml"},
+		"POST /subscriptions": {status: 422, fixture: "processor_declined.xml"},
+	})
+	defer done()
+	c := NewSubscriptionClient(gw)
+	ctx := context.Background()
+
+	resp, err := c.Create(ctx, &pb.CreateSubscriptionRequest{Customer: "cus1", Plan: "gold"})
+	assert.NoError(t, err)
+	assert.Equal(t, pb.ErrorType_Card, resp.GetError().GetType())
+	assert.Equal(t, pb.CardErrors_Declined, resp.GetError().GetCode())
+
+	resp, err = c.Create(ctx, &pb.CreateSubscriptionRequest{Customer: "cus2", Plan: "gold"})
+	assert.NoError(t, err)
+	assert.Equal(t, "customer", resp.GetError().GetParam())
+
+	resp, err = c.Create(ctx, &pb.CreateSubscriptionRequest{Customer: "cus9", Plan: "gold"})
+	assert.NoError(t, err)
+	assert.Equal(t, "No such customer: cus9", resp.GetError().GetMessage())
+
+	resp, err = c.Create(ctx, &pb.CreateSubscriptionRequest{Customer: "cus1", Plan: "gold", Quantity: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, "quantity", resp.GetError().GetParam())
+}
+
+func TestSubscriptionGet(t *testing.T) {
+	gw, _, done := newTestGateway(t, map[string]route{
+		"GET /subscriptions/sub1":       {fixture: "subscription.xml"},
+		"GET /payment_methods/any/tok1": {fixture: "payment_method.xml"},
+		"GET /plans/gold":               {fixture: "plan.xml"},
+	})
+	defer done()
+
+	resp, err := NewSubscriptionClient(gw).Get(context.Background(), &pb.GetSubscriptionRequest{Id: "sub1"})
+	assert.NoError(t, err)
+	assert.Equal(t, &pb.Subscription{
+		Id:                 "sub1",
+		Customer:           "cus1",
+		Plan:               goldPlan,
+		Quantity:           1,
+		Status:             pb.SubscriptionStatus_Active,
+		Created:            1502439600,
+		CurrentPeriodStart: 1506297600,
+		CurrentPeriodEnd:   1508803200,
+		Start:              1502439600,
+		TrialStart:         1502439600,
+		TrialEnd:           1503619200,
+	}, resp.GetSuccess())
+}
+
+func TestSubscriptionUpdate(t *testing.T) {
+	gw, tg, done := newTestGateway(t, map[string]route{
+		"PUT /subscriptions/sub1":       {fixture: "subscription.xml"},
+		"GET /payment_methods/any/tok1": {fixture: "payment_method.xml"},
+		"GET /plans/gold":               {fixture: "plan.xml"},
+	})
+	defer done()
+
+	resp, err := NewSubscriptionClient(gw).Update(context.Background(), &pb.UpdateSubscriptionRequest{Id: "sub1", Plan: "gold", NoProrate: true})
+	assert.NoError(t, err)
+	assert.Equal(t, "sub1", resp.GetSuccess().GetId())
+
+	body := tg.body("PUT /subscriptions/sub1")
+	assert.Contains(t, body, "<plan-id>gold</plan-id><price>20.00</price>")
+	assert.Contains(t, body, `<options><prorate-charges type="boolean">false</prorate-charges></options>`)
+	assert.Equal(t, 1, count(tg.sent(), "GET /plans/gold"), "the plan is looked up once")
+}
+
+func TestSubscriptionCancel(t *testing.T) {
+	gw, tg, done := newTestGateway(t, map[string]route{
+		"GET /subscriptions/sub1":        {fixture: "subscription.xml"},
+		"PUT /subscriptions/sub1":        {fixture: "subscription_cancel_at_period_end.xml"},
+		"PUT /subscriptions/sub2/cancel": {fixture: "subscription_canceled.xml"},
+		"GET /subscriptions/sub3":        {fixture: "subscription_trial.xml"},
+		"GET /payment_methods/any/tok1":  {fixture: "payment_method.xml"},
+		"GET /plans/gold":                {fixture: "plan.xml"},
+	})
+	defer done()
+	c := NewSubscriptionClient(gw)
+	ctx := context.Background()
+
+	resp, err := c.Cancel(ctx, &pb.CancelSubscriptionRequest{Id: "sub1", AtPeriodEnd: true})
+	assert.NoError(t, err)
+	assert.True(t, resp.GetSuccess().GetCancelAtPeriodEnd())
+	assert.Equal(t, pb.SubscriptionStatus_Active, resp.GetSuccess().GetStatus())
+	body := tg.body("PUT /subscriptions/sub1")
+	assert.Contains(t, body, `<never-expires type="boolean">false</never-expires>`)
+	assert.Contains(t, body, `<number-of-billing-cycles type="integer">2</number-of-billing-cycles>`)
+
+	resp, err = c.Cancel(ctx, &pb.CancelSubscriptionRequest{Id: "sub2"})
+	assert.NoError(t, err)
+	assert.Equal(t, pb.SubscriptionStatus_Canceled, resp.GetSuccess().GetStatus())
+	assert.Equal(t, int64(1500508800), resp.GetSuccess().GetCanceledAt())
+	assert.Equal(t, int64(1500508800), resp.GetSuccess().GetEndedAt())
+	assert.Equal(t, "", resp.GetSuccess().GetCustomer(), "the payment method was deleted")
+
+	resp, err = c.Cancel(ctx, &pb.CancelSubscriptionRequest{Id: "sub3", AtPeriodEnd: true})
+	assert.NoError(t, err)
+	assert.Equal(t, "at_period_end", resp.GetError().GetParam())
+}
+
+func TestSubscriptionReactivate(t *testing.T) {
+	gw, tg, done := newTestGateway(t, map[string]route{
+		"GET /subscriptions/sub1":       {fixture: "subscription_cancel_at_period_end.xml"},
+		"PUT /subscriptions/sub1":       {fixture: "subscription.xml"},
+		"GET /subscriptions/sub2":       {fixture: "subscription_canceled.xml"},
+		"GET /payment_methods/any/tok1": {fixture: "payment_method.xml"},
+		"GET /plans/gold":               {fixture: "plan.xml"},
+	})
+	defer done()
+	c := NewSubscriptionClient(gw)
+	ctx := context.Background()
+
+	resp, err := c.Reactivate(ctx, &pb.ReactivateSubscriptionRequest{Id: "sub1"})
+	assert.NoError(t, err)
+	assert.False(t, resp.GetSuccess().GetCancelAtPeriodEnd())
+	assert.Contains(t, tg.body("PUT /subscriptions/sub1"), `<never-expires type="boolean">true</never-expires>`)
+
+	resp, err = c.Reactivate(ctx, &pb.ReactivateSubscriptionRequest{Id: "sub2"})
+	assert.NoError(t, err)
+	assert.Equal(t, pb.ErrorType_InvalidRequest, resp.GetError().GetType())
+}
+
+func TestSubscriptionList(t *testing.T) {
+	gw, tg, done := newTestGateway(t, map[string]route{
+		"GET /customers/cus1":                     {fixture: "customer.xml"},
+		"POST /subscriptions/advanced_search_ids": {fixture: "subscription_search_ids.xml"},
+		"POST /subscriptions/advanced_search":     {fixture: "subscriptions.xml"},
+		"GET /plans/gold":                         {fixture: "plan.xml"},
+	})
+	defer done()
+	c := NewSubscriptionClient(gw)
+
+	list := func(req *pb.ListSubscriptionsRequest) []string {
+		s, err := c.List(context.Background(), req)
+		assert.NoError(t, err)
+		var ids []string
+		for s.Next() {
+			sub := s.Current().GetSuccess()
+			assert.Equal(t, "cus1", sub.GetCustomer())
+			ids = append(ids, sub.GetId())
+		}
+		assert.NoError(t, s.Err())
+		return ids
+	}
+
+	assert.Equal(t, []string{"sub1", "sub2"}, list(&pb.ListSubscriptionsRequest{Customer: "cus1", Plan: "gold"}))
+	body := tg.body("POST /subscriptions/advanced_search_ids")
+	assert.Contains(t, body, `<ids type="array"><item>sub1</item><item>sub2</item></ids>`)
+	assert.Contains(t, body, "<plan-id><is>gold</is></plan-id>")
+	assert.NotContains(t, tg.sent(), "GET /payment_methods/any/tok1", "payment methods are known from the customer")
+
+	assert.Equal(t, []string{"sub2"}, list(&pb.ListSubscriptionsRequest{Customer: "cus1", Status: pb.SubscriptionStatus_Canceled}))
+	assert.Contains(t, tg.body("POST /subscriptions/advanced_search_ids"), `<status type="array"><item>Canceled</item><item>Expired</item></status>`)
+
+	assert.Empty(t, list(&pb.ListSubscriptionsRequest{Status: pb.SubscriptionStatus_Unpaid}))
+}
+
+func count(ss []string, s string) int {
+	n := 0
+	for _, v := range ss {
+		if v == s {
+			n++
+		}
+	}
+	return n
+}
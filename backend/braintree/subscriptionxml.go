@@ -0,0 +1,176 @@
+package braintree
+
+import (
+	"encoding/xml"
+	"time"
+
+	"github.com/BTBurke/recur/pb"
+)
+
+// Braintree subscription statuses
+const (
+	statusActive   = "Active"
+	statusCanceled = "Canceled"
+	statusExpired  = "Expired"
+	statusPastDue  = "Past Due"
+	statusPending  = "Pending"
+)
+
+type subscriptionXML struct {
+	XMLName                xml.Name          `xml:"subscription"`
+	ID                     string            `xml:"id,omitempty"`
+	PlanID                 string            `xml:"plan-id,omitempty"`
+	PaymentMethodToken     string            `xml:"payment-method-token,omitempty"`
+	Price                  string            `xml:"price,omitempty"`
+	Status                 string            `xml:"status,omitempty"`
+	NeverExpires           *xmlValue         `xml:"never-expires,omitempty"`
+	NumberOfBillingCycles  *xmlValue         `xml:"number-of-billing-cycles,omitempty"`
+	CurrentBillingCycle    *xmlValue         `xml:"current-billing-cycle,omitempty"`
+	TrialPeriod            *xmlValue         `xml:"trial-period,omitempty"`
+	TrialDuration          *xmlValue         `xml:"trial-duration,omitempty"`
+	TrialDurationUnit      string            `xml:"trial-duration-unit,omitempty"`
+	CreatedAt              *xmlValue         `xml:"created-at,omitempty"`
+	BillingPeriodStartDate *xmlValue         `xml:"billing-period-start-date,omitempty"`
+	BillingPeriodEndDate   *xmlValue         `xml:"billing-period-end-date,omitempty"`
+	FirstBillingDate       *xmlValue         `xml:"first-billing-date,omitempty"`
+	StatusHistory          []statusEventXML  `xml:"status-history>status-event,omitempty"`
+	Options                *subscriptionOpts `xml:"options,omitempty"`
+}
+
+type statusEventXML struct {
+	Timestamp *xmlValue `xml:"timestamp"`
+	Status    string    `xml:"status"`
+}
+
+type subscriptionOpts struct {
+	ProrateCharges *xmlValue `xml:"prorate-charges,omitempty"`
+}
+
+type subscriptionsXML struct {
+	XMLName       xml.Name          `xml:"subscriptions"`
+	Subscriptions []subscriptionXML `xml:"subscription"`
+}
+
+// cancelsAtPeriodEnd reports whether the subscription has been set to end after its current
+// billing cycle.  Braintree has no such flag; Cancel sets the number of billing cycles to the
+// current cycle instead.
+func (s *subscriptionXML) cancelsAtPeriodEnd() bool {
+	if s.Status != statusActive && s.Status != statusPastDue {
+		return false
+	}
+	cycles := s.NumberOfBillingCycles.uint()
+	return !s.NeverExpires.bool() && cycles > 0 && s.CurrentBillingCycle.uint() >= cycles
+}
+
+// inTrial reports whether the subscription has not yet been billed for its first cycle
+func (s *subscriptionXML) inTrial() bool {
+	return s.TrialPeriod.bool() && s.CurrentBillingCycle.uint() == 0
+}
+
+// endedAt returns the time the subscription was canceled or expired from its status history
+func (s *subscriptionXML) endedAt() int64 {
+	for _, ev := range s.StatusHistory {
+		if ev.Status == statusCanceled || ev.Status == statusExpired {
+			return ev.Timestamp.unix()
+		}
+	}
+	return 0
+}
+
+// checkSubscription rejects subscription fields that Braintree cannot store.  Braintree bills
+// quantities through add-ons, which have no equivalent here.
+func checkSubscription(quantity uint64, metadata map[string]string) *pb.Error {
+	switch {
+	case quantity > 1:
+		return errInvalid("quantity", "Braintree subscriptions have a quantity of one.")
+	case len(metadata) > 0:
+		return errInvalid("metadata", "Braintree subscriptions do not support metadata.")
+	default:
+		return nil
+	}
+}
+
+// convert from a subscription create request to a Braintree subscription charged to the payment
+// method token.  A trial end is converted to a trial of whole days, rounded up.
+func subCreateToXML(req *pb.CreateSubscriptionRequest, token string, now time.Time) *subscriptionXML {
+	s := &subscriptionXML{
+		PlanID:             req.Plan,
+		PaymentMethodToken: token,
+	}
+	if req.TrialEnd > 0 {
+		secs := req.TrialEnd - now.Unix()
+		days := uint64(0)
+		if secs > 0 {
+			days = uint64((secs + 86399) / 86400)
+		}
+		s.TrialPeriod = boolValue(days > 0)
+		if days > 0 {
+			s.TrialDuration = intValue(days)
+			s.TrialDurationUnit = "day"
+		}
+	}
+	return s
+}
+
+// convert a status filter to the matching Braintree statuses.  A Braintree status can map to
+// more than one pb status, so results must still be filtered after conversion.
+func pbToBraintreeStatus(status pb.SubscriptionStatus) []string {
+	switch status {
+	case pb.SubscriptionStatus_Trialing:
+		return []string{statusActive, statusPending}
+	case pb.SubscriptionStatus_Active:
+		return []string{statusActive, statusPending}
+	case pb.SubscriptionStatus_PastDue:
+		return []string{statusPastDue}
+	case pb.SubscriptionStatus_Canceled:
+		return []string{statusCanceled, statusExpired}
+	default:
+		return nil
+	}
+}
+
+func braintreeToPbStatus(s *subscriptionXML) pb.SubscriptionStatus {
+	switch s.Status {
+	case statusActive, statusPending:
+		if s.inTrial() {
+			return pb.SubscriptionStatus_Trialing
+		}
+		return pb.SubscriptionStatus_Active
+	case statusPastDue:
+		return pb.SubscriptionStatus_PastDue
+	case statusCanceled, statusExpired:
+		return pb.SubscriptionStatus_Canceled
+	default:
+		return pb.SubscriptionStatus_UnknownStatus
+	}
+}
+
+// convert a Braintree subscription to a pb.Subscription for the customer and plan.  During a
+// trial the current period is the trial, as it is in Stripe.
+func xmlToSubscription(s *subscriptionXML, customer string, plan *pb.Plan) *pb.Subscription {
+	sub := &pb.Subscription{
+		Id:                 s.ID,
+		Customer:           customer,
+		Plan:               plan,
+		Quantity:           1,
+		Status:             braintreeToPbStatus(s),
+		CancelAtPeriodEnd:  s.cancelsAtPeriodEnd(),
+		Created:            s.CreatedAt.unix(),
+		CurrentPeriodStart: s.BillingPeriodStartDate.unix(),
+		CurrentPeriodEnd:   s.BillingPeriodEndDate.unix(),
+		Start:              s.CreatedAt.unix(),
+	}
+	if s.TrialPeriod.bool() {
+		sub.TrialStart = s.CreatedAt.unix()
+		sub.TrialEnd = s.FirstBillingDate.unix()
+	}
+	if s.inTrial() {
+		sub.CurrentPeriodStart = sub.TrialStart
+		sub.CurrentPeriodEnd = sub.TrialEnd
+	}
+	if sub.Status == pb.SubscriptionStatus_Canceled {
+		sub.CanceledAt = s.endedAt()
+		sub.EndedAt = sub.CanceledAt
+	}
+	return sub
+}
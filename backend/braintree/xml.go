@@ -0,0 +1,51 @@
+package braintree
+
+import (
+	"strconv"
+	"time"
+)
+
+// xmlValue is a typed element such as <trial-period type="boolean">true</trial-period>.  Braintree
+// marks empty values with nil="true".
+type xmlValue struct {
+	Type  string `xml:"type,attr,omitempty"`
+	Nil   string `xml:"nil,attr,omitempty"`
+	Value string `xml:",chardata"`
+}
+
+func intValue(n uint64) *xmlValue {
+	return &xmlValue{Type: "integer", Value: strconv.FormatUint(n, 10)}
+}
+
+func boolValue(b bool) *xmlValue {
+	return &xmlValue{Type: "boolean", Value: strconv.FormatBool(b)}
+}
+
+func (v *xmlValue) uint() uint64 {
+	if v == nil {
+		return 0
+	}
+	n, _ := strconv.ParseUint(v.Value, 10, 64)
+	return n
+}
+
+func (v *xmlValue) bool() bool {
+	if v == nil {
+		return false
+	}
+	b, _ := strconv.ParseBool(v.Value)
+	return b
+}
+
+// unix parses a datetime or date value to a unix timestamp.  Dates are midnight UTC.
+func (v *xmlValue) unix() int64 {
+	if v == nil || len(v.Value) == 0 {
+		return 0
+	}
+	for _, layout := range []string{time.RFC3339, "2006-01-02"} {
+		if t, err := time.Parse(layout, v.Value); err == nil {
+			return t.Unix()
+		}
+	}
+	return 0
+}
@@ -0,0 +1,72 @@
+package backend
+
+import (
+	context "golang.org/x/net/context"
+)
+
+// Metadata keys that callers can set on a GRPC request to a recur service to control the backend
+// request.  The server copies them to the context with the helpers below.
+const (
+	// IdempotencyKeyMD sets the idempotency key sent to the backend
+	IdempotencyKeyMD = "idempotency-key"
+	// StripeAccountMD makes the request on behalf of a Stripe Connect account
+	StripeAccountMD = "stripe-account"
+	// HeaderMDPrefix forwards metadata with this prefix to the backend as an HTTP header, e.g.
+	// header-stripe-version: 2017-08-15 sets the Stripe-Version header.  The server only forwards
+	// the headers it allows.
+	HeaderMDPrefix = "header-"
+)
+
+// contextKey is unexported so that values set by this package cannot collide with keys defined
+// in other packages
+type contextKey int
+
+const (
+	idempotencyKey contextKey = iota
+	stripeAccountKey
+	headersKey
+)
+
+// WithIdempotencyKey returns a context that sends the idempotency key with backend requests
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKey, key)
+}
+
+// IdempotencyKey returns the idempotency key set on the context, if any
+func IdempotencyKey(ctx context.Context) (string, bool) {
+	key, ok := ctx.Value(idempotencyKey).(string)
+	return key, ok && len(key) > 0
+}
+
+// WithStripeAccount returns a context that makes backend requests on behalf of a Stripe Connect
+// account
+func WithStripeAccount(ctx context.Context, acct string) context.Context {
+	return context.WithValue(ctx, stripeAccountKey, acct)
+}
+
+// StripeAccount returns the Stripe Connect account set on the context, if any
+func StripeAccount(ctx context.Context) (string, bool) {
+	acct, ok := ctx.Value(stripeAccountKey).(string)
+	return acct, ok && len(acct) > 0
+}
+
+// WithHeaders returns a context that adds the headers to backend requests.  Headers already set
+// on the context are kept unless they are overwritten.
+func WithHeaders(ctx context.Context, headers map[string]string) context.Context {
+	merged := make(map[string]string)
+	if prev, ok := Headers(ctx); ok {
+		for k, v := range prev {
+			merged[k] = v
+		}
+	}
+	for k, v := range headers {
+		merged[k] = v
+	}
+	return context.WithValue(ctx, headersKey, merged)
+}
+
+// Headers returns the additional headers set on the context, if any
+func Headers(ctx context.Context) (map[string]string, bool) {
+	headers, ok := ctx.Value(headersKey).(map[string]string)
+	return headers, ok && len(headers) > 0
+}
@@ -0,0 +1,275 @@
+package memory
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/BTBurke/recur/backend"
+	"github.com/BTBurke/recur/pb"
+	"github.com/golang/protobuf/proto"
+	context "golang.org/x/net/context"
+)
+
+// CouponClient implements backend.CouponClient in memory.  Discounts are recorded on the customer
+// or subscription but are not applied to in-memory invoices.
+type CouponClient struct {
+	store *Store
+}
+
+var _ backend.CouponClient = (*CouponClient)(nil)
+
+// NewCouponClient returns a coupon client backed by the store
+func NewCouponClient(store *Store) *CouponClient {
+	return &CouponClient{store: store}
+}
+
+func (c *CouponClient) Create(ctx context.Context, req *pb.CreateCouponRequest) (*pb.CouponResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	c.store.mu.Lock()
+	defer c.store.mu.Unlock()
+
+	now := c.store.now()
+	if req.RedeemBy > 0 && req.RedeemBy <= now.Unix() {
+		return couponError(errInvalid("redeem_by", "Redeem by must be in the future")), nil
+	}
+	coupon := &pb.Coupon{
+		Id:               req.Id,
+		AmountOff:        req.AmountOff,
+		Created:          now.Unix(),
+		Currency:         req.Currency,
+		Duration:         req.Duration,
+		DurationInMonths: req.DurationInMonths,
+		MaxRedemptions:   req.MaxRedemptions,
+		Metadata:         copyMeta(req.Metadata),
+		PercentOff:       req.PercentOff,
+		RedeemBy:         req.RedeemBy,
+		Valid:            true,
+	}
+	if len(coupon.Id) == 0 {
+		coupon.Id = newID("co")
+	}
+	if !c.store.coupons.insert(coupon.Id, coupon.Created, coupon) {
+		return couponError(errExists("Coupon")), nil
+	}
+	return couponSuccess(coupon), nil
+}
+
+// Update changes the metadata of a coupon.  As with Stripe, the terms of a coupon cannot be
+// changed after it is created.
+func (c *CouponClient) Update(ctx context.Context, req *pb.UpdateCouponRequest) (*pb.CouponResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	c.store.mu.Lock()
+	defer c.store.mu.Unlock()
+
+	coupon, ok := c.store.coupon(req.Id)
+	if !ok {
+		return couponError(errNotFound("coupon", req.Id)), nil
+	}
+	coupon.Metadata = mergeMeta(coupon.Metadata, req.Metadata)
+	return couponSuccess(coupon), nil
+}
+
+// Delete removes a coupon.  Existing discounts created from the coupon are kept.
+func (c *CouponClient) Delete(ctx context.Context, req *pb.DeleteCouponRequest) (*pb.DeleteCouponResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	c.store.mu.Lock()
+	defer c.store.mu.Unlock()
+
+	if !c.store.coupons.delete(req.Id) {
+		return &pb.DeleteCouponResponse{
+			Responses: &pb.DeleteCouponResponse_Error{Error: errNotFound("coupon", req.Id)},
+		}, nil
+	}
+	return &pb.DeleteCouponResponse{
+		Responses: &pb.DeleteCouponResponse_Success{
+			Success: &pb.DeleteCouponSuccess{Id: req.Id, Deleted: true},
+		},
+	}, nil
+}
+
+func (c *CouponClient) Get(ctx context.Context, req *pb.GetCouponRequest) (*pb.CouponResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	c.store.mu.RLock()
+	defer c.store.mu.RUnlock()
+
+	coupon, ok := c.store.coupon(req.Id)
+	if !ok {
+		return couponError(errNotFound("coupon", req.Id)), nil
+	}
+	return couponSuccess(coupon), nil
+}
+
+// List returns coupons newest first
+func (c *CouponClient) List(ctx context.Context, req *pb.ListCouponsRequest) (backend.CouponStreamer, error) {
+	p := newPager(ctx, c.store, c.store.coupons, req.GetCreated(), req.GetStartingAfter(), req.GetEndingBefore(), req.GetLimit())
+	return &couponStreamer{pager: p}, nil
+}
+
+// Apply redeems a coupon for a customer or subscription, replacing any existing discount
+func (c *CouponClient) Apply(ctx context.Context, req *pb.ApplyCouponRequest) (*pb.DiscountResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	c.store.mu.Lock()
+	defer c.store.mu.Unlock()
+
+	coupon, ok := c.store.coupon(req.Coupon)
+	if !ok {
+		return discountError(errNotFound("coupon", req.Coupon)), nil
+	}
+	if !coupon.Valid {
+		return discountError(errInvalid("coupon", fmt.Sprintf("Coupon expired: %s", req.Coupon))), nil
+	}
+	now := c.store.now()
+	discount := &pb.Discount{
+		Customer:     req.Customer,
+		Subscription: req.Subscription,
+		Start:        now.Unix(),
+	}
+	if coupon.Duration == pb.CouponDuration_Repeating {
+		discount.End = now.AddDate(0, int(coupon.DurationInMonths), 0).Unix()
+	}
+
+	switch {
+	case len(req.Customer) > 0:
+		v, ok := c.store.customers.get(req.Customer)
+		if !ok {
+			return discountError(errNotFound("customer", req.Customer)), nil
+		}
+		v.(*pb.Customer).Discount = discount
+	default:
+		v, ok := c.store.subscriptions.get(req.Subscription)
+		if !ok {
+			return discountError(errNotFound("subscription", req.Subscription)), nil
+		}
+		sub := v.(*pb.Subscription)
+		if sub.Status == pb.SubscriptionStatus_Canceled {
+			return discountError(errInvalid("subscription", "This subscription has been canceled and can no longer be modified.")), nil
+		}
+		discount.Customer = sub.Customer
+		sub.Discount = discount
+	}
+
+	coupon.TimesRedeemed++
+	coupon.Valid = couponValid(coupon, now)
+	discount.Coupon = proto.Clone(coupon).(*pb.Coupon)
+	return &pb.DiscountResponse{
+		Responses: &pb.DiscountResponse_Success{Success: proto.Clone(discount).(*pb.Discount)},
+	}, nil
+}
+
+// RemoveDiscount removes the discount from a customer or subscription
+func (c *CouponClient) RemoveDiscount(ctx context.Context, req *pb.RemoveDiscountRequest) (*pb.RemoveDiscountResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	c.store.mu.Lock()
+	defer c.store.mu.Unlock()
+
+	var found bool
+	switch {
+	case len(req.Customer) > 0:
+		v, ok := c.store.customers.get(req.Customer)
+		if !ok {
+			return removeDiscountError(errNotFound("customer", req.Customer)), nil
+		}
+		cust := v.(*pb.Customer)
+		found, cust.Discount = cust.Discount != nil, nil
+	default:
+		v, ok := c.store.subscriptions.get(req.Subscription)
+		if !ok {
+			return removeDiscountError(errNotFound("subscription", req.Subscription)), nil
+		}
+		sub := v.(*pb.Subscription)
+		found, sub.Discount = sub.Discount != nil, nil
+	}
+	if !found {
+		e := errInvalid("discount", "No active discount")
+		e.HttpStatusCode = 404
+		return removeDiscountError(e), nil
+	}
+	return &pb.RemoveDiscountResponse{
+		Responses: &pb.RemoveDiscountResponse_Success{
+			Success: &pb.RemoveDiscountSuccess{Deleted: true, Customer: req.Customer, Subscription: req.Subscription},
+		},
+	}, nil
+}
+
+// coupon returns a coupon with its validity updated for the current time.  Must be called with
+// the store lock held.
+func (s *Store) coupon(id string) (*pb.Coupon, bool) {
+	v, ok := s.coupons.get(id)
+	if !ok {
+		return nil, false
+	}
+	coupon := v.(*pb.Coupon)
+	coupon.Valid = couponValid(coupon, s.now())
+	return coupon, true
+}
+
+// couponValid returns false once a coupon has reached its maximum redemptions or its redeem by
+// date has passed
+func couponValid(coupon *pb.Coupon, now time.Time) bool {
+	switch {
+	case coupon.MaxRedemptions > 0 && coupon.TimesRedeemed >= coupon.MaxRedemptions:
+		return false
+	case coupon.RedeemBy > 0 && now.Unix() > coupon.RedeemBy:
+		return false
+	default:
+		return true
+	}
+}
+
+type couponStreamer struct {
+	*pager
+}
+
+func (s *couponStreamer) Next() bool {
+	return s.pager.next()
+}
+
+func (s *couponStreamer) Current() *pb.CouponResponse {
+	switch {
+	case s.errorResponse() != nil:
+		return couponError(s.errorResponse())
+	case s.pager.cur == nil:
+		return &pb.CouponResponse{}
+	default:
+		return &pb.CouponResponse{
+			Responses: &pb.CouponResponse_Success{Success: s.pager.cur.(*pb.Coupon)},
+		}
+	}
+}
+
+// couponSuccess returns a copy of the coupon so that callers cannot modify the store
+func couponSuccess(coupon *pb.Coupon) *pb.CouponResponse {
+	return &pb.CouponResponse{
+		Responses: &pb.CouponResponse_Success{Success: proto.Clone(coupon).(*pb.Coupon)},
+	}
+}
+
+func couponError(err *pb.Error) *pb.CouponResponse {
+	return &pb.CouponResponse{
+		Responses: &pb.CouponResponse_Error{Error: err},
+	}
+}
+
+func discountError(err *pb.Error) *pb.DiscountResponse {
+	return &pb.DiscountResponse{
+		Responses: &pb.DiscountResponse_Error{Error: err},
+	}
+}
+
+func removeDiscountError(err *pb.Error) *pb.RemoveDiscountResponse {
+	return &pb.RemoveDiscountResponse{
+		Responses: &pb.RemoveDiscountResponse_Error{Error: err},
+	}
+}
@@ -0,0 +1,70 @@
+package memory
+
+import (
+	"testing"
+
+	"github.com/BTBurke/recur/pb"
+	"github.com/stretchr/testify/assert"
+	context "golang.org/x/net/context"
+)
+
+func TestCouponDiscounts(t *testing.T) {
+	store := newTestStore()
+	plans := NewPlanClient(store)
+	customers := NewCustomerClient(store)
+	subs := NewSubscriptionClient(store)
+	coupons := NewCouponClient(store)
+	ctx := context.Background()
+
+	createPlans(t, plans, 1)
+	cust, _ := customers.Create(ctx, &pb.CreateCustomerRequest{})
+	custID := cust.GetSuccess().GetId()
+	s, _ := subs.Create(ctx, &pb.CreateSubscriptionRequest{Customer: custID, Plan: "plan-1"})
+	subID := s.GetSuccess().GetId()
+
+	resp, err := coupons.Create(ctx, &pb.CreateCouponRequest{Id: "SPRING", Duration: pb.CouponDuration_Repeating, PercentOff: 20, DurationInMonths: 3, MaxRedemptions: 1})
+	assert.NoError(t, err)
+	assert.True(t, resp.GetSuccess().GetValid())
+	resp, _ = coupons.Create(ctx, &pb.CreateCouponRequest{Id: "SPRING", Duration: pb.CouponDuration_Once, PercentOff: 10})
+	assert.Equal(t, pb.ErrorType_InvalidRequest, resp.GetError().GetType())
+
+	disc, err := coupons.Apply(ctx, &pb.ApplyCouponRequest{Coupon: "SPRING", Subscription: subID})
+	assert.NoError(t, err)
+	d := disc.GetSuccess()
+	assert.Equal(t, custID, d.Customer)
+	assert.Equal(t, subID, d.Subscription)
+	assert.Equal(t, uint64(1), d.Coupon.TimesRedeemed)
+	assert.True(t, d.End > d.Start)
+
+	sub, _ := subs.Get(ctx, &pb.GetSubscriptionRequest{Id: subID})
+	assert.Equal(t, "SPRING", sub.GetSuccess().GetDiscount().GetCoupon().GetId())
+
+	// the coupon has reached its maximum redemptions
+	disc, _ = coupons.Apply(ctx, &pb.ApplyCouponRequest{Coupon: "SPRING", Customer: custID})
+	assert.Equal(t, "coupon", disc.GetError().GetParam())
+	resp, _ = coupons.Get(ctx, &pb.GetCouponRequest{Id: "SPRING"})
+	assert.False(t, resp.GetSuccess().GetValid())
+
+	rm, _ := coupons.RemoveDiscount(ctx, &pb.RemoveDiscountRequest{Subscription: subID})
+	assert.True(t, rm.GetSuccess().GetDeleted())
+	rm, _ = coupons.RemoveDiscount(ctx, &pb.RemoveDiscountRequest{Subscription: subID})
+	assert.Equal(t, int32(404), rm.GetError().GetHttpStatusCode())
+	sub, _ = subs.Get(ctx, &pb.GetSubscriptionRequest{Id: subID})
+	assert.Nil(t, sub.GetSuccess().GetDiscount())
+
+	coupons.Create(ctx, &pb.CreateCouponRequest{Id: "FIVE", Duration: pb.CouponDuration_Forever, AmountOff: 500, Currency: pb.Currency_USD})
+	disc, _ = coupons.Apply(ctx, &pb.ApplyCouponRequest{Coupon: "FIVE", Customer: custID})
+	assert.Equal(t, int64(0), disc.GetSuccess().GetEnd())
+	c, _ := customers.Get(ctx, &pb.GetCustomerRequest{Id: custID})
+	assert.Equal(t, "FIVE", c.GetSuccess().GetDiscount().GetCoupon().GetId())
+
+	del, _ := coupons.Delete(ctx, &pb.DeleteCouponRequest{Id: "FIVE"})
+	assert.True(t, del.GetSuccess().GetDeleted())
+	c, _ = customers.Get(ctx, &pb.GetCustomerRequest{Id: custID})
+	assert.NotNil(t, c.GetSuccess().GetDiscount())
+
+	list, _ := coupons.List(ctx, &pb.ListCouponsRequest{})
+	assert.True(t, list.Next())
+	assert.Equal(t, "SPRING", list.Current().GetSuccess().GetId())
+	assert.False(t, list.Next())
+}
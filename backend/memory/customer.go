@@ -0,0 +1,145 @@
+package memory
+
+import (
+	"github.com/BTBurke/recur/backend"
+	"github.com/BTBurke/recur/pb"
+	"github.com/golang/protobuf/proto"
+	context "golang.org/x/net/context"
+)
+
+// CustomerClient implements backend.CustomerClient in memory
+type CustomerClient struct {
+	store *Store
+}
+
+var _ backend.CustomerClient = (*CustomerClient)(nil)
+
+// NewCustomerClient returns a customer client backed by the store
+func NewCustomerClient(store *Store) *CustomerClient {
+	return &CustomerClient{store: store}
+}
+
+func (c *CustomerClient) Create(ctx context.Context, req *pb.CreateCustomerRequest) (*pb.CustomerResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	c.store.mu.Lock()
+	defer c.store.mu.Unlock()
+
+	cust := &pb.Customer{
+		Id:             newID("cus"),
+		AccountBalance: req.AccountBalance,
+		Created:        c.store.now().Unix(),
+		Description:    req.Description,
+		Email:          req.Email,
+		Metadata:       copyMeta(req.Metadata),
+		BusinessVatId:  req.BusinessVatId,
+	}
+	c.store.customers.insert(cust.Id, cust.Created, cust)
+	return customerSuccess(cust), nil
+}
+
+func (c *CustomerClient) Update(ctx context.Context, req *pb.UpdateCustomerRequest) (*pb.CustomerResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	c.store.mu.Lock()
+	defer c.store.mu.Unlock()
+
+	v, ok := c.store.customers.get(req.Id)
+	if !ok {
+		return customerError(errNotFound("customer", req.Id)), nil
+	}
+	cust := v.(*pb.Customer)
+	if req.AccountBalance != 0 {
+		cust.AccountBalance = req.AccountBalance
+	}
+	if len(req.Description) > 0 {
+		cust.Description = req.Description
+	}
+	if len(req.Email) > 0 {
+		cust.Email = req.Email
+	}
+	if len(req.BusinessVatId) > 0 {
+		cust.BusinessVatId = req.BusinessVatId
+	}
+	cust.Metadata = mergeMeta(cust.Metadata, req.Metadata)
+	return customerSuccess(cust), nil
+}
+
+func (c *CustomerClient) Delete(ctx context.Context, req *pb.DeleteCustomerRequest) (*pb.DeleteCustomerResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	c.store.mu.Lock()
+	defer c.store.mu.Unlock()
+
+	if !c.store.customers.delete(req.Id) {
+		return &pb.DeleteCustomerResponse{
+			Responses: &pb.DeleteCustomerResponse_Error{Error: errNotFound("customer", req.Id)},
+		}, nil
+	}
+	for _, r := range c.store.sources.sorted(nil, nil) {
+		if r.value.(*pb.PaymentSource).Customer == req.Id {
+			c.store.sources.delete(r.id)
+		}
+	}
+	return &pb.DeleteCustomerResponse{
+		Responses: &pb.DeleteCustomerResponse_Success{
+			Success: &pb.DeleteCustomerSuccess{Id: req.Id, Deleted: true},
+		},
+	}, nil
+}
+
+func (c *CustomerClient) Get(ctx context.Context, req *pb.GetCustomerRequest) (*pb.CustomerResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	c.store.mu.RLock()
+	defer c.store.mu.RUnlock()
+
+	v, ok := c.store.customers.get(req.Id)
+	if !ok {
+		return customerError(errNotFound("customer", req.Id)), nil
+	}
+	return customerSuccess(v.(*pb.Customer)), nil
+}
+
+// List returns customers newest first, fetching pages of the requested limit as the stream is consumed
+func (c *CustomerClient) List(ctx context.Context, req *pb.ListCustomersRequest) (backend.CustomerStreamer, error) {
+	p := newPager(ctx, c.store, c.store.customers, req.GetCreated(), req.GetStartingAfter(), req.GetEndingBefore(), req.GetLimit())
+	return &customerStreamer{pager: p}, nil
+}
+
+type customerStreamer struct {
+	*pager
+}
+
+func (s *customerStreamer) Next() bool {
+	return s.pager.next()
+}
+
+func (s *customerStreamer) Current() *pb.CustomerResponse {
+	switch {
+	case s.errorResponse() != nil:
+		return customerError(s.errorResponse())
+	case s.pager.cur == nil:
+		return &pb.CustomerResponse{}
+	default:
+		return &pb.CustomerResponse{
+			Responses: &pb.CustomerResponse_Success{Success: s.pager.cur.(*pb.Customer)},
+		}
+	}
+}
+
+func customerSuccess(cust *pb.Customer) *pb.CustomerResponse {
+	return &pb.CustomerResponse{
+		Responses: &pb.CustomerResponse_Success{Success: proto.Clone(cust).(*pb.Customer)},
+	}
+}
+
+func customerError(err *pb.Error) *pb.CustomerResponse {
+	return &pb.CustomerResponse{
+		Responses: &pb.CustomerResponse_Error{Error: err},
+	}
+}
@@ -0,0 +1,47 @@
+package memory
+
+import (
+	"fmt"
+
+	"github.com/BTBurke/recur/pb"
+)
+
+// errNotFound mirrors the error Stripe returns when a resource does not exist
+func errNotFound(resource string, id string) *pb.Error {
+	return &pb.Error{
+		Type:           pb.ErrorType_InvalidRequest,
+		Message:        fmt.Sprintf("No such %s: %s", resource, id),
+		HttpStatusCode: 404,
+		Param:          "id",
+	}
+}
+
+// errExists mirrors the error Stripe returns when creating a resource with a duplicate ID
+func errExists(resource string) *pb.Error {
+	return &pb.Error{
+		Type:           pb.ErrorType_InvalidRequest,
+		Message:        fmt.Sprintf("%s already exists.", resource),
+		HttpStatusCode: 400,
+		Param:          "id",
+	}
+}
+
+// errInvalid mirrors the error Stripe returns for an invalid parameter
+func errInvalid(param string, msg string) *pb.Error {
+	return &pb.Error{
+		Type:           pb.ErrorType_InvalidRequest,
+		Message:        msg,
+		HttpStatusCode: 400,
+		Param:          param,
+	}
+}
+
+// errCard mirrors the error Stripe returns when a card is declined
+func errCard(code pb.CardErrors) *pb.Error {
+	return &pb.Error{
+		Type:           pb.ErrorType_Card,
+		Message:        "Your card was declined.",
+		HttpStatusCode: 402,
+		Code:           code,
+	}
+}
@@ -0,0 +1,98 @@
+package memory
+
+import (
+	"strings"
+
+	"github.com/BTBurke/recur/backend"
+	"github.com/BTBurke/recur/pb"
+	"github.com/golang/protobuf/proto"
+	context "golang.org/x/net/context"
+)
+
+// EventClient implements backend.EventClient in memory.  The memory backend does not generate
+// events from API calls; events are added to the store with RecordEvent.
+type EventClient struct {
+	store *Store
+}
+
+var _ backend.EventClient = (*EventClient)(nil)
+
+// NewEventClient returns an event client backed by the store
+func NewEventClient(store *Store) *EventClient {
+	return &EventClient{store: store}
+}
+
+// RecordEvent adds an event to the store so that it can be retrieved by the event client.  An
+// event with an existing ID is ignored.  The created time is set if it is zero.
+func (s *Store) RecordEvent(ev *pb.Event) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ev = proto.Clone(ev).(*pb.Event)
+	if ev.Created == 0 {
+		ev.Created = s.now().Unix()
+	}
+	s.events.insert(ev.Id, ev.Created, ev)
+}
+
+func (c *EventClient) Get(ctx context.Context, req *pb.GetEventRequest) (*pb.EventResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	c.store.mu.RLock()
+	defer c.store.mu.RUnlock()
+
+	v, ok := c.store.events.get(req.Id)
+	if !ok {
+		return eventError(errNotFound("event", req.Id)), nil
+	}
+	return eventSuccess(v.(*pb.Event)), nil
+}
+
+// List returns events newest first, filtered by type.  As with Stripe, the type filter may end
+// in a wildcard such as "invoice.*".
+func (c *EventClient) List(ctx context.Context, req *pb.ListEventsRequest) (backend.EventStreamer, error) {
+	p := newPager(ctx, c.store, c.store.events, req.GetCreated(), req.GetStartingAfter(), req.GetEndingBefore(), req.GetLimit())
+	if typ := req.GetType(); len(typ) > 0 {
+		p.match = func(v interface{}) bool {
+			t := v.(*pb.Event).Type
+			if strings.HasSuffix(typ, "*") {
+				return strings.HasPrefix(t, strings.TrimSuffix(typ, "*"))
+			}
+			return t == typ
+		}
+	}
+	return &eventStreamer{pager: p}, nil
+}
+
+type eventStreamer struct {
+	*pager
+}
+
+func (s *eventStreamer) Next() bool {
+	return s.pager.next()
+}
+
+func (s *eventStreamer) Current() *pb.EventResponse {
+	switch {
+	case s.errorResponse() != nil:
+		return eventError(s.errorResponse())
+	case s.pager.cur == nil:
+		return &pb.EventResponse{}
+	default:
+		return &pb.EventResponse{
+			Responses: &pb.EventResponse_Success{Success: s.pager.cur.(*pb.Event)},
+		}
+	}
+}
+
+func eventSuccess(ev *pb.Event) *pb.EventResponse {
+	return &pb.EventResponse{
+		Responses: &pb.EventResponse_Success{Success: proto.Clone(ev).(*pb.Event)},
+	}
+}
+
+func eventError(err *pb.Error) *pb.EventResponse {
+	return &pb.EventResponse{
+		Responses: &pb.EventResponse_Error{Error: err},
+	}
+}
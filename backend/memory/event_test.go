@@ -0,0 +1,57 @@
+package memory
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/BTBurke/recur/pb"
+	"github.com/stretchr/testify/assert"
+	context "golang.org/x/net/context"
+)
+
+func TestEvents(t *testing.T) {
+	store := newTestStore()
+	events := NewEventClient(store)
+	ctx := context.Background()
+
+	types := []string{"invoice.created", "customer.created", "invoice.paid", "invoice.created"}
+	for i, typ := range types {
+		store.RecordEvent(&pb.Event{Id: fmt.Sprintf("evt_%d", i), Type: typ})
+	}
+	store.RecordEvent(&pb.Event{Id: "evt_0", Type: "plan.created"})
+
+	resp, err := events.Get(ctx, &pb.GetEventRequest{Id: "evt_0"})
+	assert.NoError(t, err)
+	assert.Equal(t, "invoice.created", resp.GetSuccess().GetType())
+	assert.NotZero(t, resp.GetSuccess().GetCreated())
+
+	resp, _ = events.Get(ctx, &pb.GetEventRequest{Id: "evt_missing"})
+	assert.Equal(t, int32(404), resp.GetError().GetHttpStatusCode())
+
+	_, err = events.Get(ctx, &pb.GetEventRequest{})
+	assert.Error(t, err)
+
+	tt := []struct {
+		Name   string
+		Req    *pb.ListEventsRequest
+		Expect []string
+	}{
+		{Name: "all", Req: &pb.ListEventsRequest{}, Expect: []string{"evt_3", "evt_2", "evt_1", "evt_0"}},
+		{Name: "exact type", Req: &pb.ListEventsRequest{Type: "invoice.created"}, Expect: []string{"evt_3", "evt_0"}},
+		{Name: "wildcard type", Req: &pb.ListEventsRequest{Type: "invoice.*"}, Expect: []string{"evt_3", "evt_2", "evt_0"}},
+		// as with Stripe, pages are fetched backwards from the ending before event
+		{Name: "ending before", Req: &pb.ListEventsRequest{EndingBefore: "evt_1", Limit: 1}, Expect: []string{"evt_2", "evt_3"}},
+	}
+	for _, tc := range tt {
+		t.Run(tc.Name, func(t *testing.T) {
+			list, err := events.List(ctx, tc.Req)
+			assert.NoError(t, err)
+			var got []string
+			for list.Next() {
+				got = append(got, list.Current().GetSuccess().GetId())
+			}
+			assert.NoError(t, list.Err())
+			assert.Equal(t, tc.Expect, got)
+		})
+	}
+}
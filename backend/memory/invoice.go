@@ -0,0 +1,296 @@
+package memory
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/BTBurke/recur/backend"
+	"github.com/BTBurke/recur/money"
+	"github.com/BTBurke/recur/pb"
+	"github.com/golang/protobuf/proto"
+	context "golang.org/x/net/context"
+)
+
+// InvoiceClient implements backend.InvoiceClient in memory.  An invoice is created for the first
+// period of each subscription; renewals are not simulated.  Invoices are paid immediately when
+// nothing is due or the customer has a default source, otherwise they are left open.
+type InvoiceClient struct {
+	store *Store
+}
+
+var _ backend.InvoiceClient = (*InvoiceClient)(nil)
+
+// NewInvoiceClient returns an invoice client backed by the store
+func NewInvoiceClient(store *Store) *InvoiceClient {
+	return &InvoiceClient{store: store}
+}
+
+func (c *InvoiceClient) Get(ctx context.Context, req *pb.GetInvoiceRequest) (*pb.InvoiceResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	c.store.mu.RLock()
+	defer c.store.mu.RUnlock()
+
+	v, ok := c.store.invoices.get(req.Id)
+	if !ok {
+		return invoiceError(errNotFound("invoice", req.Id)), nil
+	}
+	return invoiceSuccess(v.(*pb.Invoice)), nil
+}
+
+// Upcoming previews the invoice for the next period of the subscription in the request, or of the
+// customer's first active subscription.  Prorations are not calculated in memory.
+func (c *InvoiceClient) Upcoming(ctx context.Context, req *pb.UpcomingInvoiceRequest) (*pb.InvoiceResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	c.store.mu.RLock()
+	defer c.store.mu.RUnlock()
+
+	if _, ok := c.store.customers.get(req.Customer); !ok {
+		return invoiceError(errNotFound("customer", req.Customer)), nil
+	}
+	var sub *pb.Subscription
+	for _, r := range c.store.subscriptions.sorted(nil, nil) {
+		s := r.value.(*pb.Subscription)
+		switch {
+		case s.Customer != req.Customer, s.Status == pb.SubscriptionStatus_Canceled, s.CancelAtPeriodEnd:
+			continue
+		case len(req.Subscription) > 0 && s.Id != req.Subscription:
+			continue
+		}
+		sub = s
+		break
+	}
+	if sub == nil {
+		e := errInvalid("customer", fmt.Sprintf("No upcoming invoices for customer: %s", req.Customer))
+		e.HttpStatusCode = 404
+		return invoiceError(e), nil
+	}
+
+	plan := sub.Plan
+	if len(req.Plan) > 0 {
+		v, ok := c.store.plans.get(req.Plan)
+		if !ok {
+			return invoiceError(errNotFound("plan", req.Plan)), nil
+		}
+		plan = v.(*pb.Plan)
+	}
+	quantity := sub.Quantity
+	if req.Quantity > 0 {
+		quantity = req.Quantity
+	}
+	if plan.UsageType == pb.UsageType_Metered {
+		quantity = c.store.totalUsage(sub.SubscriptionItem, plan.AggregateUsage, sub.CurrentPeriodStart, sub.CurrentPeriodEnd)
+	}
+	start := time.Unix(sub.CurrentPeriodEnd, 0)
+	inv := newInvoice(sub, plan, quantity, start, periodEnd(start, plan))
+	inv.Date = start.Unix()
+	return invoiceSuccess(inv), nil
+}
+
+// Pay marks an open invoice as paid.  A source must be given unless the customer has a default
+// source.  Paying with a declining test token such as tok_chargeDeclined returns a card error.
+func (c *InvoiceClient) Pay(ctx context.Context, req *pb.PayInvoiceRequest) (*pb.InvoiceResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	c.store.mu.Lock()
+	defer c.store.mu.Unlock()
+
+	inv, errResp := c.open(req.Id)
+	if errResp != nil {
+		return errResp, nil
+	}
+	if len(req.Source) == 0 && !c.store.hasDefaultSource(inv.Customer) {
+		return invoiceError(errInvalid("source", "Cannot charge a customer that has no active card")), nil
+	}
+	inv.AttemptCount++
+	inv.Attempted = true
+	if code, ok := testCardErrors[req.Source]; ok {
+		return invoiceError(errCard(code)), nil
+	}
+	markPaid(inv)
+	return invoiceSuccess(inv), nil
+}
+
+// Void closes an open invoice so that it can no longer be paid
+func (c *InvoiceClient) Void(ctx context.Context, req *pb.VoidInvoiceRequest) (*pb.InvoiceResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	c.store.mu.Lock()
+	defer c.store.mu.Unlock()
+
+	inv, errResp := c.open(req.Id)
+	if errResp != nil {
+		return errResp, nil
+	}
+	inv.Closed = true
+	inv.Status = pb.InvoiceStatus_Void
+	return invoiceSuccess(inv), nil
+}
+
+// MarkUncollectible forgives an open invoice
+func (c *InvoiceClient) MarkUncollectible(ctx context.Context, req *pb.MarkUncollectibleInvoiceRequest) (*pb.InvoiceResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	c.store.mu.Lock()
+	defer c.store.mu.Unlock()
+
+	inv, errResp := c.open(req.Id)
+	if errResp != nil {
+		return errResp, nil
+	}
+	inv.Closed = true
+	inv.Forgiven = true
+	inv.Status = pb.InvoiceStatus_Uncollectible
+	return invoiceSuccess(inv), nil
+}
+
+// List returns invoices newest first, filtered by customer, subscription and status.  An unknown
+// status lists invoices in any state.
+func (c *InvoiceClient) List(ctx context.Context, req *pb.ListInvoicesRequest) (backend.InvoiceStreamer, error) {
+	p := newPager(ctx, c.store, c.store.invoices, req.GetCreated(), req.GetStartingAfter(), req.GetEndingBefore(), req.GetLimit())
+	p.match = func(v interface{}) bool {
+		inv := v.(*pb.Invoice)
+		switch {
+		case len(req.GetCustomer()) > 0 && inv.Customer != req.GetCustomer():
+			return false
+		case len(req.GetSubscription()) > 0 && inv.Subscription != req.GetSubscription():
+			return false
+		case req.GetStatus() != pb.InvoiceStatus_UnknownInvoiceStatus && inv.Status != req.GetStatus():
+			return false
+		default:
+			return true
+		}
+	}
+	return &invoiceStreamer{pager: p}, nil
+}
+
+// open returns an invoice that can still be collected, or an error response if it does not
+// exist or is closed.  Must be called with the store lock held.
+func (c *InvoiceClient) open(id string) (*pb.Invoice, *pb.InvoiceResponse) {
+	v, ok := c.store.invoices.get(id)
+	if !ok {
+		return nil, invoiceError(errNotFound("invoice", id))
+	}
+	inv := v.(*pb.Invoice)
+	switch {
+	case inv.Paid:
+		return nil, invoiceError(errInvalid("id", "Invoice is already paid"))
+	case inv.Closed:
+		return nil, invoiceError(errInvalid("id", "Invoice is closed and can no longer be modified"))
+	}
+	return inv, nil
+}
+
+// invoiceSubscription creates the invoice for the first period of a new subscription.  Must be
+// called with the store lock held.
+func (s *Store) invoiceSubscription(sub *pb.Subscription, now time.Time) {
+	// metered usage is billed at the end of the period, so the first invoice charges nothing for it
+	quantity := sub.Quantity
+	if sub.Plan.UsageType == pb.UsageType_Metered {
+		quantity = 0
+	}
+	inv := newInvoice(sub, sub.Plan, quantity, time.Unix(sub.CurrentPeriodStart, 0), time.Unix(sub.CurrentPeriodEnd, 0))
+	if sub.Status == pb.SubscriptionStatus_Trialing {
+		for _, line := range inv.Lines {
+			line.Amount = 0
+		}
+		inv.Subtotal, inv.Total, inv.AmountDue = 0, 0, 0
+	}
+	inv.Id = newID("in")
+	inv.Date = now.Unix()
+	inv.Attempted = true
+	inv.AttemptCount = 1
+	if inv.AmountDue == 0 || s.hasDefaultSource(sub.Customer) {
+		markPaid(inv)
+	} else {
+		inv.Status = pb.InvoiceStatus_Open
+	}
+	s.invoices.insert(inv.Id, inv.Date, inv)
+}
+
+// hasDefaultSource returns true when the customer can be charged without a source.  Must be
+// called with the store lock held.
+func (s *Store) hasDefaultSource(customer string) bool {
+	v, ok := s.customers.get(customer)
+	return ok && len(v.(*pb.Customer).DefaultSource) > 0
+}
+
+// newInvoice returns a draft invoice for one period of a subscription.  The quantity is the
+// number of licenses billed, or the usage reported for a metered plan.
+func newInvoice(sub *pb.Subscription, plan *pb.Plan, quantity uint64, start time.Time, end time.Time) *pb.Invoice {
+	// plans are validated when created, so a quote can only fail by overflowing
+	price, _ := money.Quote(plan, quantity)
+	amount := price.Amount
+	return &pb.Invoice{
+		Customer:     sub.Customer,
+		Subscription: sub.Id,
+		Status:       pb.InvoiceStatus_Draft,
+		AmountDue:    amount,
+		Subtotal:     amount,
+		Total:        amount,
+		Currency:     plan.Currency,
+		PeriodStart:  start.Unix(),
+		PeriodEnd:    end.Unix(),
+		Lines: []*pb.InvoiceLineItem{{
+			Id:           sub.Id,
+			Type:         pb.InvoiceLineType_SubscriptionLine,
+			Amount:       amount,
+			Currency:     plan.Currency,
+			Discountable: true,
+			PeriodStart:  start.Unix(),
+			PeriodEnd:    end.Unix(),
+			Plan:         proto.Clone(plan).(*pb.Plan),
+			Quantity:     int64(quantity),
+			Subscription: sub.Id,
+		}},
+	}
+}
+
+// markPaid records a successful payment.  Paid invoices are closed.
+func markPaid(inv *pb.Invoice) {
+	inv.Paid = true
+	inv.Closed = true
+	inv.Status = pb.InvoiceStatus_Paid
+	inv.Charge = newID("ch")
+	inv.AmountDue = 0
+}
+
+type invoiceStreamer struct {
+	*pager
+}
+
+func (s *invoiceStreamer) Next() bool {
+	return s.pager.next()
+}
+
+func (s *invoiceStreamer) Current() *pb.InvoiceResponse {
+	switch {
+	case s.errorResponse() != nil:
+		return invoiceError(s.errorResponse())
+	case s.pager.cur == nil:
+		return &pb.InvoiceResponse{}
+	default:
+		return &pb.InvoiceResponse{
+			Responses: &pb.InvoiceResponse_Success{Success: s.pager.cur.(*pb.Invoice)},
+		}
+	}
+}
+
+// invoiceSuccess returns a copy of the invoice so that callers cannot modify the store
+func invoiceSuccess(inv *pb.Invoice) *pb.InvoiceResponse {
+	return &pb.InvoiceResponse{
+		Responses: &pb.InvoiceResponse_Success{Success: proto.Clone(inv).(*pb.Invoice)},
+	}
+}
+
+func invoiceError(e *pb.Error) *pb.InvoiceResponse {
+	return &pb.InvoiceResponse{
+		Responses: &pb.InvoiceResponse_Error{Error: e},
+	}
+}
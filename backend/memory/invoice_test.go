@@ -0,0 +1,122 @@
+package memory
+
+import (
+	"testing"
+
+	"github.com/BTBurke/recur/pb"
+	"github.com/stretchr/testify/assert"
+	context "golang.org/x/net/context"
+)
+
+func TestInvoiceLifecycle(t *testing.T) {
+	store := newTestStore()
+	plans := NewPlanClient(store)
+	customers := NewCustomerClient(store)
+	subs := NewSubscriptionClient(store)
+	invoices := NewInvoiceClient(store)
+	ctx := context.Background()
+
+	createPlans(t, plans, 2)
+	cust, _ := customers.Create(ctx, &pb.CreateCustomerRequest{})
+	custID := cust.GetSuccess().GetId()
+	sub, err := subs.Create(ctx, &pb.CreateSubscriptionRequest{Customer: custID, Plan: "plan-2", Quantity: 2})
+	assert.NoError(t, err)
+
+	list, _ := invoices.List(ctx, &pb.ListInvoicesRequest{Customer: custID})
+	assert.True(t, list.Next())
+	inv := list.Current().GetSuccess()
+	assert.False(t, list.Next())
+	assert.NoError(t, list.Err())
+	assert.Equal(t, pb.InvoiceStatus_Open, inv.Status)
+	assert.Equal(t, sub.GetSuccess().GetId(), inv.Subscription)
+	assert.Equal(t, int64(400), inv.AmountDue)
+	assert.Len(t, inv.Lines, 1)
+
+	resp, _ := invoices.Pay(ctx, &pb.PayInvoiceRequest{Id: inv.Id})
+	assert.Equal(t, "source", resp.GetError().GetParam())
+
+	resp, _ = invoices.Pay(ctx, &pb.PayInvoiceRequest{Id: inv.Id, Source: "tok_visa"})
+	assert.Equal(t, pb.InvoiceStatus_Paid, resp.GetSuccess().GetStatus())
+	assert.True(t, resp.GetSuccess().GetPaid())
+
+	resp, _ = invoices.Void(ctx, &pb.VoidInvoiceRequest{Id: inv.Id})
+	assert.Equal(t, pb.ErrorType_InvalidRequest, resp.GetError().GetType())
+
+	resp, _ = invoices.Get(ctx, &pb.GetInvoiceRequest{Id: inv.Id})
+	assert.Equal(t, pb.InvoiceStatus_Paid, resp.GetSuccess().GetStatus())
+
+	list, _ = invoices.List(ctx, &pb.ListInvoicesRequest{Customer: custID, Status: pb.InvoiceStatus_Open})
+	assert.False(t, list.Next())
+}
+
+func TestInvoiceVoidAndUncollectible(t *testing.T) {
+	store := newTestStore()
+	plans := NewPlanClient(store)
+	customers := NewCustomerClient(store)
+	subs := NewSubscriptionClient(store)
+	invoices := NewInvoiceClient(store)
+	ctx := context.Background()
+
+	createPlans(t, plans, 2)
+	cust, _ := customers.Create(ctx, &pb.CreateCustomerRequest{})
+	custID := cust.GetSuccess().GetId()
+	subs.Create(ctx, &pb.CreateSubscriptionRequest{Customer: custID, Plan: "plan-1"})
+	subs.Create(ctx, &pb.CreateSubscriptionRequest{Customer: custID, Plan: "plan-2"})
+
+	var ids []string
+	list, _ := invoices.List(ctx, &pb.ListInvoicesRequest{Customer: custID, Status: pb.InvoiceStatus_Open})
+	for list.Next() {
+		ids = append(ids, list.Current().GetSuccess().GetId())
+	}
+	assert.Len(t, ids, 2)
+
+	resp, _ := invoices.Void(ctx, &pb.VoidInvoiceRequest{Id: ids[0]})
+	assert.Equal(t, pb.InvoiceStatus_Void, resp.GetSuccess().GetStatus())
+	resp, _ = invoices.MarkUncollectible(ctx, &pb.MarkUncollectibleInvoiceRequest{Id: ids[1]})
+	assert.Equal(t, pb.InvoiceStatus_Uncollectible, resp.GetSuccess().GetStatus())
+
+	resp, _ = invoices.Pay(ctx, &pb.PayInvoiceRequest{Id: ids[0], Source: "tok_visa"})
+	assert.Equal(t, pb.ErrorType_InvalidRequest, resp.GetError().GetType())
+	resp, _ = invoices.Get(ctx, &pb.GetInvoiceRequest{Id: "in_missing"})
+	assert.Equal(t, int32(404), resp.GetError().GetHttpStatusCode())
+}
+
+func TestUpcomingInvoice(t *testing.T) {
+	store := newTestStore()
+	plans := NewPlanClient(store)
+	customers := NewCustomerClient(store)
+	subs := NewSubscriptionClient(store)
+	invoices := NewInvoiceClient(store)
+	ctx := context.Background()
+
+	createPlans(t, plans, 2)
+	cust, _ := customers.Create(ctx, &pb.CreateCustomerRequest{})
+	custID := cust.GetSuccess().GetId()
+
+	resp, _ := invoices.Upcoming(ctx, &pb.UpcomingInvoiceRequest{Customer: custID})
+	assert.Equal(t, int32(404), resp.GetError().GetHttpStatusCode())
+
+	s, _ := subs.Create(ctx, &pb.CreateSubscriptionRequest{Customer: custID, Plan: "plan-1"})
+	sub := s.GetSuccess()
+
+	resp, err := invoices.Upcoming(ctx, &pb.UpcomingInvoiceRequest{Customer: custID})
+	assert.NoError(t, err)
+	inv := resp.GetSuccess()
+	assert.Empty(t, inv.Id)
+	assert.Equal(t, pb.InvoiceStatus_Draft, inv.Status)
+	assert.Equal(t, sub.CurrentPeriodEnd, inv.PeriodStart)
+	assert.Equal(t, int64(100), inv.AmountDue)
+
+	resp, _ = invoices.Upcoming(ctx, &pb.UpcomingInvoiceRequest{Customer: custID, Plan: "plan-2", Quantity: 3})
+	assert.Equal(t, int64(600), resp.GetSuccess().GetAmountDue())
+
+	_, err = plans.Create(ctx, &pb.CreatePlanRequest{
+		Id: "tiered", Name: "Tiered", Currency: pb.Currency_USD, Interval: pb.Interval_Month,
+		BillingScheme: pb.BillingScheme_Tiered,
+		TiersMode:     pb.TiersMode_Graduated,
+		Tiers:         []*pb.PlanTier{{UpTo: 2, UnitAmount: 1000, FlatAmount: 500}, {UnitAmount: 700}},
+	})
+	assert.NoError(t, err)
+	resp, _ = invoices.Upcoming(ctx, &pb.UpcomingInvoiceRequest{Customer: custID, Plan: "tiered", Quantity: 3})
+	assert.Equal(t, int64(3200), resp.GetSuccess().GetAmountDue(), "tiered plans are priced by their tiers")
+}
@@ -0,0 +1,165 @@
+package memory
+
+import (
+	"fmt"
+
+	"github.com/BTBurke/recur/pb"
+	"github.com/golang/protobuf/proto"
+	context "golang.org/x/net/context"
+)
+
+const defaultLimit = 10
+
+// pager pages through a collection the same way the Stripe iterator does, fetching up to limit
+// records at a time after (or before) the last record seen.  Changes to the collection between
+// pages are visible to the pager.  Iteration stops when the context is done or the pager is
+// closed.
+type pager struct {
+	ctx     context.Context
+	store   *Store
+	coll    *collection
+	created *pb.ListFilter
+	match   func(v interface{}) bool
+
+	start string
+	end   string
+	limit int
+
+	buf    []proto.Message
+	cur    proto.Message
+	more   bool
+	err    *pb.Error
+	ctxErr error
+	closed bool
+}
+
+func newPager(ctx context.Context, store *Store, coll *collection, created *pb.ListFilter, start string, end string, limit int32) *pager {
+	l := int(limit)
+	if l <= 0 {
+		l = defaultLimit
+	}
+	return &pager{
+		ctx:     ctx,
+		store:   store,
+		coll:    coll,
+		created: created,
+		start:   start,
+		end:     end,
+		limit:   l,
+		more:    true,
+	}
+}
+
+func (p *pager) next() bool {
+	if p.closed || p.ctxErr != nil {
+		return false
+	}
+	if err := p.ctx.Err(); err != nil {
+		p.ctxErr = err
+		p.cur = nil
+		return false
+	}
+	if len(p.buf) == 0 && p.more && p.err == nil {
+		p.fetch()
+	}
+	if len(p.buf) == 0 {
+		return false
+	}
+	p.cur = p.buf[0]
+	p.buf = p.buf[1:]
+	return true
+}
+
+// Err returns the error that stopped iteration, or nil if the list was exhausted
+func (p *pager) Err() error {
+	switch {
+	case p.err != nil:
+		return p.err
+	default:
+		return p.ctxErr
+	}
+}
+
+// Close stops iteration
+func (p *pager) Close() {
+	p.closed = true
+}
+
+// errorResponse returns the error that stopped iteration as a pb.Error, or nil if there is none
+func (p *pager) errorResponse() *pb.Error {
+	switch {
+	case p.err != nil:
+		return p.err
+	case p.ctxErr != nil:
+		return &pb.Error{Type: pb.ErrorType_Unknown, Message: p.ctxErr.Error()}
+	default:
+		return nil
+	}
+}
+
+func (p *pager) fetch() {
+	p.store.mu.RLock()
+	defer p.store.mu.RUnlock()
+
+	recs := p.coll.sorted(p.created, p.match)
+	var page []*record
+	switch {
+	case len(p.end) > 0:
+		// moving backward, the records before the cursor are returned nearest first
+		idx := indexOf(recs, p.end)
+		if idx < 0 {
+			p.err = errInvalidCursor("ending_before", p.end)
+			return
+		}
+		lo := idx - p.limit
+		if lo < 0 {
+			lo = 0
+		}
+		for i := idx - 1; i >= lo; i-- {
+			page = append(page, recs[i])
+		}
+		p.more = lo > 0
+		if len(page) > 0 {
+			p.end = page[len(page)-1].id
+		}
+	default:
+		idx := -1
+		if len(p.start) > 0 {
+			idx = indexOf(recs, p.start)
+			if idx < 0 {
+				p.err = errInvalidCursor("starting_after", p.start)
+				return
+			}
+		}
+		hi := idx + 1 + p.limit
+		if hi > len(recs) {
+			hi = len(recs)
+		}
+		page = recs[idx+1 : hi]
+		p.more = hi < len(recs)
+		if len(page) > 0 {
+			p.start = page[len(page)-1].id
+		}
+	}
+	for _, r := range page {
+		p.buf = append(p.buf, proto.Clone(r.value.(proto.Message)))
+	}
+}
+
+func indexOf(recs []*record, id string) int {
+	for i, r := range recs {
+		if r.id == id {
+			return i
+		}
+	}
+	return -1
+}
+
+func errInvalidCursor(param string, id string) *pb.Error {
+	return &pb.Error{
+		Type:           pb.ErrorType_InvalidRequest,
+		Message:        fmt.Sprintf("Invalid %s: object %s not found in list", param, id),
+		HttpStatusCode: 400,
+		Param:          param,
+	}
+}
@@ -0,0 +1,141 @@
+// Package memory implements the backend interfaces entirely in memory.  It is intended for tests
+// and local development and follows Stripe semantics closely enough that code written against it
+// behaves the same way against the Stripe backend.
+package memory
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/BTBurke/recur/pb"
+)
+
+// Store holds the state shared by the in-memory resource clients.  Clients created from the same
+// store see each other's resources, e.g. a subscription can reference a plan created by the plan client.
+type Store struct {
+	mu            sync.RWMutex
+	plans         *collection
+	products      *collection
+	customers     *collection
+	subscriptions *collection
+	invoices      *collection
+	coupons       *collection
+	sources       *collection
+	events        *collection
+	usage         map[string][]*pb.UsageRecord
+	usageKeys     map[string]*pb.UsageRecord
+
+	// now returns the current time and can be replaced in tests
+	now func() time.Time
+}
+
+// NewStore returns an empty store
+func NewStore() *Store {
+	return &Store{
+		plans:         newCollection(),
+		products:      newCollection(),
+		customers:     newCollection(),
+		subscriptions: newCollection(),
+		invoices:      newCollection(),
+		coupons:       newCollection(),
+		sources:       newCollection(),
+		events:        newCollection(),
+		usage:         make(map[string][]*pb.UsageRecord),
+		usageKeys:     make(map[string]*pb.UsageRecord),
+		now:           time.Now,
+	}
+}
+
+// newID returns a random ID with a Stripe style prefix
+func newID(prefix string) string {
+	b := make([]byte, 12)
+	rand.Read(b)
+	return prefix + "_" + hex.EncodeToString(b)
+}
+
+type record struct {
+	id      string
+	created int64
+	seq     uint64
+	value   interface{}
+}
+
+// collection is a set of records listed in Stripe order, newest first.  Access is guarded by the
+// store mutex.
+type collection struct {
+	records map[string]*record
+	seq     uint64
+}
+
+func newCollection() *collection {
+	return &collection{
+		records: make(map[string]*record),
+	}
+}
+
+func (c *collection) insert(id string, created int64, value interface{}) bool {
+	if _, ok := c.records[id]; ok {
+		return false
+	}
+	c.seq++
+	c.records[id] = &record{id: id, created: created, seq: c.seq, value: value}
+	return true
+}
+
+func (c *collection) get(id string) (interface{}, bool) {
+	r, ok := c.records[id]
+	if !ok {
+		return nil, false
+	}
+	return r.value, true
+}
+
+func (c *collection) delete(id string) bool {
+	if _, ok := c.records[id]; !ok {
+		return false
+	}
+	delete(c.records, id)
+	return true
+}
+
+// sorted returns the records matching the filters, newest first
+func (c *collection) sorted(created *pb.ListFilter, match func(v interface{}) bool) []*record {
+	var out []*record
+	for _, r := range c.records {
+		if !inRange(r.created, created) {
+			continue
+		}
+		if match != nil && !match(r.value) {
+			continue
+		}
+		out = append(out, r)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].created != out[j].created {
+			return out[i].created > out[j].created
+		}
+		return out[i].seq > out[j].seq
+	})
+	return out
+}
+
+// inRange checks a created timestamp against a list filter
+func inRange(created int64, f *pb.ListFilter) bool {
+	switch {
+	case f == nil:
+		return true
+	case f.Gt != 0 && created <= f.Gt:
+		return false
+	case f.Gte != 0 && created < f.Gte:
+		return false
+	case f.Lt != 0 && created >= f.Lt:
+		return false
+	case f.Lte != 0 && created > f.Lte:
+		return false
+	default:
+		return true
+	}
+}
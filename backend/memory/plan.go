@@ -0,0 +1,226 @@
+package memory
+
+import (
+	"github.com/BTBurke/recur/backend"
+	"github.com/BTBurke/recur/pb"
+	"github.com/golang/protobuf/proto"
+	context "golang.org/x/net/context"
+)
+
+// PlanClient implements backend.PlanClient in memory
+type PlanClient struct {
+	store *Store
+}
+
+var _ backend.PlanClient = (*PlanClient)(nil)
+
+// NewPlanClient returns a plan client backed by the store
+func NewPlanClient(store *Store) *PlanClient {
+	return &PlanClient{store: store}
+}
+
+func (c *PlanClient) Create(ctx context.Context, req *pb.CreatePlanRequest) (*pb.PlanResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	c.store.mu.Lock()
+	defer c.store.mu.Unlock()
+
+	if len(req.Product) > 0 {
+		if _, ok := c.store.products.get(req.Product); !ok {
+			e := errNotFound("product", req.Product)
+			e.Param = "product"
+			return planError(e), nil
+		}
+	}
+	plan := &pb.Plan{
+		Id:                  req.Id,
+		Amount:              req.Amount,
+		Created:             c.store.now().Unix(),
+		Currency:            req.Currency,
+		Interval:            req.Interval,
+		IntervalCount:       req.IntervalCount,
+		Metadata:            copyMeta(req.Metadata),
+		Name:                req.Name,
+		StatementDescriptor: req.StatementDescriptor,
+		TrialPeriodDays:     req.TrialPeriodDays,
+		BillingScheme:       req.BillingScheme,
+		Tiers:               copyTiers(req.Tiers),
+		TiersMode:           req.TiersMode,
+		UsageType:           req.UsageType,
+		AggregateUsage:      req.AggregateUsage,
+		Product:             req.Product,
+		Active:              true,
+	}
+	if plan.UsageType == pb.UsageType_Metered && plan.AggregateUsage == pb.AggregateUsage_UnknownAggregateUsage {
+		plan.AggregateUsage = pb.AggregateUsage_Sum
+	}
+	if plan.IntervalCount == 0 {
+		plan.IntervalCount = 1
+	}
+	if !c.store.plans.insert(plan.Id, plan.Created, plan) {
+		return planError(errExists("Plan")), nil
+	}
+	return planSuccess(plan), nil
+}
+
+// Update changes the mutable fields of a plan.  As with Stripe, the amount, currency and interval
+// of a plan cannot be changed after it is created.
+func (c *PlanClient) Update(ctx context.Context, req *pb.UpdatePlanRequest) (*pb.PlanResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	c.store.mu.Lock()
+	defer c.store.mu.Unlock()
+
+	v, ok := c.store.plans.get(req.Id)
+	if !ok {
+		return planError(errNotFound("plan", req.Id)), nil
+	}
+	plan := v.(*pb.Plan)
+	if len(req.Name) > 0 {
+		plan.Name = req.Name
+	}
+	if len(req.StatementDescriptor) > 0 {
+		plan.StatementDescriptor = req.StatementDescriptor
+	}
+	if req.TrialPeriodDays > 0 {
+		plan.TrialPeriodDays = req.TrialPeriodDays
+	}
+	switch req.Active {
+	case pb.ActiveState_ActiveTrue:
+		plan.Active = true
+	case pb.ActiveState_ActiveFalse:
+		plan.Active = false
+	}
+	plan.Metadata = mergeMeta(plan.Metadata, req.Metadata)
+	return planSuccess(plan), nil
+}
+
+func (c *PlanClient) Delete(ctx context.Context, req *pb.DeletePlanRequest) (*pb.DeletePlanResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	c.store.mu.Lock()
+	defer c.store.mu.Unlock()
+
+	if !c.store.plans.delete(req.Id) {
+		return &pb.DeletePlanResponse{
+			Responses: &pb.DeletePlanResponse_Error{Error: errNotFound("plan", req.Id)},
+		}, nil
+	}
+	return &pb.DeletePlanResponse{
+		Responses: &pb.DeletePlanResponse_Success{
+			Success: &pb.DeletePlanSuccess{Id: req.Id, Deleted: true},
+		},
+	}, nil
+}
+
+func (c *PlanClient) Get(ctx context.Context, req *pb.GetPlanRequest) (*pb.PlanResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	c.store.mu.RLock()
+	defer c.store.mu.RUnlock()
+
+	v, ok := c.store.plans.get(req.Id)
+	if !ok {
+		return planError(errNotFound("plan", req.Id)), nil
+	}
+	return planSuccess(v.(*pb.Plan)), nil
+}
+
+// List returns plans newest first, filtered by product and active state.  The limit sets the
+// page size, and pages are fetched as the stream is consumed in the same way as the Stripe
+// iterator.
+func (c *PlanClient) List(ctx context.Context, req *pb.ListPlansRequest) (backend.PlanStreamer, error) {
+	p := newPager(ctx, c.store, c.store.plans, req.GetCreated(), req.GetStartingAfter(), req.GetEndingBefore(), req.GetLimit())
+	product, active := req.GetProduct(), req.GetActive()
+	if len(product) > 0 || active != pb.ActiveState_ActiveUnset {
+		p.match = func(v interface{}) bool {
+			plan := v.(*pb.Plan)
+			return (len(product) == 0 || plan.Product == product) && matchActive(active, plan.Active)
+		}
+	}
+	return &planStreamer{pager: p}, nil
+}
+
+type planStreamer struct {
+	*pager
+}
+
+func (s *planStreamer) Next() bool {
+	return s.pager.next()
+}
+
+func (s *planStreamer) Current() *pb.PlanResponse {
+	switch {
+	case s.errorResponse() != nil:
+		return planError(s.errorResponse())
+	case s.pager.cur == nil:
+		return &pb.PlanResponse{}
+	default:
+		return &pb.PlanResponse{
+			Responses: &pb.PlanResponse_Success{Success: s.pager.cur.(*pb.Plan)},
+		}
+	}
+}
+
+// planSuccess returns a copy of the plan so that callers cannot modify the store
+func planSuccess(plan *pb.Plan) *pb.PlanResponse {
+	return &pb.PlanResponse{
+		Responses: &pb.PlanResponse_Success{Success: proto.Clone(plan).(*pb.Plan)},
+	}
+}
+
+func planError(err *pb.Error) *pb.PlanResponse {
+	return &pb.PlanResponse{
+		Responses: &pb.PlanResponse_Error{Error: err},
+	}
+}
+
+func copyMeta(meta map[string]string) map[string]string {
+	if len(meta) == 0 {
+		return nil
+	}
+	out := make(map[string]string, len(meta))
+	for k, v := range meta {
+		out[k] = v
+	}
+	return out
+}
+
+func copyTiers(tiers []*pb.PlanTier) []*pb.PlanTier {
+	if len(tiers) == 0 {
+		return nil
+	}
+	out := make([]*pb.PlanTier, len(tiers))
+	for i, t := range tiers {
+		out[i] = proto.Clone(t).(*pb.PlanTier)
+	}
+	return out
+}
+
+// mergeMeta applies a metadata update.  As with Stripe, keys are merged into the existing
+// metadata and a key with an empty value is removed.
+func mergeMeta(meta map[string]string, update map[string]string) map[string]string {
+	if len(update) == 0 {
+		return meta
+	}
+	out := copyMeta(meta)
+	if out == nil {
+		out = make(map[string]string)
+	}
+	for k, v := range update {
+		switch {
+		case len(v) == 0:
+			delete(out, k)
+		default:
+			out[k] = v
+		}
+	}
+	if len(out) == 0 {
+		return nil
+	}
+	return out
+}
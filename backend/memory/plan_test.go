@@ -0,0 +1,143 @@
+package memory
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/BTBurke/recur/pb"
+	"github.com/stretchr/testify/assert"
+	context "golang.org/x/net/context"
+)
+
+// newTestStore returns a store whose clock advances one second each time it is read
+func newTestStore() *Store {
+	s := NewStore()
+	t := time.Unix(1500000000, 0)
+	s.now = func() time.Time {
+		t = t.Add(time.Second)
+		return t
+	}
+	return s
+}
+
+func createPlans(t *testing.T, c *PlanClient, n int) {
+	for i := 1; i <= n; i++ {
+		resp, err := c.Create(context.Background(), &pb.CreatePlanRequest{
+			Id:       fmt.Sprintf("plan-%d", i),
+			Name:     fmt.Sprintf("plan %d", i),
+			Amount:   uint64(i * 100),
+			Currency: pb.Currency_USD,
+			Interval: pb.Interval_Month,
+		})
+		if err != nil || resp.GetError() != nil {
+			t.Fatalf("failed to create plan: %v %v", err, resp.GetError())
+		}
+	}
+}
+
+func listIDs(t *testing.T, c *PlanClient, req *pb.ListPlansRequest) []string {
+	plans, err := c.List(context.Background(), req)
+	if err != nil {
+		t.Fatalf("unexpected error listing plans: %s", err)
+	}
+	var ids []string
+	for plans.Next() {
+		ids = append(ids, plans.Current().GetSuccess().GetId())
+	}
+	return ids
+}
+
+func TestPlanCRUD(t *testing.T) {
+	c := NewPlanClient(newTestStore())
+	createPlans(t, c, 1)
+
+	resp, err := c.Create(context.Background(), &pb.CreatePlanRequest{
+		Id:       "plan-1",
+		Name:     "duplicate",
+		Currency: pb.Currency_USD,
+		Interval: pb.Interval_Month,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, pb.ErrorType_InvalidRequest, resp.GetError().GetType())
+
+	_, err = c.Create(context.Background(), &pb.CreatePlanRequest{Id: "invalid"})
+	assert.IsType(t, pb.ValidationError{}, err)
+
+	resp, err = c.Update(context.Background(), &pb.UpdatePlanRequest{
+		Id:       "plan-1",
+		Name:     "updated",
+		Metadata: map[string]string{"tier": "gold"},
+	})
+	assert.NoError(t, err)
+	got := resp.GetSuccess()
+	assert.Equal(t, "updated", got.Name)
+	assert.Equal(t, uint64(100), got.Amount)
+	assert.Equal(t, pb.Currency_USD, got.Currency)
+	assert.Equal(t, pb.Interval_Month, got.Interval)
+	assert.Equal(t, map[string]string{"tier": "gold"}, got.Metadata)
+
+	// modifying a response does not change the stored plan
+	got.Amount = 1
+	resp, _ = c.Get(context.Background(), &pb.GetPlanRequest{Id: "plan-1"})
+	assert.Equal(t, uint64(100), resp.GetSuccess().Amount)
+
+	del, err := c.Delete(context.Background(), &pb.DeletePlanRequest{Id: "plan-1"})
+	assert.NoError(t, err)
+	assert.True(t, del.GetSuccess().GetDeleted())
+
+	resp, _ = c.Get(context.Background(), &pb.GetPlanRequest{Id: "plan-1"})
+	assert.Equal(t, int32(404), resp.GetError().GetHttpStatusCode())
+	del, _ = c.Delete(context.Background(), &pb.DeletePlanRequest{Id: "plan-1"})
+	assert.Equal(t, pb.ErrorType_InvalidRequest, del.GetError().GetType())
+}
+
+func TestPlanList(t *testing.T) {
+	c := NewPlanClient(newTestStore())
+	createPlans(t, c, 5)
+
+	tt := []struct {
+		Name   string
+		Req    *pb.ListPlansRequest
+		Expect []string
+	}{
+		{Name: "nil request", Req: nil, Expect: []string{"plan-5", "plan-4", "plan-3", "plan-2", "plan-1"}},
+		{Name: "pages smaller than list", Req: &pb.ListPlansRequest{Limit: 2}, Expect: []string{"plan-5", "plan-4", "plan-3", "plan-2", "plan-1"}},
+		{Name: "starting after", Req: &pb.ListPlansRequest{StartingAfter: "plan-4", Limit: 2}, Expect: []string{"plan-3", "plan-2", "plan-1"}},
+		{Name: "ending before", Req: &pb.ListPlansRequest{EndingBefore: "plan-2", Limit: 2}, Expect: []string{"plan-3", "plan-4", "plan-5"}},
+		{Name: "created filter", Req: &pb.ListPlansRequest{Created: &pb.ListFilter{Gt: 1500000002, Lte: 1500000004}}, Expect: []string{"plan-4", "plan-3"}},
+		{Name: "unknown cursor", Req: &pb.ListPlansRequest{StartingAfter: "missing"}, Expect: nil},
+	}
+	for _, tc := range tt {
+		t.Run(tc.Name, func(t *testing.T) {
+			assert.Equal(t, tc.Expect, listIDs(t, c, tc.Req))
+		})
+	}
+
+	plans, _ := c.List(context.Background(), &pb.ListPlansRequest{StartingAfter: "missing"})
+	assert.False(t, plans.Next())
+	assert.Equal(t, "starting_after", plans.Current().GetError().GetParam())
+}
+
+func TestPlanListStop(t *testing.T) {
+	c := NewPlanClient(newTestStore())
+	createPlans(t, c, 3)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	plans, _ := c.List(ctx, nil)
+	assert.True(t, plans.Next())
+	cancel()
+	assert.False(t, plans.Next())
+	assert.Equal(t, context.Canceled, plans.Err())
+	assert.Equal(t, pb.ErrorType_Unknown, plans.Current().GetError().GetType())
+
+	plans, _ = c.List(context.Background(), &pb.ListPlansRequest{StartingAfter: "missing"})
+	assert.False(t, plans.Next())
+	assert.Error(t, plans.Err())
+
+	plans, _ = c.List(context.Background(), nil)
+	assert.True(t, plans.Next())
+	plans.Close()
+	assert.False(t, plans.Next())
+	assert.NoError(t, plans.Err())
+}
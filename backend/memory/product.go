@@ -0,0 +1,184 @@
+package memory
+
+import (
+	"fmt"
+
+	"github.com/BTBurke/recur/backend"
+	"github.com/BTBurke/recur/pb"
+	"github.com/golang/protobuf/proto"
+	context "golang.org/x/net/context"
+)
+
+// ProductClient implements backend.ProductClient in memory
+type ProductClient struct {
+	store *Store
+}
+
+var _ backend.ProductClient = (*ProductClient)(nil)
+
+// NewProductClient returns a product client backed by the store
+func NewProductClient(store *Store) *ProductClient {
+	return &ProductClient{store: store}
+}
+
+// Create adds an active product.  A product without an ID is given a random one.
+func (c *ProductClient) Create(ctx context.Context, req *pb.CreateProductRequest) (*pb.ProductResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	c.store.mu.Lock()
+	defer c.store.mu.Unlock()
+
+	now := c.store.now().Unix()
+	product := &pb.Product{
+		Id:                  req.Id,
+		Name:                req.Name,
+		Active:              true,
+		Created:             now,
+		Updated:             now,
+		Metadata:            copyMeta(req.Metadata),
+		StatementDescriptor: req.StatementDescriptor,
+		UnitLabel:           req.UnitLabel,
+	}
+	if len(product.Id) == 0 {
+		product.Id = newID("prod")
+	}
+	if !c.store.products.insert(product.Id, product.Created, product) {
+		return productError(errExists("Product")), nil
+	}
+	return productSuccess(product), nil
+}
+
+func (c *ProductClient) Update(ctx context.Context, req *pb.UpdateProductRequest) (*pb.ProductResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	c.store.mu.Lock()
+	defer c.store.mu.Unlock()
+
+	v, ok := c.store.products.get(req.Id)
+	if !ok {
+		return productError(errNotFound("product", req.Id)), nil
+	}
+	product := v.(*pb.Product)
+	if len(req.Name) > 0 {
+		product.Name = req.Name
+	}
+	if len(req.StatementDescriptor) > 0 {
+		product.StatementDescriptor = req.StatementDescriptor
+	}
+	if len(req.UnitLabel) > 0 {
+		product.UnitLabel = req.UnitLabel
+	}
+	switch req.Active {
+	case pb.ActiveState_ActiveTrue:
+		product.Active = true
+	case pb.ActiveState_ActiveFalse:
+		product.Active = false
+	}
+	product.Metadata = mergeMeta(product.Metadata, req.Metadata)
+	product.Updated = c.store.now().Unix()
+	return productSuccess(product), nil
+}
+
+// Delete removes a product.  As with Stripe, a product cannot be deleted while it has plans;
+// deactivate it instead.
+func (c *ProductClient) Delete(ctx context.Context, req *pb.DeleteProductRequest) (*pb.DeleteProductResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	c.store.mu.Lock()
+	defer c.store.mu.Unlock()
+
+	if _, ok := c.store.products.get(req.Id); !ok {
+		return &pb.DeleteProductResponse{
+			Responses: &pb.DeleteProductResponse_Error{Error: errNotFound("product", req.Id)},
+		}, nil
+	}
+	for _, r := range c.store.plans.sorted(nil, nil) {
+		if r.value.(*pb.Plan).Product == req.Id {
+			return &pb.DeleteProductResponse{
+				Responses: &pb.DeleteProductResponse_Error{
+					Error: errInvalid("id", fmt.Sprintf("Product %s cannot be deleted because it has one or more plans", req.Id)),
+				},
+			}, nil
+		}
+	}
+	c.store.products.delete(req.Id)
+	return &pb.DeleteProductResponse{
+		Responses: &pb.DeleteProductResponse_Success{
+			Success: &pb.DeleteProductSuccess{Id: req.Id, Deleted: true},
+		},
+	}, nil
+}
+
+func (c *ProductClient) Get(ctx context.Context, req *pb.GetProductRequest) (*pb.ProductResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	c.store.mu.RLock()
+	defer c.store.mu.RUnlock()
+
+	v, ok := c.store.products.get(req.Id)
+	if !ok {
+		return productError(errNotFound("product", req.Id)), nil
+	}
+	return productSuccess(v.(*pb.Product)), nil
+}
+
+// List returns products newest first, filtered by active state
+func (c *ProductClient) List(ctx context.Context, req *pb.ListProductsRequest) (backend.ProductStreamer, error) {
+	p := newPager(ctx, c.store, c.store.products, req.GetCreated(), req.GetStartingAfter(), req.GetEndingBefore(), req.GetLimit())
+	if active := req.GetActive(); active != pb.ActiveState_ActiveUnset {
+		p.match = func(v interface{}) bool {
+			return matchActive(active, v.(*pb.Product).Active)
+		}
+	}
+	return &productStreamer{pager: p}, nil
+}
+
+// matchActive returns true when the active flag of a resource matches the filter
+func matchActive(filter pb.ActiveState, active bool) bool {
+	switch filter {
+	case pb.ActiveState_ActiveTrue:
+		return active
+	case pb.ActiveState_ActiveFalse:
+		return !active
+	default:
+		return true
+	}
+}
+
+type productStreamer struct {
+	*pager
+}
+
+func (s *productStreamer) Next() bool {
+	return s.pager.next()
+}
+
+func (s *productStreamer) Current() *pb.ProductResponse {
+	switch {
+	case s.errorResponse() != nil:
+		return productError(s.errorResponse())
+	case s.pager.cur == nil:
+		return &pb.ProductResponse{}
+	default:
+		return &pb.ProductResponse{
+			Responses: &pb.ProductResponse_Success{Success: s.pager.cur.(*pb.Product)},
+		}
+	}
+}
+
+// productSuccess returns a copy of the product so that callers cannot modify the store
+func productSuccess(product *pb.Product) *pb.ProductResponse {
+	return &pb.ProductResponse{
+		Responses: &pb.ProductResponse_Success{Success: proto.Clone(product).(*pb.Product)},
+	}
+}
+
+func productError(err *pb.Error) *pb.ProductResponse {
+	return &pb.ProductResponse{
+		Responses: &pb.ProductResponse_Error{Error: err},
+	}
+}
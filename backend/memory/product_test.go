@@ -0,0 +1,71 @@
+package memory
+
+import (
+	"testing"
+
+	"github.com/BTBurke/recur/pb"
+	"github.com/stretchr/testify/assert"
+	context "golang.org/x/net/context"
+)
+
+func TestProducts(t *testing.T) {
+	store := newTestStore()
+	products := NewProductClient(store)
+	plans := NewPlanClient(store)
+	ctx := context.Background()
+
+	resp, err := products.Create(ctx, &pb.CreateProductRequest{Id: "gold", Name: "Gold", UnitLabel: "seat"})
+	assert.NoError(t, err)
+	assert.True(t, resp.GetSuccess().GetActive())
+	resp, _ = products.Create(ctx, &pb.CreateProductRequest{Id: "gold", Name: "Gold"})
+	assert.Equal(t, pb.ErrorType_InvalidRequest, resp.GetError().GetType())
+	resp, _ = products.Create(ctx, &pb.CreateProductRequest{Name: "Legacy"})
+	legacy := resp.GetSuccess().GetId()
+	assert.Contains(t, legacy, "prod_")
+
+	plan := func(id string, product string) *pb.CreatePlanRequest {
+		return &pb.CreatePlanRequest{Id: id, Name: id, Amount: 1000, Currency: pb.Currency_USD, Interval: pb.Interval_Month, Product: product}
+	}
+	p, _ := plans.Create(ctx, plan("gold-monthly", "gold"))
+	assert.Equal(t, "gold", p.GetSuccess().GetProduct())
+	assert.True(t, p.GetSuccess().GetActive())
+	plans.Create(ctx, plan("gold-yearly", "gold"))
+	plans.Create(ctx, plan("legacy-monthly", legacy))
+	p, _ = plans.Create(ctx, plan("missing", "prod_missing"))
+	assert.Equal(t, "product", p.GetError().GetParam())
+
+	var ids []string
+	list, _ := plans.List(ctx, &pb.ListPlansRequest{Product: "gold"})
+	for list.Next() {
+		ids = append(ids, list.Current().GetSuccess().GetId())
+	}
+	assert.Equal(t, []string{"gold-yearly", "gold-monthly"}, ids)
+	list, _ = plans.List(ctx, &pb.ListPlansRequest{Active: pb.ActiveState_ActiveFalse})
+	assert.False(t, list.Next())
+	p, _ = plans.Update(ctx, &pb.UpdatePlanRequest{Id: "gold-yearly", Active: pb.ActiveState_ActiveFalse})
+	assert.False(t, p.GetSuccess().GetActive())
+	list, _ = plans.List(ctx, &pb.ListPlansRequest{Active: pb.ActiveState_ActiveFalse})
+	if assert.True(t, list.Next()) {
+		assert.Equal(t, "gold-yearly", list.Current().GetSuccess().GetId())
+	}
+
+	resp, _ = products.Update(ctx, &pb.UpdateProductRequest{Id: legacy, Active: pb.ActiveState_ActiveFalse, Metadata: map[string]string{"sunset": "2018"}})
+	assert.False(t, resp.GetSuccess().GetActive())
+	assert.Equal(t, "Legacy", resp.GetSuccess().GetName(), "unset fields are unchanged")
+
+	ids = nil
+	plist, _ := products.List(ctx, &pb.ListProductsRequest{Active: pb.ActiveState_ActiveTrue})
+	for plist.Next() {
+		ids = append(ids, plist.Current().GetSuccess().GetId())
+	}
+	assert.Equal(t, []string{"gold"}, ids)
+
+	del, _ := products.Delete(ctx, &pb.DeleteProductRequest{Id: "gold"})
+	assert.Equal(t, int32(400), del.GetError().GetHttpStatusCode(), "a product with plans cannot be deleted")
+	plans.Delete(ctx, &pb.DeletePlanRequest{Id: "gold-monthly"})
+	plans.Delete(ctx, &pb.DeletePlanRequest{Id: "gold-yearly"})
+	del, _ = products.Delete(ctx, &pb.DeleteProductRequest{Id: "gold"})
+	assert.True(t, del.GetSuccess().GetDeleted())
+	resp, _ = products.Get(ctx, &pb.GetProductRequest{Id: "gold"})
+	assert.Equal(t, int32(404), resp.GetError().GetHttpStatusCode())
+}
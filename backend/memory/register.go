@@ -0,0 +1,29 @@
+package memory
+
+import (
+	"github.com/BTBurke/recur/backend"
+)
+
+// Name is the name the memory backend is registered under
+const Name = "memory"
+
+func init() {
+	backend.Register(Name, newClients)
+}
+
+// newClients creates memory clients for every resource sharing a new, empty store.  The key and
+// retry policy are ignored.
+func newClients(cfg backend.Config) (*backend.Clients, error) {
+	store := NewStore()
+	return &backend.Clients{
+		Plan:         NewPlanClient(store),
+		Product:      NewProductClient(store),
+		Customer:     NewCustomerClient(store),
+		Subscription: NewSubscriptionClient(store),
+		Invoice:      NewInvoiceClient(store),
+		Coupon:       NewCouponClient(store),
+		Source:       NewSourceClient(store),
+		Event:        NewEventClient(store),
+		Usage:        NewUsageClient(store),
+	}, nil
+}
@@ -0,0 +1,197 @@
+package memory
+
+import (
+	"fmt"
+
+	"github.com/BTBurke/recur/backend"
+	"github.com/BTBurke/recur/pb"
+	"github.com/golang/protobuf/proto"
+	context "golang.org/x/net/context"
+)
+
+// testCards are the Stripe test tokens accepted by the in-memory source client.  Tokens for cards
+// that Stripe declines when they are attached map to the card error that is returned.
+var testCards = map[string]*pb.CardDetails{
+	"tok_visa":       {Brand: "Visa", Last4: "4242", Funding: "credit", Country: "US", CvcCheck: "pass"},
+	"tok_mastercard": {Brand: "MasterCard", Last4: "4444", Funding: "credit", Country: "US", CvcCheck: "pass"},
+	"tok_amex":       {Brand: "American Express", Last4: "8431", Funding: "credit", Country: "US", CvcCheck: "pass"},
+	"tok_discover":   {Brand: "Discover", Last4: "1117", Funding: "credit", Country: "US", CvcCheck: "pass"},
+}
+
+var testCardErrors = map[string]pb.CardErrors{
+	"tok_chargeDeclined":                pb.CardErrors_Declined,
+	"tok_chargeDeclinedExpiredCard":     pb.CardErrors_Expired,
+	"tok_chargeDeclinedIncorrectCvc":    pb.CardErrors_IncorrectCvc,
+	"tok_chargeDeclinedProcessingError": pb.CardErrors_ProcessingError,
+}
+
+// SourceClient implements backend.SourceClient in memory.  Only the Stripe test card tokens such
+// as tok_visa are accepted.
+type SourceClient struct {
+	store *Store
+}
+
+var _ backend.SourceClient = (*SourceClient)(nil)
+
+// NewSourceClient returns a source client backed by the store
+func NewSourceClient(store *Store) *SourceClient {
+	return &SourceClient{store: store}
+}
+
+// Attach creates a card from a test token and attaches it to the customer.  The first source
+// attached to a customer becomes its default source.
+func (c *SourceClient) Attach(ctx context.Context, req *pb.AttachSourceRequest) (*pb.SourceResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	c.store.mu.Lock()
+	defer c.store.mu.Unlock()
+
+	v, ok := c.store.customers.get(req.Customer)
+	if !ok {
+		return sourceError(errNotFound("customer", req.Customer)), nil
+	}
+	if code, ok := testCardErrors[req.Token]; ok {
+		return sourceError(errCard(code)), nil
+	}
+	card, ok := testCards[req.Token]
+	if !ok {
+		return sourceError(errInvalid("source", fmt.Sprintf("No such token: %s", req.Token))), nil
+	}
+	now := c.store.now()
+	source := &pb.PaymentSource{
+		Id:       newID("card"),
+		Customer: req.Customer,
+		Type:     pb.SourceType_CardSource,
+		Card:     proto.Clone(card).(*pb.CardDetails),
+	}
+	source.Card.ExpMonth = 12
+	source.Card.ExpYear = uint32(now.Year() + 2)
+	source.Card.Fingerprint = newID("fp")
+	source.Card.Metadata = copyMeta(req.Metadata)
+	c.store.sources.insert(source.Id, now.Unix(), source)
+
+	cust := v.(*pb.Customer)
+	if len(cust.DefaultSource) == 0 {
+		cust.DefaultSource = source.Id
+	}
+	return sourceSuccess(source), nil
+}
+
+// List returns the customer's sources, most recently attached first
+func (c *SourceClient) List(ctx context.Context, req *pb.ListSourcesRequest) (backend.SourceStreamer, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	p := newPager(ctx, c.store, c.store.sources, nil, req.GetStartingAfter(), req.GetEndingBefore(), req.GetLimit())
+	p.match = func(v interface{}) bool {
+		return v.(*pb.PaymentSource).Customer == req.GetCustomer()
+	}
+	return &sourceStreamer{pager: p}, nil
+}
+
+func (c *SourceClient) SetDefault(ctx context.Context, req *pb.SetDefaultSourceRequest) (*pb.CustomerResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	c.store.mu.Lock()
+	defer c.store.mu.Unlock()
+
+	v, ok := c.store.customers.get(req.Customer)
+	if !ok {
+		return customerError(errNotFound("customer", req.Customer)), nil
+	}
+	if _, ok := c.store.customerSource(req.Customer, req.Source); !ok {
+		e := errNotFound("source", req.Source)
+		e.Param = "default_source"
+		return customerError(e), nil
+	}
+	cust := v.(*pb.Customer)
+	cust.DefaultSource = req.Source
+	return customerSuccess(cust), nil
+}
+
+// Detach removes a source from the customer.  If it was the default source, the most recently
+// attached remaining source becomes the default.
+func (c *SourceClient) Detach(ctx context.Context, req *pb.DetachSourceRequest) (*pb.DetachSourceResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	c.store.mu.Lock()
+	defer c.store.mu.Unlock()
+
+	v, ok := c.store.customers.get(req.Customer)
+	if !ok {
+		return detachSourceError(errNotFound("customer", req.Customer)), nil
+	}
+	if _, ok := c.store.customerSource(req.Customer, req.Source); !ok {
+		return detachSourceError(errNotFound("source", req.Source)), nil
+	}
+	c.store.sources.delete(req.Source)
+
+	cust := v.(*pb.Customer)
+	if cust.DefaultSource == req.Source {
+		cust.DefaultSource = ""
+		remaining := c.store.sources.sorted(nil, func(v interface{}) bool {
+			return v.(*pb.PaymentSource).Customer == req.Customer
+		})
+		if len(remaining) > 0 {
+			cust.DefaultSource = remaining[0].id
+		}
+	}
+	return &pb.DetachSourceResponse{
+		Responses: &pb.DetachSourceResponse_Success{
+			Success: &pb.DetachSourceSuccess{Id: req.Source, Deleted: true},
+		},
+	}, nil
+}
+
+// customerSource returns a source attached to the customer.  Must be called with the store lock
+// held.
+func (s *Store) customerSource(customer string, id string) (*pb.PaymentSource, bool) {
+	v, ok := s.sources.get(id)
+	if !ok || v.(*pb.PaymentSource).Customer != customer {
+		return nil, false
+	}
+	return v.(*pb.PaymentSource), true
+}
+
+type sourceStreamer struct {
+	*pager
+}
+
+func (s *sourceStreamer) Next() bool {
+	return s.pager.next()
+}
+
+func (s *sourceStreamer) Current() *pb.SourceResponse {
+	switch {
+	case s.errorResponse() != nil:
+		return sourceError(s.errorResponse())
+	case s.pager.cur == nil:
+		return &pb.SourceResponse{}
+	default:
+		return &pb.SourceResponse{
+			Responses: &pb.SourceResponse_Success{Success: s.pager.cur.(*pb.PaymentSource)},
+		}
+	}
+}
+
+// sourceSuccess returns a copy of the source so that callers cannot modify the store
+func sourceSuccess(source *pb.PaymentSource) *pb.SourceResponse {
+	return &pb.SourceResponse{
+		Responses: &pb.SourceResponse_Success{Success: proto.Clone(source).(*pb.PaymentSource)},
+	}
+}
+
+func sourceError(err *pb.Error) *pb.SourceResponse {
+	return &pb.SourceResponse{
+		Responses: &pb.SourceResponse_Error{Error: err},
+	}
+}
+
+func detachSourceError(err *pb.Error) *pb.DetachSourceResponse {
+	return &pb.DetachSourceResponse{
+		Responses: &pb.DetachSourceResponse_Error{Error: err},
+	}
+}
@@ -0,0 +1,57 @@
+package memory
+
+import (
+	"testing"
+
+	"github.com/BTBurke/recur/pb"
+	"github.com/stretchr/testify/assert"
+	context "golang.org/x/net/context"
+)
+
+func TestSources(t *testing.T) {
+	store := newTestStore()
+	customers := NewCustomerClient(store)
+	sources := NewSourceClient(store)
+	ctx := context.Background()
+
+	cust, _ := customers.Create(ctx, &pb.CreateCustomerRequest{})
+	custID := cust.GetSuccess().GetId()
+
+	resp, err := sources.Attach(ctx, &pb.AttachSourceRequest{Customer: custID, Token: "tok_chargeDeclined"})
+	assert.NoError(t, err)
+	assert.True(t, pb.IsCardError(resp.GetError()))
+	assert.Equal(t, pb.CardErrors_Declined, resp.GetError().GetCode())
+
+	resp, _ = sources.Attach(ctx, &pb.AttachSourceRequest{Customer: custID, Token: "tok_visa"})
+	visa := resp.GetSuccess()
+	assert.Equal(t, "4242", visa.GetCard().GetLast4())
+	assert.Equal(t, pb.SourceType_CardSource, visa.GetType())
+	resp, _ = sources.Attach(ctx, &pb.AttachSourceRequest{Customer: custID, Token: "tok_mastercard"})
+	mc := resp.GetSuccess()
+
+	c, _ := customers.Get(ctx, &pb.GetCustomerRequest{Id: custID})
+	assert.Equal(t, visa.Id, c.GetSuccess().GetDefaultSource())
+
+	c, _ = sources.SetDefault(ctx, &pb.SetDefaultSourceRequest{Customer: custID, Source: mc.Id})
+	assert.Equal(t, mc.Id, c.GetSuccess().GetDefaultSource())
+	c, _ = sources.SetDefault(ctx, &pb.SetDefaultSourceRequest{Customer: custID, Source: "card_missing"})
+	assert.Equal(t, int32(404), c.GetError().GetHttpStatusCode())
+
+	list, _ := sources.List(ctx, &pb.ListSourcesRequest{Customer: custID})
+	var ids []string
+	for list.Next() {
+		ids = append(ids, list.Current().GetSuccess().GetId())
+	}
+	assert.Equal(t, []string{mc.Id, visa.Id}, ids)
+
+	del, _ := sources.Detach(ctx, &pb.DetachSourceRequest{Customer: custID, Source: mc.Id})
+	assert.True(t, del.GetSuccess().GetDeleted())
+	c, _ = customers.Get(ctx, &pb.GetCustomerRequest{Id: custID})
+	assert.Equal(t, visa.Id, c.GetSuccess().GetDefaultSource())
+
+	sources.Detach(ctx, &pb.DetachSourceRequest{Customer: custID, Source: visa.Id})
+	c, _ = customers.Get(ctx, &pb.GetCustomerRequest{Id: custID})
+	assert.Empty(t, c.GetSuccess().GetDefaultSource())
+	del, _ = sources.Detach(ctx, &pb.DetachSourceRequest{Customer: custID, Source: visa.Id})
+	assert.Equal(t, int32(404), del.GetError().GetHttpStatusCode())
+}
@@ -0,0 +1,239 @@
+package memory
+
+import (
+	"time"
+
+	"github.com/BTBurke/recur/backend"
+	"github.com/BTBurke/recur/pb"
+	"github.com/golang/protobuf/proto"
+	context "golang.org/x/net/context"
+)
+
+// SubscriptionClient implements backend.SubscriptionClient in memory.  Plans and customers
+// referenced by a subscription must exist in the same store.
+type SubscriptionClient struct {
+	store *Store
+}
+
+var _ backend.SubscriptionClient = (*SubscriptionClient)(nil)
+
+// NewSubscriptionClient returns a subscription client backed by the store
+func NewSubscriptionClient(store *Store) *SubscriptionClient {
+	return &SubscriptionClient{store: store}
+}
+
+func (c *SubscriptionClient) Create(ctx context.Context, req *pb.CreateSubscriptionRequest) (*pb.SubscriptionResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	c.store.mu.Lock()
+	defer c.store.mu.Unlock()
+
+	if _, ok := c.store.customers.get(req.Customer); !ok {
+		return subscriptionError(errNotFound("customer", req.Customer)), nil
+	}
+	v, ok := c.store.plans.get(req.Plan)
+	if !ok {
+		return subscriptionError(errNotFound("plan", req.Plan)), nil
+	}
+	plan := v.(*pb.Plan)
+	now := c.store.now()
+
+	sub := &pb.Subscription{
+		Id:               newID("sub"),
+		Customer:         req.Customer,
+		Plan:             proto.Clone(plan).(*pb.Plan),
+		Quantity:         req.Quantity,
+		Status:           pb.SubscriptionStatus_Active,
+		Created:          now.Unix(),
+		Start:            now.Unix(),
+		Metadata:         copyMeta(req.Metadata),
+		SubscriptionItem: newID("si"),
+	}
+	if sub.Quantity == 0 {
+		sub.Quantity = 1
+	}
+	switch {
+	case req.TrialEnd > now.Unix():
+		sub.TrialEnd = req.TrialEnd
+	case plan.TrialPeriodDays > 0:
+		sub.TrialEnd = now.AddDate(0, 0, int(plan.TrialPeriodDays)).Unix()
+	}
+	if sub.TrialEnd > 0 {
+		sub.Status = pb.SubscriptionStatus_Trialing
+		sub.TrialStart = now.Unix()
+		sub.CurrentPeriodStart = now.Unix()
+		sub.CurrentPeriodEnd = sub.TrialEnd
+	} else {
+		sub.CurrentPeriodStart = now.Unix()
+		sub.CurrentPeriodEnd = periodEnd(now, plan).Unix()
+	}
+	c.store.subscriptions.insert(sub.Id, sub.Created, sub)
+	c.store.invoiceSubscription(sub, now)
+	return subscriptionSuccess(sub), nil
+}
+
+// Update changes the plan or quantity of a subscription.  Prorations are not calculated in memory.
+func (c *SubscriptionClient) Update(ctx context.Context, req *pb.UpdateSubscriptionRequest) (*pb.SubscriptionResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	c.store.mu.Lock()
+	defer c.store.mu.Unlock()
+
+	sub, errResp := c.active(req.Id)
+	if errResp != nil {
+		return errResp, nil
+	}
+	if len(req.Plan) > 0 && req.Plan != sub.Plan.GetId() {
+		v, ok := c.store.plans.get(req.Plan)
+		if !ok {
+			return subscriptionError(errNotFound("plan", req.Plan)), nil
+		}
+		sub.Plan = proto.Clone(v.(*pb.Plan)).(*pb.Plan)
+	}
+	if req.Quantity > 0 {
+		sub.Quantity = req.Quantity
+	}
+	sub.Metadata = mergeMeta(sub.Metadata, req.Metadata)
+	return subscriptionSuccess(sub), nil
+}
+
+func (c *SubscriptionClient) Cancel(ctx context.Context, req *pb.CancelSubscriptionRequest) (*pb.SubscriptionResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	c.store.mu.Lock()
+	defer c.store.mu.Unlock()
+
+	sub, errResp := c.active(req.Id)
+	if errResp != nil {
+		return errResp, nil
+	}
+	now := c.store.now().Unix()
+	sub.CanceledAt = now
+	switch {
+	case req.AtPeriodEnd:
+		sub.CancelAtPeriodEnd = true
+	default:
+		sub.Status = pb.SubscriptionStatus_Canceled
+		sub.EndedAt = now
+	}
+	return subscriptionSuccess(sub), nil
+}
+
+// Reactivate restores a subscription that was canceled at period end
+func (c *SubscriptionClient) Reactivate(ctx context.Context, req *pb.ReactivateSubscriptionRequest) (*pb.SubscriptionResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	c.store.mu.Lock()
+	defer c.store.mu.Unlock()
+
+	sub, errResp := c.active(req.Id)
+	if errResp != nil {
+		return errResp, nil
+	}
+	sub.CancelAtPeriodEnd = false
+	sub.CanceledAt = 0
+	return subscriptionSuccess(sub), nil
+}
+
+func (c *SubscriptionClient) Get(ctx context.Context, req *pb.GetSubscriptionRequest) (*pb.SubscriptionResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	c.store.mu.RLock()
+	defer c.store.mu.RUnlock()
+
+	v, ok := c.store.subscriptions.get(req.Id)
+	if !ok {
+		return subscriptionError(errNotFound("subscription", req.Id)), nil
+	}
+	return subscriptionSuccess(v.(*pb.Subscription)), nil
+}
+
+// List returns subscriptions newest first, filtered by customer, plan and status.  An unknown
+// status lists subscriptions in any state.
+func (c *SubscriptionClient) List(ctx context.Context, req *pb.ListSubscriptionsRequest) (backend.SubscriptionStreamer, error) {
+	p := newPager(ctx, c.store, c.store.subscriptions, req.GetCreated(), req.GetStartingAfter(), req.GetEndingBefore(), req.GetLimit())
+	p.match = func(v interface{}) bool {
+		sub := v.(*pb.Subscription)
+		switch {
+		case len(req.GetCustomer()) > 0 && sub.Customer != req.GetCustomer():
+			return false
+		case len(req.GetPlan()) > 0 && sub.GetPlan().GetId() != req.GetPlan():
+			return false
+		case req.GetStatus() != pb.SubscriptionStatus_UnknownStatus && sub.Status != req.GetStatus():
+			return false
+		default:
+			return true
+		}
+	}
+	return &subscriptionStreamer{pager: p}, nil
+}
+
+// active returns a subscription that can be modified, or an error response if it does not exist
+// or has already been canceled.  Must be called with the store lock held.
+func (c *SubscriptionClient) active(id string) (*pb.Subscription, *pb.SubscriptionResponse) {
+	v, ok := c.store.subscriptions.get(id)
+	if !ok {
+		return nil, subscriptionError(errNotFound("subscription", id))
+	}
+	sub := v.(*pb.Subscription)
+	if sub.Status == pb.SubscriptionStatus_Canceled {
+		return nil, subscriptionError(errInvalid("id", "This subscription has been canceled and can no longer be modified."))
+	}
+	return sub, nil
+}
+
+type subscriptionStreamer struct {
+	*pager
+}
+
+func (s *subscriptionStreamer) Next() bool {
+	return s.pager.next()
+}
+
+func (s *subscriptionStreamer) Current() *pb.SubscriptionResponse {
+	switch {
+	case s.errorResponse() != nil:
+		return subscriptionError(s.errorResponse())
+	case s.pager.cur == nil:
+		return &pb.SubscriptionResponse{}
+	default:
+		return &pb.SubscriptionResponse{
+			Responses: &pb.SubscriptionResponse_Success{Success: s.pager.cur.(*pb.Subscription)},
+		}
+	}
+}
+
+// periodEnd returns the end of the first billing period for the plan
+func periodEnd(start time.Time, plan *pb.Plan) time.Time {
+	n := int(plan.IntervalCount)
+	if n == 0 {
+		n = 1
+	}
+	switch plan.Interval {
+	case pb.Interval_Day:
+		return start.AddDate(0, 0, n)
+	case pb.Interval_Week:
+		return start.AddDate(0, 0, 7*n)
+	case pb.Interval_Year:
+		return start.AddDate(n, 0, 0)
+	default:
+		return start.AddDate(0, n, 0)
+	}
+}
+
+func subscriptionSuccess(sub *pb.Subscription) *pb.SubscriptionResponse {
+	return &pb.SubscriptionResponse{
+		Responses: &pb.SubscriptionResponse_Success{Success: proto.Clone(sub).(*pb.Subscription)},
+	}
+}
+
+func subscriptionError(err *pb.Error) *pb.SubscriptionResponse {
+	return &pb.SubscriptionResponse{
+		Responses: &pb.SubscriptionResponse_Error{Error: err},
+	}
+}
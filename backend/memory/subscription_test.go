@@ -0,0 +1,73 @@
+package memory
+
+import (
+	"testing"
+
+	"github.com/BTBurke/recur/pb"
+	"github.com/stretchr/testify/assert"
+	context "golang.org/x/net/context"
+)
+
+func TestSubscriptionLifecycle(t *testing.T) {
+	store := newTestStore()
+	plans := NewPlanClient(store)
+	customers := NewCustomerClient(store)
+	subs := NewSubscriptionClient(store)
+	ctx := context.Background()
+
+	createPlans(t, plans, 2)
+	cust, err := customers.Create(ctx, &pb.CreateCustomerRequest{Email: "test@example.com"})
+	assert.NoError(t, err)
+	custID := cust.GetSuccess().GetId()
+
+	resp, err := subs.Create(ctx, &pb.CreateSubscriptionRequest{Customer: "cus_missing", Plan: "plan-1"})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(404), resp.GetError().GetHttpStatusCode())
+
+	resp, err = subs.Create(ctx, &pb.CreateSubscriptionRequest{Customer: custID, Plan: "plan-1"})
+	assert.NoError(t, err)
+	sub := resp.GetSuccess()
+	assert.Equal(t, pb.SubscriptionStatus_Active, sub.Status)
+	assert.Equal(t, uint64(1), sub.Quantity)
+	assert.Equal(t, "plan-1", sub.Plan.Id)
+
+	resp, _ = subs.Update(ctx, &pb.UpdateSubscriptionRequest{Id: sub.Id, Plan: "plan-2", Quantity: 3})
+	assert.Equal(t, "plan-2", resp.GetSuccess().GetPlan().GetId())
+	assert.Equal(t, uint64(3), resp.GetSuccess().GetQuantity())
+
+	resp, _ = subs.Cancel(ctx, &pb.CancelSubscriptionRequest{Id: sub.Id, AtPeriodEnd: true})
+	assert.True(t, resp.GetSuccess().GetCancelAtPeriodEnd())
+	assert.Equal(t, pb.SubscriptionStatus_Active, resp.GetSuccess().GetStatus())
+
+	resp, _ = subs.Reactivate(ctx, &pb.ReactivateSubscriptionRequest{Id: sub.Id})
+	assert.False(t, resp.GetSuccess().GetCancelAtPeriodEnd())
+
+	resp, _ = subs.Cancel(ctx, &pb.CancelSubscriptionRequest{Id: sub.Id})
+	assert.Equal(t, pb.SubscriptionStatus_Canceled, resp.GetSuccess().GetStatus())
+
+	resp, _ = subs.Reactivate(ctx, &pb.ReactivateSubscriptionRequest{Id: sub.Id})
+	assert.Equal(t, pb.ErrorType_InvalidRequest, resp.GetError().GetType())
+
+	list, _ := subs.List(ctx, &pb.ListSubscriptionsRequest{Customer: custID, Status: pb.SubscriptionStatus_Active})
+	assert.False(t, list.Next())
+	list, _ = subs.List(ctx, &pb.ListSubscriptionsRequest{Customer: custID})
+	assert.True(t, list.Next())
+	assert.Equal(t, sub.Id, list.Current().GetSuccess().GetId())
+}
+
+func TestSubscriptionTrial(t *testing.T) {
+	store := newTestStore()
+	plans := NewPlanClient(store)
+	customers := NewCustomerClient(store)
+	subs := NewSubscriptionClient(store)
+	ctx := context.Background()
+
+	plans.Create(ctx, &pb.CreatePlanRequest{Id: "trial", Name: "trial", Currency: pb.Currency_USD, Interval: pb.Interval_Month, TrialPeriodDays: 14})
+	cust, _ := customers.Create(ctx, &pb.CreateCustomerRequest{})
+
+	resp, err := subs.Create(ctx, &pb.CreateSubscriptionRequest{Customer: cust.GetSuccess().GetId(), Plan: "trial"})
+	assert.NoError(t, err)
+	sub := resp.GetSuccess()
+	assert.Equal(t, pb.SubscriptionStatus_Trialing, sub.Status)
+	assert.Equal(t, int64(14*24*60*60), sub.TrialEnd-sub.TrialStart)
+}
@@ -0,0 +1,217 @@
+package memory
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/BTBurke/recur/backend"
+	"github.com/BTBurke/recur/pb"
+	"github.com/golang/protobuf/proto"
+	context "golang.org/x/net/context"
+)
+
+// UsageClient implements backend.UsageClient in memory.  Usage is kept per subscription item as
+// one record for each timestamp, so that setting the usage at a timestamp replaces it and
+// incrementing adds to it, as with Stripe.  Creating a usage record with an idempotency key that
+// has already been used returns the original record without reporting the usage again.
+type UsageClient struct {
+	store *Store
+}
+
+var _ backend.UsageClient = (*UsageClient)(nil)
+
+// NewUsageClient returns a usage client backed by the store
+func NewUsageClient(store *Store) *UsageClient {
+	return &UsageClient{store: store}
+}
+
+func (c *UsageClient) Create(ctx context.Context, req *pb.CreateUsageRecordRequest) (*pb.UsageRecordResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	c.store.mu.Lock()
+	defer c.store.mu.Unlock()
+
+	key, hasKey := backend.IdempotencyKey(ctx)
+	if hasKey {
+		if rec, ok := c.store.usageKeys[key]; ok {
+			return usageSuccess(rec), nil
+		}
+	}
+
+	sub := c.store.subscriptionByItem(req.SubscriptionItem)
+	if sub == nil {
+		e := errNotFound("subscription item", req.SubscriptionItem)
+		e.Param = "subscription_item"
+		return usageError(e), nil
+	}
+	if sub.Plan.UsageType != pb.UsageType_Metered {
+		return usageError(errInvalid("subscription_item", "Usage can only be reported for subscriptions to metered plans")), nil
+	}
+	if sub.Status == pb.SubscriptionStatus_Canceled {
+		return usageError(errInvalid("subscription_item", fmt.Sprintf("Cannot report usage for canceled subscription: %s", sub.Id))), nil
+	}
+
+	ts := req.Timestamp
+	if ts == 0 {
+		ts = c.store.now().Unix()
+	}
+	if ts < sub.CurrentPeriodStart || ts >= sub.CurrentPeriodEnd {
+		return usageError(errInvalid("timestamp", "Cannot report usage outside the current billing period")), nil
+	}
+
+	records := c.store.usage[req.SubscriptionItem]
+	i := sort.Search(len(records), func(i int) bool { return records[i].Timestamp >= ts })
+	var rec *pb.UsageRecord
+	switch {
+	case i < len(records) && records[i].Timestamp == ts:
+		rec = records[i]
+		if req.Action == pb.UsageAction_Set {
+			rec.Quantity = req.Quantity
+		} else {
+			rec.Quantity += req.Quantity
+		}
+	default:
+		rec = &pb.UsageRecord{
+			Id:               newID("mbur"),
+			SubscriptionItem: req.SubscriptionItem,
+			Quantity:         req.Quantity,
+			Timestamp:        ts,
+		}
+		records = append(records, nil)
+		copy(records[i+1:], records[i:])
+		records[i] = rec
+		c.store.usage[req.SubscriptionItem] = records
+	}
+
+	rec = proto.Clone(rec).(*pb.UsageRecord)
+	if hasKey {
+		c.store.usageKeys[key] = rec
+	}
+	return usageSuccess(rec), nil
+}
+
+// ListSummaries returns the usage of the current period of a subscription item.  The memory
+// backend does not keep the usage of periods that have already been invoiced.
+func (c *UsageClient) ListSummaries(ctx context.Context, req *pb.ListUsageRecordSummariesRequest) (backend.UsageRecordSummaryStreamer, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	c.store.mu.RLock()
+	defer c.store.mu.RUnlock()
+
+	sub := c.store.subscriptionByItem(req.SubscriptionItem)
+	if sub == nil {
+		e := errNotFound("subscription item", req.SubscriptionItem)
+		e.Param = "subscription_item"
+		return &usageSummaryStreamer{ctx: ctx, err: e}, nil
+	}
+	summary := &pb.UsageRecordSummary{
+		Id:               "sis_" + sub.SubscriptionItem,
+		SubscriptionItem: sub.SubscriptionItem,
+		PeriodStart:      sub.CurrentPeriodStart,
+		PeriodEnd:        sub.CurrentPeriodEnd,
+		TotalUsage:       c.store.totalUsage(sub.SubscriptionItem, sub.Plan.AggregateUsage, sub.CurrentPeriodStart, sub.CurrentPeriodEnd),
+	}
+	return &usageSummaryStreamer{ctx: ctx, summaries: []*pb.UsageRecordSummary{summary}}, nil
+}
+
+// subscriptionByItem returns the subscription with a subscription item, or nil.  The caller must
+// hold the store lock.
+func (s *Store) subscriptionByItem(item string) *pb.Subscription {
+	for _, r := range s.subscriptions.sorted(nil, nil) {
+		if sub := r.value.(*pb.Subscription); sub.SubscriptionItem == item {
+			return sub
+		}
+	}
+	return nil
+}
+
+// totalUsage aggregates the usage reported for a subscription item in the period [start, end).
+// The caller must hold the store lock.
+func (s *Store) totalUsage(item string, agg pb.AggregateUsage, start int64, end int64) uint64 {
+	var total uint64
+	for _, rec := range s.usage[item] {
+		if rec.Timestamp >= end {
+			break
+		}
+		if rec.Timestamp < start && agg != pb.AggregateUsage_LastEver {
+			continue
+		}
+		switch agg {
+		case pb.AggregateUsage_LastDuringPeriod, pb.AggregateUsage_LastEver:
+			total = rec.Quantity
+		case pb.AggregateUsage_Max:
+			if rec.Quantity > total {
+				total = rec.Quantity
+			}
+		default:
+			total += rec.Quantity
+		}
+	}
+	return total
+}
+
+type usageSummaryStreamer struct {
+	ctx       context.Context
+	summaries []*pb.UsageRecordSummary
+	err       *pb.Error
+	cur       *pb.UsageRecordSummaryResponse
+	ctxErr    error
+	done      bool
+}
+
+func (s *usageSummaryStreamer) Next() bool {
+	if s.done || s.ctxErr != nil {
+		return false
+	}
+	if err := s.ctx.Err(); err != nil {
+		s.ctxErr = err
+		s.cur = nil
+		return false
+	}
+	switch {
+	case s.err != nil:
+		s.cur = &pb.UsageRecordSummaryResponse{
+			Responses: &pb.UsageRecordSummaryResponse_Error{Error: s.err},
+		}
+		s.done = true
+	case len(s.summaries) > 0:
+		s.cur = &pb.UsageRecordSummaryResponse{
+			Responses: &pb.UsageRecordSummaryResponse_Success{Success: s.summaries[0]},
+		}
+		s.summaries = s.summaries[1:]
+	default:
+		s.cur = nil
+		s.done = true
+		return false
+	}
+	return true
+}
+
+func (s *usageSummaryStreamer) Current() *pb.UsageRecordSummaryResponse {
+	if s.cur == nil {
+		return &pb.UsageRecordSummaryResponse{}
+	}
+	return s.cur
+}
+
+func (s *usageSummaryStreamer) Err() error {
+	return s.ctxErr
+}
+
+func (s *usageSummaryStreamer) Close() {
+	s.done = true
+}
+
+func usageSuccess(rec *pb.UsageRecord) *pb.UsageRecordResponse {
+	return &pb.UsageRecordResponse{
+		Responses: &pb.UsageRecordResponse_Success{Success: proto.Clone(rec).(*pb.UsageRecord)},
+	}
+}
+
+func usageError(err *pb.Error) *pb.UsageRecordResponse {
+	return &pb.UsageRecordResponse{
+		Responses: &pb.UsageRecordResponse_Error{Error: err},
+	}
+}
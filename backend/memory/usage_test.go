@@ -0,0 +1,90 @@
+package memory
+
+import (
+	"testing"
+
+	"github.com/BTBurke/recur/backend"
+	"github.com/BTBurke/recur/pb"
+	"github.com/stretchr/testify/assert"
+	context "golang.org/x/net/context"
+)
+
+func TestUsageRecords(t *testing.T) {
+	store := newTestStore()
+	plans := NewPlanClient(store)
+	customers := NewCustomerClient(store)
+	subs := NewSubscriptionClient(store)
+	invoices := NewInvoiceClient(store)
+	usage := NewUsageClient(store)
+	ctx := context.Background()
+
+	createPlans(t, plans, 1)
+	_, err := plans.Create(ctx, &pb.CreatePlanRequest{
+		Id: "api", Name: "API calls", Amount: 1, Currency: pb.Currency_USD, Interval: pb.Interval_Month,
+		UsageType: pb.UsageType_Metered,
+	})
+	assert.NoError(t, err, "a metered unit price may be below the minimum charge")
+	cust, _ := customers.Create(ctx, &pb.CreateCustomerRequest{})
+	custID := cust.GetSuccess().GetId()
+	licensed, _ := subs.Create(ctx, &pb.CreateSubscriptionRequest{Customer: custID, Plan: "plan-1"})
+	metered, _ := subs.Create(ctx, &pb.CreateSubscriptionRequest{Customer: custID, Plan: "api"})
+	item := metered.GetSuccess().GetSubscriptionItem()
+	assert.NotEmpty(t, item)
+
+	resp, _ := usage.Create(ctx, &pb.CreateUsageRecordRequest{SubscriptionItem: licensed.GetSuccess().GetSubscriptionItem(), Quantity: 1})
+	assert.Equal(t, "subscription_item", resp.GetError().GetParam(), "licensed plans do not take usage")
+	resp, _ = usage.Create(ctx, &pb.CreateUsageRecordRequest{SubscriptionItem: "si_missing", Quantity: 1})
+	assert.Equal(t, int32(404), resp.GetError().GetHttpStatusCode())
+	resp, _ = usage.Create(ctx, &pb.CreateUsageRecordRequest{SubscriptionItem: item, Quantity: 1, Timestamp: 1})
+	assert.Equal(t, "timestamp", resp.GetError().GetParam())
+
+	ts := metered.GetSuccess().GetCurrentPeriodStart() + 60
+	resp, _ = usage.Create(ctx, &pb.CreateUsageRecordRequest{SubscriptionItem: item, Quantity: 10, Timestamp: ts})
+	assert.Equal(t, uint64(10), resp.GetSuccess().GetQuantity())
+	resp, _ = usage.Create(ctx, &pb.CreateUsageRecordRequest{SubscriptionItem: item, Quantity: 5, Timestamp: ts})
+	assert.Equal(t, uint64(15), resp.GetSuccess().GetQuantity(), "increments add to the usage at a timestamp")
+	resp, _ = usage.Create(ctx, &pb.CreateUsageRecordRequest{SubscriptionItem: item, Quantity: 12, Timestamp: ts, Action: pb.UsageAction_Set})
+	assert.Equal(t, uint64(12), resp.GetSuccess().GetQuantity(), "set replaces the usage at a timestamp")
+
+	keyed := backend.WithIdempotencyKey(ctx, "batch-1")
+	first, _ := usage.Create(keyed, &pb.CreateUsageRecordRequest{SubscriptionItem: item, Quantity: 8})
+	retry, _ := usage.Create(keyed, &pb.CreateUsageRecordRequest{SubscriptionItem: item, Quantity: 8})
+	assert.Equal(t, first, retry, "a retry with the same key is not counted twice")
+
+	list, err := usage.ListSummaries(ctx, &pb.ListUsageRecordSummariesRequest{SubscriptionItem: item})
+	assert.NoError(t, err)
+	assert.True(t, list.Next())
+	summary := list.Current().GetSuccess()
+	assert.False(t, list.Next())
+	assert.NoError(t, list.Err())
+	assert.Equal(t, uint64(20), summary.GetTotalUsage())
+	assert.Equal(t, metered.GetSuccess().GetCurrentPeriodEnd(), summary.GetPeriodEnd())
+
+	list, _ = usage.ListSummaries(ctx, &pb.ListUsageRecordSummariesRequest{SubscriptionItem: "si_missing"})
+	assert.True(t, list.Next())
+	assert.Equal(t, int32(404), list.Current().GetError().GetHttpStatusCode())
+
+	inv, _ := invoices.Upcoming(ctx, &pb.UpcomingInvoiceRequest{Customer: custID, Subscription: metered.GetSuccess().GetId()})
+	assert.Equal(t, int64(20), inv.GetSuccess().GetAmountDue(), "the upcoming invoice bills the usage of the current period")
+}
+
+func TestTotalUsage(t *testing.T) {
+	store := NewStore()
+	store.usage["si"] = []*pb.UsageRecord{
+		{Timestamp: 5, Quantity: 9},
+		{Timestamp: 10, Quantity: 3},
+		{Timestamp: 11, Quantity: 7},
+		{Timestamp: 12, Quantity: 2},
+		{Timestamp: 20, Quantity: 100},
+	}
+	for agg, want := range map[pb.AggregateUsage]uint64{
+		pb.AggregateUsage_Sum:              12,
+		pb.AggregateUsage_LastDuringPeriod: 2,
+		pb.AggregateUsage_Max:              7,
+		pb.AggregateUsage_LastEver:         2,
+	} {
+		assert.Equal(t, want, store.totalUsage("si", agg, 10, 20), agg.String())
+	}
+	assert.Equal(t, uint64(9), store.totalUsage("si", pb.AggregateUsage_LastEver, 8, 10), "last ever looks before the period")
+	assert.Equal(t, uint64(0), store.totalUsage("si", pb.AggregateUsage_LastDuringPeriod, 8, 10))
+}
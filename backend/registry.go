@@ -0,0 +1,110 @@
+package backend
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/cenkalti/backoff"
+	log "github.com/sirupsen/logrus"
+	context "golang.org/x/net/context"
+)
+
+// Clients is the set of resource clients provided by a backend.  A backend leaves the client for
+// a resource nil if it does not support it.
+type Clients struct {
+	Plan         PlanClient
+	Product      ProductClient
+	Customer     CustomerClient
+	Subscription SubscriptionClient
+	Invoice      InvoiceClient
+	Coupon       CouponClient
+	Source       SourceClient
+	Event        EventClient
+	Usage        UsageClient
+}
+
+// RetryPolicy limits retries of transient errors such as rate limits and network failures.
+// Retries stop after MaxAttempts attempts or when MaxElapsed has passed, whichever comes first;
+// zero removes either limit.
+type RetryPolicy struct {
+	// MaxElapsed is the maximum time spent retrying a request
+	MaxElapsed time.Duration
+	// MaxAttempts is the maximum number of attempts, including the first
+	MaxAttempts int
+}
+
+// BackOff returns an exponential backoff that follows the policy and stops when the context is
+// done.  A policy of one attempt never retries.
+func (r RetryPolicy) BackOff(ctx context.Context) backoff.BackOff {
+	exp := backoff.NewExponentialBackOff()
+	exp.MaxElapsedTime = r.MaxElapsed
+	var b backoff.BackOff = exp
+	switch {
+	case r.MaxAttempts == 1:
+		// WithMaxTries treats zero retries as no limit
+		b = &backoff.StopBackOff{}
+	case r.MaxAttempts > 1:
+		b = backoff.WithMaxTries(exp, uint64(r.MaxAttempts-1))
+	}
+	return backoff.WithContext(b, ctx)
+}
+
+// Config is passed to a backend factory to create its clients
+type Config struct {
+	// Key is the secret used to authenticate with the billing provider
+	Key    string
+	Logger *log.Logger
+	// Retry overrides the default retry policy of the backend when set
+	Retry *RetryPolicy
+}
+
+// Factory creates the clients for a backend
+type Factory func(cfg Config) (*Clients, error)
+
+var (
+	registryMu sync.RWMutex
+	registry   = make(map[string]Factory)
+)
+
+// Register makes a backend available by name.  It is intended to be called from the init function
+// of the package implementing the backend, in the same way as database/sql drivers.  Register
+// panics if the factory is nil or the name is already registered.
+func Register(name string, f Factory) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	if f == nil {
+		panic("backend: Register factory is nil")
+	}
+	if _, dup := registry[name]; dup {
+		panic("backend: Register called twice for backend " + name)
+	}
+	registry[name] = f
+}
+
+// Registered returns the names of the registered backends in sorted order
+func Registered() []string {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// New creates the clients of the named backend
+func New(name string, cfg Config) (*Clients, error) {
+	registryMu.RLock()
+	f, ok := registry[name]
+	registryMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("backend: unknown backend %q (forgotten import?)", name)
+	}
+	if cfg.Logger == nil {
+		cfg.Logger = log.New()
+	}
+	return f(cfg)
+}
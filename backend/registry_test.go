@@ -0,0 +1,44 @@
+package backend
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/cenkalti/backoff"
+	"github.com/stretchr/testify/assert"
+	context "golang.org/x/net/context"
+)
+
+func TestRegistry(t *testing.T) {
+	var got Config
+	Register("registry-test", func(cfg Config) (*Clients, error) {
+		got = cfg
+		return &Clients{}, nil
+	})
+	assert.Contains(t, Registered(), "registry-test")
+
+	clients, err := New("registry-test", Config{Key: "key", Retry: &RetryPolicy{MaxAttempts: 2}})
+	assert.NoError(t, err)
+	assert.NotNil(t, clients)
+	assert.Equal(t, "key", got.Key)
+	assert.Equal(t, 2, got.Retry.MaxAttempts)
+	assert.NotNil(t, got.Logger, "a default logger is set")
+
+	_, err = New("missing", Config{})
+	assert.Error(t, err)
+
+	assert.Panics(t, func() { Register("registry-test", func(cfg Config) (*Clients, error) { return nil, nil }) })
+	assert.Panics(t, func() { Register("nil-factory", nil) })
+}
+
+func TestRetryPolicyAttempts(t *testing.T) {
+	for _, attempts := range []int{1, 2, 3} {
+		var calls int
+		err := backoff.Retry(func() error {
+			calls++
+			return errors.New("transient")
+		}, RetryPolicy{MaxAttempts: attempts}.BackOff(context.Background()))
+		assert.Error(t, err)
+		assert.Equal(t, attempts, calls, "MaxAttempts %d", attempts)
+	}
+}
@@ -0,0 +1,166 @@
+package stripe
+
+import (
+	"github.com/BTBurke/recur/backend"
+	"github.com/BTBurke/recur/pb"
+	"github.com/stripe/stripe-go/coupon"
+	"github.com/stripe/stripe-go/customer"
+	"github.com/stripe/stripe-go/discount"
+	"github.com/stripe/stripe-go/sub"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stripe/stripe-go"
+	context "golang.org/x/net/context"
+)
+
+// interface for the Stripe coupon API
+type couponClient interface {
+	New(params *stripe.CouponParams) (*stripe.Coupon, error)
+	Get(id string, params *stripe.CouponParams) (*stripe.Coupon, error)
+	Update(id string, params *stripe.CouponParams) (*stripe.Coupon, error)
+	Del(id string) (*stripe.Coupon, error)
+	List(params *stripe.CouponListParams) *coupon.Iter
+}
+
+// interface for the Stripe discount API
+type discountClient interface {
+	Del(customerID string) (*stripe.Discount, error)
+	DelSub(subscriptionID string) (*stripe.Discount, error)
+}
+
+type StripeCouponClient struct {
+	key    string
+	logger log.StdLogger
+	// api, customers, subs and discounts allow mocking the Stripe backend.  Coupons are applied
+	// by updating the customer or subscription.
+	api       couponClient
+	customers customerClient
+	subs      subscriptionClient
+	discounts discountClient
+	// policy controls retries of failed requests
+	policy backend.RetryPolicy
+}
+
+func NewCouponClient(key string, logger log.StdLogger, opts ...Option) *StripeCouponClient {
+	o := newOptions(opts)
+	b := stripe.GetBackend(stripe.SupportedBackend("api"))
+	return &StripeCouponClient{
+		key:       key,
+		logger:    logger,
+		policy:    o.retry,
+		api:       coupon.Client{B: b, Key: key},
+		customers: customer.Client{B: b, Key: key},
+		subs:      sub.Client{B: b, Key: key},
+		discounts: discount.Client{B: b, Key: key},
+	}
+}
+
+func (c *StripeCouponClient) Create(ctx context.Context, req *pb.CreateCouponRequest) (*pb.CouponResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	ctx, key := withIdempotencyKey(ctx)
+	params := couponCreateToCouponParams(ctx, c.key, req)
+
+	resp := new(pb.CouponResponse)
+	err := retry(ctx, c.policy, retryableCoupon(params, c.api, resp, couponCreate))
+
+	reportIdempotencyKey(c.logger, "coupon create", key, resp.GetError(), err)
+	return resp, err
+}
+
+func (c *StripeCouponClient) Update(ctx context.Context, req *pb.UpdateCouponRequest) (*pb.CouponResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	ctx, key := withIdempotencyKey(ctx)
+	params := couponUpdateToCouponParams(ctx, c.key, req)
+
+	resp := new(pb.CouponResponse)
+	err := retry(ctx, c.policy, retryableCoupon(params, c.api, resp, couponUpdate))
+
+	reportIdempotencyKey(c.logger, "coupon update", key, resp.GetError(), err)
+	return resp, err
+}
+
+// Delete deletes a coupon.  Customers and subscriptions that already have a discount from the
+// coupon keep it.  The Stripe API used by recur does not accept parameters for coupon deletes, so
+// no idempotency key or Connect account is sent.
+func (c *StripeCouponClient) Delete(ctx context.Context, req *pb.DeleteCouponRequest) (*pb.DeleteCouponResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	resp := new(pb.DeleteCouponResponse)
+	err := retry(ctx, c.policy, retryableCouponDelete(req.Id, c.api, resp))
+	return resp, err
+}
+
+func (c *StripeCouponClient) Get(ctx context.Context, req *pb.GetCouponRequest) (*pb.CouponResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	params := couponGetToCouponParams(ctx, c.key, req)
+
+	resp := new(pb.CouponResponse)
+	err := retry(ctx, c.policy, retryableCoupon(params, c.api, resp, couponGet))
+
+	return resp, err
+}
+
+// couponStreamer implements the CouponStreamer interface, converting Stripe responses
+// to a CouponResponse.
+type couponStreamer struct {
+	listIter
+	iter *coupon.Iter
+}
+
+func (s *couponStreamer) Current() *pb.CouponResponse {
+	if e := s.errorResponse(); e != nil {
+		return &pb.CouponResponse{Responses: &pb.CouponResponse_Error{Error: e}}
+	}
+	return respToCouponSuccess(s.iter.Coupon())
+}
+
+func (c *StripeCouponClient) List(ctx context.Context, req *pb.ListCouponsRequest) (backend.CouponStreamer, error) {
+	params := couponListToListParams(ctx, c.key, req)
+
+	streamer := &couponStreamer{listIter: listIter{ctx: ctx}}
+	err := retry(ctx, c.policy, retryableCouponList(params, c.api, streamer))
+
+	return streamer, err
+}
+
+// Apply applies a coupon to a customer or subscription, replacing any existing discount
+func (c *StripeCouponClient) Apply(ctx context.Context, req *pb.ApplyCouponRequest) (*pb.DiscountResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	ctx, key := withIdempotencyKey(ctx)
+	params := paramsFromContext(ctx, c.key, nil)
+
+	resp := new(pb.DiscountResponse)
+	var err error
+	switch {
+	case len(req.Customer) > 0:
+		p := &stripe.CustomerParams{Params: params, Coupon: req.Coupon}
+		err = retry(ctx, c.policy, retryableCustomerDiscount(req.Customer, p, c.customers, resp))
+	default:
+		p := &stripe.SubParams{Params: params, Coupon: req.Coupon}
+		err = retry(ctx, c.policy, retryableSubscriptionDiscount(req.Subscription, p, c.subs, resp))
+	}
+
+	reportIdempotencyKey(c.logger, "coupon apply", key, resp.GetError(), err)
+	return resp, err
+}
+
+// RemoveDiscount removes the discount from a customer or subscription.  The Stripe API used by
+// recur does not accept parameters for discount deletes, so no idempotency key or Connect account
+// is sent.
+func (c *StripeCouponClient) RemoveDiscount(ctx context.Context, req *pb.RemoveDiscountRequest) (*pb.RemoveDiscountResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	resp := new(pb.RemoveDiscountResponse)
+	err := retry(ctx, c.policy, retryableRemoveDiscount(req, c.discounts, resp))
+	return resp, err
+}
@@ -0,0 +1,176 @@
+package stripe
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/BTBurke/recur/pb"
+	"github.com/stripe/stripe-go"
+	context "golang.org/x/net/context"
+)
+
+// convert from a coupon create request to CouponParams
+func couponCreateToCouponParams(ctx context.Context, key string, req *pb.CreateCouponRequest) *stripe.CouponParams {
+	params := &stripe.CouponParams{
+		Params:         paramsFromContext(ctx, key, &req.Metadata),
+		ID:             req.Id,
+		Duration:       pbToStripeCouponDuration(req.GetDuration()),
+		Amount:         req.AmountOff,
+		Percent:        req.PercentOff,
+		DurationPeriod: req.DurationInMonths,
+		Redemptions:    req.MaxRedemptions,
+		RedeemBy:       req.RedeemBy,
+	}
+	if req.AmountOff > 0 {
+		params.Currency = pbToStripeCurrency(req.GetCurrency())
+	}
+	return params
+}
+
+// convert from coupon update to CouponParams.  Only the metadata of a coupon can be changed.
+func couponUpdateToCouponParams(ctx context.Context, key string, req *pb.UpdateCouponRequest) *stripe.CouponParams {
+	return &stripe.CouponParams{
+		Params: paramsFromContext(ctx, key, &req.Metadata),
+		ID:     req.Id,
+	}
+}
+
+// convert from coupon get to CouponParams
+func couponGetToCouponParams(ctx context.Context, key string, req *pb.GetCouponRequest) *stripe.CouponParams {
+	return &stripe.CouponParams{
+		Params: paramsFromContext(ctx, key, nil),
+		ID:     req.Id,
+	}
+}
+
+func couponListToListParams(ctx context.Context, key string, req *pb.ListCouponsRequest) *stripe.CouponListParams {
+	switch {
+	case req == nil:
+		return &stripe.CouponListParams{
+			ListParams: stripe.ListParams{
+				Limit: 10,
+			},
+		}
+	default:
+		return &stripe.CouponListParams{
+			ListParams: stripe.ListParams{
+				Start: req.StartingAfter,
+				End:   req.EndingBefore,
+				Limit: defaultInt(int(req.Limit), 10),
+			},
+			CreatedRange: &stripe.RangeQueryParams{
+				GreaterThan:        req.GetCreated().GetGt(),
+				GreaterThanOrEqual: req.GetCreated().GetGte(),
+				LesserThan:         req.GetCreated().GetLt(),
+				LesserThanOrEqual:  req.GetCreated().GetLte(),
+			},
+		}
+	}
+}
+
+// convert a success response from Stripe to a CouponResponse (success)
+func respToCouponSuccess(c *stripe.Coupon) *pb.CouponResponse {
+	return &pb.CouponResponse{
+		Responses: &pb.CouponResponse_Success{
+			Success: stripeToPbCoupon(c),
+		},
+	}
+}
+
+// convert a Stripe coupon to a pb.Coupon
+func stripeToPbCoupon(c *stripe.Coupon) *pb.Coupon {
+	coupon := &pb.Coupon{
+		Id:               c.ID,
+		AmountOff:        c.Amount,
+		Created:          c.Created,
+		Duration:         stripeToPbCouponDuration(c.Duration),
+		DurationInMonths: c.DurationPeriod,
+		Livemode:         c.Live,
+		MaxRedemptions:   c.Redemptions,
+		Metadata:         c.Meta,
+		PercentOff:       c.Percent,
+		RedeemBy:         c.RedeemBy,
+		TimesRedeemed:    c.Redeemed,
+		Valid:            c.Valid,
+	}
+	if len(c.Currency) > 0 {
+		coupon.Currency = stripeToPbCurrency(c.Currency)
+	}
+	return coupon
+}
+
+// convert an error response from Stripe to a CouponResponse (error)
+func respToCouponError(err *stripe.Error) *pb.CouponResponse {
+	return &pb.CouponResponse{
+		Responses: &pb.CouponResponse_Error{
+			Error: respToError(err),
+		},
+	}
+}
+
+// convert a delete success response from Stripe to a DeleteCouponResponse
+func respToCouponDeleteSuccess(c *stripe.Coupon) *pb.DeleteCouponResponse {
+	return &pb.DeleteCouponResponse{
+		Responses: &pb.DeleteCouponResponse_Success{
+			Success: &pb.DeleteCouponSuccess{
+				Id:      c.ID,
+				Deleted: c.Deleted,
+			},
+		},
+	}
+}
+
+// convert a delete error response from Stripe to a DeleteCouponResponse
+func respToCouponDeleteError(err *stripe.Error) *pb.DeleteCouponResponse {
+	return &pb.DeleteCouponResponse{
+		Responses: &pb.DeleteCouponResponse_Error{
+			Error: respToError(err),
+		},
+	}
+}
+
+// convert a Stripe discount to a pb.Discount.  Customers and subscriptions without a discount
+// return nil.
+func stripeToPbDiscount(d *stripe.Discount) *pb.Discount {
+	if d == nil {
+		return nil
+	}
+	discount := &pb.Discount{
+		Customer:     d.Customer,
+		Subscription: d.Sub,
+		Start:        d.Start,
+		End:          d.End,
+	}
+	if d.Coupon != nil {
+		discount.Coupon = stripeToPbCoupon(d.Coupon)
+	}
+	return discount
+}
+
+// convert an error response from Stripe to a DiscountResponse (error)
+func respToDiscountError(err *stripe.Error) *pb.DiscountResponse {
+	return &pb.DiscountResponse{
+		Responses: &pb.DiscountResponse_Error{
+			Error: respToError(err),
+		},
+	}
+}
+
+// convert an error response from Stripe to a RemoveDiscountResponse (error)
+func respToRemoveDiscountError(err *stripe.Error) *pb.RemoveDiscountResponse {
+	return &pb.RemoveDiscountResponse{
+		Responses: &pb.RemoveDiscountResponse_Error{
+			Error: respToError(err),
+		},
+	}
+}
+
+// constant conversions from stripe to protobuf - coupon duration
+func stripeToPbCouponDuration(d stripe.CouponDuration) pb.CouponDuration {
+	return pb.CouponDuration(pb.CouponDuration_value[strings.Title(fmt.Sprintf("%s", d))])
+}
+
+// constant conversions from protobuf to stripe - coupon duration
+func pbToStripeCouponDuration(d pb.CouponDuration) stripe.CouponDuration {
+	return stripe.CouponDuration(strings.ToLower(pb.CouponDuration_name[int32(d)]))
+}
@@ -0,0 +1,121 @@
+package stripe
+
+import (
+	"github.com/BTBurke/recur/pb"
+	"github.com/cenkalti/backoff"
+
+	"github.com/stripe/stripe-go"
+)
+
+type couponAction int
+
+const (
+	couponCreate couponAction = iota
+	couponUpdate
+	couponGet
+)
+
+func retryableCoupon(params *stripe.CouponParams, api couponClient, c *pb.CouponResponse, action couponAction) backoff.Operation {
+	return func() error {
+		var coupon = new(stripe.Coupon)
+		var err error
+		switch action {
+		case couponCreate:
+			coupon, err = api.New(params)
+		case couponUpdate:
+			coupon, err = api.Update(params.ID, params)
+		case couponGet:
+			coupon, err = api.Get(params.ID, params)
+		default:
+		}
+		if err != nil {
+			if stripeErr, ok := err.(*stripe.Error); ok {
+				*c = *respToCouponError(stripeErr)
+			}
+			return classify(err)
+		}
+		*c = *respToCouponSuccess(coupon)
+		return nil
+	}
+}
+
+func retryableCouponDelete(id string, api couponClient, c *pb.DeleteCouponResponse) backoff.Operation {
+	return func() error {
+		coupon, err := api.Del(id)
+		if err != nil {
+			if stripeErr, ok := err.(*stripe.Error); ok {
+				*c = *respToCouponDeleteError(stripeErr)
+			}
+			return classify(err)
+		}
+		*c = *respToCouponDeleteSuccess(coupon)
+		return nil
+	}
+}
+
+func retryableCouponList(params *stripe.CouponListParams, api couponClient, c *couponStreamer) backoff.Operation {
+	return func() error {
+		c.iter = api.List(params)
+		if c.iter != nil {
+			c.pages = c.iter
+		}
+		return nil
+	}
+}
+
+func retryableCustomerDiscount(id string, params *stripe.CustomerParams, api customerClient, d *pb.DiscountResponse) backoff.Operation {
+	return func() error {
+		cust, err := api.Update(id, params)
+		if err != nil {
+			if stripeErr, ok := err.(*stripe.Error); ok {
+				*d = *respToDiscountError(stripeErr)
+			}
+			return classify(err)
+		}
+		*d = pb.DiscountResponse{Responses: &pb.DiscountResponse_Success{Success: stripeToPbDiscount(cust.Discount)}}
+		return nil
+	}
+}
+
+func retryableSubscriptionDiscount(id string, params *stripe.SubParams, api subscriptionClient, d *pb.DiscountResponse) backoff.Operation {
+	return func() error {
+		s, err := api.Update(id, params)
+		if err != nil {
+			if stripeErr, ok := err.(*stripe.Error); ok {
+				*d = *respToDiscountError(stripeErr)
+			}
+			return classify(err)
+		}
+		*d = pb.DiscountResponse{Responses: &pb.DiscountResponse_Success{Success: stripeToPbDiscount(s.Discount)}}
+		return nil
+	}
+}
+
+func retryableRemoveDiscount(req *pb.RemoveDiscountRequest, api discountClient, d *pb.RemoveDiscountResponse) backoff.Operation {
+	return func() error {
+		var discount *stripe.Discount
+		var err error
+		switch {
+		case len(req.Customer) > 0:
+			discount, err = api.Del(req.Customer)
+		default:
+			discount, err = api.DelSub(req.Subscription)
+		}
+		if err != nil {
+			if stripeErr, ok := err.(*stripe.Error); ok {
+				*d = *respToRemoveDiscountError(stripeErr)
+			}
+			return classify(err)
+		}
+		*d = pb.RemoveDiscountResponse{
+			Responses: &pb.RemoveDiscountResponse_Success{
+				Success: &pb.RemoveDiscountSuccess{
+					Deleted:      discount.Deleted,
+					Customer:     req.Customer,
+					Subscription: req.Subscription,
+				},
+			},
+		}
+		return nil
+	}
+}
@@ -0,0 +1,116 @@
+package stripe
+
+import (
+	"fmt"
+	"testing"
+
+	context "golang.org/x/net/context"
+
+	"github.com/BTBurke/recur/pb"
+	"github.com/cenkalti/backoff"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/coupon"
+)
+
+type mockCoupon struct {
+	mock.Mock
+}
+
+func (m *mockCoupon) New(params *stripe.CouponParams) (*stripe.Coupon, error) {
+	args := m.Called(params)
+	return args.Get(0).(*stripe.Coupon), args.Error(1)
+}
+
+func (m *mockCoupon) Get(id string, params *stripe.CouponParams) (*stripe.Coupon, error) {
+	args := m.Called(id)
+	return args.Get(0).(*stripe.Coupon), args.Error(1)
+}
+
+func (m *mockCoupon) Update(id string, params *stripe.CouponParams) (*stripe.Coupon, error) {
+	args := m.Called(id)
+	return args.Get(0).(*stripe.Coupon), args.Error(1)
+}
+
+func (m *mockCoupon) Del(id string) (*stripe.Coupon, error) {
+	args := m.Called(id)
+	return args.Get(0).(*stripe.Coupon), args.Error(1)
+}
+
+func (m *mockCoupon) List(params *stripe.CouponListParams) *coupon.Iter {
+	args := m.Called(params)
+	return args.Get(0).(*coupon.Iter)
+}
+
+type mockDiscount struct {
+	mock.Mock
+}
+
+func (m *mockDiscount) Del(customerID string) (*stripe.Discount, error) {
+	args := m.Called(customerID)
+	return args.Get(0).(*stripe.Discount), args.Error(1)
+}
+
+func (m *mockDiscount) DelSub(subscriptionID string) (*stripe.Discount, error) {
+	args := m.Called(subscriptionID)
+	return args.Get(0).(*stripe.Discount), args.Error(1)
+}
+
+func TestRetryableCoupon(t *testing.T) {
+	c := &stripe.Coupon{ID: "FIVE", Duration: "repeating", DurationPeriod: 3, Amount: 500, Currency: "usd", Valid: true}
+
+	mck := new(mockCoupon)
+	mck.On("Get", "FIVE").Return((*stripe.Coupon)(nil), fmt.Errorf("test retry")).Once()
+	mck.On("Get", "FIVE").Return(c, nil).Once()
+	resp := new(pb.CouponResponse)
+	err := backoff.Retry(
+		retryableCoupon(&stripe.CouponParams{ID: "FIVE"}, mck, resp, couponGet),
+		backoff.WithContext(backoff.NewExponentialBackOff(), context.Background()),
+	)
+	assert.NoError(t, err)
+	mck.AssertExpectations(t)
+	got := resp.GetSuccess()
+	assert.Equal(t, "FIVE", got.GetId())
+	assert.Equal(t, pb.CouponDuration_Repeating, got.GetDuration())
+	assert.Equal(t, uint64(3), got.GetDurationInMonths())
+	assert.Equal(t, pb.Currency_USD, got.GetCurrency())
+}
+
+func TestCouponConversion(t *testing.T) {
+	params := couponCreateToCouponParams(context.Background(), "", &pb.CreateCouponRequest{Duration: pb.CouponDuration_Once, PercentOff: 25, Currency: pb.Currency_USD})
+	assert.Equal(t, coupon.Once, params.Duration)
+	assert.Equal(t, uint64(25), params.Percent)
+	assert.Equal(t, stripe.Currency(""), params.Currency)
+	assert.Equal(t, coupon.Forever, pbToStripeCouponDuration(pb.CouponDuration_Forever))
+	assert.Nil(t, stripeToPbDiscount(nil))
+}
+
+func TestRetryableDiscount(t *testing.T) {
+	cust := &stripe.Customer{
+		ID:       "cus_test",
+		Discount: &stripe.Discount{Customer: "cus_test", Start: 1500000000, Coupon: &stripe.Coupon{ID: "FIVE", Duration: "forever", Percent: 5}},
+	}
+	customers := new(mockCustomer)
+	customers.On("Update").Return(cust, nil)
+	resp := new(pb.DiscountResponse)
+	err := backoff.Retry(
+		retryableCustomerDiscount("cus_test", &stripe.CustomerParams{Coupon: "FIVE"}, customers, resp),
+		backoff.WithContext(backoff.NewExponentialBackOff(), context.Background()),
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, "cus_test", resp.GetSuccess().GetCustomer())
+	assert.Equal(t, pb.CouponDuration_Forever, resp.GetSuccess().GetCoupon().GetDuration())
+
+	discounts := new(mockDiscount)
+	discounts.On("DelSub", "sub_test").Return(&stripe.Discount{Deleted: true}, nil)
+	rm := new(pb.RemoveDiscountResponse)
+	err = backoff.Retry(
+		retryableRemoveDiscount(&pb.RemoveDiscountRequest{Subscription: "sub_test"}, discounts, rm),
+		backoff.WithContext(backoff.NewExponentialBackOff(), context.Background()),
+	)
+	assert.NoError(t, err)
+	discounts.AssertExpectations(t)
+	assert.True(t, rm.GetSuccess().GetDeleted())
+	assert.Equal(t, "sub_test", rm.GetSuccess().GetSubscription())
+}
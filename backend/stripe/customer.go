@@ -0,0 +1,108 @@
+package stripe
+
+import (
+	"github.com/BTBurke/recur/backend"
+	"github.com/BTBurke/recur/pb"
+	"github.com/stripe/stripe-go/customer"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stripe/stripe-go"
+	context "golang.org/x/net/context"
+)
+
+// interface for the Stripe customer API
+type customerClient interface {
+	New(params *stripe.CustomerParams) (*stripe.Customer, error)
+	Get(id string, params *stripe.CustomerParams) (*stripe.Customer, error)
+	Update(id string, params *stripe.CustomerParams) (*stripe.Customer, error)
+	Del(id string) (*stripe.Customer, error)
+	List(params *stripe.CustomerListParams) *customer.Iter
+}
+
+type StripeCustomerClient struct {
+	key    string
+	logger log.StdLogger
+	// api allows mocking the Stripe backend
+	api customerClient
+	// policy controls retries of failed requests
+	policy backend.RetryPolicy
+}
+
+func NewCustomerClient(key string, logger log.StdLogger, opts ...Option) *StripeCustomerClient {
+	o := newOptions(opts)
+	return &StripeCustomerClient{
+		key:    key,
+		logger: logger,
+		policy: o.retry,
+		api: customer.Client{
+			B:   stripe.GetBackend(stripe.SupportedBackend("api")),
+			Key: key,
+		},
+	}
+}
+
+func (c *StripeCustomerClient) Create(ctx context.Context, req *pb.CreateCustomerRequest) (*pb.CustomerResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	ctx, key := withIdempotencyKey(ctx)
+	params := customerCreateToCustomerParams(ctx, c.key, req)
+	resp := new(pb.CustomerResponse)
+	err := retry(ctx, c.policy, retryableCustomer("", params, c.api, resp, customerCreate))
+	reportIdempotencyKey(c.logger, "customer create", key, resp.GetError(), err)
+	return resp, err
+}
+
+func (c *StripeCustomerClient) Update(ctx context.Context, req *pb.UpdateCustomerRequest) (*pb.CustomerResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	ctx, key := withIdempotencyKey(ctx)
+	params := customerUpdateToCustomerParams(ctx, c.key, req)
+	resp := new(pb.CustomerResponse)
+	err := retry(ctx, c.policy, retryableCustomer(req.Id, params, c.api, resp, customerUpdate))
+	reportIdempotencyKey(c.logger, "customer update", key, resp.GetError(), err)
+	return resp, err
+}
+
+// Delete deletes the customer.  The Stripe customer API does not accept params on delete, so no
+// idempotency key is sent; deleting a customer twice returns a not found error.
+func (c *StripeCustomerClient) Delete(ctx context.Context, req *pb.DeleteCustomerRequest) (*pb.DeleteCustomerResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	resp := new(pb.DeleteCustomerResponse)
+	err := retry(ctx, c.policy, retryableCustomerDelete(req.Id, c.api, resp))
+	return resp, err
+}
+
+func (c *StripeCustomerClient) Get(ctx context.Context, req *pb.GetCustomerRequest) (*pb.CustomerResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	params := customerGetToCustomerParams(ctx, c.key, req)
+	resp := new(pb.CustomerResponse)
+	err := retry(ctx, c.policy, retryableCustomer(req.Id, params, c.api, resp, customerGet))
+	return resp, err
+}
+
+// customerStreamer implements the CustomerStreamer interface, converting Stripe responses
+// to a CustomerResponse.
+type customerStreamer struct {
+	listIter
+	iter *customer.Iter
+}
+
+func (s *customerStreamer) Current() *pb.CustomerResponse {
+	if e := s.errorResponse(); e != nil {
+		return &pb.CustomerResponse{Responses: &pb.CustomerResponse_Error{Error: e}}
+	}
+	return respToCustomerSuccess(s.iter.Customer())
+}
+
+func (c *StripeCustomerClient) List(ctx context.Context, req *pb.ListCustomersRequest) (backend.CustomerStreamer, error) {
+	params := customerListToListParams(ctx, c.key, req)
+	streamer := &customerStreamer{listIter: listIter{ctx: ctx}}
+	err := retry(ctx, c.policy, retryableCustomerList(params, c.api, streamer))
+	return streamer, err
+}
@@ -0,0 +1,117 @@
+package stripe
+
+import (
+	"github.com/BTBurke/recur/pb"
+	"github.com/stripe/stripe-go"
+	context "golang.org/x/net/context"
+)
+
+// convert from a customer create request to CustomerParams
+func customerCreateToCustomerParams(ctx context.Context, key string, req *pb.CreateCustomerRequest) *stripe.CustomerParams {
+	return &stripe.CustomerParams{
+		Params:        paramsFromContext(ctx, key, &req.Metadata),
+		Balance:       req.AccountBalance,
+		Desc:          req.Description,
+		Email:         req.Email,
+		BusinessVatID: req.BusinessVatId,
+	}
+}
+
+// convert from customer update to CustomerParams
+func customerUpdateToCustomerParams(ctx context.Context, key string, req *pb.UpdateCustomerRequest) *stripe.CustomerParams {
+	return &stripe.CustomerParams{
+		Params:        paramsFromContext(ctx, key, &req.Metadata),
+		Balance:       req.AccountBalance,
+		Desc:          req.Description,
+		Email:         req.Email,
+		BusinessVatID: req.BusinessVatId,
+	}
+}
+
+// convert from customer get to CustomerParams
+func customerGetToCustomerParams(ctx context.Context, key string, req *pb.GetCustomerRequest) *stripe.CustomerParams {
+	return &stripe.CustomerParams{
+		Params: paramsFromContext(ctx, key, nil),
+	}
+}
+
+func customerListToListParams(ctx context.Context, key string, req *pb.ListCustomersRequest) *stripe.CustomerListParams {
+	switch {
+	case req == nil:
+		return &stripe.CustomerListParams{
+			ListParams: stripe.ListParams{
+				Limit: 10,
+			},
+		}
+	default:
+		return &stripe.CustomerListParams{
+			ListParams: stripe.ListParams{
+				Start: req.StartingAfter,
+				End:   req.EndingBefore,
+				Limit: defaultInt(int(req.Limit), 10),
+			},
+			CreatedRange: &stripe.RangeQueryParams{
+				GreaterThan:        req.GetCreated().GetGt(),
+				GreaterThanOrEqual: req.GetCreated().GetGte(),
+				LesserThan:         req.GetCreated().GetLt(),
+				LesserThanOrEqual:  req.GetCreated().GetLte(),
+			},
+		}
+	}
+}
+
+// convert a success response from Stripe to a CustomerResponse (success)
+func respToCustomerSuccess(cust *stripe.Customer) *pb.CustomerResponse {
+	var defaultSource string
+	if cust.DefaultSource != nil {
+		defaultSource = cust.DefaultSource.ID
+	}
+	return &pb.CustomerResponse{
+		Responses: &pb.CustomerResponse_Success{
+			Success: &pb.Customer{
+				Id:             cust.ID,
+				AccountBalance: cust.Balance,
+				Created:        cust.Created,
+				Currency:       stripeToPbCurrency(cust.Currency),
+				DefaultSource:  defaultSource,
+				Delinquent:     cust.Delinquent,
+				Description:    cust.Desc,
+				Email:          cust.Email,
+				Livemode:       cust.Live,
+				Metadata:       cust.Meta,
+				BusinessVatId:  cust.BusinessVatID,
+				Discount:       stripeToPbDiscount(cust.Discount),
+			},
+		},
+	}
+}
+
+// convert an error response from Stripe to a CustomerResponse (error)
+func respToCustomerError(err *stripe.Error) *pb.CustomerResponse {
+	return &pb.CustomerResponse{
+		Responses: &pb.CustomerResponse_Error{
+			Error: respToError(err),
+		},
+	}
+}
+
+// convert a delete success response from Stripe to a DeleteCustomerResponse
+func respToCustomerDeleteSuccess(cust *stripe.Customer) *pb.DeleteCustomerResponse {
+	return &pb.DeleteCustomerResponse{
+		Responses: &pb.DeleteCustomerResponse_Success{
+			Success: &pb.DeleteCustomerSuccess{
+				Id:      cust.ID,
+				Deleted: cust.Deleted,
+			},
+		},
+	}
+}
+
+// convert a delete error response from Stripe to a DeleteCustomerResponse
+func respToCustomerDeleteError(err *stripe.Error) *pb.DeleteCustomerResponse {
+	return &pb.DeleteCustomerResponse{
+		Responses: &pb.DeleteCustomerResponse_Error{
+			Error: respToError(err),
+		},
+	}
+}
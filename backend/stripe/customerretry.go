@@ -0,0 +1,63 @@
+package stripe
+
+import (
+	"github.com/BTBurke/recur/pb"
+	"github.com/cenkalti/backoff"
+	"github.com/stripe/stripe-go"
+)
+
+type customerAction int
+
+const (
+	customerCreate customerAction = iota
+	customerUpdate
+	customerGet
+)
+
+func retryableCustomer(id string, params *stripe.CustomerParams, api customerClient, c *pb.CustomerResponse, action customerAction) backoff.Operation {
+	return func() error {
+		var cust = new(stripe.Customer)
+		var err error
+		switch action {
+		case customerCreate:
+			cust, err = api.New(params)
+		case customerUpdate:
+			cust, err = api.Update(id, params)
+		case customerGet:
+			cust, err = api.Get(id, params)
+		default:
+		}
+		if err != nil {
+			if stripeErr, ok := err.(*stripe.Error); ok {
+				*c = *respToCustomerError(stripeErr)
+			}
+			return classify(err)
+		}
+		*c = *respToCustomerSuccess(cust)
+		return nil
+	}
+}
+
+func retryableCustomerDelete(id string, api customerClient, c *pb.DeleteCustomerResponse) backoff.Operation {
+	return func() error {
+		cust, err := api.Del(id)
+		if err != nil {
+			if stripeErr, ok := err.(*stripe.Error); ok {
+				*c = *respToCustomerDeleteError(stripeErr)
+			}
+			return classify(err)
+		}
+		*c = *respToCustomerDeleteSuccess(cust)
+		return nil
+	}
+}
+
+func retryableCustomerList(params *stripe.CustomerListParams, api customerClient, c *customerStreamer) backoff.Operation {
+	return func() error {
+		c.iter = api.List(params)
+		if c.iter != nil {
+			c.pages = c.iter
+		}
+		return nil
+	}
+}
@@ -0,0 +1,113 @@
+package stripe
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	context "golang.org/x/net/context"
+
+	"github.com/BTBurke/recur/pb"
+	"github.com/cenkalti/backoff"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/customer"
+)
+
+type mockCustomer struct {
+	delay  time.Duration
+	called int
+	mock.Mock
+}
+
+func (m *mockCustomer) respond(args mock.Arguments) (*stripe.Customer, error) {
+	time.Sleep(m.delay)
+	if m.called > 0 && m.delay == 0 {
+		return args.Get(0).(*stripe.Customer), args.Error(1)
+	}
+	m.called++
+	return nil, fmt.Errorf("test retry")
+}
+
+func (m *mockCustomer) New(params *stripe.CustomerParams) (*stripe.Customer, error) {
+	return m.respond(m.Called())
+}
+
+func (m *mockCustomer) Get(id string, params *stripe.CustomerParams) (*stripe.Customer, error) {
+	return m.respond(m.Called())
+}
+
+func (m *mockCustomer) Update(id string, params *stripe.CustomerParams) (*stripe.Customer, error) {
+	return m.respond(m.Called())
+}
+
+func (m *mockCustomer) Del(id string) (*stripe.Customer, error) {
+	return m.respond(m.Called())
+}
+
+func (m *mockCustomer) List(params *stripe.CustomerListParams) *customer.Iter {
+	args := m.Called(params)
+	return args.Get(0).(*customer.Iter)
+}
+
+func TestRetryableCustomer(t *testing.T) {
+	cust := &stripe.Customer{
+		ID:    "cus_test",
+		Email: "test@example.com",
+		DefaultSource: &stripe.PaymentSource{
+			ID: "card_test",
+		},
+	}
+
+	tt := []struct {
+		Name      string
+		Method    string
+		Action    customerAction
+		Timeout   time.Duration
+		Delay     time.Duration
+		ShouldErr bool
+	}{
+		{Name: "create no timeout", Method: "New", Action: customerCreate, Timeout: 1 * time.Second, ShouldErr: false},
+		{Name: "get no timeout", Method: "Get", Action: customerGet, Timeout: 1 * time.Second, ShouldErr: false},
+		{Name: "update no timeout", Method: "Update", Action: customerUpdate, Timeout: 1 * time.Second, ShouldErr: false},
+		{Name: "update times out", Method: "Update", Action: customerUpdate, Timeout: 100 * time.Millisecond, Delay: 120 * time.Millisecond, ShouldErr: true},
+	}
+	for _, tc := range tt {
+		t.Run(tc.Name, func(t *testing.T) {
+			mck := &mockCustomer{delay: tc.Delay}
+			resp := new(pb.CustomerResponse)
+			ctx, cancelFunc := context.WithTimeout(context.Background(), tc.Timeout)
+			defer cancelFunc()
+			mck.On(tc.Method).Return(cust, nil)
+
+			err := backoff.Retry(
+				retryableCustomer(cust.ID, &stripe.CustomerParams{}, mck, resp, tc.Action),
+				backoff.WithContext(backoff.NewExponentialBackOff(), ctx),
+			)
+			switch tc.ShouldErr {
+			case true:
+				assert.Error(t, err)
+			default:
+				assert.NoError(t, err)
+				mck.AssertNumberOfCalls(t, tc.Method, 2)
+				assert.Equal(t, cust.ID, resp.GetSuccess().GetId())
+				assert.Equal(t, "card_test", resp.GetSuccess().GetDefaultSource())
+			}
+		})
+	}
+}
+
+func TestRetryableCustomerDelete(t *testing.T) {
+	mck := new(mockCustomer)
+	mck.On("Del").Return(&stripe.Customer{ID: "cus_test", Deleted: true}, nil)
+	resp := new(pb.DeleteCustomerResponse)
+
+	err := backoff.Retry(
+		retryableCustomerDelete("cus_test", mck, resp),
+		backoff.WithContext(backoff.NewExponentialBackOff(), context.Background()),
+	)
+	assert.NoError(t, err)
+	mck.AssertNumberOfCalls(t, "Del", 2)
+	assert.True(t, resp.GetSuccess().GetDeleted())
+}
@@ -0,0 +1,96 @@
+package stripe
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/BTBurke/recur/backend"
+	"github.com/BTBurke/recur/pb"
+	"github.com/stripe/stripe-go/event"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stripe/stripe-go"
+	context "golang.org/x/net/context"
+)
+
+// interface for the Stripe event API
+type eventClient interface {
+	Get(id string, params *stripe.Params) (*stripe.Event, error)
+	List(params *stripe.EventListParams) *event.Iter
+}
+
+// StripeEventClient retrieves past events.  Stripe keeps events for 30 days.
+type StripeEventClient struct {
+	key    string
+	logger log.StdLogger
+	// api allows mocking the Stripe backend
+	api eventClient
+	// policy controls retries of failed requests
+	policy backend.RetryPolicy
+}
+
+var _ backend.EventClient = (*StripeEventClient)(nil)
+
+func NewEventClient(key string, logger log.StdLogger, opts ...Option) *StripeEventClient {
+	o := newOptions(opts)
+	return &StripeEventClient{
+		key:    key,
+		logger: logger,
+		policy: o.retry,
+		api: event.Client{
+			B:   stripe.GetBackend(stripe.SupportedBackend("api")),
+			Key: key,
+		},
+	}
+}
+
+func (e *StripeEventClient) Get(ctx context.Context, req *pb.GetEventRequest) (*pb.EventResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	params := paramsFromContext(ctx, e.key, nil)
+	resp := new(pb.EventResponse)
+	err := retry(ctx, e.policy, retryableEvent(req.Id, &params, e.api, resp))
+	return resp, err
+}
+
+// eventStreamer implements the EventStreamer interface, converting Stripe responses to an
+// EventResponse
+type eventStreamer struct {
+	listIter
+	iter *event.Iter
+}
+
+func (s *eventStreamer) Next() bool {
+	return s.listIter.Next()
+}
+
+func (s *eventStreamer) Current() *pb.EventResponse {
+	if e := s.errorResponse(); e != nil {
+		return &pb.EventResponse{Responses: &pb.EventResponse_Error{Error: e}}
+	}
+	return respToEventSuccess(s.iter.Event())
+}
+
+// List returns events newest first.  A type filter may end in a wildcard such as "invoice.*".
+func (e *StripeEventClient) List(ctx context.Context, req *pb.ListEventsRequest) (backend.EventStreamer, error) {
+	params := eventListToListParams(ctx, e.key, req)
+	streamer := &eventStreamer{listIter: listIter{ctx: ctx}}
+	err := retry(ctx, e.policy, retryableEventList(params, e.api, streamer))
+	return streamer, err
+}
+
+// DecodeEvent decodes the JSON body of a Stripe event, as delivered to a webhook endpoint or
+// returned from the events API, into an Event.  Plans, customers, subscriptions and invoices are
+// converted to their typed payloads.  Events about other objects are returned with only the raw
+// object set.
+func DecodeEvent(payload []byte) (*pb.Event, error) {
+	var e stripe.Event
+	if err := json.Unmarshal(payload, &e); err != nil {
+		return nil, fmt.Errorf("stripe: invalid event: %s", err)
+	}
+	if len(e.ID) == 0 || len(e.Type) == 0 {
+		return nil, fmt.Errorf("stripe: invalid event: id and type are required")
+	}
+	return stripeToPbEvent(&e)
+}
@@ -0,0 +1,49 @@
+package stripe
+
+import (
+	"testing"
+
+	"github.com/BTBurke/recur/pb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeEvent(t *testing.T) {
+	tt := []struct {
+		Name    string
+		Payload string
+		Check   func(t *testing.T, ev *pb.Event)
+		Err     bool
+	}{
+		{Name: "plan", Payload: `{"id":"evt_1","type":"plan.created","data":{"object":{"id":"gold","object":"plan","amount":2000,"currency":"usd","interval":"month","name":"Gold"}}}`, Check: func(t *testing.T, ev *pb.Event) {
+			assert.Equal(t, "gold", ev.GetPlan().GetId())
+			assert.Equal(t, uint64(2000), ev.GetPlan().GetAmount())
+		}},
+		{Name: "customer", Payload: `{"id":"evt_2","type":"customer.updated","data":{"object":{"id":"cus_1","object":"customer","email":"a@example.com"},"previous_attributes":{"email":"b@example.com","description":null}}}`, Check: func(t *testing.T, ev *pb.Event) {
+			assert.Equal(t, "a@example.com", ev.GetCustomer().GetEmail())
+			assert.Equal(t, []string{"description", "email"}, ev.PreviousAttributes)
+		}},
+		{Name: "subscription", Payload: `{"id":"evt_3","type":"customer.subscription.deleted","data":{"object":{"id":"sub_1","object":"subscription","customer":"cus_1","status":"canceled"}}}`, Check: func(t *testing.T, ev *pb.Event) {
+			assert.Equal(t, "sub_1", ev.GetSubscription().GetId())
+			assert.Equal(t, "cus_1", ev.GetSubscription().GetCustomer())
+		}},
+		{Name: "untyped object", Payload: `{"id":"evt_4","type":"charge.succeeded","data":{"object":{"id":"ch_1","object":"charge"}}}`, Check: func(t *testing.T, ev *pb.Event) {
+			assert.Equal(t, "charge", ev.Object)
+			assert.Nil(t, ev.Data)
+			assert.Equal(t, `{"id":"ch_1","object":"charge"}`, string(ev.RawObject))
+		}},
+		{Name: "missing id", Payload: `{"type":"plan.created"}`, Err: true},
+		{Name: "invalid json", Payload: `{`, Err: true},
+	}
+	for _, tc := range tt {
+		t.Run(tc.Name, func(t *testing.T) {
+			ev, err := DecodeEvent([]byte(tc.Payload))
+			if tc.Err {
+				assert.Error(t, err)
+				return
+			}
+			if assert.NoError(t, err) {
+				tc.Check(t, ev)
+			}
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package stripe
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/BTBurke/recur/pb"
+	stripe "github.com/stripe/stripe-go"
+	context "golang.org/x/net/context"
+)
+
+func eventListToListParams(ctx context.Context, key string, req *pb.ListEventsRequest) *stripe.EventListParams {
+	switch {
+	case req == nil:
+		return &stripe.EventListParams{
+			ListParams: stripe.ListParams{
+				Limit: 10,
+			},
+		}
+	default:
+		return &stripe.EventListParams{
+			ListParams: stripe.ListParams{
+				Start: req.StartingAfter,
+				End:   req.EndingBefore,
+				Limit: defaultInt(int(req.Limit), 10),
+			},
+			CreatedRange: &stripe.RangeQueryParams{
+				GreaterThan:        req.GetCreated().GetGt(),
+				GreaterThanOrEqual: req.GetCreated().GetGte(),
+				LesserThan:         req.GetCreated().GetLt(),
+				LesserThanOrEqual:  req.GetCreated().GetLte(),
+			},
+			Type: req.Type,
+		}
+	}
+}
+
+// convert a success response from Stripe to an EventResponse (success).  An event whose object
+// cannot be decoded is returned as an error response so that list iteration can continue.
+func respToEventSuccess(e *stripe.Event) *pb.EventResponse {
+	ev, err := stripeToPbEvent(e)
+	if err != nil {
+		return &pb.EventResponse{
+			Responses: &pb.EventResponse_Error{
+				Error: &pb.Error{Type: pb.ErrorType_Unknown, Message: err.Error()},
+			},
+		}
+	}
+	return &pb.EventResponse{
+		Responses: &pb.EventResponse_Success{
+			Success: ev,
+		},
+	}
+}
+
+// convert an error response from Stripe to an EventResponse (error)
+func respToEventError(err *stripe.Error) *pb.EventResponse {
+	return &pb.EventResponse{
+		Responses: &pb.EventResponse_Error{
+			Error: respToError(err),
+		},
+	}
+}
+
+// convert a Stripe event to an Event, decoding the data object by its object type
+func stripeToPbEvent(e *stripe.Event) (*pb.Event, error) {
+	ev := &pb.Event{
+		Id:              e.ID,
+		Type:            e.Type,
+		Created:         e.Created,
+		Livemode:        e.Live,
+		Account:         e.Account,
+		PendingWebhooks: e.Webhooks,
+	}
+	if e.Request != nil {
+		ev.RequestId = e.Request.ID
+		ev.IdempotencyKey = e.Request.IdempotencyKey
+	}
+	if e.Data == nil || len(e.Data.Raw) == 0 {
+		return ev, nil
+	}
+	ev.RawObject = []byte(e.Data.Raw)
+	for k := range e.Data.Prev {
+		ev.PreviousAttributes = append(ev.PreviousAttributes, k)
+	}
+	sort.Strings(ev.PreviousAttributes)
+
+	obj, _ := e.Data.Obj["object"].(string)
+	ev.Object = obj
+	switch obj {
+	case "plan":
+		var p stripePlan
+		if err := json.Unmarshal(e.Data.Raw, &p); err != nil {
+			return nil, fmt.Errorf("stripe: invalid plan in event %s: %s", e.ID, err)
+		}
+		ev.Data = &pb.Event_Plan{Plan: stripeToPbPlan(&p)}
+	case "customer":
+		var c stripe.Customer
+		if err := json.Unmarshal(e.Data.Raw, &c); err != nil {
+			return nil, fmt.Errorf("stripe: invalid customer in event %s: %s", e.ID, err)
+		}
+		ev.Data = &pb.Event_Customer{Customer: respToCustomerSuccess(&c).GetSuccess()}
+	case "subscription":
+		var s stripe.Sub
+		if err := json.Unmarshal(e.Data.Raw, &s); err != nil {
+			return nil, fmt.Errorf("stripe: invalid subscription in event %s: %s", e.ID, err)
+		}
+		ev.Data = &pb.Event_Subscription{Subscription: respToSubscriptionSuccess(&s).GetSuccess()}
+	case "invoice":
+		var inv stripeInvoice
+		if err := json.Unmarshal(e.Data.Raw, &inv); err != nil {
+			return nil, fmt.Errorf("stripe: invalid invoice in event %s: %s", e.ID, err)
+		}
+		ev.Data = &pb.Event_Invoice{Invoice: stripeToPbInvoice(&inv)}
+	}
+	return ev, nil
+}
@@ -0,0 +1,31 @@
+package stripe
+
+import (
+	"github.com/BTBurke/recur/pb"
+	"github.com/cenkalti/backoff"
+	"github.com/stripe/stripe-go"
+)
+
+func retryableEvent(id string, params *stripe.Params, api eventClient, e *pb.EventResponse) backoff.Operation {
+	return func() error {
+		ev, err := api.Get(id, params)
+		if err != nil {
+			if stripeErr, ok := err.(*stripe.Error); ok {
+				*e = *respToEventError(stripeErr)
+			}
+			return classify(err)
+		}
+		*e = *respToEventSuccess(ev)
+		return nil
+	}
+}
+
+func retryableEventList(params *stripe.EventListParams, api eventClient, e *eventStreamer) backoff.Operation {
+	return func() error {
+		e.iter = api.List(params)
+		if e.iter != nil {
+			e.pages = e.iter
+		}
+		return nil
+	}
+}
@@ -0,0 +1,75 @@
+package stripe
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	context "golang.org/x/net/context"
+
+	"github.com/BTBurke/recur/pb"
+	"github.com/cenkalti/backoff"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/event"
+)
+
+type mockEvent struct {
+	mock.Mock
+}
+
+func (m *mockEvent) Get(id string, params *stripe.Params) (*stripe.Event, error) {
+	args := m.Called(id)
+	return args.Get(0).(*stripe.Event), args.Error(1)
+}
+
+func (m *mockEvent) List(params *stripe.EventListParams) *event.Iter {
+	args := m.Called(params)
+	return args.Get(0).(*event.Iter)
+}
+
+func TestRetryableEvent(t *testing.T) {
+	var ev stripe.Event
+	err := json.Unmarshal([]byte(`{"id":"evt_test","type":"customer.created","data":{"object":{"id":"cus_test","object":"customer"}}}`), &ev)
+	if err != nil {
+		t.Fatalf("failed to decode test event: %s", err)
+	}
+
+	tt := []struct {
+		Name   string
+		Setup  func(m *mockEvent)
+		Expect func(t *testing.T, resp *pb.EventResponse)
+	}{
+		{Name: "get", Setup: func(m *mockEvent) {
+			m.On("Get", "evt_test").Return(&ev, nil)
+		}, Expect: func(t *testing.T, resp *pb.EventResponse) {
+			assert.Equal(t, "evt_test", resp.GetSuccess().GetId())
+			assert.Equal(t, "cus_test", resp.GetSuccess().GetCustomer().GetId())
+		}},
+		{Name: "retry on network error", Setup: func(m *mockEvent) {
+			m.On("Get", "evt_test").Return((*stripe.Event)(nil), fmt.Errorf("test retry")).Once()
+			m.On("Get", "evt_test").Return(&ev, nil).Once()
+		}, Expect: func(t *testing.T, resp *pb.EventResponse) {
+			assert.Equal(t, "evt_test", resp.GetSuccess().GetId())
+		}},
+		{Name: "not found", Setup: func(m *mockEvent) {
+			m.On("Get", "evt_test").Return((*stripe.Event)(nil), &stripe.Error{Type: stripe.InvalidRequest, HTTPStatusCode: 404, Msg: "No such event"}).Once()
+		}, Expect: func(t *testing.T, resp *pb.EventResponse) {
+			assert.True(t, pb.IsNotFound(resp.GetError()))
+		}},
+	}
+	for _, tc := range tt {
+		t.Run(tc.Name, func(t *testing.T) {
+			mck := new(mockEvent)
+			tc.Setup(mck)
+			resp := new(pb.EventResponse)
+			backoff.Retry(
+				retryableEvent("evt_test", &stripe.Params{}, mck, resp),
+				backoff.WithContext(backoff.NewExponentialBackOff(), context.Background()),
+			)
+			mck.AssertExpectations(t)
+			tc.Expect(t, resp)
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package stripe
+
+import (
+	"github.com/BTBurke/recur/backend"
+	"github.com/BTBurke/recur/pb"
+	log "github.com/sirupsen/logrus"
+	"github.com/stripe/stripe-go"
+	context "golang.org/x/net/context"
+)
+
+// withIdempotencyKey returns a context carrying the idempotency key for a write request,
+// generating a new key when the caller did not set one.  Params are built from the context once
+// per call and reused for every retry, so all attempts send the same key and Stripe applies the
+// write at most once.
+func withIdempotencyKey(ctx context.Context) (context.Context, string) {
+	if key, ok := backend.IdempotencyKey(ctx); ok {
+		return ctx, key
+	}
+	key := "recur_" + stripe.NewIdempotencyKey()
+	return backend.WithIdempotencyKey(ctx, key), key
+}
+
+// reportIdempotencyKey adds the idempotency key to an error response and logs it when the
+// request failed without a response from Stripe, so the outcome can be reconciled later.
+func reportIdempotencyKey(logger log.StdLogger, action string, key string, pbErr *pb.Error, err error) {
+	if pbErr != nil {
+		pbErr.IdempotencyKey = key
+	}
+	if err != nil && logger != nil {
+		logger.Printf("%s failed, retry with idempotency key %s to reconcile: %s", action, key, err)
+	}
+}
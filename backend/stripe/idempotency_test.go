@@ -0,0 +1,77 @@
+package stripe
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/BTBurke/recur/backend"
+	"github.com/BTBurke/recur/pb"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stripe/stripe-go"
+	context "golang.org/x/net/context"
+)
+
+// keyRecorder fails the first request with a network error and records the idempotency key sent
+// on every attempt
+type keyRecorder struct {
+	keys []string
+}
+
+func (k *keyRecorder) record(params *stripe.PlanParams) (*stripePlan, error) {
+	k.keys = append(k.keys, params.IdempotencyKey)
+	if len(k.keys) == 1 {
+		return nil, fmt.Errorf("connection reset")
+	}
+	return &stripePlan{Plan: stripe.Plan{ID: params.ID}}, nil
+}
+
+func (k *keyRecorder) New(params *stripe.PlanParams) (*stripePlan, error) {
+	return k.record(params)
+}
+func (k *keyRecorder) Get(id string, params *stripe.PlanParams) (*stripePlan, error) {
+	return k.record(params)
+}
+func (k *keyRecorder) Update(id string, params *stripe.PlanParams) (*stripePlan, error) {
+	return k.record(params)
+}
+func (k *keyRecorder) Del(id string, params *stripe.PlanParams) (*stripe.Plan, error) {
+	p, err := k.record(params)
+	if err != nil {
+		return nil, err
+	}
+	return &p.Plan, nil
+}
+func (k *keyRecorder) List(params *stripe.PlanListParams) *planIter {
+	return nil
+}
+
+func TestIdempotencyKeyReusedAcrossRetries(t *testing.T) {
+	req := &pb.CreatePlanRequest{Id: "test", Name: "test", Currency: pb.Currency_USD, Interval: pb.Interval_Month}
+
+	rec := new(keyRecorder)
+	client := &StripePlanClient{logger: log.New(), api: rec}
+	_, err := client.Create(context.Background(), req)
+	assert.NoError(t, err)
+	if assert.Len(t, rec.keys, 2) {
+		assert.NotEmpty(t, rec.keys[0])
+		assert.Equal(t, rec.keys[0], rec.keys[1])
+	}
+
+	rec = new(keyRecorder)
+	client.api = rec
+	_, err = client.Create(backend.WithIdempotencyKey(context.Background(), "caller-key"), req)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"caller-key", "caller-key"}, rec.keys)
+}
+
+func TestIdempotencyKeyOnError(t *testing.T) {
+	key, done := getAPIKey(t)
+	defer done()
+	client := NewPlanClient(key, log.New())
+	ctx := backend.WithIdempotencyKey(context.Background(), "recur_test_missing")
+
+	resp, err := client.Update(ctx, &pb.UpdatePlanRequest{Id: "test-missing-plan", Name: "missing"})
+	assert.NoError(t, err)
+	assert.Equal(t, "recur_test_missing", resp.GetError().GetIdempotencyKey())
+}
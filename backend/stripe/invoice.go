@@ -0,0 +1,135 @@
+package stripe
+
+import (
+	"github.com/BTBurke/recur/backend"
+	"github.com/BTBurke/recur/pb"
+	"github.com/stripe/stripe-go/invoice"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stripe/stripe-go"
+	context "golang.org/x/net/context"
+)
+
+// interface for the Stripe invoice API
+type invoiceClient interface {
+	Get(id string, params *stripe.InvoiceParams) (*stripeInvoice, error)
+	GetNext(params *stripe.InvoiceParams) (*stripeInvoice, error)
+	Pay(id string, params *stripe.InvoicePayParams) (*stripeInvoice, error)
+	Void(id string, params *stripe.InvoiceParams) (*stripeInvoice, error)
+	MarkUncollectible(id string, params *stripe.InvoiceParams) (*stripeInvoice, error)
+	List(params *stripe.InvoiceListParams) *invoiceIter
+}
+
+type StripeInvoiceClient struct {
+	key    string
+	logger log.StdLogger
+	// api allows mocking the Stripe backend
+	api invoiceClient
+	// policy controls retries of failed requests
+	policy backend.RetryPolicy
+}
+
+func NewInvoiceClient(key string, logger log.StdLogger, opts ...Option) *StripeInvoiceClient {
+	o := newOptions(opts)
+	return &StripeInvoiceClient{
+		key:    key,
+		logger: logger,
+		policy: o.retry,
+		api: invoiceAPI{invoice.Client{
+			B:   stripe.GetBackend(stripe.SupportedBackend("api")),
+			Key: key,
+		}},
+	}
+}
+
+func (i *StripeInvoiceClient) Get(ctx context.Context, req *pb.GetInvoiceRequest) (*pb.InvoiceResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	params := &stripe.InvoiceParams{
+		Params: paramsFromContext(ctx, i.key, nil),
+	}
+	resp := new(pb.InvoiceResponse)
+	err := retry(ctx, i.policy, retryableInvoice(req.Id, params, i.api, resp, invoiceGet))
+	return resp, err
+}
+
+// Upcoming previews the next invoice for a customer, including prorations for any change to
+// the subscription plan or quantity set in the request
+func (i *StripeInvoiceClient) Upcoming(ctx context.Context, req *pb.UpcomingInvoiceRequest) (*pb.InvoiceResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	params := invoiceUpcomingToInvoiceParams(ctx, i.key, req)
+	resp := new(pb.InvoiceResponse)
+	err := retry(ctx, i.policy, retryableInvoice("", params, i.api, resp, invoiceUpcoming))
+	return resp, err
+}
+
+// Pay attempts to collect payment for an open invoice, using the source in the request or the
+// customer's default source
+func (i *StripeInvoiceClient) Pay(ctx context.Context, req *pb.PayInvoiceRequest) (*pb.InvoiceResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	ctx, key := withIdempotencyKey(ctx)
+	params := &stripe.InvoicePayParams{
+		Params: paramsFromContext(ctx, i.key, nil),
+		Source: req.Source,
+	}
+	resp := new(pb.InvoiceResponse)
+	err := retry(ctx, i.policy, retryableInvoicePay(req.Id, params, i.api, resp))
+	reportIdempotencyKey(i.logger, "invoice pay", key, resp.GetError(), err)
+	return resp, err
+}
+
+// Void voids a finalized invoice so that no further payment attempts are made
+func (i *StripeInvoiceClient) Void(ctx context.Context, req *pb.VoidInvoiceRequest) (*pb.InvoiceResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	ctx, key := withIdempotencyKey(ctx)
+	params := &stripe.InvoiceParams{
+		Params: paramsFromContext(ctx, i.key, nil),
+	}
+	resp := new(pb.InvoiceResponse)
+	err := retry(ctx, i.policy, retryableInvoice(req.Id, params, i.api, resp, invoiceVoid))
+	reportIdempotencyKey(i.logger, "invoice void", key, resp.GetError(), err)
+	return resp, err
+}
+
+// MarkUncollectible writes off an invoice that is not expected to be paid
+func (i *StripeInvoiceClient) MarkUncollectible(ctx context.Context, req *pb.MarkUncollectibleInvoiceRequest) (*pb.InvoiceResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	ctx, key := withIdempotencyKey(ctx)
+	params := &stripe.InvoiceParams{
+		Params: paramsFromContext(ctx, i.key, nil),
+	}
+	resp := new(pb.InvoiceResponse)
+	err := retry(ctx, i.policy, retryableInvoice(req.Id, params, i.api, resp, invoiceMarkUncollectible))
+	reportIdempotencyKey(i.logger, "invoice mark uncollectible", key, resp.GetError(), err)
+	return resp, err
+}
+
+// invoiceStreamer implements the InvoiceStreamer interface, converting Stripe responses
+// to an InvoiceResponse
+type invoiceStreamer struct {
+	listIter
+	iter *invoiceIter
+}
+
+func (s *invoiceStreamer) Current() *pb.InvoiceResponse {
+	if e := s.errorResponse(); e != nil {
+		return &pb.InvoiceResponse{Responses: &pb.InvoiceResponse_Error{Error: e}}
+	}
+	return respToInvoiceSuccess(s.iter.Invoice())
+}
+
+func (i *StripeInvoiceClient) List(ctx context.Context, req *pb.ListInvoicesRequest) (backend.InvoiceStreamer, error) {
+	params := invoiceListToListParams(ctx, i.key, req)
+	streamer := &invoiceStreamer{listIter: listIter{ctx: ctx}}
+	err := retry(ctx, i.policy, retryableInvoiceList(params, i.api, streamer))
+	return streamer, err
+}
@@ -0,0 +1,159 @@
+package stripe
+
+import (
+	"encoding/json"
+	"net/url"
+	"strconv"
+
+	"github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/invoice"
+)
+
+// invoiceAPI calls the Stripe invoice API in the same way as invoice.Client, but decodes the
+// invoice status that the vendored stripe-go does not know about.  The vendored stripe-go also
+// predates voiding invoices and marking them uncollectible, so those are sent to their own
+// endpoints.
+type invoiceAPI struct {
+	invoice.Client
+}
+
+func (c invoiceAPI) Get(id string, params *stripe.InvoiceParams) (*stripeInvoice, error) {
+	var body *stripe.RequestValues
+	var commonParams *stripe.Params
+	if params != nil {
+		commonParams = &params.Params
+		body = &stripe.RequestValues{}
+		params.AppendTo(body)
+	}
+
+	inv := &stripeInvoice{}
+	err := c.B.Call("GET", "/invoices/"+url.QueryEscape(id), c.Key, body, commonParams, inv)
+	return inv, err
+}
+
+// GetNext previews the upcoming invoice.  Only the subscription changes that recur can preview
+// are sent.
+func (c invoiceAPI) GetNext(params *stripe.InvoiceParams) (*stripeInvoice, error) {
+	body := &stripe.RequestValues{}
+	body.Add("customer", params.Customer)
+	if len(params.Sub) > 0 {
+		body.Add("subscription", params.Sub)
+	}
+	if len(params.SubPlan) > 0 {
+		body.Add("subscription_plan", params.SubPlan)
+	}
+	if params.SubNoProrate {
+		body.Add("subscription_prorate", strconv.FormatBool(false))
+	}
+	if params.SubProrationDate > 0 {
+		body.Add("subscription_proration_date", strconv.FormatInt(params.SubProrationDate, 10))
+	}
+	if params.SubQuantity > 0 {
+		body.Add("subscription_quantity", strconv.FormatUint(params.SubQuantity, 10))
+	}
+	params.AppendTo(body)
+
+	inv := &stripeInvoice{}
+	err := c.B.Call("GET", "/invoices/upcoming", c.Key, body, &params.Params, inv)
+	return inv, err
+}
+
+func (c invoiceAPI) Pay(id string, params *stripe.InvoicePayParams) (*stripeInvoice, error) {
+	body := &stripe.RequestValues{}
+	if len(params.Source) > 0 {
+		body.Add("source", params.Source)
+	}
+	params.AppendTo(body)
+
+	inv := &stripeInvoice{}
+	err := c.B.Call("POST", "/invoices/"+url.QueryEscape(id)+"/pay", c.Key, body, &params.Params, inv)
+	return inv, err
+}
+
+func (c invoiceAPI) Void(id string, params *stripe.InvoiceParams) (*stripeInvoice, error) {
+	return c.action(id, "void", params)
+}
+
+func (c invoiceAPI) MarkUncollectible(id string, params *stripe.InvoiceParams) (*stripeInvoice, error) {
+	return c.action(id, "mark_uncollectible", params)
+}
+
+func (c invoiceAPI) action(id string, action string, params *stripe.InvoiceParams) (*stripeInvoice, error) {
+	body := &stripe.RequestValues{}
+	params.AppendTo(body)
+
+	inv := &stripeInvoice{}
+	err := c.B.Call("POST", "/invoices/"+url.QueryEscape(id)+"/"+action, c.Key, body, &params.Params, inv)
+	return inv, err
+}
+
+// List lists invoices.  The status filter is sent as a list filter on the params.
+func (c invoiceAPI) List(params *stripe.InvoiceListParams) *invoiceIter {
+	var body *stripe.RequestValues
+	var lp *stripe.ListParams
+	var p *stripe.Params
+	if params != nil {
+		body = &stripe.RequestValues{}
+		if len(params.Customer) > 0 {
+			body.Add("customer", params.Customer)
+		}
+		if len(params.Sub) > 0 {
+			body.Add("subscription", params.Sub)
+		}
+		if params.DateRange != nil {
+			params.DateRange.AppendTo(body, "date")
+		}
+		params.AppendTo(body)
+		lp = &params.ListParams
+		p = params.ToParams()
+	}
+
+	return &invoiceIter{stripe.GetIter(lp, body, func(b *stripe.RequestValues) ([]interface{}, stripe.ListMeta, error) {
+		list := &stripeInvoiceList{}
+		err := c.B.Call("GET", "/invoices", c.Key, b, p, list)
+
+		ret := make([]interface{}, len(list.Values))
+		for i, v := range list.Values {
+			ret[i] = v
+		}
+		return ret, list.ListMeta, err
+	})}
+}
+
+// stripeInvoice is an invoice as returned by Stripe, with the status that the vendored
+// stripe-go does not know about
+type stripeInvoice struct {
+	stripe.Invoice
+	Status string `json:"status"`
+}
+
+// UnmarshalJSON decodes the status separately, as stripe.Invoice has its own UnmarshalJSON that
+// would otherwise hide it
+func (i *stripeInvoice) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &i.Invoice); err != nil {
+		return err
+	}
+	var status struct {
+		Status string `json:"status"`
+	}
+	if err := json.Unmarshal(data, &status); err != nil {
+		return err
+	}
+	i.Status = status.Status
+	return nil
+}
+
+type stripeInvoiceList struct {
+	stripe.ListMeta
+	Values []*stripeInvoice `json:"data"`
+}
+
+// invoiceIter is an iterator for lists of invoices
+type invoiceIter struct {
+	*stripe.Iter
+}
+
+// Invoice returns the invoice at the current position of the iterator
+func (i *invoiceIter) Invoice() *stripeInvoice {
+	return i.Current().(*stripeInvoice)
+}
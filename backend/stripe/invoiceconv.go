@@ -0,0 +1,190 @@
+package stripe
+
+import (
+	"github.com/BTBurke/recur/pb"
+	"github.com/stripe/stripe-go"
+	context "golang.org/x/net/context"
+)
+
+// convert from an upcoming invoice request to InvoiceParams
+func invoiceUpcomingToInvoiceParams(ctx context.Context, key string, req *pb.UpcomingInvoiceRequest) *stripe.InvoiceParams {
+	return &stripe.InvoiceParams{
+		Params:           paramsFromContext(ctx, key, nil),
+		Customer:         req.Customer,
+		Sub:              req.Subscription,
+		SubPlan:          req.Plan,
+		SubQuantity:      req.Quantity,
+		SubNoProrate:     req.NoProrate,
+		SubProrationDate: req.ProrationDate,
+	}
+}
+
+func invoiceListToListParams(ctx context.Context, key string, req *pb.ListInvoicesRequest) *stripe.InvoiceListParams {
+	switch {
+	case req == nil:
+		return &stripe.InvoiceListParams{
+			ListParams: stripe.ListParams{
+				Limit: 10,
+			},
+		}
+	default:
+		params := &stripe.InvoiceListParams{
+			ListParams: stripe.ListParams{
+				Start: req.StartingAfter,
+				End:   req.EndingBefore,
+				Limit: defaultInt(int(req.Limit), 10),
+			},
+			DateRange: &stripe.RangeQueryParams{
+				GreaterThan:        req.GetCreated().GetGt(),
+				GreaterThanOrEqual: req.GetCreated().GetGte(),
+				LesserThan:         req.GetCreated().GetLt(),
+				LesserThanOrEqual:  req.GetCreated().GetLte(),
+			},
+			Customer: req.Customer,
+			Sub:      req.Subscription,
+		}
+		if status, ok := pbToStripeInvoiceStatus[req.Status]; ok {
+			params.Filters.AddFilter("status", "", status)
+		}
+		return params
+	}
+}
+
+// convert a success response from Stripe to an InvoiceResponse (success)
+func respToInvoiceSuccess(inv *stripeInvoice) *pb.InvoiceResponse {
+	return &pb.InvoiceResponse{
+		Responses: &pb.InvoiceResponse_Success{
+			Success: stripeToPbInvoice(inv),
+		},
+	}
+}
+
+// convert a Stripe invoice to a pb.Invoice
+func stripeToPbInvoice(inv *stripeInvoice) *pb.Invoice {
+	var customer, charge string
+	if inv.Customer != nil {
+		customer = inv.Customer.ID
+	}
+	if inv.Charge != nil {
+		charge = inv.Charge.ID
+	}
+	var lines []*pb.InvoiceLineItem
+	var linesHasMore bool
+	if inv.Lines != nil {
+		for _, line := range inv.Lines.Values {
+			lines = append(lines, stripeToPbInvoiceLine(line))
+		}
+		linesHasMore = inv.Lines.More
+	}
+	return &pb.Invoice{
+		Id:                  inv.ID,
+		Customer:            customer,
+		Subscription:        inv.Sub,
+		Status:              stripeToPbInvoiceStatus(inv),
+		AmountDue:           inv.Amount,
+		Subtotal:            inv.Subtotal,
+		Tax:                 inv.Tax,
+		TaxPercent:          inv.TaxPercent,
+		Total:               inv.Total,
+		Currency:            stripeToPbCurrency(inv.Currency),
+		StartingBalance:     inv.StartBalance,
+		EndingBalance:       inv.EndBalance,
+		AttemptCount:        inv.Attempts,
+		Attempted:           inv.Attempted,
+		Paid:                inv.Paid,
+		Closed:              inv.Closed,
+		Forgiven:            inv.Forgive,
+		Date:                inv.Date,
+		DueDate:             inv.DueDate,
+		PeriodStart:         inv.Start,
+		PeriodEnd:           inv.End,
+		NextPaymentAttempt:  inv.NextAttempt,
+		Description:         inv.Desc,
+		StatementDescriptor: inv.Statement,
+		ReceiptNumber:       inv.ReceiptNumber,
+		Number:              inv.Number,
+		Charge:              charge,
+		Lines:               lines,
+		LinesHasMore:        linesHasMore,
+		Metadata:            inv.Meta,
+		Livemode:            inv.Live,
+	}
+}
+
+// convert a Stripe invoice line to a pb.InvoiceLineItem
+func stripeToPbInvoiceLine(line *stripe.InvoiceLine) *pb.InvoiceLineItem {
+	item := &pb.InvoiceLineItem{
+		Id:           line.ID,
+		Type:         stripeToPbLineType(line.Type),
+		Amount:       line.Amount,
+		Currency:     stripeToPbCurrency(line.Currency),
+		Description:  line.Desc,
+		Discountable: line.Discountable,
+		Proration:    line.Proration,
+		Quantity:     line.Quantity,
+		Subscription: line.Sub,
+		Metadata:     line.Meta,
+	}
+	if line.Period != nil {
+		item.PeriodStart = line.Period.Start
+		item.PeriodEnd = line.Period.End
+	}
+	if line.Plan != nil {
+		item.Plan = stripeToPbPlan(&stripePlan{Plan: *line.Plan})
+	}
+	return item
+}
+
+// convert an error response from Stripe to an InvoiceResponse (error)
+func respToInvoiceError(err *stripe.Error) *pb.InvoiceResponse {
+	return &pb.InvoiceResponse{
+		Responses: &pb.InvoiceResponse_Error{
+			Error: respToError(err),
+		},
+	}
+}
+
+// pbToStripeInvoiceStatus maps invoice statuses to the Stripe invoice status
+var pbToStripeInvoiceStatus = map[pb.InvoiceStatus]string{
+	pb.InvoiceStatus_Draft:         "draft",
+	pb.InvoiceStatus_Open:          "open",
+	pb.InvoiceStatus_Paid:          "paid",
+	pb.InvoiceStatus_Uncollectible: "uncollectible",
+	pb.InvoiceStatus_Void:          "void",
+}
+
+// stripeToPbInvoiceStatus converts the Stripe invoice status.  When Stripe does not send a
+// status, a paid invoice is paid, a forgiven invoice is uncollectible, an upcoming invoice has no
+// id and is a draft, and any other invoice is open.  A closed invoice is still open, as closed
+// only means that Stripe stopped trying to collect payment.
+func stripeToPbInvoiceStatus(inv *stripeInvoice) pb.InvoiceStatus {
+	lookup := map[string]pb.InvoiceStatus{
+		"draft":         pb.InvoiceStatus_Draft,
+		"open":          pb.InvoiceStatus_Open,
+		"paid":          pb.InvoiceStatus_Paid,
+		"uncollectible": pb.InvoiceStatus_Uncollectible,
+		"void":          pb.InvoiceStatus_Void,
+	}
+	if status, ok := lookup[inv.Status]; ok {
+		return status
+	}
+	switch {
+	case inv.Paid:
+		return pb.InvoiceStatus_Paid
+	case inv.Forgive:
+		return pb.InvoiceStatus_Uncollectible
+	case len(inv.ID) == 0:
+		return pb.InvoiceStatus_Draft
+	default:
+		return pb.InvoiceStatus_Open
+	}
+}
+
+// constant conversions from stripe to protobuf - invoice line type
+func stripeToPbLineType(t stripe.InvoiceLineType) pb.InvoiceLineType {
+	lookup := map[stripe.InvoiceLineType]pb.InvoiceLineType{
+		"invoiceitem":  pb.InvoiceLineType_InvoiceItemLine,
+		"subscription": pb.InvoiceLineType_SubscriptionLine,
+	}
+	return lookup[t]
+}
@@ -0,0 +1,66 @@
+package stripe
+
+import (
+	"github.com/BTBurke/recur/pb"
+	"github.com/cenkalti/backoff"
+	"github.com/stripe/stripe-go"
+)
+
+type invoiceAction int
+
+const (
+	invoiceGet invoiceAction = iota
+	invoiceUpcoming
+	invoiceVoid
+	invoiceMarkUncollectible
+)
+
+func retryableInvoice(id string, params *stripe.InvoiceParams, api invoiceClient, i *pb.InvoiceResponse, action invoiceAction) backoff.Operation {
+	return func() error {
+		var inv = new(stripeInvoice)
+		var err error
+		switch action {
+		case invoiceGet:
+			inv, err = api.Get(id, params)
+		case invoiceUpcoming:
+			inv, err = api.GetNext(params)
+		case invoiceVoid:
+			inv, err = api.Void(id, params)
+		case invoiceMarkUncollectible:
+			inv, err = api.MarkUncollectible(id, params)
+		default:
+		}
+		if err != nil {
+			if stripeErr, ok := err.(*stripe.Error); ok {
+				*i = *respToInvoiceError(stripeErr)
+			}
+			return classify(err)
+		}
+		*i = *respToInvoiceSuccess(inv)
+		return nil
+	}
+}
+
+func retryableInvoicePay(id string, params *stripe.InvoicePayParams, api invoiceClient, i *pb.InvoiceResponse) backoff.Operation {
+	return func() error {
+		inv, err := api.Pay(id, params)
+		if err != nil {
+			if stripeErr, ok := err.(*stripe.Error); ok {
+				*i = *respToInvoiceError(stripeErr)
+			}
+			return classify(err)
+		}
+		*i = *respToInvoiceSuccess(inv)
+		return nil
+	}
+}
+
+func retryableInvoiceList(params *stripe.InvoiceListParams, api invoiceClient, i *invoiceStreamer) backoff.Operation {
+	return func() error {
+		i.iter = api.List(params)
+		if i.iter != nil {
+			i.pages = i.iter
+		}
+		return nil
+	}
+}
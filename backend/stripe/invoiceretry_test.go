@@ -0,0 +1,136 @@
+package stripe
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	context "golang.org/x/net/context"
+
+	"github.com/BTBurke/recur/pb"
+	"github.com/cenkalti/backoff"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stripe/stripe-go"
+)
+
+type mockInvoice struct {
+	mock.Mock
+}
+
+func (m *mockInvoice) Get(id string, params *stripe.InvoiceParams) (*stripeInvoice, error) {
+	args := m.Called(id)
+	return args.Get(0).(*stripeInvoice), args.Error(1)
+}
+
+func (m *mockInvoice) GetNext(params *stripe.InvoiceParams) (*stripeInvoice, error) {
+	args := m.Called(params.Customer)
+	return args.Get(0).(*stripeInvoice), args.Error(1)
+}
+
+func (m *mockInvoice) Pay(id string, params *stripe.InvoicePayParams) (*stripeInvoice, error) {
+	args := m.Called(id)
+	return args.Get(0).(*stripeInvoice), args.Error(1)
+}
+
+func (m *mockInvoice) Void(id string, params *stripe.InvoiceParams) (*stripeInvoice, error) {
+	args := m.Called(id)
+	return args.Get(0).(*stripeInvoice), args.Error(1)
+}
+
+func (m *mockInvoice) MarkUncollectible(id string, params *stripe.InvoiceParams) (*stripeInvoice, error) {
+	args := m.Called(id)
+	return args.Get(0).(*stripeInvoice), args.Error(1)
+}
+
+func (m *mockInvoice) List(params *stripe.InvoiceListParams) *invoiceIter {
+	args := m.Called(params)
+	return args.Get(0).(*invoiceIter)
+}
+
+func TestRetryableInvoice(t *testing.T) {
+	open := &stripeInvoice{Invoice: stripe.Invoice{ID: "in_test", Customer: &stripe.Customer{ID: "cus_test"}, Amount: 1000, Currency: "usd"}, Status: "open"}
+	void := *open
+	void.Status = "void"
+	uncollectible := *open
+	uncollectible.Status = "uncollectible"
+
+	tt := []struct {
+		Name   string
+		Action invoiceAction
+		Params *stripe.InvoiceParams
+		Setup  func(m *mockInvoice)
+		Expect pb.InvoiceStatus
+	}{
+		{Name: "get", Action: invoiceGet, Params: &stripe.InvoiceParams{}, Expect: pb.InvoiceStatus_Open, Setup: func(m *mockInvoice) {
+			m.On("Get", "in_test").Return(open, nil)
+		}},
+		{Name: "void", Action: invoiceVoid, Params: &stripe.InvoiceParams{}, Expect: pb.InvoiceStatus_Void, Setup: func(m *mockInvoice) {
+			m.On("Void", "in_test").Return(&void, nil)
+		}},
+		{Name: "uncollectible", Action: invoiceMarkUncollectible, Params: &stripe.InvoiceParams{}, Expect: pb.InvoiceStatus_Uncollectible, Setup: func(m *mockInvoice) {
+			m.On("MarkUncollectible", "in_test").Return(&uncollectible, nil)
+		}},
+		{Name: "retry on network error", Action: invoiceGet, Params: &stripe.InvoiceParams{}, Expect: pb.InvoiceStatus_Open, Setup: func(m *mockInvoice) {
+			m.On("Get", "in_test").Return((*stripeInvoice)(nil), fmt.Errorf("test retry")).Once()
+			m.On("Get", "in_test").Return(open, nil).Once()
+		}},
+	}
+	for _, tc := range tt {
+		t.Run(tc.Name, func(t *testing.T) {
+			mck := new(mockInvoice)
+			tc.Setup(mck)
+			resp := new(pb.InvoiceResponse)
+			err := backoff.Retry(
+				retryableInvoice("in_test", tc.Params, mck, resp, tc.Action),
+				backoff.WithContext(backoff.NewExponentialBackOff(), context.Background()),
+			)
+			assert.NoError(t, err)
+			mck.AssertExpectations(t)
+			assert.Equal(t, "in_test", resp.GetSuccess().GetId())
+			assert.Equal(t, "cus_test", resp.GetSuccess().GetCustomer())
+			assert.Equal(t, tc.Expect, resp.GetSuccess().GetStatus())
+		})
+	}
+}
+
+func TestInvoiceStatus(t *testing.T) {
+	tt := []struct {
+		JSON   string
+		Expect pb.InvoiceStatus
+	}{
+		{`{"id": "in_test", "status": "draft"}`, pb.InvoiceStatus_Draft},
+		{`{"id": "in_test", "status": "void", "closed": true}`, pb.InvoiceStatus_Void},
+		{`{"id": "in_test", "status": "uncollectible"}`, pb.InvoiceStatus_Uncollectible},
+		{`{"id": "in_test", "attempted": false}`, pb.InvoiceStatus_Open},
+		{`{"id": "in_test", "attempted": true, "closed": true}`, pb.InvoiceStatus_Open},
+		{`{"id": "in_test", "closed": true, "forgiven": true}`, pb.InvoiceStatus_Uncollectible},
+		{`{"id": "in_test", "paid": true}`, pb.InvoiceStatus_Paid},
+		{`{"customer": "cus_test"}`, pb.InvoiceStatus_Draft},
+	}
+	for _, tc := range tt {
+		var inv stripeInvoice
+		assert.NoError(t, json.Unmarshal([]byte(tc.JSON), &inv))
+		assert.Equal(t, tc.Expect, stripeToPbInvoiceStatus(&inv), tc.JSON)
+	}
+}
+
+func TestInvoiceListStatus(t *testing.T) {
+	params := invoiceListToListParams(context.Background(), "sk_test", &pb.ListInvoicesRequest{Status: pb.InvoiceStatus_Void})
+	body := &stripe.RequestValues{}
+	params.AppendTo(body)
+	assert.Equal(t, []string{"void"}, body.Get("status"))
+}
+
+func TestRetryableInvoicePayCardError(t *testing.T) {
+	mck := new(mockInvoice)
+	mck.On("Pay", "in_test").Return((*stripeInvoice)(nil), &stripe.Error{Type: stripe.ErrorTypeCard, HTTPStatusCode: 402, Msg: "declined"}).Once()
+	resp := new(pb.InvoiceResponse)
+	err := backoff.Retry(
+		retryableInvoicePay("in_test", &stripe.InvoicePayParams{}, mck, resp),
+		backoff.WithContext(backoff.NewExponentialBackOff(), context.Background()),
+	)
+	assert.Error(t, err)
+	mck.AssertExpectations(t)
+	assert.True(t, pb.IsCardError(resp.GetError()))
+}
@@ -0,0 +1,67 @@
+package stripe
+
+import (
+	"github.com/BTBurke/recur/pb"
+	"github.com/stripe/stripe-go"
+	context "golang.org/x/net/context"
+)
+
+// pageIterator is the part of the Stripe list iterators used to page through results
+type pageIterator interface {
+	Next() bool
+	Err() error
+}
+
+// listIter holds the iteration state shared by the list streamers.  Iteration stops at the first
+// error, when the context is done, or when the stream is closed.  The Stripe iterator fetches
+// the next page from within Next, so the context is checked before each call.
+type listIter struct {
+	ctx    context.Context
+	pages  pageIterator
+	err    error
+	closed bool
+}
+
+func (l *listIter) Next() bool {
+	if l.closed || l.err != nil || l.pages == nil {
+		return false
+	}
+	if err := l.ctx.Err(); err != nil {
+		l.err = err
+		return false
+	}
+	if l.pages.Next() {
+		return true
+	}
+	l.err = l.pages.Err()
+	return false
+}
+
+// Err returns the error that stopped iteration, or nil if the list was exhausted.  Errors from
+// the Stripe API are returned as a *pb.Error.
+func (l *listIter) Err() error {
+	if stripeErr, ok := l.err.(*stripe.Error); ok {
+		return respToError(stripeErr)
+	}
+	return l.err
+}
+
+// Close stops iteration.  No further pages are fetched.
+func (l *listIter) Close() {
+	l.closed = true
+}
+
+// errorResponse converts the iteration error to a pb.Error, or returns nil if there is no error
+func (l *listIter) errorResponse() *pb.Error {
+	switch err := l.err.(type) {
+	case nil:
+		return nil
+	case *stripe.Error:
+		return respToError(err)
+	default:
+		return &pb.Error{
+			Type:    pb.ErrorType_Unknown,
+			Message: err.Error(),
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package stripe
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/BTBurke/recur/pb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stripe/stripe-go"
+	context "golang.org/x/net/context"
+)
+
+// fakePages returns n items and then stops with err
+type fakePages struct {
+	n     int
+	err   error
+	calls int
+}
+
+func (f *fakePages) Next() bool {
+	f.calls++
+	return f.calls <= f.n
+}
+
+func (f *fakePages) Err() error {
+	if f.calls > f.n {
+		return f.err
+	}
+	return nil
+}
+
+func TestPlanStreamerErrors(t *testing.T) {
+	stripeErr := &stripe.Error{Type: stripe.ErrorTypeRateLimit, HTTPStatusCode: 429, Msg: "slow down"}
+	netErr := fmt.Errorf("connection reset")
+
+	tt := []struct {
+		Name      string
+		Pages     *fakePages
+		Cancel    bool
+		Items     int
+		ErrorType pb.ErrorType
+		Err       error
+	}{
+		{Name: "exhausted", Pages: &fakePages{n: 0}},
+		{Name: "stripe error", Pages: &fakePages{n: 0, err: stripeErr}, ErrorType: pb.ErrorType_RateLimit},
+		{Name: "network error", Pages: &fakePages{n: 0, err: netErr}, Err: netErr, ErrorType: pb.ErrorType_Unknown},
+		{Name: "context canceled", Pages: &fakePages{n: 5}, Cancel: true, Items: 1, Err: context.Canceled, ErrorType: pb.ErrorType_Unknown},
+	}
+	for _, tc := range tt {
+		t.Run(tc.Name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			s := &planStreamer{listIter: listIter{ctx: ctx, pages: tc.Pages}}
+			var items int
+			for s.Next() {
+				items++
+				if tc.Cancel {
+					cancel()
+				}
+			}
+			assert.Equal(t, tc.Items, items)
+			switch {
+			case tc.Err != nil:
+				assert.Equal(t, tc.Err, s.Err())
+			case tc.Pages.err != nil:
+				assert.True(t, pb.IsRateLimited(s.Err()))
+			default:
+				assert.NoError(t, s.Err())
+				return
+			}
+			assert.Equal(t, tc.ErrorType, s.Current().GetError().GetType())
+		})
+	}
+}
+
+func TestPlanStreamerClose(t *testing.T) {
+	pages := &fakePages{n: 5}
+	s := &planStreamer{listIter: listIter{ctx: context.Background(), pages: pages}}
+	assert.True(t, s.Next())
+	s.Close()
+	assert.False(t, s.Next())
+	assert.Equal(t, 1, pages.calls)
+	assert.NoError(t, s.Err())
+}
@@ -1,6 +1,9 @@
 package stripe
 
 import (
+	"net/http"
+
+	"github.com/BTBurke/recur/backend"
 	"github.com/stripe/stripe-go"
 	context "golang.org/x/net/context"
 )
@@ -12,19 +15,17 @@ func paramsFromContext(ctx context.Context, key string, meta *map[string]string)
 			p.AddMeta(k, v)
 		}
 	}
-	headers, ok := ctx.Value("headers").(map[string]string)
-	if ok {
+	if headers, ok := backend.Headers(ctx); ok {
+		p.Headers = make(http.Header)
 		for k, v := range headers {
 			p.Headers.Add(k, v)
 		}
 	}
-	idempotencyKey, ok := ctx.Value("idempotency").(string)
-	if ok {
-		p.IdempotencyKey = idempotencyKey
+	if key, ok := backend.IdempotencyKey(ctx); ok {
+		p.IdempotencyKey = key
 	}
-	stripeAccount, ok := ctx.Value("connectkey").(string)
-	if ok {
-		p.SetStripeAccount(stripeAccount)
+	if acct, ok := backend.StripeAccount(ctx); ok {
+		p.SetStripeAccount(acct)
 	}
 
 	return p
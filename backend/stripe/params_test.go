@@ -0,0 +1,27 @@
+package stripe
+
+import (
+	"testing"
+
+	"github.com/BTBurke/recur/backend"
+	"github.com/stretchr/testify/assert"
+	context "golang.org/x/net/context"
+)
+
+func TestParamsFromContext(t *testing.T) {
+	ctx := backend.WithIdempotencyKey(context.Background(), "key1")
+	ctx = backend.WithStripeAccount(ctx, "acct_1")
+	ctx = backend.WithHeaders(ctx, map[string]string{"Stripe-Version": "2017-08-15"})
+	ctx = context.WithValue(ctx, "idempotency", "ignored")
+
+	p := paramsFromContext(ctx, "", &map[string]string{"a": "b"})
+	assert.Equal(t, "key1", p.IdempotencyKey)
+	assert.Equal(t, "acct_1", p.StripeAccount)
+	assert.Equal(t, "2017-08-15", p.Headers.Get("Stripe-Version"))
+	assert.Equal(t, "b", p.Meta["a"])
+
+	p = paramsFromContext(context.Background(), "", nil)
+	assert.Empty(t, p.IdempotencyKey)
+	assert.Empty(t, p.StripeAccount)
+	assert.Nil(t, p.Headers)
+}
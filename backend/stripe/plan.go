@@ -3,7 +3,6 @@ package stripe
 import (
 	"github.com/BTBurke/recur/backend"
 	"github.com/BTBurke/recur/pb"
-	"github.com/cenkalti/backoff"
 	"github.com/stripe/stripe-go/plan"
 
 	log "github.com/sirupsen/logrus"
@@ -13,11 +12,11 @@ import (
 
 // interface for the Stripe plan API
 type planClient interface {
-	New(params *stripe.PlanParams) (*stripe.Plan, error)
-	Get(id string, params *stripe.PlanParams) (*stripe.Plan, error)
-	Update(id string, params *stripe.PlanParams) (*stripe.Plan, error)
+	New(params *stripe.PlanParams) (*stripePlan, error)
+	Get(id string, params *stripe.PlanParams) (*stripePlan, error)
+	Update(id string, params *stripe.PlanParams) (*stripePlan, error)
 	Del(id string, params *stripe.PlanParams) (*stripe.Plan, error)
-	List(params *stripe.PlanListParams) *plan.Iter
+	List(params *stripe.PlanListParams) *planIter
 }
 
 type StripePlanClient struct {
@@ -26,16 +25,20 @@ type StripePlanClient struct {
 
 	// api allows mocking the Stripe backend
 	api planClient
+	// policy controls retries of failed requests
+	policy backend.RetryPolicy
 }
 
-func NewPlanClient(key string, logger log.StdLogger) *StripePlanClient {
+func NewPlanClient(key string, logger log.StdLogger, opts ...Option) *StripePlanClient {
+	o := newOptions(opts)
 	return &StripePlanClient{
 		key:    key,
 		logger: logger,
-		api: plan.Client{
+		policy: o.retry,
+		api: planAPI{plan.Client{
 			B:   stripe.GetBackend(stripe.SupportedBackend("api")),
 			Key: key,
-		},
+		}},
 	}
 }
 
@@ -43,14 +46,13 @@ func (p *StripePlanClient) Create(ctx context.Context, req *pb.CreatePlanRequest
 	if err := req.Validate(); err != nil {
 		return nil, err
 	}
+	ctx, key := withIdempotencyKey(ctx)
 	planParams := planCreateToPlanParams(ctx, p.key, req)
 
 	resp := new(pb.PlanResponse)
-	err := backoff.Retry(
-		retryablePlan(planParams, p.api, resp, planCreate),
-		backoff.WithContext(backoff.NewExponentialBackOff(), ctx),
-	)
+	err := retry(ctx, p.policy, retryablePlan(planParams, p.api, resp, planCreate))
 
+	reportIdempotencyKey(p.logger, "plan create", key, resp.GetError(), err)
 	return resp, err
 }
 
@@ -58,14 +60,25 @@ func (p *StripePlanClient) Update(ctx context.Context, req *pb.UpdatePlanRequest
 	if err := req.Validate(); err != nil {
 		return nil, err
 	}
-	params := planUpdateToPlanParams(ctx, p.key, req)
+	getCtx := ctx
+	ctx, key := withIdempotencyKey(ctx)
+	// a rename depends on whether the plan belongs to a product, so the plan is fetched once
+	// before the update is sent
+	var product string
+	if len(req.Name) > 0 {
+		cur, err := p.Get(getCtx, &pb.GetPlanRequest{Id: req.Id})
+		if err != nil || cur.GetError() != nil {
+			reportIdempotencyKey(p.logger, "plan update", key, cur.GetError(), err)
+			return cur, err
+		}
+		product = cur.GetSuccess().GetProduct()
+	}
+	params := planUpdateToPlanParams(ctx, p.key, req, product)
 
 	resp := new(pb.PlanResponse)
-	err := backoff.Retry(
-		retryablePlan(params, p.api, resp, planUpdate),
-		backoff.WithContext(backoff.NewExponentialBackOff(), ctx),
-	)
+	err := retry(ctx, p.policy, retryablePlan(params, p.api, resp, planUpdate))
 
+	reportIdempotencyKey(p.logger, "plan update", key, resp.GetError(), err)
 	return resp, err
 }
 
@@ -73,15 +86,14 @@ func (p *StripePlanClient) Delete(ctx context.Context, req *pb.DeletePlanRequest
 	if err := req.Validate(); err != nil {
 		return nil, err
 	}
+	ctx, key := withIdempotencyKey(ctx)
 
 	params := planDeleteToPlanParams(ctx, p.key, req)
 
 	resp := new(pb.DeletePlanResponse)
-	err := backoff.Retry(
-		retryablePlanDelete(params, p.api, resp, planDelete),
-		backoff.WithContext(backoff.NewExponentialBackOff(), ctx),
-	)
+	err := retry(ctx, p.policy, retryablePlanDelete(params, p.api, resp, planDelete))
 
+	reportIdempotencyKey(p.logger, "plan delete", key, resp.GetError(), err)
 	return resp, err
 }
 
@@ -93,10 +105,7 @@ func (p *StripePlanClient) Get(ctx context.Context, req *pb.GetPlanRequest) (*pb
 	params := planGetToPlanParams(ctx, p.key, req)
 
 	resp := new(pb.PlanResponse)
-	err := backoff.Retry(
-		retryablePlan(params, p.api, resp, planGet),
-		backoff.WithContext(backoff.NewExponentialBackOff(), ctx),
-	)
+	err := retry(ctx, p.policy, retryablePlan(params, p.api, resp, planGet))
 
 	return resp, err
 }
@@ -104,31 +113,23 @@ func (p *StripePlanClient) Get(ctx context.Context, req *pb.GetPlanRequest) (*pb
 // planStreamer implements the PlanStreamer interface, converting Stripe responses
 // to a PlanResponse.
 type planStreamer struct {
-	iter *plan.Iter
-}
-
-func (s *planStreamer) Next() bool {
-	return s.iter.Next()
+	listIter
+	iter *planIter
 }
 
 func (s *planStreamer) Current() *pb.PlanResponse {
-	switch {
-	case s.iter.Err() != nil:
-		return respToPlanError(s.iter.Err().(*stripe.Error))
-	default:
-		return respToPlanSuccess(s.iter.Plan())
+	if e := s.errorResponse(); e != nil {
+		return &pb.PlanResponse{Responses: &pb.PlanResponse_Error{Error: e}}
 	}
+	return respToPlanSuccess(s.iter.Plan())
 }
 
 func (p *StripePlanClient) List(ctx context.Context, req *pb.ListPlansRequest) (backend.PlanStreamer, error) {
 
 	params := planListToListParams(ctx, p.key, req)
 
-	streamer := new(planStreamer)
-	err := backoff.Retry(
-		retryablePlanList(params, p.api, streamer),
-		backoff.WithContext(backoff.NewExponentialBackOff(), ctx),
-	)
+	streamer := &planStreamer{listIter: listIter{ctx: ctx}}
+	err := retry(ctx, p.policy, retryablePlanList(params, p.api, streamer))
 
 	return streamer, err
 }
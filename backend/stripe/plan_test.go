@@ -1,10 +1,12 @@
 package stripe
 
 import (
+	"net/url"
 	"os"
 	"strings"
 	"testing"
 
+	"github.com/BTBurke/recur/backend/stripe/stripetest"
 	"github.com/BTBurke/recur/pb"
 	"github.com/stretchr/testify/assert"
 	context "golang.org/x/net/context"
@@ -12,18 +14,26 @@ import (
 	log "github.com/sirupsen/logrus"
 )
 
-func getAPIKeyOrSkip(t *testing.T) string {
+// getAPIKey returns the Stripe testing key from env STRIPE_KEY.  When it is not set, the global
+// backend is pointed at a local fake Stripe server instead.  Call the returned function when
+// finished to restore the backend.
+func getAPIKey(t *testing.T) (string, func()) {
 	key := os.Getenv("STRIPE_KEY")
-	if len(key) == 0 || !strings.Contains(key, "sk_test") {
-		t.Skipf("No valid stripe testing key found, skipping Stripe integration tests. Set env STRIPE_KEY to run tests.")
-		return ""
+	if len(key) > 0 && strings.Contains(key, "sk_test") {
+		return key, func() {}
+	}
+	t.Log("No valid stripe testing key found, running integration tests against a fake server. Set env STRIPE_KEY to test against Stripe.")
+	srv := stripetest.NewServer()
+	restore := srv.Install()
+	return stripetest.Key, func() {
+		restore()
+		srv.Close()
 	}
-
-	return key
 }
 
 func TestPlanIntegration(t *testing.T) {
-	key := getAPIKeyOrSkip(t)
+	key, done := getAPIKey(t)
+	defer done()
 	client := NewPlanClient(key, log.New())
 
 	if err := deleteAllExistingPlans(client); err != nil {
@@ -123,6 +133,88 @@ func TestPlanIntegration(t *testing.T) {
 	}
 }
 
+func TestPricedPlanIntegration(t *testing.T) {
+	key, done := getAPIKey(t)
+	defer done()
+	client := NewPlanClient(key, log.New())
+	ctx := context.Background()
+
+	if err := deleteAllExistingPlans(client); err != nil {
+		t.Fatalf("Failed to delete existing plans before starting integration test: %s", err)
+	}
+
+	reqs := []*pb.CreatePlanRequest{{
+		Id:            "test-plan-tiered",
+		Currency:      pb.Currency_USD,
+		Name:          "tiered",
+		Interval:      pb.Interval_Month,
+		IntervalCount: 1,
+		BillingScheme: pb.BillingScheme_Tiered,
+		TiersMode:     pb.TiersMode_Graduated,
+		Tiers:         []*pb.PlanTier{{UpTo: 10, UnitAmount: 1000, FlatAmount: 500}, {UnitAmount: 800}},
+	}, {
+		Id:             "test-plan-metered",
+		Amount:         100,
+		Currency:       pb.Currency_USD,
+		Name:           "metered",
+		Interval:       pb.Interval_Month,
+		IntervalCount:  1,
+		UsageType:      pb.UsageType_Metered,
+		AggregateUsage: pb.AggregateUsage_LastDuringPeriod,
+	}}
+	for _, req := range reqs {
+		resp, err := client.Create(ctx, req)
+		if !assert.NoError(t, err) || !assert.Nil(t, resp.GetError()) {
+			continue
+		}
+		got := resp.GetSuccess()
+		assert.Equal(t, req.Amount, got.Amount, "amount")
+		assert.Equal(t, req.BillingScheme, got.BillingScheme, "billing scheme")
+		assert.Equal(t, req.Tiers, got.Tiers, "tiers")
+		assert.Equal(t, req.TiersMode, got.TiersMode, "tiers mode")
+		assert.Equal(t, req.UsageType, got.UsageType, "usage type")
+		assert.Equal(t, req.AggregateUsage, got.AggregateUsage, "aggregate usage")
+
+		get, err := client.Get(ctx, &pb.GetPlanRequest{Id: req.Id})
+		assert.NoError(t, err)
+		assert.Equal(t, got, get.GetSuccess())
+	}
+
+	plans, err := client.List(ctx, nil)
+	if assert.NoError(t, err) {
+		for plans.Next() {
+			if p := plans.Current().GetSuccess(); p.Id == "test-plan-tiered" {
+				assert.Len(t, p.Tiers, 2, "listed plans include their tiers")
+			}
+		}
+		assert.NoError(t, plans.Err())
+	}
+}
+
+func TestPlanPricingParams(t *testing.T) {
+	params := planCreateToPlanParams(context.Background(), "key", &pb.CreatePlanRequest{
+		BillingScheme:  pb.BillingScheme_Tiered,
+		TiersMode:      pb.TiersMode_Volume,
+		Tiers:          []*pb.PlanTier{{UpTo: 5, UnitAmount: 1000}, {UnitAmount: 800, FlatAmount: 100}},
+		UsageType:      pb.UsageType_Metered,
+		AggregateUsage: pb.AggregateUsage_LastEver,
+	})
+	assert.Equal(t, url.Values{
+		"billing_scheme":        {"tiered"},
+		"tiers_mode":            {"volume"},
+		"tiers[0][up_to]":       {"5"},
+		"tiers[0][unit_amount]": {"1000"},
+		"tiers[1][up_to]":       {"inf"},
+		"tiers[1][unit_amount]": {"800"},
+		"tiers[1][flat_amount]": {"100"},
+		"usage_type":            {"metered"},
+		"aggregate_usage":       {"last_ever"},
+	}, params.Extra)
+
+	params = planCreateToPlanParams(context.Background(), "key", &pb.CreatePlanRequest{Amount: 1000})
+	assert.Empty(t, params.Extra, "per unit licensed plans use the defaults")
+}
+
 func deleteAllExistingPlans(client *StripePlanClient) error {
 	plans, err := client.List(context.Background(), nil)
 	if err != nil {
@@ -0,0 +1,129 @@
+package stripe
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/plan"
+)
+
+// planAPI calls the Stripe plan API in the same way as plan.Client, but decodes the pricing
+// fields of plans that the vendored stripe-go does not know about.  The amount is left out when
+// creating a tiered plan, as Stripe rejects plans with both an amount and tiers.  A plan that
+// belongs to a product is created with the name as its nickname, as the name is taken from the
+// product, and is renamed with the nickname extra.  Plans are activated and deactivated with the
+// active extra.
+type planAPI struct {
+	plan.Client
+}
+
+func (c planAPI) New(params *stripe.PlanParams) (*stripePlan, error) {
+	body := &stripe.RequestValues{}
+	body.Add("id", params.ID)
+	if len(params.Extra.Get("product")) > 0 {
+		body.Add("nickname", params.Name)
+	} else {
+		body.Add("name", params.Name)
+	}
+	if params.Extra.Get("billing_scheme") != "tiered" {
+		body.Add("amount", strconv.FormatUint(params.Amount, 10))
+	}
+	body.Add("currency", string(params.Currency))
+	body.Add("interval", string(params.Interval))
+	if params.IntervalCount > 0 {
+		body.Add("interval_count", strconv.FormatUint(params.IntervalCount, 10))
+	}
+	if params.TrialPeriod > 0 {
+		body.Add("trial_period_days", strconv.FormatUint(params.TrialPeriod, 10))
+	}
+	if len(params.Statement) > 0 {
+		body.Add("statement_descriptor", params.Statement)
+	}
+	params.AppendTo(body)
+
+	p := &stripePlan{}
+	err := c.B.Call("POST", "/plans", c.Key, body, &params.Params, p)
+	return p, err
+}
+
+func (c planAPI) Get(id string, params *stripe.PlanParams) (*stripePlan, error) {
+	var body *stripe.RequestValues
+	var commonParams *stripe.Params
+	if params != nil {
+		commonParams = &params.Params
+		body = &stripe.RequestValues{}
+		params.AppendTo(body)
+	}
+
+	p := &stripePlan{}
+	err := c.B.Call("GET", "/plans/"+url.QueryEscape(id), c.Key, body, commonParams, p)
+	return p, err
+}
+
+func (c planAPI) Update(id string, params *stripe.PlanParams) (*stripePlan, error) {
+	var body *stripe.RequestValues
+	var commonParams *stripe.Params
+	if params != nil {
+		commonParams = &params.Params
+		body = &stripe.RequestValues{}
+		if len(params.Name) > 0 {
+			body.Add("name", params.Name)
+		}
+		if len(params.Statement) > 0 {
+			body.Add("statement_descriptor", params.Statement)
+		}
+		if params.TrialPeriod > 0 {
+			body.Add("trial_period_days", strconv.FormatUint(params.TrialPeriod, 10))
+		}
+		params.AppendTo(body)
+	}
+
+	p := &stripePlan{}
+	err := c.B.Call("POST", "/plans/"+url.QueryEscape(id), c.Key, body, commonParams, p)
+	return p, err
+}
+
+func (c planAPI) List(params *stripe.PlanListParams) *planIter {
+	var body *stripe.RequestValues
+	var lp *stripe.ListParams
+	var p *stripe.Params
+	if params != nil {
+		body = &stripe.RequestValues{}
+		if params.Created > 0 {
+			body.Add("created", strconv.FormatInt(params.Created, 10))
+		}
+		if params.CreatedRange != nil {
+			params.CreatedRange.AppendTo(body, "created")
+		}
+		params.AppendTo(body)
+		lp = &params.ListParams
+		p = params.ToParams()
+	}
+
+	return &planIter{stripe.GetIter(lp, body, func(b *stripe.RequestValues) ([]interface{}, stripe.ListMeta, error) {
+		list := &stripePlanList{}
+		err := c.B.Call("GET", "/plans", c.Key, b, p, list)
+
+		ret := make([]interface{}, len(list.Values))
+		for i, v := range list.Values {
+			ret[i] = v
+		}
+		return ret, list.ListMeta, err
+	})}
+}
+
+type stripePlanList struct {
+	stripe.ListMeta
+	Values []*stripePlan `json:"data"`
+}
+
+// planIter is an iterator for lists of plans
+type planIter struct {
+	*stripe.Iter
+}
+
+// Plan returns the plan at the current position of the iterator
+func (i *planIter) Plan() *stripePlan {
+	return i.Current().(*stripePlan)
+}
@@ -2,7 +2,9 @@ package stripe
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
+	"unicode"
 
 	"github.com/BTBurke/recur/pb"
 	"github.com/stripe/stripe-go"
@@ -11,7 +13,7 @@ import (
 
 // convert from a plan create request to PlanParams
 func planCreateToPlanParams(ctx context.Context, key string, req *pb.CreatePlanRequest) *stripe.PlanParams {
-	return &stripe.PlanParams{
+	params := &stripe.PlanParams{
 		Params:        paramsFromContext(ctx, key, &req.Metadata),
 		ID:            req.Id,
 		Name:          req.Name,
@@ -22,17 +24,52 @@ func planCreateToPlanParams(ctx context.Context, key string, req *pb.CreatePlanR
 		TrialPeriod:   req.TrialPeriodDays,
 		Statement:     req.StatementDescriptor,
 	}
+	// the pricing fields are newer than the vendored stripe-go, so they are sent as extra params
+	if req.BillingScheme == pb.BillingScheme_Tiered {
+		params.AddExtra("billing_scheme", pbToStripeEnum(req.BillingScheme.String()))
+		params.AddExtra("tiers_mode", pbToStripeEnum(req.TiersMode.String()))
+		for i, tier := range req.Tiers {
+			upTo := "inf"
+			if tier.UpTo > 0 {
+				upTo = strconv.FormatUint(tier.UpTo, 10)
+			}
+			params.AddExtra(fmt.Sprintf("tiers[%d][up_to]", i), upTo)
+			params.AddExtra(fmt.Sprintf("tiers[%d][unit_amount]", i), strconv.FormatUint(tier.UnitAmount, 10))
+			if tier.FlatAmount > 0 {
+				params.AddExtra(fmt.Sprintf("tiers[%d][flat_amount]", i), strconv.FormatUint(tier.FlatAmount, 10))
+			}
+		}
+	}
+	if req.UsageType == pb.UsageType_Metered {
+		params.AddExtra("usage_type", pbToStripeEnum(req.UsageType.String()))
+		if req.AggregateUsage != pb.AggregateUsage_UnknownAggregateUsage {
+			params.AddExtra("aggregate_usage", pbToStripeEnum(req.AggregateUsage.String()))
+		}
+	}
+	if len(req.Product) > 0 {
+		params.AddExtra("product", req.Product)
+	}
+	return params
 }
 
-// convert from plan update to PlanParams
-func planUpdateToPlanParams(ctx context.Context, key string, req *pb.UpdatePlanRequest) *stripe.PlanParams {
-	return &stripe.PlanParams{
+// convert from plan update to PlanParams.  A plan that belongs to a product is renamed by its
+// nickname, as Stripe rejects the name of such a plan.
+func planUpdateToPlanParams(ctx context.Context, key string, req *pb.UpdatePlanRequest, product string) *stripe.PlanParams {
+	params := &stripe.PlanParams{
 		Params:      paramsFromContext(ctx, key, &req.Metadata),
 		ID:          req.Id,
 		Name:        req.Name,
 		Statement:   req.StatementDescriptor,
 		TrialPeriod: req.TrialPeriodDays,
 	}
+	if len(product) > 0 && len(req.Name) > 0 {
+		params.Name = ""
+		params.AddExtra("nickname", req.Name)
+	}
+	if active, ok := pbToStripeActive(req.Active); ok {
+		params.AddExtra("active", strconv.FormatBool(active))
+	}
+	return params
 }
 
 // convert from plan delete to PlanParams
@@ -60,43 +97,108 @@ func planListToListParams(ctx context.Context, key string, req *pb.ListPlansRequ
 			},
 		}
 	default:
-		return &stripe.PlanListParams{
+		params := &stripe.PlanListParams{
 			ListParams: stripe.ListParams{
 				Start: req.StartingAfter,
 				End:   req.EndingBefore,
 				Limit: defaultInt(int(req.Limit), 10),
 			},
 			CreatedRange: &stripe.RangeQueryParams{
-				GreaterThan:        req.Created.Gt,
-				GreaterThanOrEqual: req.Created.Gte,
-				LesserThan:         req.Created.Lt,
-				LesserThanOrEqual:  req.Created.Lte,
+				GreaterThan:        req.GetCreated().GetGt(),
+				GreaterThanOrEqual: req.GetCreated().GetGte(),
+				LesserThan:         req.GetCreated().GetLt(),
+				LesserThanOrEqual:  req.GetCreated().GetLte(),
 			},
 		}
+		if len(req.Product) > 0 {
+			params.Filters.AddFilter("product", "", req.Product)
+		}
+		if active, ok := pbToStripeActive(req.Active); ok {
+			params.Filters.AddFilter("active", "", strconv.FormatBool(active))
+		}
+		return params
+	}
+}
+
+// pbToStripeActive converts an active state to the Stripe active flag.  It returns false when
+// the state is unset.
+func pbToStripeActive(a pb.ActiveState) (bool, bool) {
+	switch a {
+	case pb.ActiveState_ActiveTrue:
+		return true, true
+	case pb.ActiveState_ActiveFalse:
+		return false, true
+	default:
+		return false, false
 	}
 }
 
+// stripePlan is a Stripe plan with the pricing and product fields that the vendored stripe-go does not decode.
+// Plans embedded in subscriptions and invoice lines are decoded by stripe-go and so are converted
+// without them.
+type stripePlan struct {
+	stripe.Plan
+	BillingScheme  string       `json:"billing_scheme"`
+	Tiers          []stripeTier `json:"tiers"`
+	TiersMode      string       `json:"tiers_mode"`
+	UsageType      string       `json:"usage_type"`
+	AggregateUsage string       `json:"aggregate_usage"`
+	Product        string       `json:"product"`
+	Nickname       string       `json:"nickname"`
+	Active         *bool        `json:"active"`
+}
+
+// stripeTier is a tier of a tiered plan.  The up to quantity of the last tier is null.
+type stripeTier struct {
+	UpTo       uint64 `json:"up_to"`
+	UnitAmount uint64 `json:"unit_amount"`
+	FlatAmount uint64 `json:"flat_amount"`
+}
+
 // convert a success response from Stripe to a PlanResponse (success)
-func respToPlanSuccess(plan *stripe.Plan) *pb.PlanResponse {
+func respToPlanSuccess(plan *stripePlan) *pb.PlanResponse {
 	return &pb.PlanResponse{
 		Responses: &pb.PlanResponse_Success{
-			Success: &pb.Plan{
-				Id:                  plan.ID,
-				Amount:              plan.Amount,
-				Created:             plan.Created,
-				Currency:            stripeToPbCurrency(plan.Currency),
-				Interval:            stripeToPbInterval(plan.Interval),
-				IntervalCount:       plan.IntervalCount,
-				Livemode:            plan.Live,
-				Metadata:            plan.Meta,
-				Name:                plan.Name,
-				StatementDescriptor: plan.Statement,
-				TrialPeriodDays:     plan.TrialPeriod,
-			},
+			Success: stripeToPbPlan(plan),
 		},
 	}
 }
 
+// convert a Stripe plan to a pb.Plan
+func stripeToPbPlan(plan *stripePlan) *pb.Plan {
+	var tiers []*pb.PlanTier
+	for _, t := range plan.Tiers {
+		tiers = append(tiers, &pb.PlanTier{UpTo: t.UpTo, UnitAmount: t.UnitAmount, FlatAmount: t.FlatAmount})
+	}
+	// plans that belong to a product have a nickname in place of a name, and API versions older
+	// than products do not return the active flag
+	name := plan.Name
+	if len(name) == 0 {
+		name = plan.Nickname
+	}
+	active := plan.Active == nil || *plan.Active
+	return &pb.Plan{
+		Id:                  plan.ID,
+		Amount:              plan.Amount,
+		Created:             plan.Created,
+		Currency:            stripeToPbCurrency(plan.Currency),
+		Interval:            stripeToPbInterval(plan.Interval),
+		IntervalCount:       plan.IntervalCount,
+		Livemode:            plan.Live,
+		Metadata:            plan.Meta,
+		Name:                name,
+		StatementDescriptor: plan.Statement,
+		TrialPeriodDays:     plan.TrialPeriod,
+		BillingScheme:       pb.BillingScheme(pb.BillingScheme_value[stripeToPbEnum(plan.BillingScheme)]),
+		Tiers:               tiers,
+		TiersMode:           pb.TiersMode(pb.TiersMode_value[stripeToPbEnum(plan.TiersMode)]),
+		UsageType:           pb.UsageType(pb.UsageType_value[stripeToPbEnum(plan.UsageType)]),
+		AggregateUsage:      pb.AggregateUsage(pb.AggregateUsage_value[stripeToPbEnum(plan.AggregateUsage)]),
+		Product:             plan.Product,
+		Active:              active,
+	}
+}
+
 // convert an error response from Stripe to a PlanResponse (error)
 func respToPlanError(err *stripe.Error) *pb.PlanResponse {
 	return &pb.PlanResponse{
@@ -146,3 +248,29 @@ func pbToStripeCurrency(e pb.Currency) stripe.Currency {
 func pbToStripeInterval(p pb.Interval) stripe.PlanInterval {
 	return stripe.PlanInterval(strings.ToLower(pb.Interval_name[int32(p)]))
 }
+
+// constant conversions from stripe to protobuf - snake case values such as per_unit to the enum
+// name PerUnit
+func stripeToPbEnum(v string) string {
+	parts := strings.Split(v, "_")
+	for i, p := range parts {
+		parts[i] = strings.Title(p)
+	}
+	return strings.Join(parts, "")
+}
+
+// constant conversions from protobuf to stripe - enum names such as PerUnit to the snake case
+// value per_unit
+func pbToStripeEnum(name string) string {
+	var b strings.Builder
+	for i, r := range name {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
@@ -18,7 +18,7 @@ const (
 
 func retryablePlan(params *stripe.PlanParams, api planClient, p *pb.PlanResponse, action planAction) backoff.Operation {
 	return func() error {
-		var plan = new(stripe.Plan)
+		var plan = new(stripePlan)
 		var err error
 		switch action {
 		case planCreate:
@@ -30,13 +30,10 @@ func retryablePlan(params *stripe.PlanParams, api planClient, p *pb.PlanResponse
 		default:
 		}
 		if err != nil {
-			switch err.(type) {
-			case *stripe.Error:
-				*p = *respToPlanError(err.(*stripe.Error))
-				return nil
-			default:
-				return err
+			if stripeErr, ok := err.(*stripe.Error); ok {
+				*p = *respToPlanError(stripeErr)
 			}
+			return classify(err)
 		}
 		*p = *respToPlanSuccess(plan)
 		return nil
@@ -53,13 +50,10 @@ func retryablePlanDelete(params *stripe.PlanParams, api planClient, p *pb.Delete
 		default:
 		}
 		if err != nil {
-			switch err.(type) {
-			case *stripe.Error:
-				*p = *respToDeleteError(err.(*stripe.Error))
-				return nil
-			default:
-				return err
+			if stripeErr, ok := err.(*stripe.Error); ok {
+				*p = *respToDeleteError(stripeErr)
 			}
+			return classify(err)
 		}
 		*p = *respToDeleteSuccess(plan)
 		return nil
@@ -69,6 +63,9 @@ func retryablePlanDelete(params *stripe.PlanParams, api planClient, p *pb.Delete
 func retryablePlanList(params *stripe.PlanListParams, api planClient, p *planStreamer) backoff.Operation {
 	return func() error {
 		p.iter = api.List(params)
+		if p.iter != nil {
+			p.pages = p.iter
+		}
 		return nil
 	}
 }
@@ -9,10 +9,10 @@ import (
 
 	"github.com/BTBurke/recur/pb"
 	"github.com/cenkalti/backoff"
+	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stripe/stripe-go"
-	"github.com/stripe/stripe-go/plan"
 )
 
 type mockPlan struct {
@@ -21,29 +21,29 @@ type mockPlan struct {
 	mock.Mock
 }
 
-func (m *mockPlan) New(params *stripe.PlanParams) (*stripe.Plan, error) {
+func (m *mockPlan) New(params *stripe.PlanParams) (*stripePlan, error) {
 	args := m.Called()
 	time.Sleep(m.delay)
 	if m.called > 0 && m.delay == 0 {
-		return args.Get(0).(*stripe.Plan), args.Error(1)
+		return args.Get(0).(*stripePlan), args.Error(1)
 	}
 	m.called++
 	return nil, fmt.Errorf("test retry")
 }
-func (m *mockPlan) Get(id string, params *stripe.PlanParams) (*stripe.Plan, error) {
+func (m *mockPlan) Get(id string, params *stripe.PlanParams) (*stripePlan, error) {
 	args := m.Called()
 	time.Sleep(m.delay)
 	if m.called > 0 && m.delay == 0 {
-		return args.Get(0).(*stripe.Plan), args.Error(1)
+		return args.Get(0).(*stripePlan), args.Error(1)
 	}
 	m.called++
 	return nil, fmt.Errorf("test retry")
 }
-func (m *mockPlan) Update(id string, params *stripe.PlanParams) (*stripe.Plan, error) {
+func (m *mockPlan) Update(id string, params *stripe.PlanParams) (*stripePlan, error) {
 	args := m.Called()
 	time.Sleep(m.delay)
 	if m.called > 0 && m.delay == 0 {
-		return args.Get(0).(*stripe.Plan), args.Error(1)
+		return args.Get(0).(*stripePlan), args.Error(1)
 	}
 	m.called++
 	return nil, fmt.Errorf("test retry")
@@ -57,21 +57,21 @@ func (m *mockPlan) Del(id string, params *stripe.PlanParams) (*stripe.Plan, erro
 	m.called++
 	return nil, fmt.Errorf("test retry")
 }
-func (m *mockPlan) List(params *stripe.PlanListParams) *plan.Iter {
+func (m *mockPlan) List(params *stripe.PlanListParams) *planIter {
 	m.called++
 	args := m.Called(params)
 	time.Sleep(m.delay)
-	return args.Get(0).(*plan.Iter)
+	return args.Get(0).(*planIter)
 }
 
 func TestRetryablePlan(t *testing.T) {
-	pln := &stripe.Plan{
+	pln := &stripePlan{Plan: stripe.Plan{
 		ID:       "test",
 		Live:     true,
 		Amount:   1000,
 		Currency: stripe.Currency("usd"),
 		Interval: stripe.PlanInterval("month"),
-	}
+	}}
 	tt := []struct {
 		Name      string
 		Method    string
@@ -109,3 +109,41 @@ func TestRetryablePlan(t *testing.T) {
 		})
 	}
 }
+
+// renameRecorder fails the first update with a network error and records the requests made
+type renameRecorder struct {
+	keyRecorder
+	calls    []string
+	nickname string
+}
+
+func (r *renameRecorder) Get(id string, params *stripe.PlanParams) (*stripePlan, error) {
+	r.calls = append(r.calls, "Get")
+	return &stripePlan{Plan: stripe.Plan{ID: id}, Product: "prod_test"}, nil
+}
+
+func (r *renameRecorder) Update(id string, params *stripe.PlanParams) (*stripePlan, error) {
+	r.calls = append(r.calls, "Update")
+	r.nickname = params.Extra.Get("nickname")
+	if params.Name != "" {
+		return nil, &stripe.Error{Type: stripe.ErrorTypeInvalidRequest, HTTPStatusCode: 400, Msg: "Received unknown parameter: name"}
+	}
+	return r.record(params)
+}
+
+func TestPlanRenameFetchesOnce(t *testing.T) {
+	rec := new(renameRecorder)
+	client := &StripePlanClient{api: rec, logger: log.New(), policy: DefaultRetryPolicy}
+
+	resp, err := client.Update(context.Background(), &pb.UpdatePlanRequest{Id: "test", Name: "Gold"})
+	assert.NoError(t, err)
+	assert.Nil(t, resp.GetError())
+	assert.Equal(t, []string{"Get", "Update", "Update"}, rec.calls, "the plan is fetched once, not on every attempt")
+	assert.Equal(t, "Gold", rec.nickname, "a plan that belongs to a product is renamed by its nickname")
+
+	rec = new(renameRecorder)
+	client.api = rec
+	_, err = client.Update(context.Background(), &pb.UpdatePlanRequest{Id: "test", TrialPeriodDays: 7})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Update", "Update"}, rec.calls, "the plan is only fetched for a rename")
+}
@@ -0,0 +1,115 @@
+package stripe
+
+import (
+	"github.com/BTBurke/recur/backend"
+	"github.com/BTBurke/recur/pb"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stripe/stripe-go"
+	context "golang.org/x/net/context"
+)
+
+// interface for the Stripe product API
+type productClient interface {
+	New(params *productParams) (*stripeProduct, error)
+	Get(id string, params *productParams) (*stripeProduct, error)
+	Update(id string, params *productParams) (*stripeProduct, error)
+	Del(id string, params *productParams) (*stripeProduct, error)
+	List(params *productListParams) *productIter
+}
+
+type StripeProductClient struct {
+	key    string
+	logger log.StdLogger
+	// api allows mocking the Stripe backend
+	api productClient
+	// policy controls retries of failed requests
+	policy backend.RetryPolicy
+}
+
+func NewProductClient(key string, logger log.StdLogger, opts ...Option) *StripeProductClient {
+	o := newOptions(opts)
+	return &StripeProductClient{
+		key:    key,
+		logger: logger,
+		policy: o.retry,
+		api: productAPI{
+			B:   stripe.GetBackend(stripe.SupportedBackend("api")),
+			Key: key,
+		},
+	}
+}
+
+func (c *StripeProductClient) Create(ctx context.Context, req *pb.CreateProductRequest) (*pb.ProductResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	ctx, key := withIdempotencyKey(ctx)
+	params := productCreateToProductParams(ctx, c.key, req)
+
+	resp := new(pb.ProductResponse)
+	err := retry(ctx, c.policy, retryableProduct(params, c.api, resp, productCreate))
+
+	reportIdempotencyKey(c.logger, "product create", key, resp.GetError(), err)
+	return resp, err
+}
+
+func (c *StripeProductClient) Update(ctx context.Context, req *pb.UpdateProductRequest) (*pb.ProductResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	ctx, key := withIdempotencyKey(ctx)
+	params := productUpdateToProductParams(ctx, c.key, req)
+
+	resp := new(pb.ProductResponse)
+	err := retry(ctx, c.policy, retryableProduct(params, c.api, resp, productUpdate))
+
+	reportIdempotencyKey(c.logger, "product update", key, resp.GetError(), err)
+	return resp, err
+}
+
+// Delete deletes a product.  Stripe refuses to delete a product that still has plans.
+func (c *StripeProductClient) Delete(ctx context.Context, req *pb.DeleteProductRequest) (*pb.DeleteProductResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	params := &productParams{Params: paramsFromContext(ctx, c.key, nil), ID: req.Id}
+
+	resp := new(pb.DeleteProductResponse)
+	err := retry(ctx, c.policy, retryableProductDelete(params, c.api, resp))
+	return resp, err
+}
+
+func (c *StripeProductClient) Get(ctx context.Context, req *pb.GetProductRequest) (*pb.ProductResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	params := &productParams{Params: paramsFromContext(ctx, c.key, nil), ID: req.Id}
+
+	resp := new(pb.ProductResponse)
+	err := retry(ctx, c.policy, retryableProduct(params, c.api, resp, productGet))
+	return resp, err
+}
+
+// productStreamer implements the ProductStreamer interface, converting Stripe responses
+// to a ProductResponse.
+type productStreamer struct {
+	listIter
+	iter *productIter
+}
+
+func (s *productStreamer) Current() *pb.ProductResponse {
+	if e := s.errorResponse(); e != nil {
+		return &pb.ProductResponse{Responses: &pb.ProductResponse_Error{Error: e}}
+	}
+	return respToProductSuccess(s.iter.Product())
+}
+
+func (c *StripeProductClient) List(ctx context.Context, req *pb.ListProductsRequest) (backend.ProductStreamer, error) {
+	params := productListToListParams(ctx, c.key, req)
+
+	streamer := &productStreamer{listIter: listIter{ctx: ctx}}
+	err := retry(ctx, c.policy, retryableProductList(params, c.api, streamer))
+
+	return streamer, err
+}
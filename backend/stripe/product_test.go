@@ -0,0 +1,67 @@
+package stripe
+
+import (
+	"testing"
+
+	"github.com/BTBurke/recur/pb"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	context "golang.org/x/net/context"
+)
+
+func TestProductIntegration(t *testing.T) {
+	key, done := getAPIKey(t)
+	defer done()
+	products := NewProductClient(key, log.New())
+	plans := NewPlanClient(key, log.New())
+	ctx := context.Background()
+
+	prod, err := products.Create(ctx, &pb.CreateProductRequest{Id: "test-product", Name: "Test", UnitLabel: "seat"})
+	if err != nil || prod.GetError() != nil {
+		t.Fatalf("Failed to create product: %v %v", err, prod.GetError())
+	}
+	defer products.Delete(ctx, &pb.DeleteProductRequest{Id: "test-product"})
+	assert.True(t, prod.GetSuccess().GetActive())
+	assert.Equal(t, "seat", prod.GetSuccess().GetUnitLabel())
+
+	plan, err := plans.Create(ctx, &pb.CreatePlanRequest{
+		Id:       "test-product-plan",
+		Amount:   1000,
+		Currency: pb.Currency_USD,
+		Name:     "Test monthly",
+		Interval: pb.Interval_Month,
+		Product:  "test-product",
+	})
+	if err != nil || plan.GetError() != nil {
+		t.Fatalf("Failed to create plan: %v %v", err, plan.GetError())
+	}
+	defer plans.Delete(ctx, &pb.DeletePlanRequest{Id: "test-product-plan"})
+	assert.Equal(t, "test-product", plan.GetSuccess().GetProduct())
+	assert.Equal(t, "Test monthly", plan.GetSuccess().GetName())
+	assert.True(t, plan.GetSuccess().GetActive())
+
+	list, err := plans.List(ctx, &pb.ListPlansRequest{Product: "test-product"})
+	assert.NoError(t, err)
+	var ids []string
+	for list.Next() {
+		ids = append(ids, list.Current().GetSuccess().GetId())
+	}
+	assert.Equal(t, []string{"test-product-plan"}, ids)
+
+	renamed, err := plans.Update(ctx, &pb.UpdatePlanRequest{Id: "test-product-plan", Name: "Test monthly plus", Active: pb.ActiveState_ActiveFalse})
+	assert.NoError(t, err)
+	assert.Nil(t, renamed.GetError(), "a plan that belongs to a product is renamed by its nickname")
+	assert.Equal(t, "Test monthly plus", renamed.GetSuccess().GetName())
+	assert.False(t, renamed.GetSuccess().GetActive())
+
+	upd, err := products.Update(ctx, &pb.UpdateProductRequest{Id: "test-product", Active: pb.ActiveState_ActiveFalse})
+	assert.NoError(t, err)
+	assert.False(t, upd.GetSuccess().GetActive())
+	assert.Equal(t, "Test", upd.GetSuccess().GetName())
+
+	plist, err := products.List(ctx, &pb.ListProductsRequest{Active: pb.ActiveState_ActiveTrue})
+	assert.NoError(t, err)
+	for plist.Next() {
+		assert.NotEqual(t, "test-product", plist.Current().GetSuccess().GetId(), "inactive products are filtered")
+	}
+}
@@ -0,0 +1,140 @@
+package stripe
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/stripe/stripe-go"
+)
+
+// productParams are the parameters for creating or updating a product.  The product client in
+// the vendored stripe-go only knows about products sold as goods, so products are sent with
+// productAPI as service products, the kind that plans belong to.
+type productParams struct {
+	stripe.Params
+	ID        string
+	Name      string
+	Statement string
+	UnitLabel string
+	Active    *bool
+}
+
+// productListParams are the parameters for listing products
+type productListParams struct {
+	stripe.ListParams
+	CreatedRange *stripe.RangeQueryParams
+	Active       *bool
+}
+
+// stripeProduct is a service product as returned by Stripe
+type stripeProduct struct {
+	ID        string            `json:"id"`
+	Active    bool              `json:"active"`
+	Created   int64             `json:"created"`
+	Updated   int64             `json:"updated"`
+	Live      bool              `json:"livemode"`
+	Meta      map[string]string `json:"metadata"`
+	Name      string            `json:"name"`
+	Statement string            `json:"statement_descriptor"`
+	UnitLabel string            `json:"unit_label"`
+	Deleted   bool              `json:"deleted"`
+}
+
+// productAPI calls the Stripe product API in the same way as the stripe-go resource clients
+type productAPI struct {
+	B   stripe.Backend
+	Key string
+}
+
+func (c productAPI) New(params *productParams) (*stripeProduct, error) {
+	body := &stripe.RequestValues{}
+	if len(params.ID) > 0 {
+		body.Add("id", params.ID)
+	}
+	body.Add("name", params.Name)
+	body.Add("type", "service")
+	params.appendTo(body)
+
+	p := &stripeProduct{}
+	err := c.B.Call("POST", "/products", c.Key, body, &params.Params, p)
+	return p, err
+}
+
+func (c productAPI) Get(id string, params *productParams) (*stripeProduct, error) {
+	body := &stripe.RequestValues{}
+	params.Params.AppendTo(body)
+
+	p := &stripeProduct{}
+	err := c.B.Call("GET", "/products/"+url.QueryEscape(id), c.Key, body, &params.Params, p)
+	return p, err
+}
+
+func (c productAPI) Update(id string, params *productParams) (*stripeProduct, error) {
+	body := &stripe.RequestValues{}
+	if len(params.Name) > 0 {
+		body.Add("name", params.Name)
+	}
+	params.appendTo(body)
+
+	p := &stripeProduct{}
+	err := c.B.Call("POST", "/products/"+url.QueryEscape(id), c.Key, body, &params.Params, p)
+	return p, err
+}
+
+func (c productAPI) Del(id string, params *productParams) (*stripeProduct, error) {
+	p := &stripeProduct{}
+	err := c.B.Call("DELETE", "/products/"+url.QueryEscape(id), c.Key, nil, &params.Params, p)
+	return p, err
+}
+
+func (c productAPI) List(params *productListParams) *productIter {
+	body := &stripe.RequestValues{}
+	if params.CreatedRange != nil {
+		params.CreatedRange.AppendTo(body, "created")
+	}
+	if params.Active != nil {
+		body.Add("active", strconv.FormatBool(*params.Active))
+	}
+	params.AppendTo(body)
+	p := params.ToParams()
+
+	return &productIter{stripe.GetIter(&params.ListParams, body, func(b *stripe.RequestValues) ([]interface{}, stripe.ListMeta, error) {
+		list := &stripeProductList{}
+		err := c.B.Call("GET", "/products", c.Key, b, p, list)
+
+		ret := make([]interface{}, len(list.Values))
+		for i, v := range list.Values {
+			ret[i] = v
+		}
+		return ret, list.ListMeta, err
+	})}
+}
+
+// appendTo adds the fields shared by creates and updates
+func (params *productParams) appendTo(body *stripe.RequestValues) {
+	if len(params.Statement) > 0 {
+		body.Add("statement_descriptor", params.Statement)
+	}
+	if len(params.UnitLabel) > 0 {
+		body.Add("unit_label", params.UnitLabel)
+	}
+	if params.Active != nil {
+		body.Add("active", strconv.FormatBool(*params.Active))
+	}
+	params.Params.AppendTo(body)
+}
+
+type stripeProductList struct {
+	stripe.ListMeta
+	Values []*stripeProduct `json:"data"`
+}
+
+// productIter is an iterator for lists of products
+type productIter struct {
+	*stripe.Iter
+}
+
+// Product returns the product at the current position of the iterator
+func (i *productIter) Product() *stripeProduct {
+	return i.Current().(*stripeProduct)
+}
@@ -0,0 +1,115 @@
+package stripe
+
+import (
+	"github.com/BTBurke/recur/pb"
+	"github.com/stripe/stripe-go"
+	context "golang.org/x/net/context"
+)
+
+// convert from a product create request to productParams
+func productCreateToProductParams(ctx context.Context, key string, req *pb.CreateProductRequest) *productParams {
+	return &productParams{
+		Params:    paramsFromContext(ctx, key, &req.Metadata),
+		ID:        req.Id,
+		Name:      req.Name,
+		Statement: req.StatementDescriptor,
+		UnitLabel: req.UnitLabel,
+	}
+}
+
+// convert from a product update request to productParams.  Fields that are not set are left
+// unchanged.
+func productUpdateToProductParams(ctx context.Context, key string, req *pb.UpdateProductRequest) *productParams {
+	params := &productParams{
+		Params:    paramsFromContext(ctx, key, &req.Metadata),
+		ID:        req.Id,
+		Name:      req.Name,
+		Statement: req.StatementDescriptor,
+		UnitLabel: req.UnitLabel,
+	}
+	if active, ok := pbToStripeActive(req.Active); ok {
+		params.Active = &active
+	}
+	return params
+}
+
+func productListToListParams(ctx context.Context, key string, req *pb.ListProductsRequest) *productListParams {
+	if req == nil {
+		return &productListParams{
+			ListParams: stripe.ListParams{
+				Limit: 10,
+			},
+		}
+	}
+	params := &productListParams{
+		ListParams: stripe.ListParams{
+			Start: req.StartingAfter,
+			End:   req.EndingBefore,
+			Limit: defaultInt(int(req.Limit), 10),
+		},
+		CreatedRange: &stripe.RangeQueryParams{
+			GreaterThan:        req.GetCreated().GetGt(),
+			GreaterThanOrEqual: req.GetCreated().GetGte(),
+			LesserThan:         req.GetCreated().GetLt(),
+			LesserThanOrEqual:  req.GetCreated().GetLte(),
+		},
+	}
+	if active, ok := pbToStripeActive(req.Active); ok {
+		params.Active = &active
+	}
+	return params
+}
+
+// convert a success response from Stripe to a ProductResponse (success)
+func respToProductSuccess(p *stripeProduct) *pb.ProductResponse {
+	return &pb.ProductResponse{
+		Responses: &pb.ProductResponse_Success{
+			Success: stripeToPbProduct(p),
+		},
+	}
+}
+
+// convert a Stripe product to a pb.Product
+func stripeToPbProduct(p *stripeProduct) *pb.Product {
+	return &pb.Product{
+		Id:                  p.ID,
+		Name:                p.Name,
+		Active:              p.Active,
+		Created:             p.Created,
+		Updated:             p.Updated,
+		Livemode:            p.Live,
+		Metadata:            p.Meta,
+		StatementDescriptor: p.Statement,
+		UnitLabel:           p.UnitLabel,
+	}
+}
+
+// convert an error response from Stripe to a ProductResponse (error)
+func respToProductError(err *stripe.Error) *pb.ProductResponse {
+	return &pb.ProductResponse{
+		Responses: &pb.ProductResponse_Error{
+			Error: respToError(err),
+		},
+	}
+}
+
+// convert a delete success response from Stripe to a DeleteProductResponse
+func respToProductDeleteSuccess(p *stripeProduct) *pb.DeleteProductResponse {
+	return &pb.DeleteProductResponse{
+		Responses: &pb.DeleteProductResponse_Success{
+			Success: &pb.DeleteProductSuccess{
+				Id:      p.ID,
+				Deleted: p.Deleted,
+			},
+		},
+	}
+}
+
+// convert a delete error response from Stripe to a DeleteProductResponse
+func respToProductDeleteError(err *stripe.Error) *pb.DeleteProductResponse {
+	return &pb.DeleteProductResponse{
+		Responses: &pb.DeleteProductResponse_Error{
+			Error: respToError(err),
+		},
+	}
+}
@@ -0,0 +1,64 @@
+package stripe
+
+import (
+	"github.com/BTBurke/recur/pb"
+	"github.com/cenkalti/backoff"
+
+	"github.com/stripe/stripe-go"
+)
+
+type productAction int
+
+const (
+	productCreate productAction = iota
+	productUpdate
+	productGet
+)
+
+func retryableProduct(params *productParams, api productClient, p *pb.ProductResponse, action productAction) backoff.Operation {
+	return func() error {
+		var product = new(stripeProduct)
+		var err error
+		switch action {
+		case productCreate:
+			product, err = api.New(params)
+		case productUpdate:
+			product, err = api.Update(params.ID, params)
+		case productGet:
+			product, err = api.Get(params.ID, params)
+		default:
+		}
+		if err != nil {
+			if stripeErr, ok := err.(*stripe.Error); ok {
+				*p = *respToProductError(stripeErr)
+			}
+			return classify(err)
+		}
+		*p = *respToProductSuccess(product)
+		return nil
+	}
+}
+
+func retryableProductDelete(params *productParams, api productClient, p *pb.DeleteProductResponse) backoff.Operation {
+	return func() error {
+		product, err := api.Del(params.ID, params)
+		if err != nil {
+			if stripeErr, ok := err.(*stripe.Error); ok {
+				*p = *respToProductDeleteError(stripeErr)
+			}
+			return classify(err)
+		}
+		*p = *respToProductDeleteSuccess(product)
+		return nil
+	}
+}
+
+func retryableProductList(params *productListParams, api productClient, p *productStreamer) backoff.Operation {
+	return func() error {
+		p.iter = api.List(params)
+		if p.iter != nil {
+			p.pages = p.iter
+		}
+		return nil
+	}
+}
@@ -0,0 +1,75 @@
+package stripe
+
+import (
+	"fmt"
+	"testing"
+
+	context "golang.org/x/net/context"
+
+	"github.com/BTBurke/recur/pb"
+	"github.com/cenkalti/backoff"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockProduct struct {
+	mock.Mock
+}
+
+func (m *mockProduct) New(params *productParams) (*stripeProduct, error) {
+	args := m.Called(params)
+	return args.Get(0).(*stripeProduct), args.Error(1)
+}
+
+func (m *mockProduct) Get(id string, params *productParams) (*stripeProduct, error) {
+	args := m.Called(id)
+	return args.Get(0).(*stripeProduct), args.Error(1)
+}
+
+func (m *mockProduct) Update(id string, params *productParams) (*stripeProduct, error) {
+	args := m.Called(id)
+	return args.Get(0).(*stripeProduct), args.Error(1)
+}
+
+func (m *mockProduct) Del(id string, params *productParams) (*stripeProduct, error) {
+	args := m.Called(id)
+	return args.Get(0).(*stripeProduct), args.Error(1)
+}
+
+func (m *mockProduct) List(params *productListParams) *productIter {
+	args := m.Called(params)
+	return args.Get(0).(*productIter)
+}
+
+func TestRetryableProduct(t *testing.T) {
+	p := &stripeProduct{ID: "gold", Name: "Gold", Active: true, UnitLabel: "seat"}
+
+	mck := new(mockProduct)
+	mck.On("Get", "gold").Return((*stripeProduct)(nil), fmt.Errorf("test retry")).Once()
+	mck.On("Get", "gold").Return(p, nil).Once()
+	resp := new(pb.ProductResponse)
+	err := backoff.Retry(
+		retryableProduct(&productParams{ID: "gold"}, mck, resp, productGet),
+		backoff.WithContext(backoff.NewExponentialBackOff(), context.Background()),
+	)
+	assert.NoError(t, err)
+	mck.AssertExpectations(t)
+	got := resp.GetSuccess()
+	assert.Equal(t, "gold", got.GetId())
+	assert.True(t, got.GetActive())
+	assert.Equal(t, "seat", got.GetUnitLabel())
+}
+
+func TestProductConversion(t *testing.T) {
+	params := productUpdateToProductParams(context.Background(), "", &pb.UpdateProductRequest{Id: "gold", Active: pb.ActiveState_ActiveFalse})
+	if assert.NotNil(t, params.Active) {
+		assert.False(t, *params.Active)
+	}
+	params = productUpdateToProductParams(context.Background(), "", &pb.UpdateProductRequest{Id: "gold"})
+	assert.Nil(t, params.Active, "an unset active state is not sent")
+
+	list := productListToListParams(context.Background(), "", &pb.ListProductsRequest{Active: pb.ActiveState_ActiveTrue})
+	if assert.NotNil(t, list.Active) {
+		assert.True(t, *list.Active)
+	}
+}
@@ -0,0 +1,32 @@
+package stripe
+
+import (
+	"github.com/BTBurke/recur/backend"
+)
+
+// Name is the name the Stripe backend is registered under
+const Name = "stripe"
+
+func init() {
+	backend.Register(Name, newClients)
+}
+
+// newClients creates the Stripe clients for every resource
+func newClients(cfg backend.Config) (*backend.Clients, error) {
+	policy := DefaultRetryPolicy
+	if cfg.Retry != nil {
+		policy = *cfg.Retry
+	}
+	retry := WithRetryPolicy(policy)
+	return &backend.Clients{
+		Plan:         NewPlanClient(cfg.Key, cfg.Logger, retry),
+		Product:      NewProductClient(cfg.Key, cfg.Logger, retry),
+		Customer:     NewCustomerClient(cfg.Key, cfg.Logger, retry),
+		Subscription: NewSubscriptionClient(cfg.Key, cfg.Logger, retry),
+		Invoice:      NewInvoiceClient(cfg.Key, cfg.Logger, retry),
+		Coupon:       NewCouponClient(cfg.Key, cfg.Logger, retry),
+		Source:       NewSourceClient(cfg.Key, cfg.Logger, retry),
+		Event:        NewEventClient(cfg.Key, cfg.Logger, retry),
+		Usage:        NewUsageClient(cfg.Key, cfg.Logger, retry),
+	}, nil
+}
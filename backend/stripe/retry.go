@@ -0,0 +1,75 @@
+package stripe
+
+import (
+	"time"
+
+	"github.com/BTBurke/recur/backend"
+	"github.com/cenkalti/backoff"
+	"github.com/stripe/stripe-go"
+	context "golang.org/x/net/context"
+)
+
+// DefaultRetryPolicy is used when no policy is set.  Only transient errors are retried: rate
+// limits, connection failures and 5xx API errors.  Invalid requests, card errors and
+// authentication errors are returned immediately.
+var DefaultRetryPolicy = backend.RetryPolicy{
+	MaxElapsed:  30 * time.Second,
+	MaxAttempts: 5,
+}
+
+// Option configures optional behavior of the Stripe clients
+type Option func(o *options)
+
+type options struct {
+	retry backend.RetryPolicy
+}
+
+// WithRetryPolicy sets the policy used to retry failed requests
+func WithRetryPolicy(r backend.RetryPolicy) Option {
+	return func(o *options) {
+		o.retry = r
+	}
+}
+
+func newOptions(opts []Option) options {
+	o := options{retry: DefaultRetryPolicy}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// retry runs the operation until it succeeds or the policy stops retrying.  Operations record
+// Stripe errors in their response before returning them, so a Stripe error is not returned as a
+// Go error once retries stop.
+func retry(ctx context.Context, policy backend.RetryPolicy, op backoff.Operation) error {
+	err := backoff.Retry(op, policy.BackOff(ctx))
+	if _, ok := err.(*stripe.Error); ok {
+		return nil
+	}
+	return err
+}
+
+// classify marks errors that should not be retried as permanent.  Errors that do not come from
+// the Stripe API, such as network failures, are transient.
+func classify(err error) error {
+	stripeErr, ok := err.(*stripe.Error)
+	if !ok || isTransient(stripeErr) {
+		return err
+	}
+	return backoff.Permanent(err)
+}
+
+// isTransient returns true for Stripe errors that may succeed if the request is retried
+func isTransient(err *stripe.Error) bool {
+	switch {
+	case err.HTTPStatusCode == 429:
+		return true
+	case err.Type == stripe.ErrorTypeRateLimit, err.Type == stripe.ErrorTypeAPIConnection:
+		return true
+	case err.Type == stripe.ErrorTypeAPI:
+		return err.HTTPStatusCode == 0 || err.HTTPStatusCode >= 500
+	default:
+		return false
+	}
+}
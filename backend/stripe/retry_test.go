@@ -0,0 +1,85 @@
+package stripe
+
+import (
+	"testing"
+	"time"
+
+	"github.com/BTBurke/recur/backend"
+	"github.com/BTBurke/recur/pb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stripe/stripe-go"
+	context "golang.org/x/net/context"
+)
+
+// scriptedPlan returns each error in turn and then succeeds
+type scriptedPlan struct {
+	errs  []error
+	calls int
+}
+
+func (s *scriptedPlan) next(id string) (*stripePlan, error) {
+	s.calls++
+	if s.calls <= len(s.errs) {
+		return nil, s.errs[s.calls-1]
+	}
+	return &stripePlan{Plan: stripe.Plan{ID: id}}, nil
+}
+
+func (s *scriptedPlan) New(params *stripe.PlanParams) (*stripePlan, error) {
+	return s.next(params.ID)
+}
+func (s *scriptedPlan) Get(id string, params *stripe.PlanParams) (*stripePlan, error) {
+	return s.next(id)
+}
+func (s *scriptedPlan) Update(id string, params *stripe.PlanParams) (*stripePlan, error) {
+	return s.next(id)
+}
+func (s *scriptedPlan) Del(id string, params *stripe.PlanParams) (*stripe.Plan, error) {
+	p, err := s.next(id)
+	if err != nil {
+		return nil, err
+	}
+	return &p.Plan, nil
+}
+func (s *scriptedPlan) List(params *stripe.PlanListParams) *planIter {
+	return nil
+}
+
+func TestRetryPolicy(t *testing.T) {
+	rateLimit := &stripe.Error{Type: stripe.ErrorTypeRateLimit, HTTPStatusCode: 429, Msg: "slow down"}
+	apiErr := &stripe.Error{Type: stripe.ErrorTypeAPI, HTTPStatusCode: 500, Msg: "server error"}
+	invalid := &stripe.Error{Type: stripe.ErrorTypeInvalidRequest, HTTPStatusCode: 400, Msg: "bad request"}
+	card := &stripe.Error{Type: stripe.ErrorTypeCard, HTTPStatusCode: 402, Msg: "declined"}
+
+	tt := []struct {
+		Name      string
+		Errs      []error
+		Policy    backend.RetryPolicy
+		Calls     int
+		ErrorType pb.ErrorType
+	}{
+		{Name: "retry rate limit", Errs: []error{rateLimit}, Policy: DefaultRetryPolicy, Calls: 2},
+		{Name: "retry api error", Errs: []error{apiErr}, Policy: DefaultRetryPolicy, Calls: 2},
+		{Name: "no retry invalid request", Errs: []error{invalid}, Policy: DefaultRetryPolicy, Calls: 1, ErrorType: pb.ErrorType_InvalidRequest},
+		{Name: "no retry card error", Errs: []error{card}, Policy: DefaultRetryPolicy, Calls: 1, ErrorType: pb.ErrorType_Card},
+		{Name: "single attempt", Errs: []error{rateLimit, rateLimit, rateLimit}, Policy: backend.RetryPolicy{MaxAttempts: 1}, Calls: 1, ErrorType: pb.ErrorType_RateLimit},
+		{Name: "max attempts", Errs: []error{rateLimit, rateLimit, rateLimit}, Policy: backend.RetryPolicy{MaxAttempts: 2}, Calls: 2, ErrorType: pb.ErrorType_RateLimit},
+		{Name: "max attempts 3", Errs: []error{rateLimit, rateLimit, rateLimit, rateLimit}, Policy: backend.RetryPolicy{MaxAttempts: 3}, Calls: 3, ErrorType: pb.ErrorType_RateLimit},
+		{Name: "max elapsed", Errs: []error{apiErr, apiErr, apiErr}, Policy: backend.RetryPolicy{MaxElapsed: 100 * time.Millisecond}, Calls: 2, ErrorType: pb.ErrorType_API},
+	}
+	for _, tc := range tt {
+		t.Run(tc.Name, func(t *testing.T) {
+			api := &scriptedPlan{errs: tc.Errs}
+			client := &StripePlanClient{api: api, policy: tc.Policy}
+			resp, err := client.Get(context.Background(), &pb.GetPlanRequest{Id: "test"})
+			assert.NoError(t, err)
+			assert.Equal(t, tc.Calls, api.calls)
+			switch tc.ErrorType {
+			case pb.ErrorType_Unknown:
+				assert.Equal(t, "test", resp.GetSuccess().GetId())
+			default:
+				assert.Equal(t, tc.ErrorType, resp.GetError().GetType())
+			}
+		})
+	}
+}
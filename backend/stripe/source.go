@@ -0,0 +1,120 @@
+package stripe
+
+import (
+	"github.com/BTBurke/recur/backend"
+	"github.com/BTBurke/recur/pb"
+	"github.com/stripe/stripe-go/customer"
+	"github.com/stripe/stripe-go/paymentsource"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stripe/stripe-go"
+	context "golang.org/x/net/context"
+)
+
+// interface for the Stripe customer sources API
+type sourceClient interface {
+	New(params *stripe.CustomerSourceParams) (*stripe.PaymentSource, error)
+	Del(id string, params *stripe.CustomerSourceParams) (*stripe.PaymentSource, error)
+	List(params *stripe.SourceListParams) *paymentsource.Iter
+}
+
+type StripeSourceClient struct {
+	key    string
+	logger log.StdLogger
+	// api and customers allow mocking the Stripe backend.  The default source is set by updating
+	// the customer.
+	api       sourceClient
+	customers customerClient
+	// policy controls retries of failed requests
+	policy backend.RetryPolicy
+}
+
+func NewSourceClient(key string, logger log.StdLogger, opts ...Option) *StripeSourceClient {
+	o := newOptions(opts)
+	b := stripe.GetBackend(stripe.SupportedBackend("api"))
+	return &StripeSourceClient{
+		key:       key,
+		logger:    logger,
+		policy:    o.retry,
+		api:       paymentsource.Client{B: b, Key: key},
+		customers: customer.Client{B: b, Key: key},
+	}
+}
+
+// Attach attaches the source created from a token to the customer.  The first source attached to
+// a customer becomes its default source.  Stripe verifies cards when they are attached, so a card
+// that would be declined returns a card error.
+func (s *StripeSourceClient) Attach(ctx context.Context, req *pb.AttachSourceRequest) (*pb.SourceResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	ctx, key := withIdempotencyKey(ctx)
+	params := sourceAttachToSourceParams(ctx, s.key, req)
+
+	resp := new(pb.SourceResponse)
+	err := retry(ctx, s.policy, retryableSourceAttach(params, s.api, resp))
+
+	reportIdempotencyKey(s.logger, "source attach", key, resp.GetError(), err)
+	return resp, err
+}
+
+func (s *StripeSourceClient) SetDefault(ctx context.Context, req *pb.SetDefaultSourceRequest) (*pb.CustomerResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	ctx, key := withIdempotencyKey(ctx)
+	params := &stripe.CustomerParams{
+		Params:        paramsFromContext(ctx, s.key, nil),
+		DefaultSource: req.Source,
+	}
+
+	resp := new(pb.CustomerResponse)
+	err := retry(ctx, s.policy, retryableCustomer(req.Customer, params, s.customers, resp, customerUpdate))
+
+	reportIdempotencyKey(s.logger, "source set default", key, resp.GetError(), err)
+	return resp, err
+}
+
+// Detach removes a source from the customer.  If it was the default source, Stripe makes the
+// most recently attached remaining source the default.
+func (s *StripeSourceClient) Detach(ctx context.Context, req *pb.DetachSourceRequest) (*pb.DetachSourceResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	ctx, key := withIdempotencyKey(ctx)
+	params := &stripe.CustomerSourceParams{
+		Params:   paramsFromContext(ctx, s.key, nil),
+		Customer: req.Customer,
+	}
+
+	resp := new(pb.DetachSourceResponse)
+	err := retry(ctx, s.policy, retryableSourceDetach(req.Source, params, s.api, resp))
+
+	reportIdempotencyKey(s.logger, "source detach", key, resp.GetError(), err)
+	return resp, err
+}
+
+// sourceStreamer implements the SourceStreamer interface, converting Stripe responses
+// to a SourceResponse.
+type sourceStreamer struct {
+	listIter
+	iter     *paymentsource.Iter
+	customer string
+}
+
+func (s *sourceStreamer) Current() *pb.SourceResponse {
+	if e := s.errorResponse(); e != nil {
+		return &pb.SourceResponse{Responses: &pb.SourceResponse_Error{Error: e}}
+	}
+	return respToSourceSuccess(s.customer, s.iter.PaymentSource())
+}
+
+func (s *StripeSourceClient) List(ctx context.Context, req *pb.ListSourcesRequest) (backend.SourceStreamer, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	params := sourceListToListParams(ctx, s.key, req)
+	streamer := &sourceStreamer{listIter: listIter{ctx: ctx}, customer: req.Customer}
+	err := retry(ctx, s.policy, retryableSourceList(params, s.api, streamer))
+	return streamer, err
+}
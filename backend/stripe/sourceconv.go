@@ -0,0 +1,103 @@
+package stripe
+
+import (
+	"github.com/BTBurke/recur/pb"
+	"github.com/stripe/stripe-go"
+	context "golang.org/x/net/context"
+)
+
+// convert from a source attach request to CustomerSourceParams
+func sourceAttachToSourceParams(ctx context.Context, key string, req *pb.AttachSourceRequest) *stripe.CustomerSourceParams {
+	return &stripe.CustomerSourceParams{
+		Params:   paramsFromContext(ctx, key, &req.Metadata),
+		Customer: req.Customer,
+		Source:   &stripe.SourceParams{Token: req.Token},
+	}
+}
+
+func sourceListToListParams(ctx context.Context, key string, req *pb.ListSourcesRequest) *stripe.SourceListParams {
+	return &stripe.SourceListParams{
+		ListParams: stripe.ListParams{
+			Start: req.StartingAfter,
+			End:   req.EndingBefore,
+			Limit: defaultInt(int(req.Limit), 10),
+		},
+		Customer: req.Customer,
+	}
+}
+
+// convert a success response from Stripe to a SourceResponse (success).  Stripe does not always
+// expand the customer on a source, so the customer from the request is used.
+func respToSourceSuccess(customer string, s *stripe.PaymentSource) *pb.SourceResponse {
+	return &pb.SourceResponse{
+		Responses: &pb.SourceResponse_Success{
+			Success: stripeToPbSource(customer, s),
+		},
+	}
+}
+
+// convert a Stripe payment source to a pb.PaymentSource
+func stripeToPbSource(customer string, s *stripe.PaymentSource) *pb.PaymentSource {
+	source := &pb.PaymentSource{
+		Id:       s.ID,
+		Customer: customer,
+		Type:     stripeToPbSourceType(s.Type),
+	}
+	if c := s.Card; c != nil {
+		source.Card = &pb.CardDetails{
+			Brand:       string(c.Brand),
+			Last4:       c.LastFour,
+			ExpMonth:    uint32(c.Month),
+			ExpYear:     uint32(c.Year),
+			Funding:     string(c.Funding),
+			Country:     c.CardCountry,
+			Fingerprint: c.Fingerprint,
+			Name:        c.Name,
+			AddressZip:  c.Zip,
+			CvcCheck:    string(c.CVCCheck),
+			Metadata:    c.Meta,
+		}
+	}
+	return source
+}
+
+// convert an error response from Stripe to a SourceResponse (error)
+func respToSourceError(err *stripe.Error) *pb.SourceResponse {
+	return &pb.SourceResponse{
+		Responses: &pb.SourceResponse_Error{
+			Error: respToError(err),
+		},
+	}
+}
+
+// convert a delete success response from Stripe to a DetachSourceResponse
+func respToSourceDetachSuccess(s *stripe.PaymentSource) *pb.DetachSourceResponse {
+	return &pb.DetachSourceResponse{
+		Responses: &pb.DetachSourceResponse_Success{
+			Success: &pb.DetachSourceSuccess{
+				Id:      s.ID,
+				Deleted: s.Deleted,
+			},
+		},
+	}
+}
+
+// convert a delete error response from Stripe to a DetachSourceResponse
+func respToSourceDetachError(err *stripe.Error) *pb.DetachSourceResponse {
+	return &pb.DetachSourceResponse{
+		Responses: &pb.DetachSourceResponse_Error{
+			Error: respToError(err),
+		},
+	}
+}
+
+// map from Stripe source types to proto
+func stripeToPbSourceType(t stripe.PaymentSourceType) pb.SourceType {
+	lookup := map[stripe.PaymentSourceType]pb.SourceType{
+		stripe.PaymentSourceCard:            pb.SourceType_CardSource,
+		stripe.PaymentSourceBankAccount:     pb.SourceType_BankAccountSource,
+		stripe.PaymentSourceBitcoinReceiver: pb.SourceType_BitcoinReceiverSource,
+		stripe.PaymentSourceObject:          pb.SourceType_SourceObject,
+	}
+	return lookup[t]
+}
@@ -0,0 +1,45 @@
+package stripe
+
+import (
+	"github.com/BTBurke/recur/pb"
+	"github.com/cenkalti/backoff"
+	"github.com/stripe/stripe-go"
+)
+
+func retryableSourceAttach(params *stripe.CustomerSourceParams, api sourceClient, s *pb.SourceResponse) backoff.Operation {
+	return func() error {
+		source, err := api.New(params)
+		if err != nil {
+			if stripeErr, ok := err.(*stripe.Error); ok {
+				*s = *respToSourceError(stripeErr)
+			}
+			return classify(err)
+		}
+		*s = *respToSourceSuccess(params.Customer, source)
+		return nil
+	}
+}
+
+func retryableSourceDetach(id string, params *stripe.CustomerSourceParams, api sourceClient, s *pb.DetachSourceResponse) backoff.Operation {
+	return func() error {
+		source, err := api.Del(id, params)
+		if err != nil {
+			if stripeErr, ok := err.(*stripe.Error); ok {
+				*s = *respToSourceDetachError(stripeErr)
+			}
+			return classify(err)
+		}
+		*s = *respToSourceDetachSuccess(source)
+		return nil
+	}
+}
+
+func retryableSourceList(params *stripe.SourceListParams, api sourceClient, s *sourceStreamer) backoff.Operation {
+	return func() error {
+		s.iter = api.List(params)
+		if s.iter != nil {
+			s.pages = s.iter
+		}
+		return nil
+	}
+}
@@ -0,0 +1,70 @@
+package stripe
+
+import (
+	"testing"
+
+	context "golang.org/x/net/context"
+
+	"github.com/BTBurke/recur/pb"
+	"github.com/cenkalti/backoff"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/paymentsource"
+)
+
+type mockSource struct {
+	mock.Mock
+}
+
+func (m *mockSource) New(params *stripe.CustomerSourceParams) (*stripe.PaymentSource, error) {
+	args := m.Called(params.Customer, params.Source.Token)
+	return args.Get(0).(*stripe.PaymentSource), args.Error(1)
+}
+
+func (m *mockSource) Del(id string, params *stripe.CustomerSourceParams) (*stripe.PaymentSource, error) {
+	args := m.Called(id, params.Customer)
+	return args.Get(0).(*stripe.PaymentSource), args.Error(1)
+}
+
+func (m *mockSource) List(params *stripe.SourceListParams) *paymentsource.Iter {
+	args := m.Called(params)
+	return args.Get(0).(*paymentsource.Iter)
+}
+
+func TestRetryableSourceAttach(t *testing.T) {
+	card := &stripe.PaymentSource{
+		ID:   "card_test",
+		Type: stripe.PaymentSourceCard,
+		Card: &stripe.Card{ID: "card_test", Brand: "Visa", LastFour: "4242", Month: 8, Year: 2030, Funding: "credit"},
+	}
+	mck := new(mockSource)
+	mck.On("New", "cus_test", "tok_visa").Return(card, nil)
+
+	resp := new(pb.SourceResponse)
+	params := sourceAttachToSourceParams(context.Background(), "", &pb.AttachSourceRequest{Customer: "cus_test", Token: "tok_visa"})
+	err := backoff.Retry(retryableSourceAttach(params, mck, resp), backoff.WithContext(backoff.NewExponentialBackOff(), context.Background()))
+	assert.NoError(t, err)
+	mck.AssertExpectations(t)
+	got := resp.GetSuccess()
+	assert.Equal(t, "card_test", got.GetId())
+	assert.Equal(t, "cus_test", got.GetCustomer())
+	assert.Equal(t, pb.SourceType_CardSource, got.GetType())
+	assert.Equal(t, "4242", got.GetCard().GetLast4())
+	assert.Equal(t, uint32(8), got.GetCard().GetExpMonth())
+	assert.Equal(t, uint32(2030), got.GetCard().GetExpYear())
+}
+
+func TestSourceCardError(t *testing.T) {
+	declined := &stripe.Error{Type: stripe.ErrorTypeCard, Code: stripe.CardDeclined, HTTPStatusCode: 402, Msg: "Your card was declined."}
+	mck := new(mockSource)
+	mck.On("New", "cus_test", "tok_chargeDeclined").Return((*stripe.PaymentSource)(nil), declined).Once()
+
+	resp := new(pb.SourceResponse)
+	params := sourceAttachToSourceParams(context.Background(), "", &pb.AttachSourceRequest{Customer: "cus_test", Token: "tok_chargeDeclined"})
+	err := backoff.Retry(retryableSourceAttach(params, mck, resp), backoff.WithContext(backoff.NewExponentialBackOff(), context.Background()))
+	assert.Error(t, err)
+	mck.AssertExpectations(t)
+	assert.Equal(t, pb.ErrorType_Card, resp.GetError().GetType())
+	assert.Equal(t, pb.CardErrors_Declined, resp.GetError().GetCode())
+}
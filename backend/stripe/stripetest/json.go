@@ -0,0 +1,225 @@
+package stripetest
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/BTBurke/recur/pb"
+)
+
+// The functions in this file render resources as Stripe API JSON objects
+
+func planJSON(p *pb.Plan) map[string]interface{} {
+	if p == nil {
+		return nil
+	}
+	obj := map[string]interface{}{
+		"id":                   p.Id,
+		"object":               "plan",
+		"amount":               p.Amount,
+		"created":              p.Created,
+		"currency":             strings.ToLower(p.Currency.String()),
+		"interval":             strings.ToLower(p.Interval.String()),
+		"interval_count":       p.IntervalCount,
+		"livemode":             false,
+		"metadata":             meta(p.Metadata),
+		"name":                 p.Name,
+		"nickname":             p.Name,
+		"product":              nil,
+		"active":               p.Active,
+		"statement_descriptor": p.StatementDescriptor,
+		"trial_period_days":    p.TrialPeriodDays,
+		"billing_scheme":       enumJSON(p.BillingScheme.String()),
+		"tiers":                tiersJSON(p.Tiers),
+		"tiers_mode":           nil,
+		"usage_type":           enumJSON(p.UsageType.String()),
+		"aggregate_usage":      nil,
+	}
+	if len(p.Product) > 0 {
+		obj["product"] = p.Product
+	}
+	if p.TiersMode != pb.TiersMode_UnknownTiersMode {
+		obj["tiers_mode"] = enumJSON(p.TiersMode.String())
+	}
+	if p.AggregateUsage != pb.AggregateUsage_UnknownAggregateUsage {
+		obj["aggregate_usage"] = enumJSON(p.AggregateUsage.String())
+	}
+	return obj
+}
+
+func productJSON(p *pb.Product) map[string]interface{} {
+	if p == nil {
+		return nil
+	}
+	return map[string]interface{}{
+		"id":                   p.Id,
+		"object":               "product",
+		"active":               p.Active,
+		"created":              p.Created,
+		"updated":              p.Updated,
+		"livemode":             false,
+		"metadata":             meta(p.Metadata),
+		"name":                 p.Name,
+		"statement_descriptor": p.StatementDescriptor,
+		"type":                 "service",
+		"unit_label":           p.UnitLabel,
+	}
+}
+
+func tiersJSON(tiers []*pb.PlanTier) interface{} {
+	if len(tiers) == 0 {
+		return nil
+	}
+	out := make([]map[string]interface{}, len(tiers))
+	for i, t := range tiers {
+		out[i] = map[string]interface{}{"up_to": nil, "unit_amount": t.UnitAmount, "flat_amount": nil}
+		if t.UpTo > 0 {
+			out[i]["up_to"] = t.UpTo
+		}
+		if t.FlatAmount > 0 {
+			out[i]["flat_amount"] = t.FlatAmount
+		}
+	}
+	return out
+}
+
+// enumJSON renders a protobuf enum name such as PerUnit as the snake case Stripe value per_unit
+func enumJSON(name string) string {
+	var b strings.Builder
+	for i, r := range name {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+func customerJSON(c *pb.Customer) map[string]interface{} {
+	if c == nil {
+		return nil
+	}
+	obj := map[string]interface{}{
+		"id":              c.Id,
+		"object":          "customer",
+		"account_balance": c.AccountBalance,
+		"created":         c.Created,
+		"currency":        nil,
+		"default_source":  nil,
+		"delinquent":      c.Delinquent,
+		"description":     c.Description,
+		"email":           c.Email,
+		"livemode":        false,
+		"metadata":        meta(c.Metadata),
+		"business_vat_id": c.BusinessVatId,
+	}
+	if c.Currency != pb.Currency_UNK {
+		obj["currency"] = strings.ToLower(c.Currency.String())
+	}
+	if len(c.DefaultSource) > 0 {
+		obj["default_source"] = c.DefaultSource
+	}
+	return obj
+}
+
+func subscriptionJSON(s *pb.Subscription) map[string]interface{} {
+	if s == nil {
+		return nil
+	}
+	var status string
+	for k, v := range subscriptionStatuses {
+		if v == s.Status {
+			status = k
+		}
+	}
+	return map[string]interface{}{
+		"id":                   s.Id,
+		"object":               "subscription",
+		"cancel_at_period_end": s.CancelAtPeriodEnd,
+		"canceled_at":          nullable(s.CanceledAt),
+		"created":              s.Created,
+		"current_period_end":   s.CurrentPeriodEnd,
+		"current_period_start": s.CurrentPeriodStart,
+		"customer":             s.Customer,
+		"ended_at":             nullable(s.EndedAt),
+		"metadata":             meta(s.Metadata),
+		"plan":                 planJSON(s.Plan),
+		"quantity":             s.Quantity,
+		"start":                s.Start,
+		"status":               status,
+		"trial_end":            nullable(s.TrialEnd),
+		"trial_start":          nullable(s.TrialStart),
+		"items": map[string]interface{}{
+			"object":   "list",
+			"url":      "/v1/subscription_items?subscription=" + s.Id,
+			"has_more": false,
+			"data": []interface{}{map[string]interface{}{
+				"id":       s.SubscriptionItem,
+				"object":   "subscription_item",
+				"created":  s.Created,
+				"metadata": map[string]string{},
+				"plan":     planJSON(s.Plan),
+				"quantity": s.Quantity,
+			}},
+		},
+	}
+}
+
+func usageRecordJSON(r *pb.UsageRecord) map[string]interface{} {
+	if r == nil {
+		return nil
+	}
+	return map[string]interface{}{
+		"id":                r.Id,
+		"object":            "usage_record",
+		"livemode":          false,
+		"quantity":          r.Quantity,
+		"subscription_item": r.SubscriptionItem,
+		"timestamp":         r.Timestamp,
+	}
+}
+
+func usageSummaryJSON(s *pb.UsageRecordSummary) map[string]interface{} {
+	if s == nil {
+		return nil
+	}
+	var invoice interface{}
+	if len(s.Invoice) > 0 {
+		invoice = s.Invoice
+	}
+	return map[string]interface{}{
+		"id":                s.Id,
+		"object":            "usage_record_summary",
+		"invoice":           invoice,
+		"livemode":          false,
+		"period":            map[string]interface{}{"start": s.PeriodStart, "end": s.PeriodEnd},
+		"subscription_item": s.SubscriptionItem,
+		"total_usage":       s.TotalUsage,
+	}
+}
+
+func deletedJSON(id string) map[string]interface{} {
+	return map[string]interface{}{
+		"id":      id,
+		"deleted": true,
+	}
+}
+
+// meta renders empty metadata as an empty object rather than null
+func meta(m map[string]string) map[string]string {
+	if m == nil {
+		return map[string]string{}
+	}
+	return m
+}
+
+// nullable renders a zero timestamp as null
+func nullable(t int64) interface{} {
+	if t == 0 {
+		return nil
+	}
+	return t
+}
@@ -0,0 +1,426 @@
+package stripetest
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/BTBurke/recur/pb"
+	context "golang.org/x/net/context"
+)
+
+func handlePlans(w http.ResponseWriter, method string, id string, form url.Values, a *account) {
+	ctx := context.Background()
+	switch {
+	case method == http.MethodPost && len(id) == 0:
+		// plans that belong to a product are named by their nickname
+		name := form.Get("name")
+		if len(name) == 0 {
+			name = form.Get("nickname")
+		}
+		resp, err := a.plans.Create(ctx, &pb.CreatePlanRequest{
+			Id:                  form.Get("id"),
+			Amount:              parseUint(form.Get("amount")),
+			Currency:            pb.Currency(pb.Currency_value[strings.ToUpper(form.Get("currency"))]),
+			Interval:            parseInterval(form.Get("interval")),
+			IntervalCount:       parseUint(form.Get("interval_count")),
+			Metadata:            parseMeta(form),
+			Name:                name,
+			Product:             form.Get("product"),
+			StatementDescriptor: form.Get("statement_descriptor"),
+			TrialPeriodDays:     parseUint(form.Get("trial_period_days")),
+			BillingScheme:       pb.BillingScheme(parseEnum(form.Get("billing_scheme"), pb.BillingScheme_value)),
+			Tiers:               parseTiers(form),
+			TiersMode:           pb.TiersMode(parseEnum(form.Get("tiers_mode"), pb.TiersMode_value)),
+			UsageType:           pb.UsageType(parseEnum(form.Get("usage_type"), pb.UsageType_value)),
+			AggregateUsage:      pb.AggregateUsage(parseEnum(form.Get("aggregate_usage"), pb.AggregateUsage_value)),
+		})
+		writeResult(w, planJSON(resp.GetSuccess()), resp.GetError(), err)
+	case method == http.MethodPost:
+		// plans that belong to a product are named by their nickname, and newer API versions
+		// reject their name
+		name := form.Get("name")
+		if len(name) > 0 {
+			cur, _ := a.plans.Get(ctx, &pb.GetPlanRequest{Id: id})
+			if len(cur.GetSuccess().GetProduct()) > 0 {
+				writeError(w, http.StatusBadRequest, &pb.Error{
+					Type:    pb.ErrorType_InvalidRequest,
+					Message: "Received unknown parameter: name",
+					Param:   "name",
+				})
+				return
+			}
+		} else {
+			name = form.Get("nickname")
+		}
+		resp, err := a.plans.Update(ctx, &pb.UpdatePlanRequest{
+			Id:                  id,
+			Metadata:            parseMeta(form),
+			Name:                name,
+			Active:              parseActive(form.Get("active")),
+			StatementDescriptor: form.Get("statement_descriptor"),
+			TrialPeriodDays:     parseUint(form.Get("trial_period_days")),
+		})
+		writeResult(w, planJSON(resp.GetSuccess()), resp.GetError(), err)
+	case method == http.MethodGet && len(id) > 0:
+		resp, err := a.plans.Get(ctx, &pb.GetPlanRequest{Id: id})
+		writeResult(w, planJSON(resp.GetSuccess()), resp.GetError(), err)
+	case method == http.MethodGet:
+		stream, _ := a.plans.List(ctx, &pb.ListPlansRequest{
+			Product:       form.Get("product"),
+			Active:        parseActive(form.Get("active")),
+			Created:       parseCreated(form),
+			StartingAfter: form.Get("starting_after"),
+			EndingBefore:  form.Get("ending_before"),
+			Limit:         int32(parseLimit(form)),
+		})
+		writeList(w, "/v1/plans", form, func() (interface{}, *pb.Error, bool) {
+			if !stream.Next() {
+				return nil, stream.Current().GetError(), false
+			}
+			return planJSON(stream.Current().GetSuccess()), nil, true
+		})
+	case method == http.MethodDelete:
+		resp, err := a.plans.Delete(ctx, &pb.DeletePlanRequest{Id: id})
+		writeResult(w, deletedJSON(resp.GetSuccess().GetId()), resp.GetError(), err)
+	default:
+		writeNotAllowed(w, method)
+	}
+}
+
+func handleProducts(w http.ResponseWriter, method string, id string, form url.Values, a *account) {
+	ctx := context.Background()
+	switch {
+	case method == http.MethodPost && len(id) == 0:
+		resp, err := a.products.Create(ctx, &pb.CreateProductRequest{
+			Id:                  form.Get("id"),
+			Name:                form.Get("name"),
+			Metadata:            parseMeta(form),
+			StatementDescriptor: form.Get("statement_descriptor"),
+			UnitLabel:           form.Get("unit_label"),
+		})
+		writeResult(w, productJSON(resp.GetSuccess()), resp.GetError(), err)
+	case method == http.MethodPost:
+		resp, err := a.products.Update(ctx, &pb.UpdateProductRequest{
+			Id:                  id,
+			Name:                form.Get("name"),
+			Metadata:            parseMeta(form),
+			StatementDescriptor: form.Get("statement_descriptor"),
+			UnitLabel:           form.Get("unit_label"),
+			Active:              parseActive(form.Get("active")),
+		})
+		writeResult(w, productJSON(resp.GetSuccess()), resp.GetError(), err)
+	case method == http.MethodGet && len(id) > 0:
+		resp, err := a.products.Get(ctx, &pb.GetProductRequest{Id: id})
+		writeResult(w, productJSON(resp.GetSuccess()), resp.GetError(), err)
+	case method == http.MethodGet:
+		stream, _ := a.products.List(ctx, &pb.ListProductsRequest{
+			Active:        parseActive(form.Get("active")),
+			Created:       parseCreated(form),
+			StartingAfter: form.Get("starting_after"),
+			EndingBefore:  form.Get("ending_before"),
+			Limit:         int32(parseLimit(form)),
+		})
+		writeList(w, "/v1/products", form, func() (interface{}, *pb.Error, bool) {
+			if !stream.Next() {
+				return nil, stream.Current().GetError(), false
+			}
+			return productJSON(stream.Current().GetSuccess()), nil, true
+		})
+	case method == http.MethodDelete:
+		resp, err := a.products.Delete(ctx, &pb.DeleteProductRequest{Id: id})
+		writeResult(w, deletedJSON(resp.GetSuccess().GetId()), resp.GetError(), err)
+	default:
+		writeNotAllowed(w, method)
+	}
+}
+
+func handleCustomers(w http.ResponseWriter, method string, id string, form url.Values, a *account) {
+	ctx := context.Background()
+	switch {
+	case method == http.MethodPost && len(id) == 0:
+		resp, err := a.customers.Create(ctx, &pb.CreateCustomerRequest{
+			AccountBalance: parseInt(form.Get("account_balance")),
+			Description:    form.Get("description"),
+			Email:          form.Get("email"),
+			Metadata:       parseMeta(form),
+			BusinessVatId:  form.Get("business_vat_id"),
+		})
+		writeResult(w, customerJSON(resp.GetSuccess()), resp.GetError(), err)
+	case method == http.MethodPost:
+		resp, err := a.customers.Update(ctx, &pb.UpdateCustomerRequest{
+			Id:             id,
+			AccountBalance: parseInt(form.Get("account_balance")),
+			Description:    form.Get("description"),
+			Email:          form.Get("email"),
+			Metadata:       parseMeta(form),
+			BusinessVatId:  form.Get("business_vat_id"),
+		})
+		writeResult(w, customerJSON(resp.GetSuccess()), resp.GetError(), err)
+	case method == http.MethodGet && len(id) > 0:
+		resp, err := a.customers.Get(ctx, &pb.GetCustomerRequest{Id: id})
+		writeResult(w, customerJSON(resp.GetSuccess()), resp.GetError(), err)
+	case method == http.MethodGet:
+		stream, _ := a.customers.List(ctx, &pb.ListCustomersRequest{
+			Created:       parseCreated(form),
+			StartingAfter: form.Get("starting_after"),
+			EndingBefore:  form.Get("ending_before"),
+			Limit:         int32(parseLimit(form)),
+		})
+		writeList(w, "/v1/customers", form, func() (interface{}, *pb.Error, bool) {
+			if !stream.Next() {
+				return nil, stream.Current().GetError(), false
+			}
+			return customerJSON(stream.Current().GetSuccess()), nil, true
+		})
+	case method == http.MethodDelete:
+		resp, err := a.customers.Delete(ctx, &pb.DeleteCustomerRequest{Id: id})
+		writeResult(w, deletedJSON(resp.GetSuccess().GetId()), resp.GetError(), err)
+	default:
+		writeNotAllowed(w, method)
+	}
+}
+
+func handleSubscriptions(w http.ResponseWriter, method string, id string, form url.Values, a *account) {
+	ctx := context.Background()
+	switch {
+	case method == http.MethodPost && len(id) == 0:
+		resp, err := a.subscriptions.Create(ctx, &pb.CreateSubscriptionRequest{
+			Customer: form.Get("customer"),
+			Plan:     form.Get("plan"),
+			Quantity: parseUint(form.Get("quantity")),
+			TrialEnd: parseInt(form.Get("trial_end")),
+			Metadata: parseMeta(form),
+		})
+		writeResult(w, subscriptionJSON(resp.GetSuccess()), resp.GetError(), err)
+	case method == http.MethodPost:
+		// Setting the plan of a subscription that is canceled at period end reactivates it
+		if len(form.Get("plan")) > 0 {
+			current, _ := a.subscriptions.Get(ctx, &pb.GetSubscriptionRequest{Id: id})
+			if current.GetSuccess().GetCancelAtPeriodEnd() {
+				a.subscriptions.Reactivate(ctx, &pb.ReactivateSubscriptionRequest{Id: id})
+			}
+		}
+		resp, err := a.subscriptions.Update(ctx, &pb.UpdateSubscriptionRequest{
+			Id:            id,
+			Plan:          form.Get("plan"),
+			Quantity:      parseUint(form.Get("quantity")),
+			NoProrate:     form.Get("prorate") == "false",
+			ProrationDate: parseInt(form.Get("proration_date")),
+			Metadata:      parseMeta(form),
+		})
+		writeResult(w, subscriptionJSON(resp.GetSuccess()), resp.GetError(), err)
+	case method == http.MethodGet && len(id) > 0:
+		resp, err := a.subscriptions.Get(ctx, &pb.GetSubscriptionRequest{Id: id})
+		writeResult(w, subscriptionJSON(resp.GetSuccess()), resp.GetError(), err)
+	case method == http.MethodGet:
+		stream, _ := a.subscriptions.List(ctx, &pb.ListSubscriptionsRequest{
+			Customer:      form.Get("customer"),
+			Plan:          form.Get("plan"),
+			Status:        subscriptionStatuses[form.Get("status")],
+			Created:       parseCreated(form),
+			StartingAfter: form.Get("starting_after"),
+			EndingBefore:  form.Get("ending_before"),
+			Limit:         int32(parseLimit(form)),
+		})
+		writeList(w, "/v1/subscriptions", form, func() (interface{}, *pb.Error, bool) {
+			if !stream.Next() {
+				return nil, stream.Current().GetError(), false
+			}
+			return subscriptionJSON(stream.Current().GetSuccess()), nil, true
+		})
+	case method == http.MethodDelete:
+		resp, err := a.subscriptions.Cancel(ctx, &pb.CancelSubscriptionRequest{
+			Id:          id,
+			AtPeriodEnd: form.Get("at_period_end") == "true",
+		})
+		writeResult(w, subscriptionJSON(resp.GetSuccess()), resp.GetError(), err)
+	default:
+		writeNotAllowed(w, method)
+	}
+}
+
+// handleUsage serves the usage records and usage record summaries of a subscription item
+func handleUsage(w http.ResponseWriter, method string, item string, resource string, form url.Values, a *account) {
+	ctx := context.Background()
+	switch {
+	case method == http.MethodPost && resource == "usage_records":
+		action := pb.UsageAction_Increment
+		if form.Get("action") == "set" {
+			action = pb.UsageAction_Set
+		}
+		resp, err := a.usage.Create(ctx, &pb.CreateUsageRecordRequest{
+			SubscriptionItem: item,
+			Quantity:         parseUint(form.Get("quantity")),
+			Timestamp:        parseInt(form.Get("timestamp")),
+			Action:           action,
+		})
+		writeResult(w, usageRecordJSON(resp.GetSuccess()), resp.GetError(), err)
+	case method == http.MethodGet && resource == "usage_record_summaries":
+		stream, err := a.usage.ListSummaries(ctx, &pb.ListUsageRecordSummariesRequest{
+			SubscriptionItem: item,
+			StartingAfter:    form.Get("starting_after"),
+			EndingBefore:     form.Get("ending_before"),
+			Limit:            int32(parseLimit(form)),
+		})
+		if err != nil {
+			writeResult(w, nil, nil, err)
+			return
+		}
+		writeList(w, "/v1/subscription_items/"+item+"/usage_record_summaries", form, func() (interface{}, *pb.Error, bool) {
+			if !stream.Next() {
+				return nil, nil, false
+			}
+			if e := stream.Current().GetError(); e != nil {
+				return nil, e, false
+			}
+			return usageSummaryJSON(stream.Current().GetSuccess()), nil, true
+		})
+	default:
+		writeNotAllowed(w, method)
+	}
+}
+
+// writeList writes a single page of a list.  The next function returns the next object in the
+// list, or an error when the list could not be read.
+func writeList(w http.ResponseWriter, path string, form url.Values, next func() (interface{}, *pb.Error, bool)) {
+	limit := parseLimit(form)
+	data := []interface{}{}
+	hasMore := false
+	for {
+		obj, e, ok := next()
+		if e != nil {
+			writeError(w, http.StatusBadRequest, e)
+			return
+		}
+		if !ok {
+			break
+		}
+		if len(data) == limit {
+			hasMore = true
+			break
+		}
+		data = append(data, obj)
+	}
+	if len(form.Get("ending_before")) > 0 {
+		// the backend returns records nearest the cursor first, but each page is in list order
+		for i, j := 0, len(data)-1; i < j; i, j = i+1, j-1 {
+			data[i], data[j] = data[j], data[i]
+		}
+	}
+	writeJSON(w, map[string]interface{}{
+		"object":   "list",
+		"url":      path,
+		"has_more": hasMore,
+		"data":     data,
+	})
+}
+
+func writeNotAllowed(w http.ResponseWriter, method string) {
+	writeError(w, http.StatusMethodNotAllowed, &pb.Error{
+		Type:    pb.ErrorType_InvalidRequest,
+		Message: "Unsupported method " + method,
+	})
+}
+
+func parseUint(s string) uint64 {
+	v, _ := strconv.ParseUint(s, 10, 64)
+	return v
+}
+
+func parseInt(s string) int64 {
+	v, _ := strconv.ParseInt(s, 10, 64)
+	return v
+}
+
+func parseLimit(form url.Values) int {
+	limit := int(parseInt(form.Get("limit")))
+	switch {
+	case limit <= 0:
+		return 10
+	case limit > 100:
+		return 100
+	default:
+		return limit
+	}
+}
+
+// parseActive parses an active flag, which is unset when it is missing
+func parseActive(s string) pb.ActiveState {
+	switch s {
+	case "true":
+		return pb.ActiveState_ActiveTrue
+	case "false":
+		return pb.ActiveState_ActiveFalse
+	default:
+		return pb.ActiveState_ActiveUnset
+	}
+}
+
+func parseInterval(s string) pb.Interval {
+	return pb.Interval(pb.Interval_value[strings.Title(s)])
+}
+
+// parseMeta collects metadata[key] form fields
+func parseMeta(form url.Values) map[string]string {
+	var meta map[string]string
+	for k := range form {
+		if strings.HasPrefix(k, "metadata[") && strings.HasSuffix(k, "]") {
+			if meta == nil {
+				meta = make(map[string]string)
+			}
+			meta[k[len("metadata["):len(k)-1]] = form.Get(k)
+		}
+	}
+	return meta
+}
+
+// parseEnum returns the value of a snake case Stripe enum such as per_unit from the values of the
+// protobuf enum, which are named PerUnit
+func parseEnum(s string, values map[string]int32) int32 {
+	parts := strings.Split(s, "_")
+	for i, p := range parts {
+		parts[i] = strings.Title(p)
+	}
+	return values[strings.Join(parts, "")]
+}
+
+// parseTiers collects tiers[i][field] form fields.  An up_to of inf is the last tier.
+func parseTiers(form url.Values) []*pb.PlanTier {
+	var tiers []*pb.PlanTier
+	for i := 0; ; i++ {
+		prefix := fmt.Sprintf("tiers[%d]", i)
+		if _, ok := form[prefix+"[up_to]"]; !ok {
+			return tiers
+		}
+		tiers = append(tiers, &pb.PlanTier{
+			UpTo:       parseUint(form.Get(prefix + "[up_to]")),
+			UnitAmount: parseUint(form.Get(prefix + "[unit_amount]")),
+			FlatAmount: parseUint(form.Get(prefix + "[flat_amount]")),
+		})
+	}
+}
+
+// parseCreated collects created[gt] style range filters
+func parseCreated(form url.Values) *pb.ListFilter {
+	f := &pb.ListFilter{
+		Gt:  parseInt(form.Get("created[gt]")),
+		Gte: parseInt(form.Get("created[gte]")),
+		Lt:  parseInt(form.Get("created[lt]")),
+		Lte: parseInt(form.Get("created[lte]")),
+	}
+	if v := parseInt(form.Get("created")); v != 0 {
+		f.Gte, f.Lte = v, v
+	}
+	return f
+}
+
+var subscriptionStatuses = map[string]pb.SubscriptionStatus{
+	"trialing": pb.SubscriptionStatus_Trialing,
+	"active":   pb.SubscriptionStatus_Active,
+	"past_due": pb.SubscriptionStatus_PastDue,
+	"canceled": pb.SubscriptionStatus_Canceled,
+	"unpaid":   pb.SubscriptionStatus_Unpaid,
+}
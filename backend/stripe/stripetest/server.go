@@ -0,0 +1,278 @@
+// Package stripetest provides a local fake of the Stripe REST API for hermetic tests.
+//
+// The fake speaks enough of the API for the resources supported by recur: form encoded requests
+// to /v1/products, /v1/plans, /v1/customers, /v1/subscriptions and the usage records of
+// subscription items, list pagination, error envelopes, Idempotency-Key replays and per-account
+// state selected by the Stripe-Account header.  State is kept by the in-memory backend, so the
+// fake follows the same semantics as backend/memory.
+//
+//	srv := stripetest.NewServer()
+//	defer srv.Close()
+//	restore := srv.Install()
+//	defer restore()
+package stripetest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/BTBurke/recur/backend/memory"
+	"github.com/BTBurke/recur/pb"
+	"github.com/stripe/stripe-go"
+)
+
+// Key is a test secret key accepted by the fake server
+const Key = "sk_test_stripetest"
+
+// Server is a fake Stripe API server
+type Server struct {
+	*httptest.Server
+
+	mu          sync.Mutex
+	accounts    map[string]*account
+	idemMu      sync.Mutex
+	idempotent  map[string]*cachedResponse
+	requests    int64
+	lastHeaders http.Header
+}
+
+// account holds the resources of a single Stripe account
+type account struct {
+	products      *memory.ProductClient
+	plans         *memory.PlanClient
+	customers     *memory.CustomerClient
+	subscriptions *memory.SubscriptionClient
+	usage         *memory.UsageClient
+}
+
+type cachedResponse struct {
+	fingerprint string
+	status      int
+	body        []byte
+}
+
+// NewServer starts a fake Stripe server.  Call Close when finished.
+func NewServer() *Server {
+	s := &Server{
+		accounts:   make(map[string]*account),
+		idempotent: make(map[string]*cachedResponse),
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// Backend returns a Stripe backend that sends requests to the fake server
+func (s *Server) Backend() stripe.Backend {
+	return stripe.BackendConfiguration{
+		Type:       stripe.APIBackend,
+		URL:        s.URL + "/v1",
+		HTTPClient: s.Client(),
+	}
+}
+
+// Install points the global Stripe API backend at the fake server and returns a function that
+// restores the previous backend.  Clients capture the backend when they are created, so Install
+// must be called before creating them.
+func (s *Server) Install() func() {
+	prev := stripe.GetBackend(stripe.APIBackend)
+	stripe.SetBackend(stripe.APIBackend, s.Backend())
+	return func() {
+		stripe.SetBackend(stripe.APIBackend, prev)
+	}
+}
+
+// Requests returns the number of API requests handled, including idempotent replays
+func (s *Server) Requests() int {
+	return int(atomic.LoadInt64(&s.requests))
+}
+
+// LastHeaders returns the headers of the most recent request
+func (s *Server) LastHeaders() http.Header {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.lastHeaders
+}
+
+func (s *Server) account(id string) *account {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	a, ok := s.accounts[id]
+	if !ok {
+		store := memory.NewStore()
+		a = &account{
+			products:      memory.NewProductClient(store),
+			plans:         memory.NewPlanClient(store),
+			customers:     memory.NewCustomerClient(store),
+			subscriptions: memory.NewSubscriptionClient(store),
+			usage:         memory.NewUsageClient(store),
+		}
+		s.accounts[id] = a
+	}
+	return a
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	n := atomic.AddInt64(&s.requests, 1)
+	w.Header().Set("Request-Id", fmt.Sprintf("req_%d", n))
+	w.Header().Set("Content-Type", "application/json")
+	s.mu.Lock()
+	s.lastHeaders = r.Header
+	s.mu.Unlock()
+
+	key, _, _ := r.BasicAuth()
+	if len(key) == 0 {
+		writeError(w, http.StatusUnauthorized, &pb.Error{
+			Type:    pb.ErrorType_Authentication,
+			Message: "You did not provide an API key.",
+		})
+		return
+	}
+
+	body, _ := ioutil.ReadAll(r.Body)
+	form, err := parseForm(r, body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, &pb.Error{Type: pb.ErrorType_InvalidRequest, Message: err.Error()})
+		return
+	}
+
+	acct := r.Header.Get("Stripe-Account")
+	idem := r.Header.Get("Idempotency-Key")
+	if len(idem) == 0 || r.Method == http.MethodGet {
+		rec := httptest.NewRecorder()
+		s.route(rec, r, form, s.account(acct))
+		copyResponse(w, rec.Code, rec.Body.Bytes())
+		return
+	}
+
+	// Idempotent requests are replayed with the original response.  The lock is held for the
+	// whole request so that concurrent retries with the same key are serialized.
+	s.idemMu.Lock()
+	defer s.idemMu.Unlock()
+	cacheKey := acct + "|" + idem
+	fingerprint := r.Method + " " + r.URL.Path + "?" + form.Encode()
+	if cached, ok := s.idempotent[cacheKey]; ok {
+		if cached.fingerprint != fingerprint {
+			writeError(w, http.StatusBadRequest, &pb.Error{
+				Type:    pb.ErrorType_InvalidRequest,
+				Message: "Keys for idempotent requests can only be used with the same parameters they were first used with.",
+			})
+			return
+		}
+		w.Header().Set("Idempotent-Replayed", "true")
+		copyResponse(w, cached.status, cached.body)
+		return
+	}
+	rec := httptest.NewRecorder()
+	s.route(rec, r, form, s.account(acct))
+	s.idempotent[cacheKey] = &cachedResponse{fingerprint: fingerprint, status: rec.Code, body: rec.Body.Bytes()}
+	copyResponse(w, rec.Code, rec.Body.Bytes())
+}
+
+// route dispatches a request to the handler for the resource
+func (s *Server) route(w http.ResponseWriter, r *http.Request, form url.Values, a *account) {
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	if len(parts) == 4 && parts[0] == "v1" && parts[1] == "subscription_items" {
+		handleUsage(w, r.Method, parts[2], parts[3], form, a)
+		return
+	}
+	if len(parts) < 2 || len(parts) > 3 || parts[0] != "v1" {
+		writeError(w, http.StatusNotFound, &pb.Error{
+			Type:    pb.ErrorType_InvalidRequest,
+			Message: fmt.Sprintf("Unrecognized request URL (%s: %s).", r.Method, r.URL.Path),
+		})
+		return
+	}
+	var id string
+	if len(parts) == 3 {
+		id = parts[2]
+	}
+	switch parts[1] {
+	case "products":
+		handleProducts(w, r.Method, id, form, a)
+	case "plans":
+		handlePlans(w, r.Method, id, form, a)
+	case "customers":
+		handleCustomers(w, r.Method, id, form, a)
+	case "subscriptions":
+		handleSubscriptions(w, r.Method, id, form, a)
+	default:
+		writeError(w, http.StatusNotFound, &pb.Error{
+			Type:    pb.ErrorType_InvalidRequest,
+			Message: fmt.Sprintf("Unrecognized request URL (%s: %s).", r.Method, r.URL.Path),
+		})
+	}
+}
+
+// parseForm parses the query string and form encoded body.  The standard library only reads
+// the body of POST, PUT and PATCH requests, but Stripe also accepts a body with DELETE.
+func parseForm(r *http.Request, body []byte) (url.Values, error) {
+	form := r.URL.Query()
+	values, err := url.ParseQuery(string(body))
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range values {
+		form[k] = append(form[k], v...)
+	}
+	return form, nil
+}
+
+func copyResponse(w http.ResponseWriter, status int, body []byte) {
+	w.WriteHeader(status)
+	w.Write(body)
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	var buf bytes.Buffer
+	json.NewEncoder(&buf).Encode(v)
+	w.WriteHeader(http.StatusOK)
+	w.Write(buf.Bytes())
+}
+
+// writeError writes a Stripe error envelope
+func writeError(w http.ResponseWriter, status int, e *pb.Error) {
+	if e.HttpStatusCode != 0 {
+		status = int(e.HttpStatusCode)
+	}
+	inner := map[string]interface{}{
+		"type":    errorTypes[e.Type],
+		"message": e.Message,
+	}
+	if len(e.Param) > 0 {
+		inner["param"] = e.Param
+	}
+	var buf bytes.Buffer
+	json.NewEncoder(&buf).Encode(map[string]interface{}{"error": inner})
+	w.WriteHeader(status)
+	w.Write(buf.Bytes())
+}
+
+// writeResult writes either the success object or the error from a backend response
+func writeResult(w http.ResponseWriter, obj interface{}, e *pb.Error, err error) {
+	switch {
+	case err != nil:
+		writeError(w, http.StatusBadRequest, &pb.Error{Type: pb.ErrorType_InvalidRequest, Message: err.Error()})
+	case e != nil:
+		writeError(w, http.StatusBadRequest, e)
+	default:
+		writeJSON(w, obj)
+	}
+}
+
+var errorTypes = map[pb.ErrorType]string{
+	pb.ErrorType_API:            "api_error",
+	pb.ErrorType_APIConnection:  "api_connection_error",
+	pb.ErrorType_Authentication: "authentication_error",
+	pb.ErrorType_Card:           "card_error",
+	pb.ErrorType_InvalidRequest: "invalid_request_error",
+	pb.ErrorType_Permission:     "permission_error",
+	pb.ErrorType_RateLimit:      "rate_limit_error",
+}
@@ -0,0 +1,86 @@
+package stripetest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/customer"
+	"github.com/stripe/stripe-go/plan"
+)
+
+func newPlanClient(srv *Server) plan.Client {
+	return plan.Client{B: srv.Backend(), Key: Key}
+}
+
+func TestIdempotentReplay(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	c := customer.Client{B: srv.Backend(), Key: Key}
+
+	params := &stripe.CustomerParams{Email: "test@example.com"}
+	params.IdempotencyKey = "key1"
+	c1, err := c.New(params)
+	assert.NoError(t, err)
+	c2, err := c.New(params)
+	assert.NoError(t, err)
+	assert.Equal(t, c1.ID, c2.ID)
+
+	params.Email = "other@example.com"
+	_, err = c.New(params)
+	assert.Error(t, err)
+
+	var n int
+	iter := c.List(nil)
+	for iter.Next() {
+		n++
+	}
+	assert.NoError(t, iter.Err())
+	assert.Equal(t, 1, n)
+}
+
+func TestAccountIsolation(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	c := newPlanClient(srv)
+
+	params := &stripe.PlanParams{ID: "test", Name: "test", Amount: 1000, Currency: "usd", Interval: "month"}
+	params.StripeAccount = "acct_1"
+	_, err := c.New(params)
+	assert.NoError(t, err)
+	assert.Equal(t, "acct_1", srv.LastHeaders().Get("Stripe-Account"))
+
+	_, err = c.Get("test", nil)
+	assert.Error(t, err)
+
+	get := &stripe.PlanParams{}
+	get.StripeAccount = "acct_1"
+	p, err := c.Get("test", get)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1000), p.Amount)
+}
+
+func TestListPagination(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	c := newPlanClient(srv)
+
+	for _, id := range []string{"a", "b", "c"} {
+		_, err := c.New(&stripe.PlanParams{ID: id, Name: id, Amount: 100, Currency: "usd", Interval: "month"})
+		assert.NoError(t, err)
+	}
+	params := &stripe.PlanListParams{}
+	params.Limit = 2
+	iter := c.List(params)
+	var got []string
+	for iter.Next() {
+		got = append(got, iter.Plan().ID)
+	}
+	assert.NoError(t, iter.Err())
+	assert.Equal(t, []string{"c", "b", "a"}, got)
+
+	_, err := c.Get("missing", nil)
+	if assert.Error(t, err) {
+		assert.Equal(t, 404, err.(*stripe.Error).HTTPStatusCode)
+	}
+}
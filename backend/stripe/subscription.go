@@ -0,0 +1,128 @@
+package stripe
+
+import (
+	"github.com/BTBurke/recur/backend"
+	"github.com/BTBurke/recur/pb"
+	"github.com/stripe/stripe-go/sub"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stripe/stripe-go"
+	context "golang.org/x/net/context"
+)
+
+// interface for the Stripe subscription API
+type subscriptionClient interface {
+	New(params *stripe.SubParams) (*stripe.Sub, error)
+	Get(id string, params *stripe.SubParams) (*stripe.Sub, error)
+	Update(id string, params *stripe.SubParams) (*stripe.Sub, error)
+	Cancel(id string, params *stripe.SubParams) (*stripe.Sub, error)
+	List(params *stripe.SubListParams) *sub.Iter
+}
+
+type StripeSubscriptionClient struct {
+	key    string
+	logger log.StdLogger
+	// api allows mocking the Stripe backend
+	api subscriptionClient
+	// policy controls retries of failed requests
+	policy backend.RetryPolicy
+}
+
+func NewSubscriptionClient(key string, logger log.StdLogger, opts ...Option) *StripeSubscriptionClient {
+	o := newOptions(opts)
+	return &StripeSubscriptionClient{
+		key:    key,
+		logger: logger,
+		policy: o.retry,
+		api: sub.Client{
+			B:   stripe.GetBackend(stripe.SupportedBackend("api")),
+			Key: key,
+		},
+	}
+}
+
+func (s *StripeSubscriptionClient) Create(ctx context.Context, req *pb.CreateSubscriptionRequest) (*pb.SubscriptionResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	ctx, key := withIdempotencyKey(ctx)
+	params := subscriptionCreateToSubParams(ctx, s.key, req)
+	resp := new(pb.SubscriptionResponse)
+	err := retry(ctx, s.policy, retryableSubscription("", params, s.api, resp, subscriptionCreate))
+	reportIdempotencyKey(s.logger, "subscription create", key, resp.GetError(), err)
+	return resp, err
+}
+
+func (s *StripeSubscriptionClient) Update(ctx context.Context, req *pb.UpdateSubscriptionRequest) (*pb.SubscriptionResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	ctx, key := withIdempotencyKey(ctx)
+	params := subscriptionUpdateToSubParams(ctx, s.key, req)
+	resp := new(pb.SubscriptionResponse)
+	err := retry(ctx, s.policy, retryableSubscription(req.Id, params, s.api, resp, subscriptionUpdate))
+	reportIdempotencyKey(s.logger, "subscription update", key, resp.GetError(), err)
+	return resp, err
+}
+
+// Cancel cancels a subscription immediately or at the end of the current billing period
+func (s *StripeSubscriptionClient) Cancel(ctx context.Context, req *pb.CancelSubscriptionRequest) (*pb.SubscriptionResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	ctx, key := withIdempotencyKey(ctx)
+	params := subscriptionCancelToSubParams(ctx, s.key, req)
+	resp := new(pb.SubscriptionResponse)
+	err := retry(ctx, s.policy, retryableSubscription(req.Id, params, s.api, resp, subscriptionCancel))
+	reportIdempotencyKey(s.logger, "subscription cancel", key, resp.GetError(), err)
+	return resp, err
+}
+
+// Reactivate restores a subscription that was canceled at period end.  Stripe does not allow
+// subscriptions that were canceled immediately to be reactivated.
+func (s *StripeSubscriptionClient) Reactivate(ctx context.Context, req *pb.ReactivateSubscriptionRequest) (*pb.SubscriptionResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	ctx, key := withIdempotencyKey(ctx)
+	params := &stripe.SubParams{
+		Params: paramsFromContext(ctx, s.key, nil),
+	}
+	resp := new(pb.SubscriptionResponse)
+	err := retry(ctx, s.policy, retryableSubscription(req.Id, params, s.api, resp, subscriptionReactivate))
+	reportIdempotencyKey(s.logger, "subscription reactivate", key, resp.GetError(), err)
+	return resp, err
+}
+
+func (s *StripeSubscriptionClient) Get(ctx context.Context, req *pb.GetSubscriptionRequest) (*pb.SubscriptionResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	params := &stripe.SubParams{
+		Params: paramsFromContext(ctx, s.key, nil),
+	}
+	resp := new(pb.SubscriptionResponse)
+	err := retry(ctx, s.policy, retryableSubscription(req.Id, params, s.api, resp, subscriptionGet))
+	return resp, err
+}
+
+// subscriptionStreamer implements the SubscriptionStreamer interface, converting Stripe responses
+// to a SubscriptionResponse.
+type subscriptionStreamer struct {
+	listIter
+	iter *sub.Iter
+}
+
+func (s *subscriptionStreamer) Current() *pb.SubscriptionResponse {
+	if e := s.errorResponse(); e != nil {
+		return &pb.SubscriptionResponse{Responses: &pb.SubscriptionResponse_Error{Error: e}}
+	}
+	return respToSubscriptionSuccess(s.iter.Sub())
+}
+
+func (s *StripeSubscriptionClient) List(ctx context.Context, req *pb.ListSubscriptionsRequest) (backend.SubscriptionStreamer, error) {
+	params := subscriptionListToListParams(ctx, s.key, req)
+	streamer := &subscriptionStreamer{listIter: listIter{ctx: ctx}}
+	err := retry(ctx, s.policy, retryableSubscriptionList(params, s.api, streamer))
+	return streamer, err
+}
@@ -0,0 +1,65 @@
+package stripe
+
+import (
+	"testing"
+
+	"github.com/BTBurke/recur/pb"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	context "golang.org/x/net/context"
+)
+
+func TestSubscriptionIntegration(t *testing.T) {
+	key, done := getAPIKey(t)
+	defer done()
+	plans := NewPlanClient(key, log.New())
+	customers := NewCustomerClient(key, log.New())
+	subs := NewSubscriptionClient(key, log.New())
+	ctx := context.Background()
+
+	plan, err := plans.Create(ctx, &pb.CreatePlanRequest{
+		Id:       "test-sub-plan",
+		Amount:   1000,
+		Currency: pb.Currency_USD,
+		Name:     "test",
+		Interval: pb.Interval_Month,
+	})
+	if err != nil || plan.GetError() != nil {
+		t.Fatalf("Failed to create plan: %v %v", err, plan.GetError())
+	}
+	defer plans.Delete(ctx, &pb.DeletePlanRequest{Id: "test-sub-plan"})
+
+	cus, err := customers.Create(ctx, &pb.CreateCustomerRequest{Email: "test@example.com"})
+	if err != nil || cus.GetError() != nil {
+		t.Fatalf("Failed to create customer: %v %v", err, cus.GetError())
+	}
+	cusID := cus.GetSuccess().GetId()
+	defer customers.Delete(ctx, &pb.DeleteCustomerRequest{Id: cusID})
+
+	resp, err := subs.Create(ctx, &pb.CreateSubscriptionRequest{Customer: cusID, Plan: "test-sub-plan"})
+	assert.NoError(t, err)
+	sub := resp.GetSuccess()
+	assert.Equal(t, cusID, sub.GetCustomer())
+	assert.Equal(t, "test-sub-plan", sub.GetPlan().GetId())
+	assert.Equal(t, pb.SubscriptionStatus_Active, sub.GetStatus())
+
+	resp, err = subs.Cancel(ctx, &pb.CancelSubscriptionRequest{Id: sub.GetId(), AtPeriodEnd: true})
+	assert.NoError(t, err)
+	assert.True(t, resp.GetSuccess().GetCancelAtPeriodEnd())
+
+	resp, err = subs.Reactivate(ctx, &pb.ReactivateSubscriptionRequest{Id: sub.GetId()})
+	assert.NoError(t, err)
+	assert.False(t, resp.GetSuccess().GetCancelAtPeriodEnd())
+
+	list, err := subs.List(ctx, &pb.ListSubscriptionsRequest{Customer: cusID})
+	assert.NoError(t, err)
+	var got []string
+	for list.Next() {
+		got = append(got, list.Current().GetSuccess().GetId())
+	}
+	assert.Equal(t, []string{sub.GetId()}, got)
+
+	resp, err = subs.Cancel(ctx, &pb.CancelSubscriptionRequest{Id: sub.GetId()})
+	assert.NoError(t, err)
+	assert.Equal(t, pb.SubscriptionStatus_Canceled, resp.GetSuccess().GetStatus())
+}
@@ -0,0 +1,141 @@
+package stripe
+
+import (
+	"strings"
+
+	"github.com/BTBurke/recur/pb"
+	"github.com/stripe/stripe-go"
+	context "golang.org/x/net/context"
+)
+
+// convert from a subscription create request to SubParams
+func subscriptionCreateToSubParams(ctx context.Context, key string, req *pb.CreateSubscriptionRequest) *stripe.SubParams {
+	return &stripe.SubParams{
+		Params:   paramsFromContext(ctx, key, &req.Metadata),
+		Customer: req.Customer,
+		Plan:     req.Plan,
+		Quantity: req.Quantity,
+		TrialEnd: req.TrialEnd,
+	}
+}
+
+// convert from a subscription update request to SubParams
+func subscriptionUpdateToSubParams(ctx context.Context, key string, req *pb.UpdateSubscriptionRequest) *stripe.SubParams {
+	return &stripe.SubParams{
+		Params:        paramsFromContext(ctx, key, &req.Metadata),
+		Plan:          req.Plan,
+		Quantity:      req.Quantity,
+		NoProrate:     req.NoProrate,
+		ProrationDate: req.ProrationDate,
+	}
+}
+
+// convert from a subscription cancel request to SubParams
+func subscriptionCancelToSubParams(ctx context.Context, key string, req *pb.CancelSubscriptionRequest) *stripe.SubParams {
+	return &stripe.SubParams{
+		Params:    paramsFromContext(ctx, key, nil),
+		EndCancel: req.AtPeriodEnd,
+	}
+}
+
+func subscriptionListToListParams(ctx context.Context, key string, req *pb.ListSubscriptionsRequest) *stripe.SubListParams {
+	switch {
+	case req == nil:
+		return &stripe.SubListParams{
+			ListParams: stripe.ListParams{
+				Limit: 10,
+			},
+		}
+	default:
+		return &stripe.SubListParams{
+			ListParams: stripe.ListParams{
+				Start: req.StartingAfter,
+				End:   req.EndingBefore,
+				Limit: defaultInt(int(req.Limit), 10),
+			},
+			CreatedRange: &stripe.RangeQueryParams{
+				GreaterThan:        req.GetCreated().GetGt(),
+				GreaterThanOrEqual: req.GetCreated().GetGte(),
+				LesserThan:         req.GetCreated().GetLt(),
+				LesserThanOrEqual:  req.GetCreated().GetLte(),
+			},
+			Customer: req.Customer,
+			Plan:     req.Plan,
+			Status:   pbToStripeSubStatus(req.Status),
+		}
+	}
+}
+
+// convert a success response from Stripe to a SubscriptionResponse (success)
+func respToSubscriptionSuccess(s *stripe.Sub) *pb.SubscriptionResponse {
+	var customer string
+	if s.Customer != nil {
+		customer = s.Customer.ID
+	}
+	var plan *pb.Plan
+	if s.Plan != nil {
+		plan = stripeToPbPlan(&stripePlan{Plan: *s.Plan})
+	}
+	// recur subscriptions have a single plan, so usage is reported against the first item
+	var item string
+	if s.Items != nil && len(s.Items.Values) > 0 {
+		item = s.Items.Values[0].ID
+	}
+	return &pb.SubscriptionResponse{
+		Responses: &pb.SubscriptionResponse_Success{
+			Success: &pb.Subscription{
+				Id:                 s.ID,
+				Customer:           customer,
+				Plan:               plan,
+				Quantity:           s.Quantity,
+				Status:             stripeToPbSubStatus(s.Status),
+				CancelAtPeriodEnd:  s.EndCancel,
+				CanceledAt:         s.Canceled,
+				Created:            s.Created,
+				CurrentPeriodStart: s.PeriodStart,
+				CurrentPeriodEnd:   s.PeriodEnd,
+				EndedAt:            s.Ended,
+				Metadata:           s.Meta,
+				Start:              s.Start,
+				TrialStart:         s.TrialStart,
+				TrialEnd:           s.TrialEnd,
+				Discount:           stripeToPbDiscount(s.Discount),
+				SubscriptionItem:   item,
+			},
+		},
+	}
+}
+
+// convert an error response from Stripe to a SubscriptionResponse (error)
+func respToSubscriptionError(err *stripe.Error) *pb.SubscriptionResponse {
+	return &pb.SubscriptionResponse{
+		Responses: &pb.SubscriptionResponse_Error{
+			Error: respToError(err),
+		},
+	}
+}
+
+// constant conversions from stripe to protobuf - subscription status
+func stripeToPbSubStatus(s stripe.SubStatus) pb.SubscriptionStatus {
+	lookup := map[stripe.SubStatus]pb.SubscriptionStatus{
+		"trialing": pb.SubscriptionStatus_Trialing,
+		"active":   pb.SubscriptionStatus_Active,
+		"past_due": pb.SubscriptionStatus_PastDue,
+		"canceled": pb.SubscriptionStatus_Canceled,
+		"unpaid":   pb.SubscriptionStatus_Unpaid,
+	}
+	return lookup[s]
+}
+
+// constant conversions from protobuf to stripe - subscription status.  An unknown status
+// lists subscriptions in any state.
+func pbToStripeSubStatus(s pb.SubscriptionStatus) stripe.SubStatus {
+	switch s {
+	case pb.SubscriptionStatus_UnknownStatus:
+		return stripe.SubStatus("all")
+	case pb.SubscriptionStatus_PastDue:
+		return stripe.SubStatus("past_due")
+	default:
+		return stripe.SubStatus(strings.ToLower(pb.SubscriptionStatus_name[int32(s)]))
+	}
+}
@@ -0,0 +1,60 @@
+package stripe
+
+import (
+	"github.com/BTBurke/recur/pb"
+	"github.com/cenkalti/backoff"
+	"github.com/stripe/stripe-go"
+)
+
+type subscriptionAction int
+
+const (
+	subscriptionCreate subscriptionAction = iota
+	subscriptionUpdate
+	subscriptionCancel
+	subscriptionReactivate
+	subscriptionGet
+)
+
+func retryableSubscription(id string, params *stripe.SubParams, api subscriptionClient, s *pb.SubscriptionResponse, action subscriptionAction) backoff.Operation {
+	return func() error {
+		var sub = new(stripe.Sub)
+		var err error
+		switch action {
+		case subscriptionCreate:
+			sub, err = api.New(params)
+		case subscriptionUpdate:
+			sub, err = api.Update(id, params)
+		case subscriptionCancel:
+			sub, err = api.Cancel(id, params)
+		case subscriptionReactivate:
+			// reactivation is an update that sets the plan to the current plan
+			sub, err = api.Get(id, &stripe.SubParams{Params: params.Params})
+			if err == nil && sub.Plan != nil {
+				params.Plan = sub.Plan.ID
+				sub, err = api.Update(id, params)
+			}
+		case subscriptionGet:
+			sub, err = api.Get(id, params)
+		default:
+		}
+		if err != nil {
+			if stripeErr, ok := err.(*stripe.Error); ok {
+				*s = *respToSubscriptionError(stripeErr)
+			}
+			return classify(err)
+		}
+		*s = *respToSubscriptionSuccess(sub)
+		return nil
+	}
+}
+
+func retryableSubscriptionList(params *stripe.SubListParams, api subscriptionClient, s *subscriptionStreamer) backoff.Operation {
+	return func() error {
+		s.iter = api.List(params)
+		if s.iter != nil {
+			s.pages = s.iter
+		}
+		return nil
+	}
+}
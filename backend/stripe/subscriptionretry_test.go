@@ -0,0 +1,102 @@
+package stripe
+
+import (
+	"fmt"
+	"testing"
+
+	context "golang.org/x/net/context"
+
+	"github.com/BTBurke/recur/pb"
+	"github.com/cenkalti/backoff"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/sub"
+)
+
+type mockSubscription struct {
+	mock.Mock
+}
+
+func (m *mockSubscription) New(params *stripe.SubParams) (*stripe.Sub, error) {
+	args := m.Called(params)
+	return args.Get(0).(*stripe.Sub), args.Error(1)
+}
+
+func (m *mockSubscription) Get(id string, params *stripe.SubParams) (*stripe.Sub, error) {
+	args := m.Called(id)
+	return args.Get(0).(*stripe.Sub), args.Error(1)
+}
+
+func (m *mockSubscription) Update(id string, params *stripe.SubParams) (*stripe.Sub, error) {
+	args := m.Called(id, params.Plan)
+	return args.Get(0).(*stripe.Sub), args.Error(1)
+}
+
+func (m *mockSubscription) Cancel(id string, params *stripe.SubParams) (*stripe.Sub, error) {
+	args := m.Called(id, params.EndCancel)
+	return args.Get(0).(*stripe.Sub), args.Error(1)
+}
+
+func (m *mockSubscription) List(params *stripe.SubListParams) *sub.Iter {
+	args := m.Called(params)
+	return args.Get(0).(*sub.Iter)
+}
+
+func TestRetryableSubscription(t *testing.T) {
+	canceling := &stripe.Sub{
+		ID:        "sub_test",
+		Customer:  &stripe.Customer{ID: "cus_test"},
+		Plan:      &stripe.Plan{ID: "gold", Currency: "usd", Interval: "month"},
+		Status:    "active",
+		EndCancel: true,
+	}
+	active := *canceling
+	active.EndCancel = false
+
+	tt := []struct {
+		Name   string
+		Action subscriptionAction
+		Setup  func(m *mockSubscription)
+		Expect *stripe.Sub
+	}{
+		{Name: "cancel at period end", Action: subscriptionCancel, Expect: canceling, Setup: func(m *mockSubscription) {
+			m.On("Cancel", "sub_test", true).Return(canceling, nil)
+		}},
+		{Name: "reactivate", Action: subscriptionReactivate, Expect: &active, Setup: func(m *mockSubscription) {
+			m.On("Get", "sub_test").Return(canceling, nil)
+			m.On("Update", "sub_test", "gold").Return(&active, nil)
+		}},
+		{Name: "retry on network error", Action: subscriptionGet, Expect: &active, Setup: func(m *mockSubscription) {
+			m.On("Get", "sub_test").Return((*stripe.Sub)(nil), fmt.Errorf("test retry")).Once()
+			m.On("Get", "sub_test").Return(&active, nil).Once()
+		}},
+	}
+	for _, tc := range tt {
+		t.Run(tc.Name, func(t *testing.T) {
+			mck := new(mockSubscription)
+			tc.Setup(mck)
+			resp := new(pb.SubscriptionResponse)
+
+			err := backoff.Retry(
+				retryableSubscription("sub_test", &stripe.SubParams{EndCancel: true}, mck, resp, tc.Action),
+				backoff.WithContext(backoff.NewExponentialBackOff(), context.Background()),
+			)
+			assert.NoError(t, err)
+			mck.AssertExpectations(t)
+			got := resp.GetSuccess()
+			assert.Equal(t, tc.Expect.ID, got.GetId())
+			assert.Equal(t, "cus_test", got.GetCustomer())
+			assert.Equal(t, "gold", got.GetPlan().GetId())
+			assert.Equal(t, pb.SubscriptionStatus_Active, got.GetStatus())
+			assert.Equal(t, tc.Expect.EndCancel, got.GetCancelAtPeriodEnd())
+		})
+	}
+}
+
+func TestSubStatusConversion(t *testing.T) {
+	assert.Equal(t, stripe.SubStatus("all"), pbToStripeSubStatus(pb.SubscriptionStatus_UnknownStatus))
+	assert.Equal(t, stripe.SubStatus("past_due"), pbToStripeSubStatus(pb.SubscriptionStatus_PastDue))
+	assert.Equal(t, stripe.SubStatus("trialing"), pbToStripeSubStatus(pb.SubscriptionStatus_Trialing))
+	assert.Equal(t, pb.SubscriptionStatus_PastDue, stripeToPbSubStatus("past_due"))
+}
@@ -0,0 +1,76 @@
+package stripe
+
+import (
+	"github.com/BTBurke/recur/backend"
+	"github.com/BTBurke/recur/pb"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stripe/stripe-go"
+	context "golang.org/x/net/context"
+)
+
+// interface for the Stripe usage record API
+type usageClient interface {
+	New(params *usageRecordParams) (*stripeUsageRecord, error)
+	ListSummaries(params *usageSummaryListParams) *usageSummaryIter
+}
+
+type StripeUsageClient struct {
+	key    string
+	logger log.StdLogger
+	// api allows mocking the Stripe backend
+	api usageClient
+	// policy controls retries of failed requests
+	policy backend.RetryPolicy
+}
+
+func NewUsageClient(key string, logger log.StdLogger, opts ...Option) *StripeUsageClient {
+	o := newOptions(opts)
+	return &StripeUsageClient{
+		key:    key,
+		logger: logger,
+		policy: o.retry,
+		api: usageAPI{
+			B:   stripe.GetBackend(stripe.SupportedBackend("api")),
+			Key: key,
+		},
+	}
+}
+
+// Create reports usage for a subscription item.  Incrementing usage is not idempotent, so the
+// same idempotency key is sent on every retry to keep Stripe from counting the usage twice.
+func (u *StripeUsageClient) Create(ctx context.Context, req *pb.CreateUsageRecordRequest) (*pb.UsageRecordResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	ctx, key := withIdempotencyKey(ctx)
+	params := usageCreateToUsageRecordParams(ctx, u.key, req)
+	resp := new(pb.UsageRecordResponse)
+	err := retry(ctx, u.policy, retryableUsageRecord(params, u.api, resp))
+	reportIdempotencyKey(u.logger, "usage record create", key, resp.GetError(), err)
+	return resp, err
+}
+
+// usageSummaryStreamer implements the UsageRecordSummaryStreamer interface, converting Stripe
+// responses to a UsageRecordSummaryResponse
+type usageSummaryStreamer struct {
+	listIter
+	iter *usageSummaryIter
+}
+
+func (s *usageSummaryStreamer) Current() *pb.UsageRecordSummaryResponse {
+	if e := s.errorResponse(); e != nil {
+		return &pb.UsageRecordSummaryResponse{Responses: &pb.UsageRecordSummaryResponse_Error{Error: e}}
+	}
+	return respToUsageSummarySuccess(s.iter.Summary())
+}
+
+func (u *StripeUsageClient) ListSummaries(ctx context.Context, req *pb.ListUsageRecordSummariesRequest) (backend.UsageRecordSummaryStreamer, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	params := usageSummaryListToListParams(ctx, u.key, req)
+	streamer := &usageSummaryStreamer{listIter: listIter{ctx: ctx}}
+	err := retry(ctx, u.policy, retryableUsageSummaryList(params, u.api, streamer))
+	return streamer, err
+}
@@ -0,0 +1,65 @@
+package stripe
+
+import (
+	"testing"
+
+	"github.com/BTBurke/recur/backend"
+	"github.com/BTBurke/recur/pb"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	context "golang.org/x/net/context"
+)
+
+func TestUsageIntegration(t *testing.T) {
+	key, done := getAPIKey(t)
+	defer done()
+	plans := NewPlanClient(key, log.New())
+	customers := NewCustomerClient(key, log.New())
+	subs := NewSubscriptionClient(key, log.New())
+	usage := NewUsageClient(key, log.New())
+	ctx := context.Background()
+
+	plan, err := plans.Create(ctx, &pb.CreatePlanRequest{
+		Id:        "test-usage-plan",
+		Amount:    100,
+		Currency:  pb.Currency_USD,
+		Name:      "test",
+		Interval:  pb.Interval_Month,
+		UsageType: pb.UsageType_Metered,
+	})
+	if err != nil || plan.GetError() != nil {
+		t.Fatalf("Failed to create plan: %v %v", err, plan.GetError())
+	}
+	defer plans.Delete(ctx, &pb.DeletePlanRequest{Id: "test-usage-plan"})
+
+	cus, err := customers.Create(ctx, &pb.CreateCustomerRequest{Email: "test@example.com"})
+	if err != nil || cus.GetError() != nil {
+		t.Fatalf("Failed to create customer: %v %v", err, cus.GetError())
+	}
+	cusID := cus.GetSuccess().GetId()
+	defer customers.Delete(ctx, &pb.DeleteCustomerRequest{Id: cusID})
+
+	sub, err := subs.Create(ctx, &pb.CreateSubscriptionRequest{Customer: cusID, Plan: "test-usage-plan"})
+	if err != nil || sub.GetError() != nil {
+		t.Fatalf("Failed to create subscription: %v %v", err, sub.GetError())
+	}
+	defer subs.Cancel(ctx, &pb.CancelSubscriptionRequest{Id: sub.GetSuccess().GetId()})
+	item := sub.GetSuccess().GetSubscriptionItem()
+	assert.NotEmpty(t, item)
+
+	keyed := backend.WithIdempotencyKey(ctx, "test-usage-"+item)
+	for i := 0; i < 2; i++ {
+		resp, err := usage.Create(keyed, &pb.CreateUsageRecordRequest{SubscriptionItem: item, Quantity: 5})
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(5), resp.GetSuccess().GetQuantity())
+	}
+
+	list, err := usage.ListSummaries(ctx, &pb.ListUsageRecordSummariesRequest{SubscriptionItem: item})
+	assert.NoError(t, err)
+	var total uint64
+	for list.Next() {
+		total += list.Current().GetSuccess().GetTotalUsage()
+	}
+	assert.NoError(t, list.Err())
+	assert.Equal(t, uint64(5), total, "usage reported twice with the same idempotency key is counted once")
+}
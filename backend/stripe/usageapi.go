@@ -0,0 +1,99 @@
+package stripe
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/stripe/stripe-go"
+)
+
+// usageRecordParams are the parameters for reporting usage of a subscription item.  The
+// vendored stripe-go predates metered billing, so usage records are sent with usageAPI.
+type usageRecordParams struct {
+	stripe.Params
+	SubscriptionItem string
+	Quantity         uint64
+	Timestamp        int64
+	Action           string
+}
+
+// usageSummaryListParams are the parameters for listing the usage summaries of a subscription item
+type usageSummaryListParams struct {
+	stripe.ListParams
+	SubscriptionItem string
+}
+
+// stripeUsageRecord is a usage record as returned by Stripe
+type stripeUsageRecord struct {
+	ID               string `json:"id"`
+	Live             bool   `json:"livemode"`
+	Quantity         uint64 `json:"quantity"`
+	SubscriptionItem string `json:"subscription_item"`
+	Timestamp        int64  `json:"timestamp"`
+}
+
+// stripeUsageSummary is the usage of a subscription item in one billing period
+type stripeUsageSummary struct {
+	ID      string `json:"id"`
+	Invoice string `json:"invoice"`
+	Live    bool   `json:"livemode"`
+	Period  struct {
+		Start int64 `json:"start"`
+		End   int64 `json:"end"`
+	} `json:"period"`
+	SubscriptionItem string `json:"subscription_item"`
+	TotalUsage       uint64 `json:"total_usage"`
+}
+
+// usageAPI calls the Stripe usage record API in the same way as the stripe-go resource clients
+type usageAPI struct {
+	B   stripe.Backend
+	Key string
+}
+
+func (c usageAPI) New(params *usageRecordParams) (*stripeUsageRecord, error) {
+	body := &stripe.RequestValues{}
+	body.Add("quantity", strconv.FormatUint(params.Quantity, 10))
+	body.Add("timestamp", strconv.FormatInt(params.Timestamp, 10))
+	if len(params.Action) > 0 {
+		body.Add("action", params.Action)
+	}
+	params.AppendTo(body)
+
+	r := &stripeUsageRecord{}
+	err := c.B.Call("POST", "/subscription_items/"+url.QueryEscape(params.SubscriptionItem)+"/usage_records", c.Key, body, &params.Params, r)
+	return r, err
+}
+
+func (c usageAPI) ListSummaries(params *usageSummaryListParams) *usageSummaryIter {
+	body := &stripe.RequestValues{}
+	params.AppendTo(body)
+	path := "/subscription_items/" + url.QueryEscape(params.SubscriptionItem) + "/usage_record_summaries"
+	p := params.ToParams()
+
+	return &usageSummaryIter{stripe.GetIter(&params.ListParams, body, func(b *stripe.RequestValues) ([]interface{}, stripe.ListMeta, error) {
+		list := &stripeUsageSummaryList{}
+		err := c.B.Call("GET", path, c.Key, b, p, list)
+
+		ret := make([]interface{}, len(list.Values))
+		for i, v := range list.Values {
+			ret[i] = v
+		}
+		return ret, list.ListMeta, err
+	})}
+}
+
+type stripeUsageSummaryList struct {
+	stripe.ListMeta
+	Values []*stripeUsageSummary `json:"data"`
+}
+
+// usageSummaryIter is an iterator for lists of usage summaries
+type usageSummaryIter struct {
+	*stripe.Iter
+}
+
+// Summary returns the usage summary at the current position of the iterator
+func (i *usageSummaryIter) Summary() *stripeUsageSummary {
+	return i.Current().(*stripeUsageSummary)
+}
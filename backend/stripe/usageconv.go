@@ -0,0 +1,81 @@
+package stripe
+
+import (
+	"time"
+
+	"github.com/BTBurke/recur/pb"
+	"github.com/stripe/stripe-go"
+	context "golang.org/x/net/context"
+)
+
+// convert a create usage record request to usageRecordParams.  Stripe requires a timestamp, so
+// usage without one is reported at the current time.
+func usageCreateToUsageRecordParams(ctx context.Context, key string, req *pb.CreateUsageRecordRequest) *usageRecordParams {
+	ts := req.Timestamp
+	if ts == 0 {
+		ts = time.Now().Unix()
+	}
+	action := "increment"
+	if req.Action == pb.UsageAction_Set {
+		action = "set"
+	}
+	return &usageRecordParams{
+		Params:           paramsFromContext(ctx, key, nil),
+		SubscriptionItem: req.SubscriptionItem,
+		Quantity:         req.Quantity,
+		Timestamp:        ts,
+		Action:           action,
+	}
+}
+
+func usageSummaryListToListParams(ctx context.Context, key string, req *pb.ListUsageRecordSummariesRequest) *usageSummaryListParams {
+	return &usageSummaryListParams{
+		ListParams: stripe.ListParams{
+			Start: req.StartingAfter,
+			End:   req.EndingBefore,
+			Limit: defaultInt(int(req.Limit), 10),
+		},
+		SubscriptionItem: req.SubscriptionItem,
+	}
+}
+
+// convert a success response from Stripe to a UsageRecordResponse (success)
+func respToUsageRecordSuccess(r *stripeUsageRecord) *pb.UsageRecordResponse {
+	return &pb.UsageRecordResponse{
+		Responses: &pb.UsageRecordResponse_Success{
+			Success: &pb.UsageRecord{
+				Id:               r.ID,
+				SubscriptionItem: r.SubscriptionItem,
+				Quantity:         r.Quantity,
+				Timestamp:        r.Timestamp,
+				Livemode:         r.Live,
+			},
+		},
+	}
+}
+
+// convert an error response from Stripe to a UsageRecordResponse (error)
+func respToUsageRecordError(err *stripe.Error) *pb.UsageRecordResponse {
+	return &pb.UsageRecordResponse{
+		Responses: &pb.UsageRecordResponse_Error{
+			Error: respToError(err),
+		},
+	}
+}
+
+// convert a usage summary from Stripe to a UsageRecordSummaryResponse (success)
+func respToUsageSummarySuccess(s *stripeUsageSummary) *pb.UsageRecordSummaryResponse {
+	return &pb.UsageRecordSummaryResponse{
+		Responses: &pb.UsageRecordSummaryResponse_Success{
+			Success: &pb.UsageRecordSummary{
+				Id:               s.ID,
+				SubscriptionItem: s.SubscriptionItem,
+				Invoice:          s.Invoice,
+				PeriodStart:      s.Period.Start,
+				PeriodEnd:        s.Period.End,
+				TotalUsage:       s.TotalUsage,
+				Livemode:         s.Live,
+			},
+		},
+	}
+}
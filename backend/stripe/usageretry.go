@@ -0,0 +1,31 @@
+package stripe
+
+import (
+	"github.com/BTBurke/recur/pb"
+	"github.com/cenkalti/backoff"
+	"github.com/stripe/stripe-go"
+)
+
+func retryableUsageRecord(params *usageRecordParams, api usageClient, u *pb.UsageRecordResponse) backoff.Operation {
+	return func() error {
+		r, err := api.New(params)
+		if err != nil {
+			if stripeErr, ok := err.(*stripe.Error); ok {
+				*u = *respToUsageRecordError(stripeErr)
+			}
+			return classify(err)
+		}
+		*u = *respToUsageRecordSuccess(r)
+		return nil
+	}
+}
+
+func retryableUsageSummaryList(params *usageSummaryListParams, api usageClient, u *usageSummaryStreamer) backoff.Operation {
+	return func() error {
+		u.iter = api.ListSummaries(params)
+		if u.iter != nil {
+			u.pages = u.iter
+		}
+		return nil
+	}
+}
@@ -0,0 +1,67 @@
+package stripe
+
+import (
+	"fmt"
+	"testing"
+
+	context "golang.org/x/net/context"
+
+	"github.com/BTBurke/recur/backend"
+	"github.com/BTBurke/recur/pb"
+	"github.com/cenkalti/backoff"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stripe/stripe-go"
+)
+
+type mockUsage struct {
+	mock.Mock
+}
+
+func (m *mockUsage) New(params *usageRecordParams) (*stripeUsageRecord, error) {
+	args := m.Called(params.SubscriptionItem, params.IdempotencyKey)
+	return args.Get(0).(*stripeUsageRecord), args.Error(1)
+}
+
+func (m *mockUsage) ListSummaries(params *usageSummaryListParams) *usageSummaryIter {
+	args := m.Called(params)
+	return args.Get(0).(*usageSummaryIter)
+}
+
+func TestRetryableUsageRecord(t *testing.T) {
+	rec := &stripeUsageRecord{ID: "mbur_test", SubscriptionItem: "si_test", Quantity: 7, Timestamp: 1500000000}
+
+	mck := new(mockUsage)
+	mck.On("New", "si_test", "key").Return((*stripeUsageRecord)(nil), fmt.Errorf("test retry")).Once()
+	mck.On("New", "si_test", "key").Return(rec, nil).Once()
+
+	ctx := backend.WithIdempotencyKey(context.Background(), "key")
+	params := usageCreateToUsageRecordParams(ctx, "sk_test", &pb.CreateUsageRecordRequest{SubscriptionItem: "si_test", Quantity: 7, Timestamp: 1500000000})
+	resp := new(pb.UsageRecordResponse)
+	err := backoff.Retry(
+		retryableUsageRecord(params, mck, resp),
+		backoff.WithContext(backoff.NewExponentialBackOff(), context.Background()),
+	)
+	assert.NoError(t, err)
+	mck.AssertExpectations(t)
+	assert.Equal(t, &pb.UsageRecord{Id: "mbur_test", SubscriptionItem: "si_test", Quantity: 7, Timestamp: 1500000000}, resp.GetSuccess())
+}
+
+func TestRetryableUsageRecordInvalid(t *testing.T) {
+	mck := new(mockUsage)
+	mck.On("New", "si_test", "").Return((*stripeUsageRecord)(nil), &stripe.Error{Type: stripe.InvalidRequest, HTTPStatusCode: 400, Param: "timestamp", Msg: "outside the current period"}).Once()
+	resp := new(pb.UsageRecordResponse)
+	err := backoff.Retry(
+		retryableUsageRecord(&usageRecordParams{SubscriptionItem: "si_test"}, mck, resp),
+		backoff.WithContext(backoff.NewExponentialBackOff(), context.Background()),
+	)
+	assert.Error(t, err)
+	mck.AssertExpectations(t)
+	assert.Equal(t, "timestamp", resp.GetError().GetParam())
+}
+
+func TestUsageParams(t *testing.T) {
+	params := usageCreateToUsageRecordParams(context.Background(), "sk_test", &pb.CreateUsageRecordRequest{SubscriptionItem: "si_test", Quantity: 3, Action: pb.UsageAction_Set})
+	assert.Equal(t, "set", params.Action)
+	assert.NotZero(t, params.Timestamp, "usage without a timestamp is reported now")
+}
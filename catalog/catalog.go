@@ -0,0 +1,142 @@
+// Package catalog syncs plans with a declarative catalog kept in a YAML or JSON file.
+//
+// A catalog lists every plan that should exist:
+//
+//	plans:
+//	- id: gold-monthly
+//	  product: gold
+//	  name: Gold
+//	  amount: 2000
+//	  currency: usd
+//	  interval: month
+//	  trial_period_days: 14
+//	  metadata:
+//	    tier: gold
+//
+// Diff compares the catalog with the plans returned by the backend and returns the changes needed
+// to bring them in line, which Apply then makes.  Stripe does not allow the amount, currency,
+// interval or product of a plan to change after it is created, so a change to one of those fields
+// is reported as a conflict that is resolved by giving the plan a new ID.  The products themselves
+// must already exist.
+//
+// Plans that are not in the catalog are archived by deactivating them, and only when pruning.  A
+// diff can be limited to the plans of one product or to active or inactive plans, so that a
+// catalog of one product never archives the plans of another.
+package catalog
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/BTBurke/recur/pb"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// Catalog is the set of plans that should exist
+type Catalog struct {
+	Plans []Plan `json:"plans" yaml:"plans"`
+}
+
+// Plan is a plan as written in a catalog file.  The currency is an ISO code such as "usd" and the
+// interval is one of day, week, month or year.  The product is the ID of the product the plan
+// belongs to, if any.
+type Plan struct {
+	ID                  string            `json:"id" yaml:"id"`
+	Product             string            `json:"product,omitempty" yaml:"product,omitempty"`
+	Name                string            `json:"name" yaml:"name"`
+	Amount              uint64            `json:"amount" yaml:"amount"`
+	Currency            string            `json:"currency" yaml:"currency"`
+	Interval            string            `json:"interval" yaml:"interval"`
+	IntervalCount       uint64            `json:"interval_count,omitempty" yaml:"interval_count,omitempty"`
+	StatementDescriptor string            `json:"statement_descriptor,omitempty" yaml:"statement_descriptor,omitempty"`
+	TrialPeriodDays     uint64            `json:"trial_period_days,omitempty" yaml:"trial_period_days,omitempty"`
+	Metadata            map[string]string `json:"metadata,omitempty" yaml:"metadata,omitempty"`
+}
+
+// Load reads a catalog from a file.  Files ending in .json are decoded as JSON and all others as
+// YAML.
+func Load(path string) (*Catalog, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		return ParseJSON(b)
+	default:
+		return ParseYAML(b)
+	}
+}
+
+// ParseYAML decodes and validates a YAML catalog.  Unknown fields are rejected so that a
+// misspelled field is not silently ignored.
+func ParseYAML(b []byte) (*Catalog, error) {
+	var c Catalog
+	if err := yaml.UnmarshalStrict(b, &c); err != nil {
+		return nil, fmt.Errorf("catalog: %s", err)
+	}
+	return &c, c.Validate()
+}
+
+// ParseJSON decodes and validates a JSON catalog.  Unknown fields are rejected so that a
+// misspelled field is not silently ignored.
+func ParseJSON(b []byte) (*Catalog, error) {
+	var c Catalog
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&c); err != nil {
+		return nil, fmt.Errorf("catalog: %s", err)
+	}
+	return &c, c.Validate()
+}
+
+// Validate checks that every plan has the fields required to create it and that plan IDs are
+// unique
+func (c *Catalog) Validate() error {
+	seen := make(map[string]bool, len(c.Plans))
+	for i, p := range c.Plans {
+		if len(p.ID) == 0 {
+			return fmt.Errorf("catalog: plan %d has no id", i+1)
+		}
+		if seen[p.ID] {
+			return fmt.Errorf("catalog: plan %s is listed more than once", p.ID)
+		}
+		seen[p.ID] = true
+		if _, err := p.createRequest(); err != nil {
+			return fmt.Errorf("catalog: plan %s: %s", p.ID, err)
+		}
+	}
+	return nil
+}
+
+// createRequest converts the plan to a request to create it
+func (p Plan) createRequest() (*pb.CreatePlanRequest, error) {
+	currency, err := pb.ParseCurrency(p.Currency)
+	if err != nil {
+		return nil, err
+	}
+	interval, err := pb.ParseInterval(p.Interval)
+	if err != nil {
+		return nil, err
+	}
+	req := &pb.CreatePlanRequest{
+		Id:                  p.ID,
+		Amount:              p.Amount,
+		Currency:            currency,
+		Interval:            interval,
+		Name:                p.Name,
+		Product:             p.Product,
+		IntervalCount:       p.IntervalCount,
+		Metadata:            p.Metadata,
+		StatementDescriptor: p.StatementDescriptor,
+		TrialPeriodDays:     p.TrialPeriodDays,
+	}
+	if req.IntervalCount == 0 {
+		req.IntervalCount = 1
+	}
+	return req, req.Validate()
+}
@@ -0,0 +1,59 @@
+package catalog
+
+import (
+	"testing"
+
+	"github.com/BTBurke/recur/pb"
+	"github.com/stretchr/testify/assert"
+)
+
+const testYAML = `
+plans:
+- id: gold
+  name: Gold
+  amount: 2000
+  currency: usd
+  interval: month
+  trial_period_days: 14
+  metadata:
+    tier: gold
+- id: silver
+  name: Silver
+  amount: 1000
+  currency: USD
+  interval: Year
+`
+
+func TestParse(t *testing.T) {
+	c, err := ParseYAML([]byte(testYAML))
+	if assert.NoError(t, err) && assert.Len(t, c.Plans, 2) {
+		req, err := c.Plans[1].createRequest()
+		assert.NoError(t, err)
+		assert.Equal(t, pb.Currency_USD, req.Currency)
+		assert.Equal(t, pb.Interval_Year, req.Interval)
+		assert.Equal(t, uint64(1), req.IntervalCount)
+	}
+
+	j, err := ParseJSON([]byte(`{"plans": [{"id": "gold", "name": "Gold", "amount": 2000, "currency": "usd", "interval": "month", "trial_period_days": 14, "metadata": {"tier": "gold"}}]}`))
+	if assert.NoError(t, err) {
+		assert.Equal(t, c.Plans[0], j.Plans[0])
+	}
+
+	tt := []struct {
+		Name string
+		YAML string
+	}{
+		{Name: "unknown field", YAML: "plans:\n- id: gold\n  name: Gold\n  currency: usd\n  interval: month\n  ammount: 10\n"},
+		{Name: "missing id", YAML: "plans:\n- name: Gold\n  currency: usd\n  interval: month\n"},
+		{Name: "missing name", YAML: "plans:\n- id: gold\n  currency: usd\n  interval: month\n"},
+		{Name: "unknown currency", YAML: "plans:\n- id: gold\n  name: Gold\n  currency: xyz\n  interval: month\n"},
+		{Name: "unknown interval", YAML: "plans:\n- id: gold\n  name: Gold\n  currency: usd\n  interval: fortnight\n"},
+		{Name: "duplicate id", YAML: "plans:\n- id: gold\n  name: Gold\n  currency: usd\n  interval: month\n- id: gold\n  name: Gold\n  currency: usd\n  interval: month\n"},
+	}
+	for _, tc := range tt {
+		t.Run(tc.Name, func(t *testing.T) {
+			_, err := ParseYAML([]byte(tc.YAML))
+			assert.Error(t, err)
+		})
+	}
+}
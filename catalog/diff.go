@@ -0,0 +1,355 @@
+package catalog
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/BTBurke/recur/backend"
+	"github.com/BTBurke/recur/pb"
+	context "golang.org/x/net/context"
+)
+
+// Action is the kind of change needed to bring a plan in line with the catalog
+type Action string
+
+const (
+	// Create a plan that is in the catalog but does not exist
+	Create Action = "create"
+	// Update the mutable fields of an existing plan
+	Update Action = "update"
+	// Archive deactivates a plan that exists but is not in the catalog.  Subscriptions to an
+	// inactive plan continue but no new subscriptions can be created, and the plan is activated
+	// again if it returns to the catalog.  Plans are only archived when the diff is made with
+	// WithPrune.
+	Archive Action = "archive"
+	// Conflict is a change that cannot be made to an existing plan, such as a new amount.  The plan
+	// must be given a new ID in the catalog.
+	Conflict Action = "conflict"
+)
+
+// FieldChange is the old and new value of a plan field
+type FieldChange struct {
+	Field string `json:"field" yaml:"field"`
+	From  string `json:"from" yaml:"from"`
+	To    string `json:"to" yaml:"to"`
+}
+
+// Change is a change to a single plan
+type Change struct {
+	Action Action        `json:"action" yaml:"action"`
+	Plan   string        `json:"plan" yaml:"plan"`
+	Fields []FieldChange `json:"fields,omitempty" yaml:"fields,omitempty"`
+	Reason string        `json:"reason,omitempty" yaml:"reason,omitempty"`
+
+	create *pb.CreatePlanRequest
+	update *pb.UpdatePlanRequest
+}
+
+// ChangeSet is the list of changes needed to bring the plans in line with the catalog.  It
+// encodes to JSON or YAML as a machine readable diff.
+type ChangeSet struct {
+	Changes []Change `json:"changes" yaml:"changes"`
+}
+
+// Option configures optional behavior of Diff
+type Option func(o *options)
+
+type options struct {
+	prune   bool
+	product string
+	active  pb.ActiveState
+}
+
+// WithPrune archives existing plans that are not in the catalog.  Without it, plans missing from
+// the catalog are left alone, so a partial catalog never archives plans.
+func WithPrune() Option {
+	return func(o *options) {
+		o.prune = true
+	}
+}
+
+// WithProduct limits the diff to plans that belong to the product.  Plans of other products,
+// in the catalog or not, are left alone.
+func WithProduct(id string) Option {
+	return func(o *options) {
+		o.product = id
+	}
+}
+
+// WithActive limits the diff to existing plans in the active state.  Plans in the other state
+// are left alone, even when they are in the catalog.
+func WithActive(active pb.ActiveState) Option {
+	return func(o *options) {
+		o.active = active
+	}
+}
+
+// Diff lists the existing plans and compares them with the catalog.  Changes are ordered as the
+// plans appear in the catalog, followed by archives ordered by plan ID.
+func Diff(ctx context.Context, plans backend.PlanClient, c *Catalog, opts ...Option) (*ChangeSet, error) {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+	existing, err := listPlans(ctx, plans, &pb.ListPlansRequest{Limit: 100, Product: o.product, Active: o.active})
+	if err != nil {
+		return nil, err
+	}
+	cs := &ChangeSet{Changes: []Change{}}
+	for _, p := range c.Plans {
+		req, err := p.createRequest()
+		if err != nil {
+			return nil, fmt.Errorf("catalog: plan %s: %s", p.ID, err)
+		}
+		if len(o.product) > 0 && req.Product != o.product {
+			continue
+		}
+		cur, ok := existing[p.ID]
+		if !ok {
+			// a plan excluded by the active filter still exists and must not be created again
+			if o.active != pb.ActiveState_ActiveUnset {
+				found, err := planExists(ctx, plans, p.ID)
+				if err != nil {
+					return nil, err
+				}
+				if found {
+					continue
+				}
+			}
+			cs.Changes = append(cs.Changes, Change{Action: Create, Plan: p.ID, Fields: createFields(req), create: req})
+			continue
+		}
+		delete(existing, p.ID)
+		if ch, ok := diffPlan(cur, req); ok {
+			cs.Changes = append(cs.Changes, ch)
+		}
+	}
+	if !o.prune {
+		return cs, nil
+	}
+	var archived []string
+	for id, p := range existing {
+		if p.Active {
+			archived = append(archived, id)
+		}
+	}
+	sort.Strings(archived)
+	for _, id := range archived {
+		cs.Changes = append(cs.Changes, Change{Action: Archive, Plan: id})
+	}
+	return cs, nil
+}
+
+func listPlans(ctx context.Context, plans backend.PlanClient, req *pb.ListPlansRequest) (map[string]*pb.Plan, error) {
+	stream, err := plans.List(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	defer stream.Close()
+	out := make(map[string]*pb.Plan)
+	for stream.Next() {
+		resp := stream.Current()
+		if err := pb.ResponseError(resp.GetError(), nil); err != nil {
+			return nil, err
+		}
+		out[resp.GetSuccess().GetId()] = resp.GetSuccess()
+	}
+	return out, stream.Err()
+}
+
+// planExists returns true when the plan can be found
+func planExists(ctx context.Context, plans backend.PlanClient, id string) (bool, error) {
+	resp, err := plans.Get(ctx, &pb.GetPlanRequest{Id: id})
+	err = pb.ResponseError(resp.GetError(), err)
+	switch {
+	case pb.IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, err
+	default:
+		return true, nil
+	}
+}
+
+// diffPlan compares an existing plan with the catalog and returns the change needed, if any
+func diffPlan(cur *pb.Plan, req *pb.CreatePlanRequest) (Change, bool) {
+	var fields []FieldChange
+	var reasons []string
+
+	intervalCount := cur.IntervalCount
+	if intervalCount == 0 {
+		intervalCount = 1
+	}
+	immutable := []FieldChange{
+		{"amount", fmtUint(cur.Amount), fmtUint(req.Amount)},
+		{"currency", cur.Currency.String(), req.Currency.String()},
+		{"interval", cur.Interval.String(), req.Interval.String()},
+		{"interval_count", fmtUint(intervalCount), fmtUint(req.IntervalCount)},
+		{"product", cur.Product, req.Product},
+	}
+	for _, f := range immutable {
+		if f.From != f.To {
+			fields = append(fields, f)
+			reasons = append(reasons, f.Field+" cannot be changed, create a plan with a new id")
+		}
+	}
+
+	update := &pb.UpdatePlanRequest{Id: req.Id}
+	if !cur.Active {
+		fields = append(fields, FieldChange{"active", "false", "true"})
+		update.Active = pb.ActiveState_ActiveTrue
+	}
+	if cur.Name != req.Name {
+		fields = append(fields, FieldChange{"name", cur.Name, req.Name})
+		update.Name = req.Name
+	}
+	if cur.StatementDescriptor != req.StatementDescriptor {
+		fields = append(fields, FieldChange{"statement_descriptor", cur.StatementDescriptor, req.StatementDescriptor})
+		update.StatementDescriptor = req.StatementDescriptor
+		if len(req.StatementDescriptor) == 0 {
+			reasons = append(reasons, "statement_descriptor cannot be cleared")
+		}
+	}
+	if cur.TrialPeriodDays != req.TrialPeriodDays {
+		fields = append(fields, FieldChange{"trial_period_days", fmtUint(cur.TrialPeriodDays), fmtUint(req.TrialPeriodDays)})
+		update.TrialPeriodDays = req.TrialPeriodDays
+		if req.TrialPeriodDays == 0 {
+			reasons = append(reasons, "trial_period_days cannot be cleared")
+		}
+	}
+	for _, k := range metaKeys(cur.Metadata, req.Metadata) {
+		from, to := cur.Metadata[k], req.Metadata[k]
+		if from == to {
+			continue
+		}
+		fields = append(fields, FieldChange{"metadata." + k, from, to})
+		if update.Metadata == nil {
+			update.Metadata = make(map[string]string)
+		}
+		// an empty value removes the key
+		update.Metadata[k] = to
+	}
+
+	switch {
+	case len(fields) == 0:
+		return Change{}, false
+	case len(reasons) > 0:
+		return Change{Action: Conflict, Plan: req.Id, Fields: fields, Reason: strings.Join(reasons, "; ")}, true
+	default:
+		return Change{Action: Update, Plan: req.Id, Fields: fields, update: update}, true
+	}
+}
+
+// createFields lists the fields set when a plan is created
+func createFields(req *pb.CreatePlanRequest) []FieldChange {
+	var fields []FieldChange
+	if len(req.Product) > 0 {
+		fields = append(fields, FieldChange{Field: "product", To: req.Product})
+	}
+	fields = append(fields, []FieldChange{
+		{Field: "name", To: req.Name},
+		{Field: "amount", To: fmtUint(req.Amount)},
+		{Field: "currency", To: req.Currency.String()},
+		{Field: "interval", To: req.Interval.String()},
+		{Field: "interval_count", To: fmtUint(req.IntervalCount)},
+	}...)
+	if len(req.StatementDescriptor) > 0 {
+		fields = append(fields, FieldChange{Field: "statement_descriptor", To: req.StatementDescriptor})
+	}
+	if req.TrialPeriodDays > 0 {
+		fields = append(fields, FieldChange{Field: "trial_period_days", To: fmtUint(req.TrialPeriodDays)})
+	}
+	for _, k := range metaKeys(nil, req.Metadata) {
+		fields = append(fields, FieldChange{Field: "metadata." + k, To: req.Metadata[k]})
+	}
+	return fields
+}
+
+func metaKeys(a map[string]string, b map[string]string) []string {
+	var keys []string
+	for k := range a {
+		keys = append(keys, k)
+	}
+	for k := range b {
+		if _, ok := a[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func fmtUint(v uint64) string {
+	return strconv.FormatUint(v, 10)
+}
+
+// Count returns the number of changes with the action
+func (cs *ChangeSet) Count(a Action) int {
+	var n int
+	for _, ch := range cs.Changes {
+		if ch.Action == a {
+			n++
+		}
+	}
+	return n
+}
+
+// WriteText writes a human readable summary of the changes
+func (cs *ChangeSet) WriteText(w io.Writer) error {
+	symbols := map[Action]string{Create: "+", Update: "~", Archive: "-", Conflict: "!"}
+	for _, ch := range cs.Changes {
+		if _, err := fmt.Fprintf(w, "%s %s %s\n", symbols[ch.Action], ch.Action, ch.Plan); err != nil {
+			return err
+		}
+		for _, f := range ch.Fields {
+			var err error
+			switch ch.Action {
+			case Create:
+				_, err = fmt.Fprintf(w, "    %s: %q\n", f.Field, f.To)
+			default:
+				_, err = fmt.Fprintf(w, "    %s: %q -> %q\n", f.Field, f.From, f.To)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		if len(ch.Reason) > 0 {
+			if _, err := fmt.Fprintf(w, "    (%s)\n", ch.Reason); err != nil {
+				return err
+			}
+		}
+	}
+	_, err := fmt.Fprintf(w, "%d to create, %d to update, %d to archive, %d conflicts\n",
+		cs.Count(Create), cs.Count(Update), cs.Count(Archive), cs.Count(Conflict))
+	return err
+}
+
+// Apply makes the changes, stopping at the first error.  Conflicts are skipped.  It returns the
+// number of changes made.
+func Apply(ctx context.Context, plans backend.PlanClient, cs *ChangeSet) (int, error) {
+	var n int
+	for _, ch := range cs.Changes {
+		var err error
+		switch ch.Action {
+		case Create:
+			resp, rerr := plans.Create(ctx, ch.create)
+			err = pb.ResponseError(resp.GetError(), rerr)
+		case Update:
+			resp, rerr := plans.Update(ctx, ch.update)
+			err = pb.ResponseError(resp.GetError(), rerr)
+		case Archive:
+			resp, rerr := plans.Update(ctx, &pb.UpdatePlanRequest{Id: ch.Plan, Active: pb.ActiveState_ActiveFalse})
+			err = pb.ResponseError(resp.GetError(), rerr)
+		default:
+			continue
+		}
+		if err != nil {
+			return n, fmt.Errorf("catalog: %s plan %s: %s", ch.Action, ch.Plan, err)
+		}
+		n++
+	}
+	return n, nil
+}
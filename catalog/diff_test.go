@@ -0,0 +1,189 @@
+package catalog
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/BTBurke/recur/backend/memory"
+	"github.com/BTBurke/recur/pb"
+	"github.com/stretchr/testify/assert"
+	context "golang.org/x/net/context"
+)
+
+func TestDiffAndApply(t *testing.T) {
+	plans := memory.NewPlanClient(memory.NewStore())
+	ctx := context.Background()
+	existing := []*pb.CreatePlanRequest{
+		{Id: "gold", Name: "Gold", Amount: 2000, Currency: pb.Currency_USD, Interval: pb.Interval_Month, Metadata: map[string]string{"tier": "gold", "old": "x"}},
+		{Id: "silver", Name: "Silver", Amount: 900, Currency: pb.Currency_USD, Interval: pb.Interval_Year},
+		{Id: "bronze", Name: "Bronze", Amount: 500, Currency: pb.Currency_USD, Interval: pb.Interval_Month},
+	}
+	for _, req := range existing {
+		_, err := plans.Create(ctx, req)
+		assert.NoError(t, err)
+	}
+	cat, err := ParseYAML([]byte(testYAML + `
+- id: platinum
+  name: Platinum
+  amount: 5000
+  currency: usd
+  interval: month
+`))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	cs, err := Diff(ctx, plans, cat)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Zero(t, cs.Count(Archive), "plans missing from the catalog are kept without pruning")
+
+	cs, err = Diff(ctx, plans, cat, WithPrune())
+	if !assert.NoError(t, err) {
+		return
+	}
+	var got []string
+	for _, ch := range cs.Changes {
+		got = append(got, string(ch.Action)+" "+ch.Plan)
+	}
+	assert.Equal(t, []string{"update gold", "conflict silver", "create platinum", "archive bronze"}, got)
+	assert.Equal(t, []FieldChange{
+		{Field: "trial_period_days", From: "0", To: "14"},
+		{Field: "metadata.old", From: "x", To: ""},
+	}, cs.Changes[0].Fields)
+	assert.Equal(t, []FieldChange{{Field: "amount", From: "900", To: "1000"}}, cs.Changes[1].Fields)
+	assert.Contains(t, cs.Changes[1].Reason, "new id")
+
+	b, err := json.Marshal(cs)
+	assert.NoError(t, err)
+	assert.Contains(t, string(b), `{"action":"archive","plan":"bronze"}`)
+	var text bytes.Buffer
+	assert.NoError(t, cs.WriteText(&text))
+	assert.Contains(t, text.String(), "1 to create, 1 to update, 1 to archive, 1 conflicts")
+
+	n, err := Apply(ctx, plans, cs)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, n)
+
+	gold, _ := plans.Get(ctx, &pb.GetPlanRequest{Id: "gold"})
+	assert.Equal(t, uint64(14), gold.GetSuccess().GetTrialPeriodDays())
+	assert.Equal(t, map[string]string{"tier": "gold"}, gold.GetSuccess().GetMetadata())
+	bronze, _ := plans.Get(ctx, &pb.GetPlanRequest{Id: "bronze"})
+	assert.False(t, bronze.GetSuccess().GetActive(), "archived plans are deactivated, not deleted")
+
+	// only the conflict remains
+	cs, err = Diff(ctx, plans, cat, WithPrune())
+	assert.NoError(t, err)
+	if assert.Len(t, cs.Changes, 1) {
+		assert.Equal(t, Conflict, cs.Changes[0].Action)
+	}
+
+	// an archived plan that returns to the catalog is activated again
+	cat.Plans = append(cat.Plans, Plan{ID: "bronze", Name: "Bronze", Amount: 500, Currency: "usd", Interval: "month"})
+	cs, err = Diff(ctx, plans, cat, WithPrune())
+	if assert.NoError(t, err) && assert.Len(t, cs.Changes, 2) {
+		assert.Equal(t, Update, cs.Changes[1].Action)
+		assert.Equal(t, []FieldChange{{Field: "active", From: "false", To: "true"}}, cs.Changes[1].Fields)
+	}
+
+	// with the active filter, the archived plan is left alone rather than created again
+	cs, err = Diff(ctx, plans, cat, WithPrune(), WithActive(pb.ActiveState_ActiveTrue))
+	if assert.NoError(t, err) && assert.Len(t, cs.Changes, 1) {
+		assert.Equal(t, Conflict, cs.Changes[0].Action)
+	}
+}
+
+func TestDiffPruneProduct(t *testing.T) {
+	store := memory.NewStore()
+	plans := memory.NewPlanClient(store)
+	products := memory.NewProductClient(store)
+	ctx := context.Background()
+	for _, id := range []string{"gold", "silver"} {
+		_, err := products.Create(ctx, &pb.CreateProductRequest{Id: id, Name: id})
+		assert.NoError(t, err)
+	}
+	for _, req := range []*pb.CreatePlanRequest{
+		{Id: "gold-monthly", Name: "Gold", Amount: 2000, Currency: pb.Currency_USD, Interval: pb.Interval_Month, Product: "gold"},
+		{Id: "gold-weekly", Name: "Gold", Amount: 500, Currency: pb.Currency_USD, Interval: pb.Interval_Week, Product: "gold"},
+		{Id: "silver-monthly", Name: "Silver", Amount: 1000, Currency: pb.Currency_USD, Interval: pb.Interval_Month, Product: "silver"},
+	} {
+		_, err := plans.Create(ctx, req)
+		assert.NoError(t, err)
+	}
+	cat, err := ParseYAML([]byte(`
+plans:
+- id: gold-monthly
+  product: gold
+  name: Gold
+  amount: 2000
+  currency: usd
+  interval: month
+- id: silver-yearly
+  product: silver
+  name: Silver
+  amount: 10000
+  currency: usd
+  interval: year
+`))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	cs, err := Diff(ctx, plans, cat, WithPrune(), WithProduct("gold"))
+	if !assert.NoError(t, err) {
+		return
+	}
+	if assert.Len(t, cs.Changes, 1, "plans of other products are out of scope") {
+		assert.Equal(t, Change{Action: Archive, Plan: "gold-weekly"}, cs.Changes[0])
+	}
+	_, err = Apply(ctx, plans, cs)
+	assert.NoError(t, err)
+	silver, _ := plans.Get(ctx, &pb.GetPlanRequest{Id: "silver-monthly"})
+	assert.True(t, silver.GetSuccess().GetActive())
+}
+
+func TestDiffProduct(t *testing.T) {
+	store := memory.NewStore()
+	plans := memory.NewPlanClient(store)
+	products := memory.NewProductClient(store)
+	ctx := context.Background()
+	for _, id := range []string{"gold", "premium"} {
+		_, err := products.Create(ctx, &pb.CreateProductRequest{Id: id, Name: id})
+		assert.NoError(t, err)
+	}
+	_, err := plans.Create(ctx, &pb.CreatePlanRequest{Id: "gold", Name: "Gold", Amount: 2000, Currency: pb.Currency_USD, Interval: pb.Interval_Month, Product: "gold"})
+	assert.NoError(t, err)
+
+	cat, err := ParseYAML([]byte(`
+plans:
+- id: gold
+  product: premium
+  name: Gold
+  amount: 2000
+  currency: usd
+  interval: month
+- id: gold-yearly
+  product: gold
+  name: Gold
+  amount: 20000
+  currency: usd
+  interval: year
+`))
+	if !assert.NoError(t, err) {
+		return
+	}
+	cs, err := Diff(ctx, plans, cat)
+	if !assert.NoError(t, err) || !assert.Len(t, cs.Changes, 2) {
+		return
+	}
+	assert.Equal(t, Conflict, cs.Changes[0].Action)
+	assert.Equal(t, []FieldChange{{Field: "product", From: "gold", To: "premium"}}, cs.Changes[0].Fields)
+	assert.Equal(t, FieldChange{Field: "product", To: "gold"}, cs.Changes[1].Fields[0])
+
+	_, err = Apply(ctx, plans, cs)
+	assert.NoError(t, err)
+	yearly, _ := plans.Get(ctx, &pb.GetPlanRequest{Id: "gold-yearly"})
+	assert.Equal(t, "gold", yearly.GetSuccess().GetProduct())
+}
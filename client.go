@@ -7,16 +7,27 @@ import (
 	"os"
 	"time"
 
+	"github.com/BTBurke/recur/backend"
+	"github.com/BTBurke/recur/backend/braintree"
+	"github.com/BTBurke/recur/backend/memory"
 	"github.com/BTBurke/recur/backend/stripe"
 	log "github.com/sirupsen/logrus"
+	context "golang.org/x/net/context"
 )
 
-// ClientType enumerates possible backends services (Stripe only for now)
-type ClientType int
+// ClientType is the name of a registered backend service.  Stripe, Braintree and memory backends
+// are always available; other backends are made available by importing a package that registers them with
+// backend.Register.
+type ClientType string
 
 const (
 	// Use Stripe as the backend for recurring billing
-	StripeClient ClientType = iota
+	StripeClient ClientType = stripe.Name
+	// Use Braintree as the backend for plans, customers and subscriptions.  The key has the form
+	// environment:merchant_id:public_key:private_key.
+	BraintreeClient ClientType = braintree.Name
+	// Use an in-memory backend for tests and local development.  The key is ignored.
+	MemoryClient ClientType = memory.Name
 )
 
 type runMode int
@@ -26,6 +37,9 @@ const (
 	runAsLibrary
 )
 
+// DefaultTimeout is the maximum time allowed for a single request to the backend, including retries
+const DefaultTimeout = 30 * time.Second
+
 // Client
 type Client struct {
 	Key     string
@@ -33,9 +47,19 @@ type Client struct {
 	Timeout time.Duration
 	Logger  *log.Logger
 
-	Plan *PlanClient
+	Plan         *PlanClient
+	Product      *ProductClient
+	Customer     *CustomerClient
+	Subscription *SubscriptionClient
+	Invoice      *InvoiceClient
+	Coupon       *CouponClient
+	Source       *SourceClient
+	Event        *EventClient
+	Usage        *UsageClient
+	// a resource client is nil when the backend does not support the resource
 
 	runMode runMode
+	retry   *backend.RetryPolicy
 }
 
 // ClientOption is a function that applies an option to the client configuration
@@ -43,7 +67,12 @@ type ClientOption func(c *Client) error
 
 // NewClient returns a new client using the chosen service (e.g. Stripe) as the backend. Call this
 // to create a client when using recur as a library in your own Go project.  Use ClientOption
-// to configure optional behavior such as logging (disabled by default).
+// to configure optional behavior such as logging (disabled by default).  The service is the name
+// of any registered backend, e.g. recur.ClientType("mybackend") after importing a package that
+// calls backend.Register("mybackend", factory).
+//
+// Requests return a non-nil error when the backend responds with an error.  The error is the
+// *pb.Error from the response and can be checked with predicates such as pb.IsCardError.
 func NewClient(service ClientType, key string, opts ...ClientOption) (*Client, error) {
 	return newClient(service, key, runAsLibrary, opts...)
 }
@@ -59,6 +88,7 @@ func newClient(service ClientType, key string, run runMode, opts ...ClientOption
 
 	c := &Client{
 		Key:     key,
+		Backend: service,
 		runMode: run,
 		Logger:  log.New(),
 	}
@@ -66,6 +96,7 @@ func newClient(service ClientType, key string, run runMode, opts ...ClientOption
 	defaultOpts := []ClientOption{
 		LogLevel(LogLevelInfo),
 		LogFormat(TextFormatter),
+		Timeout(DefaultTimeout),
 	}
 	switch run {
 	case runAsLibrary:
@@ -74,18 +105,72 @@ func newClient(service ClientType, key string, run runMode, opts ...ClientOption
 		defaultOpts = append(defaultOpts, LogOutput(os.Stdout))
 	}
 
-	for _, opt := range opts {
+	for _, opt := range append(defaultOpts, opts...) {
 		if err := opt(c); err != nil {
 			return nil, err
 		}
 	}
 
-	switch service {
-	case StripeClient:
-		c.Plan = &PlanClient{backend: stripe.NewPlanClient(key, c.Logger)}
-		return c, nil
-	default:
-		return nil, fmt.Errorf("unknown backend service")
+	clients, err := backend.New(string(service), backend.Config{Key: key, Logger: c.Logger, Retry: c.retry})
+	if err != nil {
+		return nil, err
+	}
+	if clients.Plan != nil {
+		c.Plan = &PlanClient{backend: clients.Plan, client: c}
+	}
+	if clients.Product != nil {
+		c.Product = &ProductClient{backend: clients.Product, client: c}
+	}
+	if clients.Customer != nil {
+		c.Customer = &CustomerClient{backend: clients.Customer, client: c}
+	}
+	if clients.Subscription != nil {
+		c.Subscription = &SubscriptionClient{backend: clients.Subscription, client: c}
+	}
+	if clients.Invoice != nil {
+		c.Invoice = &InvoiceClient{backend: clients.Invoice, client: c}
+	}
+	if clients.Coupon != nil {
+		c.Coupon = &CouponClient{backend: clients.Coupon, client: c}
+	}
+	if clients.Source != nil {
+		c.Source = &SourceClient{backend: clients.Source, client: c}
+	}
+	if clients.Event != nil {
+		c.Event = &EventClient{backend: clients.Event, client: c}
+	}
+	if clients.Usage != nil {
+		c.Usage = &UsageClient{backend: clients.Usage, client: c}
+	}
+	return c, nil
+}
+
+// withTimeout applies the client timeout to the context.  A timeout of zero disables the deadline.
+func (c *Client) withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if c.Timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, c.Timeout)
+}
+
+// Timeout sets the maximum time allowed for each request to the backend.  Use 0 to disable.
+func Timeout(d time.Duration) ClientOption {
+	return func(c *Client) error {
+		c.Timeout = d
+		return nil
+	}
+}
+
+// RetryPolicy limits retries of transient backend errors such as rate limits and network
+// failures.  Retries stop after maxAttempts attempts or when maxElapsed has passed, whichever
+// comes first; use 0 to remove either limit.  Retries are also bounded by the client Timeout.
+func RetryPolicy(maxElapsed time.Duration, maxAttempts int) ClientOption {
+	return func(c *Client) error {
+		if maxElapsed < 0 || maxAttempts < 0 {
+			return fmt.Errorf("retry limits must not be negative")
+		}
+		c.retry = &backend.RetryPolicy{MaxElapsed: maxElapsed, MaxAttempts: maxAttempts}
+		return nil
 	}
 }
 
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/BTBurke/recur/catalog"
+	"github.com/BTBurke/recur/pb"
+	yaml "gopkg.in/yaml.v2"
+)
+
+const catalogUsage = `Usage: recur catalog apply [flags] <catalog file>
+
+Compares the plans in a YAML or JSON catalog with the existing plans and creates or updates
+plans to match.  Plans that are not in the catalog are archived by deactivating them, only with
+-prune.  Use -product to limit the changes to the plans of one product and -active to limit them
+to active or inactive plans.  Changes to the amount, currency, interval or product of an existing
+plan are reported as conflicts and are not applied; give the plan a new id instead.  The exit
+status is 1 if any conflicts remain.
+
+Flags:
+`
+
+func runCatalog(args []string, stdout io.Writer, stderr io.Writer) int {
+	if len(args) < 1 || args[0] != "apply" {
+		fmt.Fprint(stderr, catalogUsage)
+		return 2
+	}
+	c := newCommand("catalog apply", stdout, stderr)
+	c.flags.Usage = func() {
+		fmt.Fprint(stderr, catalogUsage)
+		c.flags.PrintDefaults()
+	}
+	return c.run(args[1:], catalogApply)
+}
+
+func catalogApply(c *command) error {
+	dryRun := c.flags.Bool("dry-run", false, "show the changes without making them")
+	prune := c.flags.Bool("prune", false, "archive existing plans that are not in the catalog")
+	product := c.flags.String("product", "", "only plans that belong to this product")
+	var active pb.ActiveState
+	c.flags.Var((*activeFlag)(&active), "active", "only active (true) or inactive (false) plans")
+	if err := c.parse("a catalog file"); err != nil {
+		return err
+	}
+	cat, err := catalog.Load(c.id)
+	if err != nil {
+		return err
+	}
+	return c.withPlans(func(plans pb.PlansClient) error {
+		ctx, cancel := c.target.context()
+		defer cancel()
+		b := plansBackend{client: plans}
+		var opts []catalog.Option
+		if *prune {
+			opts = append(opts, catalog.WithPrune())
+		}
+		if len(*product) > 0 {
+			opts = append(opts, catalog.WithProduct(*product))
+		}
+		if active != pb.ActiveState_ActiveUnset {
+			opts = append(opts, catalog.WithActive(active))
+		}
+		cs, err := catalog.Diff(ctx, b, cat, opts...)
+		if err != nil {
+			return err
+		}
+		if err := writeChanges(c.stdout, c.output, cs); err != nil {
+			return err
+		}
+		if !*dryRun {
+			n, err := catalog.Apply(ctx, b, cs)
+			if c.output == outputTable {
+				fmt.Fprintf(c.stdout, "applied %d changes\n", n)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		if n := cs.Count(catalog.Conflict); n > 0 {
+			return fmt.Errorf("%d conflicts must be resolved in the catalog", n)
+		}
+		return nil
+	})
+}
+
+func writeChanges(w io.Writer, format string, cs *catalog.ChangeSet) error {
+	switch format {
+	case outputJSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(cs)
+	case outputYAML:
+		b, err := yaml.Marshal(cs)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(b)
+		return err
+	default:
+		return cs.WriteText(w)
+	}
+}
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/BTBurke/recur/pb"
+)
+
+// errUsage is returned when a command is called with invalid arguments.  The usage has already
+// been printed.
+var errUsage = errors.New("usage")
+
+// command holds the flags shared by every subcommand
+type command struct {
+	name   string
+	flags  *flag.FlagSet
+	target target
+	output string
+	id     string
+	args   []string
+	stdout io.Writer
+	stderr io.Writer
+}
+
+func newCommand(name string, stdout io.Writer, stderr io.Writer) *command {
+	c := &command{
+		name:   name,
+		flags:  flag.NewFlagSet(name, flag.ContinueOnError),
+		stdout: stdout,
+		stderr: stderr,
+	}
+	c.flags.SetOutput(stderr)
+	c.target.register(c.flags)
+	c.flags.StringVar(&c.output, "output", outputTable, "output format (table, json, yaml)")
+	return c
+}
+
+// run calls the command function and converts its error to an exit status
+func (c *command) run(args []string, fn func(c *command) error) int {
+	c.args = args
+	switch err := fn(c); {
+	case err == nil:
+		return 0
+	case err == flag.ErrHelp:
+		return 0
+	case err == errUsage:
+		return 2
+	default:
+		fmt.Fprintf(c.stderr, "recur: %s\n", errMessage(err))
+		return 1
+	}
+}
+
+// parse parses the flags after the command function has registered its own.  A command that takes
+// a positional argument, such as an ID, names it with arg.  It is stored in c.id and may be given
+// before or after the flags.
+func (c *command) parse(arg string) error {
+	needID := len(arg) > 0
+	args := c.args
+	if needID && len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		c.id, args = args[0], args[1:]
+	}
+	if err := c.flags.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return err
+		}
+		return errUsage
+	}
+	rest := c.flags.Args()
+	if needID && len(c.id) == 0 && len(rest) > 0 {
+		c.id, rest = rest[0], rest[1:]
+	}
+	switch {
+	case needID && len(c.id) == 0:
+		return c.usageError(arg + " is required")
+	case len(rest) > 0:
+		return c.usageError(fmt.Sprintf("unexpected arguments: %s", strings.Join(rest, " ")))
+	case !validOutput(c.output):
+		return c.usageError(fmt.Sprintf("unknown output format %q", c.output))
+	}
+	return nil
+}
+
+func (c *command) usageError(msg string) error {
+	fmt.Fprintf(c.stderr, "recur %s: %s\n", c.name, msg)
+	c.flags.Usage()
+	return errUsage
+}
+
+// withPlans calls fn with a Plans client for the target
+func (c *command) withPlans(fn func(plans pb.PlansClient) error) error {
+	plans, closeFn, err := c.target.plans()
+	if err != nil {
+		return err
+	}
+	defer closeFn()
+	return fn(plans)
+}
@@ -0,0 +1,50 @@
+// Command recur manages billing resources from the command line.
+//
+// Usage:
+//
+//	recur plan create|get|update|delete|list [flags]
+//	recur catalog apply [flags] <catalog file>
+//
+// Commands talk to Stripe directly using the key from the -stripe-key flag or the STRIPE_KEY
+// environment variable, or to a running recur server set with -server or RECUR_SERVER.  Results
+// are written as a table, JSON or YAML as chosen with -output.
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+)
+
+const usage = `Usage: recur <command> [arguments]
+
+Commands:
+  plan            create, get, update, delete or list plans
+  catalog apply   sync plans with a YAML or JSON catalog file
+
+Run 'recur <command> -h' for the flags of a command.
+`
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+// run executes the command and returns the exit status
+func run(args []string, stdout io.Writer, stderr io.Writer) int {
+	if len(args) < 1 {
+		fmt.Fprint(stderr, usage)
+		return 2
+	}
+	switch args[0] {
+	case "plan":
+		return runPlan(args[1:], stdout, stderr)
+	case "catalog":
+		return runCatalog(args[1:], stdout, stderr)
+	case "help", "-h", "--help":
+		fmt.Fprint(stdout, usage)
+		return 0
+	default:
+		fmt.Fprintf(stderr, "recur: unknown command %q\n\n%s", args[0], usage)
+		return 2
+	}
+}
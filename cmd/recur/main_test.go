@@ -0,0 +1,173 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/BTBurke/recur/backend/memory"
+	"github.com/BTBurke/recur/server"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+// startServer runs a recur server with an in-memory backend and returns its address
+func startServer(t *testing.T) (string, func()) {
+	logger := log.New()
+	logger.Out = ioutil.Discard
+	srv := server.New(server.Backends{Plan: memory.NewPlanClient(memory.NewStore())}, logger)
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %s", err)
+	}
+	go srv.Serve(lis)
+	return lis.Addr().String(), srv.Stop
+}
+
+func runCmd(args ...string) (int, string, string) {
+	var stdout, stderr bytes.Buffer
+	code := run(args, &stdout, &stderr)
+	return code, stdout.String(), stderr.String()
+}
+
+func TestPlanCommands(t *testing.T) {
+	addr, stop := startServer(t)
+	defer stop()
+
+	var plan map[string]interface{}
+	code, out, errOut := runCmd("plan", "create", "-server", addr, "-id", "gold", "-name", "Gold", "-amount", "20.00", "-currency", "usd", "-interval", "month", "-meta", "tier=gold")
+	assert.Equal(t, 0, code, errOut)
+	assert.Contains(t, out, "ID")
+	assert.Contains(t, out, "gold")
+	assert.Contains(t, out, "USD")
+	assert.Contains(t, out, "$20.00")
+	assert.Contains(t, out, "Month")
+
+	code, _, errOut = runCmd("plan", "create", "-server", addr, "-id", "yen", "-name", "Yen", "-amount", "10.00", "-currency", "jpy", "-interval", "month")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, errOut, "minimum charge", "amounts are in the major unit, so 10.00 JPY is 10 yen")
+	code, _, errOut = runCmd("plan", "create", "-server", addr, "-id", "yen", "-name", "Yen", "-amount", "1000.50", "-currency", "jpy", "-interval", "month")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, errOut, "decimal places")
+
+	code, out, errOut = runCmd("plan", "create", "-server", addr, "-id", "api", "-name", "API", "-currency", "usd", "-interval", "month",
+		"-billing-scheme", "tiered", "-tiers-mode", "graduated", "-tier", "1000:0.05", "-tier", "inf:0.03:1.00",
+		"-usage-type", "metered", "-aggregate-usage", "last_during_period", "-output", "json")
+	assert.Equal(t, 0, code, errOut)
+	if assert.NoError(t, json.Unmarshal([]byte(out), &plan)) {
+		assert.Equal(t, "Tiered", plan["billing_scheme"])
+		assert.Equal(t, "Graduated", plan["tiers_mode"])
+		assert.Equal(t, "Metered", plan["usage_type"])
+		assert.Equal(t, "LastDuringPeriod", plan["aggregate_usage"])
+		assert.Len(t, plan["tiers"], 2)
+	}
+	code, _, errOut = runCmd("plan", "create", "-server", addr, "-id", "bad", "-name", "Bad", "-currency", "usd", "-interval", "month", "-usage-type", "prepaid")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, errOut, "licensed, metered")
+	code, _, _ = runCmd("plan", "delete", "api", "-server", addr)
+	assert.Equal(t, 0, code)
+
+	code, out, errOut = runCmd("plan", "get", "gold", "-server", addr, "-output", "json")
+	assert.Equal(t, 0, code, errOut)
+	plan = nil
+	if assert.NoError(t, json.Unmarshal([]byte(out), &plan)) {
+		assert.Equal(t, "gold", plan["id"])
+		assert.Equal(t, "USD", plan["currency"])
+		assert.Equal(t, "2000", plan["amount"])
+	}
+
+	code, out, errOut = runCmd("plan", "update", "-server", addr, "-output", "yaml", "-name", "Gold Plus", "-meta", "tier=", "gold")
+	assert.Equal(t, 0, code, errOut)
+	assert.Contains(t, out, "name: Gold Plus")
+	assert.NotContains(t, out, "tier")
+
+	code, out, errOut = runCmd("plan", "list", "-server", addr, "-output", "yaml")
+	assert.Equal(t, 0, code, errOut)
+	assert.True(t, strings.HasPrefix(out, "- id: gold"), out)
+
+	code, out, errOut = runCmd("plan", "list", "-server", addr, "-active", "false")
+	assert.Equal(t, 0, code, errOut)
+	assert.NotContains(t, out, "gold", "plans are active when created")
+	code, out, errOut = runCmd("plan", "update", "gold", "-server", addr, "-active", "false")
+	assert.Equal(t, 0, code, errOut)
+	code, out, errOut = runCmd("plan", "list", "-server", addr, "-active", "false")
+	assert.Equal(t, 0, code, errOut)
+	assert.Contains(t, out, "gold")
+	code, out, errOut = runCmd("plan", "list", "-server", addr, "-product", "prod_other")
+	assert.Equal(t, 0, code, errOut)
+	assert.NotContains(t, out, "gold")
+	code, _, _ = runCmd("plan", "list", "-server", addr, "-active", "maybe")
+	assert.Equal(t, 2, code)
+
+	code, out, errOut = runCmd("plan", "delete", "gold", "-server", addr)
+	assert.Equal(t, 0, code, errOut)
+	assert.Equal(t, "deleted gold\n", out)
+
+	code, _, errOut = runCmd("plan", "get", "gold", "-server", addr)
+	assert.Equal(t, 1, code)
+	assert.Equal(t, "recur: No such plan: gold\n", errOut)
+
+	code, _, errOut = runCmd("plan", "create", "-server", addr, "-id", "bad", "-name", "Bad", "-currency", "xyz", "-interval", "month")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, errOut, "unknown currency")
+
+	code, _, _ = runCmd("plan", "get", "-server", addr)
+	assert.Equal(t, 2, code)
+	code, _, _ = runCmd("plan", "list", "-server", addr, "-output", "xml")
+	assert.Equal(t, 2, code)
+	code, _, _ = runCmd("plan", "rename")
+	assert.Equal(t, 2, code)
+}
+
+func TestCatalogApply(t *testing.T) {
+	addr, stop := startServer(t)
+	defer stop()
+
+	dir, err := ioutil.TempDir("", "recur")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %s", err)
+	}
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "catalog.yaml")
+	catalog := "plans:\n- id: gold\n  name: Gold\n  amount: 2000\n  currency: usd\n  interval: month\n"
+	if err := ioutil.WriteFile(file, []byte(catalog), 0600); err != nil {
+		t.Fatalf("failed to write catalog: %s", err)
+	}
+
+	code, out, errOut := runCmd("catalog", "apply", "-server", addr, "-dry-run", "-output", "json", file)
+	assert.Equal(t, 0, code, errOut)
+	assert.Contains(t, out, `"action": "create"`)
+
+	code, _, _ = runCmd("plan", "get", "gold", "-server", addr)
+	assert.Equal(t, 1, code, "dry run should not create the plan")
+
+	code, out, errOut = runCmd("catalog", "apply", "-server", addr, file)
+	assert.Equal(t, 0, code, errOut)
+	assert.Contains(t, out, "applied 1 changes")
+
+	code, out, errOut = runCmd("catalog", "apply", "-server", addr, file)
+	assert.Equal(t, 0, code, errOut)
+	assert.Contains(t, out, "0 to create, 0 to update, 0 to archive, 0 conflicts")
+
+	code, _, errOut = runCmd("plan", "create", "-server", addr, "-id", "silver", "-name", "Silver", "-amount", "10.00", "-currency", "usd", "-interval", "month")
+	assert.Equal(t, 0, code, errOut)
+	code, out, errOut = runCmd("catalog", "apply", "-server", addr, file)
+	assert.Equal(t, 0, code, errOut)
+	assert.Contains(t, out, "0 to archive", "plans missing from the catalog are kept without -prune")
+	code, out, errOut = runCmd("catalog", "apply", "-server", addr, "-prune", "-product", "prod_other", file)
+	assert.Equal(t, 0, code, errOut)
+	assert.Contains(t, out, "0 to archive", "plans of other products are kept")
+	code, out, errOut = runCmd("catalog", "apply", "-server", addr, "-prune", "-active", "true", file)
+	assert.Equal(t, 0, code, errOut)
+	assert.Contains(t, out, "- archive silver")
+	code, out, errOut = runCmd("plan", "get", "silver", "-server", addr, "-output", "json")
+	assert.Equal(t, 0, code, errOut)
+	assert.NotContains(t, out, `"active": true`)
+	code, _, _ = runCmd("catalog", "apply", "-server", addr, "-active", "maybe", file)
+	assert.Equal(t, 2, code)
+}
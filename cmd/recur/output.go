@@ -0,0 +1,120 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"github.com/BTBurke/recur/money"
+	"github.com/BTBurke/recur/pb"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// output formats
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+	outputYAML  = "yaml"
+)
+
+func validOutput(format string) bool {
+	switch format {
+	case outputTable, outputJSON, outputYAML:
+		return true
+	default:
+		return false
+	}
+}
+
+var marshaler = jsonpb.Marshaler{OrigName: true, Indent: "  "}
+
+// writeMessages writes protobuf messages as JSON or YAML using the proto field names.  A single
+// message is written as an object and more than one as a list.
+func writeMessages(w io.Writer, format string, msgs []proto.Message, list bool) error {
+	var buf bytes.Buffer
+	if list {
+		buf.WriteString("[")
+	}
+	for i, m := range msgs {
+		if i > 0 {
+			buf.WriteString(",")
+		}
+		if err := marshaler.Marshal(&buf, m); err != nil {
+			return err
+		}
+	}
+	if list {
+		buf.WriteString("]")
+	}
+	if format == outputJSON {
+		buf.WriteString("\n")
+		_, err := buf.WriteTo(w)
+		return err
+	}
+	// YAML is a superset of JSON, so decoding into a MapSlice keeps the field order
+	var v interface{}
+	switch {
+	case list:
+		v = new([]yaml.MapSlice)
+	default:
+		v = new(yaml.MapSlice)
+	}
+	if err := yaml.Unmarshal(buf.Bytes(), v); err != nil {
+		return err
+	}
+	b, err := yaml.Marshal(v)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(b)
+	return err
+}
+
+// writePlans writes plans in the output format
+func writePlans(w io.Writer, format string, plans []*pb.Plan, list bool) error {
+	if format != outputTable {
+		msgs := make([]proto.Message, len(plans))
+		for i, p := range plans {
+			msgs[i] = p
+		}
+		return writeMessages(w, format, msgs, list)
+	}
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "ID\tNAME\tPRODUCT\tAMOUNT\tCURRENCY\tINTERVAL\tTRIAL DAYS\tCREATED")
+	for _, p := range plans {
+		interval := p.Interval.String()
+		if p.IntervalCount > 1 {
+			interval = fmt.Sprintf("%d x %s", p.IntervalCount, interval)
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%d\t%s\n", p.Id, p.Name, p.Product, formatPrice(p), p.Currency, interval, p.TrialPeriodDays, formatTime(p.Created))
+	}
+	return tw.Flush()
+}
+
+// formatPrice formats the amount of a plan for display, e.g. 2000 USD as $20.00 and 2000 JPY as
+// ¥2,000.  Tiered plans are priced by their tiers and metered plans are marked as such.
+func formatPrice(p *pb.Plan) string {
+	price := "tiered"
+	if p.BillingScheme != pb.BillingScheme_Tiered {
+		if m, err := money.FromUnsigned(p.Amount, p.Currency); err == nil {
+			price = m.String()
+		} else {
+			price = fmt.Sprintf("%d", p.Amount)
+		}
+	}
+	if p.UsageType == pb.UsageType_Metered {
+		price += " metered"
+	}
+	return price
+}
+
+func formatTime(unix int64) string {
+	if unix == 0 {
+		return ""
+	}
+	return time.Unix(unix, 0).UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,409 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/BTBurke/recur/money"
+	"github.com/BTBurke/recur/pb"
+	"github.com/golang/protobuf/proto"
+)
+
+const planUsage = `Usage: recur plan <command> [flags]
+
+Commands:
+  create            create a plan
+  get <id>          show a plan
+  update <id>       change the name, statement descriptor, trial, active flag or metadata of a plan
+  delete <id>       delete a plan; existing subscriptions are not affected
+  list              list plans, newest first
+
+Run 'recur plan <command> -h' for the flags of a command.
+`
+
+func runPlan(args []string, stdout io.Writer, stderr io.Writer) int {
+	if len(args) < 1 {
+		fmt.Fprint(stderr, planUsage)
+		return 2
+	}
+	cmds := map[string]func(c *command) error{
+		"create": planCreate,
+		"get":    planGet,
+		"update": planUpdate,
+		"delete": planDelete,
+		"list":   planList,
+	}
+	fn, ok := cmds[args[0]]
+	switch {
+	case args[0] == "help" || args[0] == "-h" || args[0] == "--help":
+		fmt.Fprint(stdout, planUsage)
+		return 0
+	case !ok:
+		fmt.Fprintf(stderr, "recur: unknown plan command %q\n\n%s", args[0], planUsage)
+		return 2
+	}
+	c := newCommand("plan "+args[0], stdout, stderr)
+	return c.run(args[1:], fn)
+}
+
+func planCreate(c *command) error {
+	var amount, currency, interval, scheme, mode, usage, aggregate string
+	var tiers tierFlag
+	meta := make(metaFlag)
+	req := new(pb.CreatePlanRequest)
+	c.flags.StringVar(&req.Id, "id", "", "plan ID (required)")
+	c.flags.StringVar(&req.Name, "name", "", "name shown on invoices and receipts (required)")
+	c.flags.StringVar(&req.Product, "product", "", "ID of the product the plan belongs to")
+	c.flags.StringVar(&amount, "amount", "", "amount to charge each interval in the major currency unit, e.g. 10.00")
+	c.flags.StringVar(&currency, "currency", "", "ISO currency code, e.g. usd (required)")
+	c.flags.StringVar(&interval, "interval", "", "billing interval: day, week, month or year (required)")
+	c.flags.Uint64Var(&req.IntervalCount, "interval-count", 1, "number of intervals between billings")
+	c.flags.StringVar(&req.StatementDescriptor, "statement-descriptor", "", "text shown on the customer's card statement")
+	c.flags.Uint64Var(&req.TrialPeriodDays, "trial-days", 0, "days of free trial for new subscriptions")
+	c.flags.StringVar(&scheme, "billing-scheme", "", "per_unit or tiered (default per_unit)")
+	c.flags.Var(&tiers, "tier", "tier of a tiered plan as up_to:unit_amount[:flat_amount] in the major currency unit, with an up_to of inf for the last tier; may be repeated")
+	c.flags.StringVar(&mode, "tiers-mode", "", "graduated or volume, required for a tiered plan")
+	c.flags.StringVar(&usage, "usage-type", "", "licensed or metered (default licensed)")
+	c.flags.StringVar(&aggregate, "aggregate-usage", "", "how the usage of a metered plan is aggregated: sum, last_during_period, last_ever or max")
+	c.flags.Var(meta, "meta", "metadata as key=value, may be repeated")
+	if err := c.parse(""); err != nil {
+		return err
+	}
+	var err error
+	if len(currency) > 0 {
+		if req.Currency, err = pb.ParseCurrency(currency); err != nil {
+			return err
+		}
+	}
+	if len(interval) > 0 {
+		if req.Interval, err = pb.ParseInterval(interval); err != nil {
+			return err
+		}
+	}
+	if len(amount) > 0 {
+		if req.Amount, err = parseAmount(amount, req.Currency); err != nil {
+			return err
+		}
+	}
+	for _, t := range tiers {
+		tier, err := parseTier(t, req.Currency)
+		if err != nil {
+			return err
+		}
+		req.Tiers = append(req.Tiers, tier)
+	}
+	enums := []struct {
+		name  string
+		value string
+		names map[int32]string
+		dst   *int32
+	}{
+		{"billing scheme", scheme, pb.BillingScheme_name, (*int32)(&req.BillingScheme)},
+		{"tiers mode", mode, pb.TiersMode_name, (*int32)(&req.TiersMode)},
+		{"usage type", usage, pb.UsageType_name, (*int32)(&req.UsageType)},
+		{"aggregate usage", aggregate, pb.AggregateUsage_name, (*int32)(&req.AggregateUsage)},
+	}
+	for _, e := range enums {
+		if len(e.value) == 0 {
+			continue
+		}
+		if *e.dst, err = parseEnum(e.name, e.value, e.names); err != nil {
+			return err
+		}
+	}
+	req.Metadata = meta.values()
+	if err := req.Validate(); err != nil {
+		return err
+	}
+	return c.withPlans(func(plans pb.PlansClient) error {
+		ctx, cancel := c.target.context()
+		defer cancel()
+		resp, err := plans.CreatePlan(ctx, req)
+		if err = pb.ResponseError(resp.GetError(), err); err != nil {
+			return err
+		}
+		return writePlans(c.stdout, c.output, []*pb.Plan{resp.GetSuccess()}, false)
+	})
+}
+
+func planGet(c *command) error {
+	if err := c.parse("an id"); err != nil {
+		return err
+	}
+	return c.withPlans(func(plans pb.PlansClient) error {
+		ctx, cancel := c.target.context()
+		defer cancel()
+		resp, err := plans.GetPlan(ctx, &pb.GetPlanRequest{Id: c.id})
+		if err = pb.ResponseError(resp.GetError(), err); err != nil {
+			return err
+		}
+		return writePlans(c.stdout, c.output, []*pb.Plan{resp.GetSuccess()}, false)
+	})
+}
+
+func planUpdate(c *command) error {
+	meta := make(metaFlag)
+	req := new(pb.UpdatePlanRequest)
+	c.flags.StringVar(&req.Name, "name", "", "name shown on invoices and receipts")
+	c.flags.StringVar(&req.StatementDescriptor, "statement-descriptor", "", "text shown on the customer's card statement")
+	c.flags.Uint64Var(&req.TrialPeriodDays, "trial-days", 0, "days of free trial for new subscriptions")
+	c.flags.Var(meta, "meta", "metadata as key=value, may be repeated; an empty value removes the key")
+	c.flags.Var((*activeFlag)(&req.Active), "active", "activate (true) or deactivate (false) the plan")
+	if err := c.parse("an id"); err != nil {
+		return err
+	}
+	req.Id = c.id
+	req.Metadata = meta.values()
+	return c.withPlans(func(plans pb.PlansClient) error {
+		ctx, cancel := c.target.context()
+		defer cancel()
+		resp, err := plans.UpdatePlan(ctx, req)
+		if err = pb.ResponseError(resp.GetError(), err); err != nil {
+			return err
+		}
+		return writePlans(c.stdout, c.output, []*pb.Plan{resp.GetSuccess()}, false)
+	})
+}
+
+func planDelete(c *command) error {
+	if err := c.parse("an id"); err != nil {
+		return err
+	}
+	return c.withPlans(func(plans pb.PlansClient) error {
+		ctx, cancel := c.target.context()
+		defer cancel()
+		resp, err := plans.DeletePlan(ctx, &pb.DeletePlanRequest{Id: c.id})
+		if err = pb.ResponseError(resp.GetError(), err); err != nil {
+			return err
+		}
+		if c.output == outputTable {
+			_, err = fmt.Fprintf(c.stdout, "deleted %s\n", resp.GetSuccess().GetId())
+			return err
+		}
+		return writeMessages(c.stdout, c.output, []proto.Message{resp.GetSuccess()}, false)
+	})
+}
+
+func planList(c *command) error {
+	var after, before string
+	var max int
+	req := new(pb.ListPlansRequest)
+	c.flags.StringVar(&after, "created-after", "", "only plans created at or after this time (RFC 3339, date or unix time)")
+	c.flags.StringVar(&before, "created-before", "", "only plans created before this time (RFC 3339, date or unix time)")
+	c.flags.StringVar(&req.StartingAfter, "starting-after", "", "list plans after this plan ID")
+	c.flags.StringVar(&req.EndingBefore, "ending-before", "", "list plans before this plan ID")
+	c.flags.StringVar(&req.Product, "product", "", "only plans that belong to this product ID")
+	c.flags.Var((*activeFlag)(&req.Active), "active", "only active (true) or inactive (false) plans")
+	c.flags.IntVar(&max, "max", 0, "maximum number of plans to show (0 for all)")
+	limit := c.flags.Int("page-size", 100, "number of plans fetched per request")
+	if err := c.parse(""); err != nil {
+		return err
+	}
+	req.Limit = int32(*limit)
+	if len(after) > 0 || len(before) > 0 {
+		req.Created = new(pb.ListFilter)
+		if len(after) > 0 {
+			t, err := parseTime(after)
+			if err != nil {
+				return err
+			}
+			req.Created.Gte = t
+		}
+		if len(before) > 0 {
+			t, err := parseTime(before)
+			if err != nil {
+				return err
+			}
+			req.Created.Lt = t
+		}
+	}
+	return c.withPlans(func(plans pb.PlansClient) error {
+		ctx, cancel := c.target.context()
+		defer cancel()
+		stream, err := plans.ListPlans(ctx, req)
+		if err != nil {
+			return err
+		}
+		var out []*pb.Plan
+		for max <= 0 || len(out) < max {
+			resp, err := stream.Recv()
+			if err == io.EOF {
+				break
+			}
+			if err = pb.ResponseError(resp.GetError(), err); err != nil {
+				return err
+			}
+			out = append(out, resp.GetSuccess())
+		}
+		return writePlans(c.stdout, c.output, out, true)
+	})
+}
+
+// parseTime accepts an RFC 3339 time, a date such as 2017-08-01, or a unix timestamp
+func parseTime(s string) (int64, error) {
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t.Unix(), nil
+	}
+	if t, err := time.Parse("2006-01-02", s); err == nil {
+		return t.Unix(), nil
+	}
+	if v, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return v, nil
+	}
+	return 0, fmt.Errorf("invalid time %q, use RFC 3339, a date such as 2017-08-01, or a unix timestamp", s)
+}
+
+// parseAmount parses an amount in the major unit of the currency, such as 10.00, and returns it
+// in the minor unit.  Amounts with more decimal places than the currency allows are rejected.
+func parseAmount(s string, currency pb.Currency) (uint64, error) {
+	if currency == pb.Currency_UNK {
+		return 0, fmt.Errorf("a currency is required to parse the amount %q", s)
+	}
+	m, err := money.Parse(s, currency)
+	if err != nil {
+		return 0, err
+	}
+	if m.Amount < 0 {
+		return 0, fmt.Errorf("invalid amount %q, must not be negative", s)
+	}
+	return uint64(m.Amount), nil
+}
+
+// parseTier parses a tier given as up_to:unit_amount[:flat_amount], where up_to is a quantity or
+// inf and the amounts are in the major unit of the currency
+func parseTier(s string, currency pb.Currency) (*pb.PlanTier, error) {
+	parts := strings.Split(s, ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return nil, fmt.Errorf("invalid tier %q, must be up_to:unit_amount[:flat_amount]", s)
+	}
+	tier := new(pb.PlanTier)
+	if parts[0] != "inf" {
+		upTo, err := strconv.ParseUint(parts[0], 10, 64)
+		if err != nil || upTo == 0 {
+			return nil, fmt.Errorf("invalid tier %q, up_to must be a positive quantity or inf", s)
+		}
+		tier.UpTo = upTo
+	}
+	var err error
+	if tier.UnitAmount, err = parseAmount(parts[1], currency); err != nil {
+		return nil, err
+	}
+	if len(parts) == 3 {
+		if tier.FlatAmount, err = parseAmount(parts[2], currency); err != nil {
+			return nil, err
+		}
+	}
+	return tier, nil
+}
+
+// parseEnum returns the value of a plan enum for its Stripe name, such as last_during_period for
+// AggregateUsage_LastDuringPeriod.  Unknown values cannot be selected.
+func parseEnum(kind string, s string, names map[int32]string) (int32, error) {
+	var valid []string
+	for v, n := range names {
+		if strings.HasPrefix(n, "Unknown") {
+			continue
+		}
+		name := snakeCase(n)
+		if name == strings.ToLower(strings.TrimSpace(s)) {
+			return v, nil
+		}
+		valid = append(valid, name)
+	}
+	sort.Strings(valid)
+	return 0, fmt.Errorf("unknown %s %q, must be one of %s", kind, s, strings.Join(valid, ", "))
+}
+
+// snakeCase converts an enum name such as LastDuringPeriod to last_during_period
+func snakeCase(s string) string {
+	var b strings.Builder
+	for i, r := range s {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// tierFlag collects repeated tier flags
+type tierFlag []string
+
+func (t *tierFlag) String() string {
+	return strings.Join(*t, ",")
+}
+
+func (t *tierFlag) Set(s string) error {
+	*t = append(*t, s)
+	return nil
+}
+
+var _ flag.Value = (*tierFlag)(nil)
+
+// metaFlag collects repeated key=value flags
+type metaFlag map[string]string
+
+func (m metaFlag) String() string {
+	var kv []string
+	for k, v := range m {
+		kv = append(kv, k+"="+v)
+	}
+	sort.Strings(kv)
+	return strings.Join(kv, ",")
+}
+
+func (m metaFlag) Set(s string) error {
+	kv := strings.SplitN(s, "=", 2)
+	if len(kv) != 2 || len(kv[0]) == 0 {
+		return fmt.Errorf("metadata must be key=value")
+	}
+	m[kv[0]] = kv[1]
+	return nil
+}
+
+func (m metaFlag) values() map[string]string {
+	if len(m) == 0 {
+		return nil
+	}
+	return map[string]string(m)
+}
+
+var _ flag.Value = metaFlag{}
+
+// activeFlag selects resources by their active flag.  It is unset unless given.
+type activeFlag pb.ActiveState
+
+func (a *activeFlag) String() string {
+	switch pb.ActiveState(*a) {
+	case pb.ActiveState_ActiveTrue:
+		return "true"
+	case pb.ActiveState_ActiveFalse:
+		return "false"
+	default:
+		return ""
+	}
+}
+
+func (a *activeFlag) Set(s string) error {
+	v, err := strconv.ParseBool(s)
+	if err != nil {
+		return fmt.Errorf("active must be true or false")
+	}
+	if v {
+		*a = activeFlag(pb.ActiveState_ActiveTrue)
+	} else {
+		*a = activeFlag(pb.ActiveState_ActiveFalse)
+	}
+	return nil
+}
+
+var _ flag.Value = (*activeFlag)(nil)
@@ -0,0 +1,161 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+
+	"github.com/BTBurke/recur"
+	"github.com/BTBurke/recur/backend"
+	"github.com/BTBurke/recur/pb"
+	context "golang.org/x/net/context"
+)
+
+// target selects the backend a command talks to: Stripe directly with a secret key, or a running
+// recur GRPC server.  The server takes precedence when both are set.
+type target struct {
+	key     string
+	server  string
+	tls     bool
+	timeout time.Duration
+}
+
+func (t *target) register(fs *flag.FlagSet) {
+	fs.StringVar(&t.key, "stripe-key", os.Getenv("STRIPE_KEY"), "Stripe secret key (default $STRIPE_KEY)")
+	fs.StringVar(&t.server, "server", os.Getenv("RECUR_SERVER"), "address of a recur GRPC server to use instead of Stripe (default $RECUR_SERVER)")
+	fs.BoolVar(&t.tls, "tls", false, "connect to the recur server with TLS")
+	fs.DurationVar(&t.timeout, "timeout", recur.DefaultTimeout, "maximum time for each request")
+}
+
+// plans returns a Plans client for the target and a function to release it
+func (t *target) plans() (pb.PlansClient, func(), error) {
+	switch {
+	case len(t.server) > 0:
+		conn, err := t.dial()
+		if err != nil {
+			return nil, nil, err
+		}
+		return pb.NewPlansClient(conn), func() { conn.Close() }, nil
+	case len(t.key) > 0:
+		c, err := recur.NewClient(recur.StripeClient, t.key, recur.Timeout(t.timeout))
+		if err != nil {
+			return nil, nil, err
+		}
+		return c.Plan, func() {}, nil
+	default:
+		return nil, nil, fmt.Errorf("a Stripe key or recur server is required, set -stripe-key or -server")
+	}
+}
+
+func (t *target) dial() (*grpc.ClientConn, error) {
+	opts := []grpc.DialOption{grpc.WithBlock(), grpc.WithTimeout(t.timeout)}
+	switch {
+	case t.tls:
+		opts = append(opts, grpc.WithTransportCredentials(credentials.NewClientTLSFromCert(nil, "")))
+	default:
+		opts = append(opts, grpc.WithInsecure())
+	}
+	conn, err := grpc.Dial(t.server, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect to %s: %s", t.server, err)
+	}
+	return conn, nil
+}
+
+// context returns a context with the request timeout of the target
+func (t *target) context() (context.Context, context.CancelFunc) {
+	if t.timeout <= 0 {
+		return context.WithCancel(context.Background())
+	}
+	return context.WithTimeout(context.Background(), t.timeout)
+}
+
+// errMessage returns the message of a backend or GRPC error without the GRPC prefix
+func errMessage(err error) string {
+	if e, ok := pb.AsError(err); ok {
+		return e.Message
+	}
+	if st, ok := status.FromError(err); ok {
+		return st.Message()
+	}
+	return err.Error()
+}
+
+// plansBackend adapts a Plans client to backend.PlanClient so that packages written against the
+// backend interfaces, such as catalog, work with either target
+type plansBackend struct {
+	client pb.PlansClient
+}
+
+var _ backend.PlanClient = plansBackend{}
+
+func (b plansBackend) Create(ctx context.Context, req *pb.CreatePlanRequest) (*pb.PlanResponse, error) {
+	return b.client.CreatePlan(ctx, req)
+}
+
+func (b plansBackend) Update(ctx context.Context, req *pb.UpdatePlanRequest) (*pb.PlanResponse, error) {
+	return b.client.UpdatePlan(ctx, req)
+}
+
+func (b plansBackend) Delete(ctx context.Context, req *pb.DeletePlanRequest) (*pb.DeletePlanResponse, error) {
+	return b.client.DeletePlan(ctx, req)
+}
+
+func (b plansBackend) Get(ctx context.Context, req *pb.GetPlanRequest) (*pb.PlanResponse, error) {
+	return b.client.GetPlan(ctx, req)
+}
+
+func (b plansBackend) List(ctx context.Context, req *pb.ListPlansRequest) (backend.PlanStreamer, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	stream, err := b.client.ListPlans(ctx, req)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	return &planStream{stream: stream, cancel: cancel}, nil
+}
+
+// planStream adapts a ListPlans client stream to backend.PlanStreamer.  Closing it cancels the
+// stream, as CloseSend only closes the client's side.
+type planStream struct {
+	stream pb.Plans_ListPlansClient
+	cancel context.CancelFunc
+	cur    *pb.PlanResponse
+	err    error
+	done   bool
+}
+
+func (s *planStream) Next() bool {
+	if s.done {
+		return false
+	}
+	resp, err := s.stream.Recv()
+	if err != nil {
+		s.done = true
+		if err != io.EOF {
+			s.err = err
+		}
+		return false
+	}
+	s.cur = resp
+	return true
+}
+
+func (s *planStream) Current() *pb.PlanResponse {
+	return s.cur
+}
+
+func (s *planStream) Err() error {
+	return s.err
+}
+
+func (s *planStream) Close() {
+	s.done = true
+	s.cancel()
+}
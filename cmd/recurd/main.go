@@ -0,0 +1,160 @@
+// Command recurd runs recur as a GRPC billing service.
+//
+// The backend is chosen with -backend and defaults to Stripe.  The Stripe key is read from the
+// -stripe-key flag or the STRIPE_KEY environment variable; other backends read their key from
+// -backend-key or RECUR_BACKEND_KEY.  The Braintree key has the form
+// environment:merchant_id:public_key:private_key.  Backends other than stripe, braintree and
+// memory must be registered by importing their package in this command.
+// When -webhook-addr is set, recurd also listens for Stripe webhooks on that address, verifying
+// each request with the signing secret from -webhook-secret or STRIPE_WEBHOOK_SECRET.  Received
+// events are streamed to GRPC clients of the Events service.
+//
+// The server shuts down gracefully on SIGINT or SIGTERM, allowing in-flight requests to complete.
+package main
+
+import (
+	"context"
+	"flag"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/BTBurke/recur/backend"
+	_ "github.com/BTBurke/recur/backend/braintree"
+	_ "github.com/BTBurke/recur/backend/memory"
+	"github.com/BTBurke/recur/backend/stripe"
+	"github.com/BTBurke/recur/server"
+	"github.com/BTBurke/recur/webhook"
+	log "github.com/sirupsen/logrus"
+)
+
+func main() {
+	addr := flag.String("addr", envOrDefault("RECUR_ADDR", ":50051"), "address to listen on")
+	name := flag.String("backend", envOrDefault("RECUR_BACKEND", stripe.Name), "billing backend ("+strings.Join(backend.Registered(), ", ")+")")
+	key := flag.String("stripe-key", os.Getenv("STRIPE_KEY"), "Stripe secret key (default $STRIPE_KEY)")
+	backendKey := flag.String("backend-key", os.Getenv("RECUR_BACKEND_KEY"), "secret key for backends other than Stripe (default $RECUR_BACKEND_KEY)")
+	level := flag.String("log-level", "info", "log level (debug, info, warn, error)")
+	format := flag.String("log-format", "text", "log format (text, json)")
+	grace := flag.Duration("shutdown-timeout", 30*time.Second, "time to wait for in-flight requests on shutdown")
+	retryElapsed := flag.Duration("retry-max-elapsed", stripe.DefaultRetryPolicy.MaxElapsed, "maximum time spent retrying a failed backend request (0 for no limit)")
+	retryAttempts := flag.Int("retry-max-attempts", stripe.DefaultRetryPolicy.MaxAttempts, "maximum attempts for a failed backend request (0 for no limit)")
+	webhookAddr := flag.String("webhook-addr", os.Getenv("RECUR_WEBHOOK_ADDR"), "address to receive Stripe webhooks on (default $RECUR_WEBHOOK_ADDR, disabled if empty)")
+	webhookSecret := flag.String("webhook-secret", os.Getenv("STRIPE_WEBHOOK_SECRET"), "Stripe webhook signing secret (default $STRIPE_WEBHOOK_SECRET)")
+	webhookPath := flag.String("webhook-path", "/webhook", "path to receive Stripe webhooks on")
+	flag.Parse()
+
+	logger := log.New()
+	logger.Out = os.Stdout
+	lvl, err := log.ParseLevel(*level)
+	if err != nil {
+		logger.Fatalf("invalid log level: %s", err)
+	}
+	logger.Level = lvl
+	if *format == "json" {
+		logger.Formatter = new(log.JSONFormatter)
+	}
+
+	if *name == stripe.Name {
+		if len(*key) == 0 {
+			logger.Fatal("a Stripe key is required, set -stripe-key or STRIPE_KEY")
+		}
+		*backendKey = *key
+	}
+
+	var events *webhook.Handler
+	var hooks *http.Server
+	if len(*webhookAddr) > 0 {
+		if len(*webhookSecret) == 0 {
+			logger.Fatal("a webhook signing secret is required, set -webhook-secret or STRIPE_WEBHOOK_SECRET")
+		}
+		events = webhook.New(*webhookSecret, logger)
+		mux := http.NewServeMux()
+		mux.Handle(*webhookPath, events)
+		hooks = &http.Server{Addr: *webhookAddr, Handler: mux}
+	}
+
+	clients, err := backend.New(*name, backend.Config{
+		Key:    *backendKey,
+		Logger: logger,
+		Retry:  &backend.RetryPolicy{MaxElapsed: *retryElapsed, MaxAttempts: *retryAttempts},
+	})
+	if err != nil {
+		logger.Fatal(err)
+	}
+	backends := server.Backends{
+		Plan:         clients.Plan,
+		Product:      clients.Product,
+		Customer:     clients.Customer,
+		Subscription: clients.Subscription,
+		Invoice:      clients.Invoice,
+		Coupon:       clients.Coupon,
+		Source:       clients.Source,
+		Usage:        clients.Usage,
+		Event:        clients.Event,
+	}
+	if events != nil {
+		backends.Events = events
+	}
+	srv := server.New(backends, logger)
+
+	lis, err := net.Listen("tcp", *addr)
+	if err != nil {
+		logger.Fatalf("failed to listen on %s: %s", *addr, err)
+	}
+
+	errc := make(chan error, 2)
+	go func() {
+		logger.Infof("recurd listening on %s", lis.Addr())
+		errc <- srv.Serve(lis)
+	}()
+	if hooks != nil {
+		go func() {
+			logger.Infof("receiving webhooks on %s%s", hooks.Addr, *webhookPath)
+			if err := hooks.ListenAndServe(); err != http.ErrServerClosed {
+				errc <- err
+			}
+		}()
+	}
+
+	sig := make(chan os.Signal, 1)
+	signal.Notify(sig, syscall.SIGINT, syscall.SIGTERM)
+	select {
+	case err := <-errc:
+		logger.Fatalf("server failed: %s", err)
+	case s := <-sig:
+		logger.Infof("received %s, shutting down", s)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), *grace)
+	defer cancel()
+	if hooks != nil {
+		if err := hooks.Shutdown(ctx); err != nil {
+			logger.Warnf("webhook shutdown: %s", err)
+		}
+		events.Close()
+	}
+
+	done := make(chan struct{})
+	go func() {
+		srv.GracefulStop()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-ctx.Done():
+		logger.Warn("shutdown timeout exceeded, closing remaining connections")
+		srv.Stop()
+	}
+	logger.Info("server stopped")
+}
+
+func envOrDefault(key string, def string) string {
+	if v := os.Getenv(key); len(v) > 0 {
+		return v
+	}
+	return def
+}
@@ -0,0 +1,24 @@
+package recur
+
+import (
+	"github.com/BTBurke/recur/backend"
+	context "golang.org/x/net/context"
+)
+
+// WithIdempotencyKey returns a context that sends the idempotency key with the request, so that
+// a write retried with the same key is only applied once by the backend.
+//
+//	resp, err := c.Plan.CreateWithCtx(recur.WithIdempotencyKey(ctx, "order-1234"), req)
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return backend.WithIdempotencyKey(ctx, key)
+}
+
+// WithStripeAccount returns a context that makes the request on behalf of a Stripe Connect account
+func WithStripeAccount(ctx context.Context, acct string) context.Context {
+	return backend.WithStripeAccount(ctx, acct)
+}
+
+// WithHeaders returns a context that adds extra HTTP headers to requests sent to the backend
+func WithHeaders(ctx context.Context, headers map[string]string) context.Context {
+	return backend.WithHeaders(ctx, headers)
+}
@@ -0,0 +1,266 @@
+package recur
+
+import (
+	"fmt"
+	"io"
+
+	"google.golang.org/grpc"
+
+	"github.com/BTBurke/recur/backend"
+	"github.com/BTBurke/recur/pb"
+	log "github.com/sirupsen/logrus"
+	context "golang.org/x/net/context"
+)
+
+// CouponClient is the library facade for coupon operations.  It satisfies pb.CouponsClient so that it
+// can be used interchangeably with a GRPC client connected to a recur service.
+type CouponClient struct {
+	backend backend.CouponClient
+	client  *Client
+}
+
+var _ pb.CouponsClient = (*CouponClient)(nil)
+
+// CreateCoupon is the GRPC endpoint to create a coupon.
+func (c *CouponClient) CreateCoupon(ctx context.Context, req *pb.CreateCouponRequest, opts ...grpc.CallOption) (*pb.CouponResponse, error) {
+	return c.create(ctx, req)
+}
+
+// Create creates a coupon with a default context
+func (c *CouponClient) Create(req *pb.CreateCouponRequest) (*pb.CouponResponse, error) {
+	return c.create(context.Background(), req)
+}
+
+// CreateWithCtx creates a coupon with a custom context
+func (c *CouponClient) CreateWithCtx(ctx context.Context, req *pb.CreateCouponRequest) (*pb.CouponResponse, error) {
+	return c.create(ctx, req)
+}
+
+func (c *CouponClient) create(ctx context.Context, req *pb.CreateCouponRequest) (*pb.CouponResponse, error) {
+	ctx, cancel := c.client.withTimeout(ctx)
+	defer cancel()
+
+	resp, err := c.backend.Create(ctx, req)
+	logResponse(c.client.Logger.WithFields(log.Fields{"action": "create", "coupon": resp.GetSuccess().GetId()}), resp.GetError(), err)
+	return resp, pb.ResponseError(resp.GetError(), err)
+}
+
+// UpdateCoupon is the GRPC endpoint to update a coupon.
+func (c *CouponClient) UpdateCoupon(ctx context.Context, req *pb.UpdateCouponRequest, opts ...grpc.CallOption) (*pb.CouponResponse, error) {
+	return c.update(ctx, req)
+}
+
+// Update updates a coupon with a default context
+func (c *CouponClient) Update(req *pb.UpdateCouponRequest) (*pb.CouponResponse, error) {
+	return c.update(context.Background(), req)
+}
+
+// UpdateWithCtx updates a coupon with a custom context
+func (c *CouponClient) UpdateWithCtx(ctx context.Context, req *pb.UpdateCouponRequest) (*pb.CouponResponse, error) {
+	return c.update(ctx, req)
+}
+
+func (c *CouponClient) update(ctx context.Context, req *pb.UpdateCouponRequest) (*pb.CouponResponse, error) {
+	ctx, cancel := c.client.withTimeout(ctx)
+	defer cancel()
+
+	resp, err := c.backend.Update(ctx, req)
+	logResponse(c.client.Logger.WithFields(log.Fields{"action": "update", "coupon": req.GetId()}), resp.GetError(), err)
+	return resp, pb.ResponseError(resp.GetError(), err)
+}
+
+// DeleteCoupon is the GRPC endpoint to delete a coupon.
+func (c *CouponClient) DeleteCoupon(ctx context.Context, req *pb.DeleteCouponRequest, opts ...grpc.CallOption) (*pb.DeleteCouponResponse, error) {
+	return c.delete(ctx, req)
+}
+
+// Delete deletes a coupon with a default context
+func (c *CouponClient) Delete(req *pb.DeleteCouponRequest) (*pb.DeleteCouponResponse, error) {
+	return c.delete(context.Background(), req)
+}
+
+// DeleteWithCtx deletes a coupon with a custom context
+func (c *CouponClient) DeleteWithCtx(ctx context.Context, req *pb.DeleteCouponRequest) (*pb.DeleteCouponResponse, error) {
+	return c.delete(ctx, req)
+}
+
+func (c *CouponClient) delete(ctx context.Context, req *pb.DeleteCouponRequest) (*pb.DeleteCouponResponse, error) {
+	ctx, cancel := c.client.withTimeout(ctx)
+	defer cancel()
+
+	resp, err := c.backend.Delete(ctx, req)
+	logResponse(c.client.Logger.WithFields(log.Fields{"action": "delete", "coupon": req.GetId()}), resp.GetError(), err)
+	return resp, pb.ResponseError(resp.GetError(), err)
+}
+
+// GetCoupon is the GRPC endpoint to get a coupon.
+func (c *CouponClient) GetCoupon(ctx context.Context, req *pb.GetCouponRequest, opts ...grpc.CallOption) (*pb.CouponResponse, error) {
+	return c.get(ctx, req)
+}
+
+// Get gets a coupon with a default context
+func (c *CouponClient) Get(req *pb.GetCouponRequest) (*pb.CouponResponse, error) {
+	return c.get(context.Background(), req)
+}
+
+// GetWithCtx gets a coupon with a custom context
+func (c *CouponClient) GetWithCtx(ctx context.Context, req *pb.GetCouponRequest) (*pb.CouponResponse, error) {
+	return c.get(ctx, req)
+}
+
+func (c *CouponClient) get(ctx context.Context, req *pb.GetCouponRequest) (*pb.CouponResponse, error) {
+	ctx, cancel := c.client.withTimeout(ctx)
+	defer cancel()
+
+	resp, err := c.backend.Get(ctx, req)
+	logResponse(c.client.Logger.WithFields(log.Fields{"action": "get", "coupon": req.GetId()}), resp.GetError(), err)
+	return resp, pb.ResponseError(resp.GetError(), err)
+}
+
+// ApplyCoupon is the GRPC endpoint to apply a coupon to a customer or subscription.
+func (c *CouponClient) ApplyCoupon(ctx context.Context, req *pb.ApplyCouponRequest, opts ...grpc.CallOption) (*pb.DiscountResponse, error) {
+	return c.apply(ctx, req)
+}
+
+// Apply applies a coupon to a customer or subscription with a default context
+func (c *CouponClient) Apply(req *pb.ApplyCouponRequest) (*pb.DiscountResponse, error) {
+	return c.apply(context.Background(), req)
+}
+
+// ApplyWithCtx applies a coupon to a customer or subscription with a custom context
+func (c *CouponClient) ApplyWithCtx(ctx context.Context, req *pb.ApplyCouponRequest) (*pb.DiscountResponse, error) {
+	return c.apply(ctx, req)
+}
+
+func (c *CouponClient) apply(ctx context.Context, req *pb.ApplyCouponRequest) (*pb.DiscountResponse, error) {
+	ctx, cancel := c.client.withTimeout(ctx)
+	defer cancel()
+
+	resp, err := c.backend.Apply(ctx, req)
+	logResponse(c.client.Logger.WithFields(discountFields("apply", req.GetCoupon(), req.GetCustomer(), req.GetSubscription())), resp.GetError(), err)
+	return resp, pb.ResponseError(resp.GetError(), err)
+}
+
+// RemoveDiscount is the GRPC endpoint to remove the discount from a customer or subscription.
+func (c *CouponClient) RemoveDiscount(ctx context.Context, req *pb.RemoveDiscountRequest, opts ...grpc.CallOption) (*pb.RemoveDiscountResponse, error) {
+	return c.removeDiscount(ctx, req)
+}
+
+// Remove removes the discount from a customer or subscription with a default context
+func (c *CouponClient) Remove(req *pb.RemoveDiscountRequest) (*pb.RemoveDiscountResponse, error) {
+	return c.removeDiscount(context.Background(), req)
+}
+
+// RemoveWithCtx removes the discount from a customer or subscription with a custom context
+func (c *CouponClient) RemoveWithCtx(ctx context.Context, req *pb.RemoveDiscountRequest) (*pb.RemoveDiscountResponse, error) {
+	return c.removeDiscount(ctx, req)
+}
+
+func (c *CouponClient) removeDiscount(ctx context.Context, req *pb.RemoveDiscountRequest) (*pb.RemoveDiscountResponse, error) {
+	ctx, cancel := c.client.withTimeout(ctx)
+	defer cancel()
+
+	resp, err := c.backend.RemoveDiscount(ctx, req)
+	logResponse(c.client.Logger.WithFields(discountFields("remove_discount", "", req.GetCustomer(), req.GetSubscription())), resp.GetError(), err)
+	return resp, pb.ResponseError(resp.GetError(), err)
+}
+
+// discountFields returns the log fields for a discount request, which targets either a
+// customer or a subscription
+func discountFields(action string, coupon string, customer string, subscription string) log.Fields {
+	fields := log.Fields{"action": action}
+	if len(coupon) > 0 {
+		fields["coupon"] = coupon
+	}
+	switch {
+	case len(customer) > 0:
+		fields["customer"] = customer
+	case len(subscription) > 0:
+		fields["subscription"] = subscription
+	}
+	return fields
+}
+
+// ListCoupons is the GRPC endpoint to list coupons.
+func (c *CouponClient) ListCoupons(ctx context.Context, req *pb.ListCouponsRequest, opts ...grpc.CallOption) (pb.Coupons_ListCouponsClient, error) {
+	ctx, cancel := c.client.withTimeout(ctx)
+	stream, err := c.list(ctx, req)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	return &couponListClient{listClient: listClient{ctx: ctx, cancel: cancel}, stream: stream}, nil
+}
+
+// List lists coupons with a default context.  The client timeout applies to the entire
+// iteration of the returned stream.
+func (c *CouponClient) List(req *pb.ListCouponsRequest) (backend.CouponStreamer, error) {
+	return c.ListWithCtx(context.Background(), req)
+}
+
+// ListWithCtx lists coupons with a custom context
+func (c *CouponClient) ListWithCtx(ctx context.Context, req *pb.ListCouponsRequest) (backend.CouponStreamer, error) {
+	ctx, cancel := c.client.withTimeout(ctx)
+	stream, err := c.list(ctx, req)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	return &cancelCouponStreamer{CouponStreamer: stream, cancel: cancel}, nil
+}
+
+func (c *CouponClient) list(ctx context.Context, req *pb.ListCouponsRequest) (backend.CouponStreamer, error) {
+	stream, err := c.backend.List(ctx, req)
+	logList(c.client.Logger.WithFields(log.Fields{"action": "list", "resource": "coupon"}), err)
+	return stream, err
+}
+
+// cancelCouponStreamer releases the context of a list request when the stream is exhausted
+type cancelCouponStreamer struct {
+	backend.CouponStreamer
+	cancel context.CancelFunc
+}
+
+func (s *cancelCouponStreamer) Next() bool {
+	if s.CouponStreamer.Next() {
+		return true
+	}
+	s.cancel()
+	return false
+}
+
+// Close stops iteration and releases the context of the list request
+func (s *cancelCouponStreamer) Close() {
+	s.CouponStreamer.Close()
+	s.cancel()
+}
+
+// couponListClient adapts a CouponStreamer to the GRPC client stream interface
+type couponListClient struct {
+	listClient
+	stream backend.CouponStreamer
+}
+
+func (s *couponListClient) Recv() (*pb.CouponResponse, error) {
+	if !s.stream.Next() {
+		s.cancel()
+		if err := s.stream.Err(); err != nil {
+			return nil, err
+		}
+		return nil, io.EOF
+	}
+	return s.stream.Current(), nil
+}
+
+func (s *couponListClient) RecvMsg(m interface{}) error {
+	resp, err := s.Recv()
+	if err != nil {
+		return err
+	}
+	out, ok := m.(*pb.CouponResponse)
+	if !ok {
+		return fmt.Errorf("unexpected message type %T", m)
+	}
+	*out = *resp
+	return nil
+}
@@ -0,0 +1,202 @@
+package recur
+
+import (
+	"fmt"
+	"io"
+
+	"google.golang.org/grpc"
+
+	"github.com/BTBurke/recur/backend"
+	"github.com/BTBurke/recur/pb"
+	log "github.com/sirupsen/logrus"
+	context "golang.org/x/net/context"
+)
+
+// CustomerClient is the library facade for customer operations.  It satisfies pb.CustomersClient so that it
+// can be used interchangeably with a GRPC client connected to a recur service.
+type CustomerClient struct {
+	backend backend.CustomerClient
+	client  *Client
+}
+
+var _ pb.CustomersClient = (*CustomerClient)(nil)
+
+// CreateCustomer is the GRPC endpoint to create a customer.
+func (c *CustomerClient) CreateCustomer(ctx context.Context, req *pb.CreateCustomerRequest, opts ...grpc.CallOption) (*pb.CustomerResponse, error) {
+	return c.create(ctx, req)
+}
+
+// Create creates a customer with a default context
+func (c *CustomerClient) Create(req *pb.CreateCustomerRequest) (*pb.CustomerResponse, error) {
+	return c.create(context.Background(), req)
+}
+
+// CreateWithCtx creates a customer with a custom context
+func (c *CustomerClient) CreateWithCtx(ctx context.Context, req *pb.CreateCustomerRequest) (*pb.CustomerResponse, error) {
+	return c.create(ctx, req)
+}
+
+func (c *CustomerClient) create(ctx context.Context, req *pb.CreateCustomerRequest) (*pb.CustomerResponse, error) {
+	ctx, cancel := c.client.withTimeout(ctx)
+	defer cancel()
+
+	resp, err := c.backend.Create(ctx, req)
+	logResponse(c.client.Logger.WithFields(log.Fields{"action": "create", "customer": resp.GetSuccess().GetId()}), resp.GetError(), err)
+	return resp, pb.ResponseError(resp.GetError(), err)
+}
+
+// UpdateCustomer is the GRPC endpoint to update a customer.
+func (c *CustomerClient) UpdateCustomer(ctx context.Context, req *pb.UpdateCustomerRequest, opts ...grpc.CallOption) (*pb.CustomerResponse, error) {
+	return c.update(ctx, req)
+}
+
+// Update updates a customer with a default context
+func (c *CustomerClient) Update(req *pb.UpdateCustomerRequest) (*pb.CustomerResponse, error) {
+	return c.update(context.Background(), req)
+}
+
+// UpdateWithCtx updates a customer with a custom context
+func (c *CustomerClient) UpdateWithCtx(ctx context.Context, req *pb.UpdateCustomerRequest) (*pb.CustomerResponse, error) {
+	return c.update(ctx, req)
+}
+
+func (c *CustomerClient) update(ctx context.Context, req *pb.UpdateCustomerRequest) (*pb.CustomerResponse, error) {
+	ctx, cancel := c.client.withTimeout(ctx)
+	defer cancel()
+
+	resp, err := c.backend.Update(ctx, req)
+	logResponse(c.client.Logger.WithFields(log.Fields{"action": "update", "customer": req.GetId()}), resp.GetError(), err)
+	return resp, pb.ResponseError(resp.GetError(), err)
+}
+
+// DeleteCustomer is the GRPC endpoint to delete a customer.
+func (c *CustomerClient) DeleteCustomer(ctx context.Context, req *pb.DeleteCustomerRequest, opts ...grpc.CallOption) (*pb.DeleteCustomerResponse, error) {
+	return c.delete(ctx, req)
+}
+
+// Delete deletes a customer with a default context
+func (c *CustomerClient) Delete(req *pb.DeleteCustomerRequest) (*pb.DeleteCustomerResponse, error) {
+	return c.delete(context.Background(), req)
+}
+
+// DeleteWithCtx deletes a customer with a custom context
+func (c *CustomerClient) DeleteWithCtx(ctx context.Context, req *pb.DeleteCustomerRequest) (*pb.DeleteCustomerResponse, error) {
+	return c.delete(ctx, req)
+}
+
+func (c *CustomerClient) delete(ctx context.Context, req *pb.DeleteCustomerRequest) (*pb.DeleteCustomerResponse, error) {
+	ctx, cancel := c.client.withTimeout(ctx)
+	defer cancel()
+
+	resp, err := c.backend.Delete(ctx, req)
+	logResponse(c.client.Logger.WithFields(log.Fields{"action": "delete", "customer": req.GetId()}), resp.GetError(), err)
+	return resp, pb.ResponseError(resp.GetError(), err)
+}
+
+// GetCustomer is the GRPC endpoint to get a customer.
+func (c *CustomerClient) GetCustomer(ctx context.Context, req *pb.GetCustomerRequest, opts ...grpc.CallOption) (*pb.CustomerResponse, error) {
+	return c.get(ctx, req)
+}
+
+// Get gets a customer with a default context
+func (c *CustomerClient) Get(req *pb.GetCustomerRequest) (*pb.CustomerResponse, error) {
+	return c.get(context.Background(), req)
+}
+
+// GetWithCtx gets a customer with a custom context
+func (c *CustomerClient) GetWithCtx(ctx context.Context, req *pb.GetCustomerRequest) (*pb.CustomerResponse, error) {
+	return c.get(ctx, req)
+}
+
+func (c *CustomerClient) get(ctx context.Context, req *pb.GetCustomerRequest) (*pb.CustomerResponse, error) {
+	ctx, cancel := c.client.withTimeout(ctx)
+	defer cancel()
+
+	resp, err := c.backend.Get(ctx, req)
+	logResponse(c.client.Logger.WithFields(log.Fields{"action": "get", "customer": req.GetId()}), resp.GetError(), err)
+	return resp, pb.ResponseError(resp.GetError(), err)
+}
+
+// ListCustomers is the GRPC endpoint to list customers.
+func (c *CustomerClient) ListCustomers(ctx context.Context, req *pb.ListCustomersRequest, opts ...grpc.CallOption) (pb.Customers_ListCustomersClient, error) {
+	ctx, cancel := c.client.withTimeout(ctx)
+	stream, err := c.list(ctx, req)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	return &customerListClient{listClient: listClient{ctx: ctx, cancel: cancel}, stream: stream}, nil
+}
+
+// List lists customers with a default context.  The client timeout applies to the entire
+// iteration of the returned stream.
+func (c *CustomerClient) List(req *pb.ListCustomersRequest) (backend.CustomerStreamer, error) {
+	return c.ListWithCtx(context.Background(), req)
+}
+
+// ListWithCtx lists customers with a custom context
+func (c *CustomerClient) ListWithCtx(ctx context.Context, req *pb.ListCustomersRequest) (backend.CustomerStreamer, error) {
+	ctx, cancel := c.client.withTimeout(ctx)
+	stream, err := c.list(ctx, req)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	return &cancelCustomerStreamer{CustomerStreamer: stream, cancel: cancel}, nil
+}
+
+func (c *CustomerClient) list(ctx context.Context, req *pb.ListCustomersRequest) (backend.CustomerStreamer, error) {
+	stream, err := c.backend.List(ctx, req)
+	logList(c.client.Logger.WithFields(log.Fields{"action": "list", "resource": "customer"}), err)
+	return stream, err
+}
+
+// cancelCustomerStreamer releases the context of a list request when the stream is exhausted
+type cancelCustomerStreamer struct {
+	backend.CustomerStreamer
+	cancel context.CancelFunc
+}
+
+func (s *cancelCustomerStreamer) Next() bool {
+	if s.CustomerStreamer.Next() {
+		return true
+	}
+	s.cancel()
+	return false
+}
+
+// Close stops iteration and releases the context of the list request
+func (s *cancelCustomerStreamer) Close() {
+	s.CustomerStreamer.Close()
+	s.cancel()
+}
+
+// customerListClient adapts a CustomerStreamer to the GRPC client stream interface
+type customerListClient struct {
+	listClient
+	stream backend.CustomerStreamer
+}
+
+func (s *customerListClient) Recv() (*pb.CustomerResponse, error) {
+	if !s.stream.Next() {
+		s.cancel()
+		if err := s.stream.Err(); err != nil {
+			return nil, err
+		}
+		return nil, io.EOF
+	}
+	return s.stream.Current(), nil
+}
+
+func (s *customerListClient) RecvMsg(m interface{}) error {
+	resp, err := s.Recv()
+	if err != nil {
+		return err
+	}
+	out, ok := m.(*pb.CustomerResponse)
+	if !ok {
+		return fmt.Errorf("unexpected message type %T", m)
+	}
+	*out = *resp
+	return nil
+}
@@ -0,0 +1,140 @@
+package recur
+
+import (
+	"fmt"
+	"io"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/BTBurke/recur/backend"
+	"github.com/BTBurke/recur/pb"
+	log "github.com/sirupsen/logrus"
+	context "golang.org/x/net/context"
+)
+
+// EventClient is the library facade for retrieving past events.  It satisfies pb.EventsClient so
+// that it can be used interchangeably with a GRPC client connected to a recur service.  New
+// events are received with a webhook.Handler; use Backfill on the handler to replay events that
+// were missed.
+type EventClient struct {
+	backend backend.EventClient
+	client  *Client
+}
+
+var _ pb.EventsClient = (*EventClient)(nil)
+
+// GetEvent is the GRPC endpoint to get an event.
+func (c *EventClient) GetEvent(ctx context.Context, req *pb.GetEventRequest, opts ...grpc.CallOption) (*pb.EventResponse, error) {
+	return c.get(ctx, req)
+}
+
+// Get gets an event with a default context
+func (c *EventClient) Get(req *pb.GetEventRequest) (*pb.EventResponse, error) {
+	return c.get(context.Background(), req)
+}
+
+// GetWithCtx gets an event with a custom context
+func (c *EventClient) GetWithCtx(ctx context.Context, req *pb.GetEventRequest) (*pb.EventResponse, error) {
+	return c.get(ctx, req)
+}
+
+func (c *EventClient) get(ctx context.Context, req *pb.GetEventRequest) (*pb.EventResponse, error) {
+	ctx, cancel := c.client.withTimeout(ctx)
+	defer cancel()
+
+	resp, err := c.backend.Get(ctx, req)
+	logResponse(c.client.Logger.WithFields(log.Fields{"action": "get", "event": req.GetId()}), resp.GetError(), err)
+	return resp, pb.ResponseError(resp.GetError(), err)
+}
+
+// ListEvents is the GRPC endpoint to list events.
+func (c *EventClient) ListEvents(ctx context.Context, req *pb.ListEventsRequest, opts ...grpc.CallOption) (pb.Events_ListEventsClient, error) {
+	ctx, cancel := c.client.withTimeout(ctx)
+	stream, err := c.list(ctx, req)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	return &eventListClient{listClient: listClient{ctx: ctx, cancel: cancel}, stream: stream}, nil
+}
+
+// List lists events with a default context.  The client timeout applies to the entire
+// iteration of the returned stream.
+func (c *EventClient) List(req *pb.ListEventsRequest) (backend.EventStreamer, error) {
+	return c.ListWithCtx(context.Background(), req)
+}
+
+// ListWithCtx lists events with a custom context
+func (c *EventClient) ListWithCtx(ctx context.Context, req *pb.ListEventsRequest) (backend.EventStreamer, error) {
+	ctx, cancel := c.client.withTimeout(ctx)
+	stream, err := c.list(ctx, req)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	return &cancelEventStreamer{EventStreamer: stream, cancel: cancel}, nil
+}
+
+func (c *EventClient) list(ctx context.Context, req *pb.ListEventsRequest) (backend.EventStreamer, error) {
+	stream, err := c.backend.List(ctx, req)
+	logList(c.client.Logger.WithFields(log.Fields{"action": "list", "resource": "event"}), err)
+	return stream, err
+}
+
+// cancelEventStreamer releases the context of a list request when the stream is exhausted
+type cancelEventStreamer struct {
+	backend.EventStreamer
+	cancel context.CancelFunc
+}
+
+func (s *cancelEventStreamer) Next() bool {
+	if s.EventStreamer.Next() {
+		return true
+	}
+	s.cancel()
+	return false
+}
+
+// Close stops iteration and releases the context of the list request
+func (s *cancelEventStreamer) Close() {
+	s.EventStreamer.Close()
+	s.cancel()
+}
+
+// eventListClient adapts an EventStreamer to the GRPC client stream interface
+type eventListClient struct {
+	listClient
+	stream backend.EventStreamer
+}
+
+func (s *eventListClient) Recv() (*pb.EventResponse, error) {
+	if !s.stream.Next() {
+		s.cancel()
+		if err := s.stream.Err(); err != nil {
+			return nil, err
+		}
+		return nil, io.EOF
+	}
+	return s.stream.Current(), nil
+}
+
+func (s *eventListClient) RecvMsg(m interface{}) error {
+	resp, err := s.Recv()
+	if err != nil {
+		return err
+	}
+	out, ok := m.(*pb.EventResponse)
+	if !ok {
+		return fmt.Errorf("unexpected message type %T", m)
+	}
+	*out = *resp
+	return nil
+}
+
+// SubscribeEvents is not available from the library.  Events are received over HTTP with a
+// webhook.Handler, which can be subscribed to directly.
+func (c *EventClient) SubscribeEvents(ctx context.Context, req *pb.SubscribeEventsRequest, opts ...grpc.CallOption) (pb.Events_SubscribeEventsClient, error) {
+	return nil, status.Error(codes.Unimplemented, "subscribe to a webhook.Handler to receive events")
+}
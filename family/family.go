@@ -0,0 +1,142 @@
+// Package family manages plan families: the same plan sold in several currencies.
+//
+// A family is defined once with a price in a base currency.  Plans derives a request to create
+// the plan in each currency of the family by converting the price with a table of exchange rates
+// and rounding it to a price that reads well in that currency, such as €17.99 or ¥2,000.  Each
+// plan is given the ID "<family>-<currency>" and the family ID in its metadata, so that List can
+// find every plan in the family from any backend.
+//
+// Exchange rates are loaded from a JSON or CSV file with LoadRates, or supplied by any type that
+// implements Rates.
+package family
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/BTBurke/recur/backend"
+	"github.com/BTBurke/recur/money"
+	"github.com/BTBurke/recur/pb"
+	context "golang.org/x/net/context"
+)
+
+// MetadataKey is the plan metadata key holding the ID of the family a plan belongs to
+const MetadataKey = "plan_family"
+
+// Family is a plan sold at an equivalent price in several currencies
+type Family struct {
+	ID                  string
+	Name                string
+	Price               money.Money
+	Interval            pb.Interval
+	IntervalCount       uint64
+	TrialPeriodDays     uint64
+	StatementDescriptor string
+	Metadata            map[string]string
+	// Currencies lists the currencies to sell the plan in besides the currency of the price
+	Currencies []pb.Currency
+}
+
+// PlanID returns the ID of the plan in the family for a currency, e.g. gold-eur
+func (f *Family) PlanID(c pb.Currency) string {
+	return fmt.Sprintf("%s-%s", f.ID, strings.ToLower(c.String()))
+}
+
+// Plans returns a request to create the plan in each currency of the family, starting with the
+// currency of the price, which is used as given.  The price is converted into the other
+// currencies with the rates.  Each request is validated, so a converted price below the minimum
+// charge of its currency is an error.
+func (f *Family) Plans(rates Rates) ([]*pb.CreatePlanRequest, error) {
+	if len(f.ID) == 0 {
+		return nil, fmt.Errorf("family: no id")
+	}
+	if f.Price.Amount < 0 {
+		return nil, fmt.Errorf("family %s: price %s is negative", f.ID, f.Price)
+	}
+	currencies := append([]pb.Currency{f.Price.Currency}, f.Currencies...)
+	seen := make(map[pb.Currency]bool, len(currencies))
+	var reqs []*pb.CreatePlanRequest
+	for _, c := range currencies {
+		if seen[c] {
+			continue
+		}
+		seen[c] = true
+		price, err := rates.Convert(f.Price, c)
+		if err != nil {
+			return nil, fmt.Errorf("family %s: %s", f.ID, strings.TrimPrefix(err.Error(), "family: "))
+		}
+		req := f.createRequest(price)
+		if err := req.Validate(); err != nil {
+			return nil, fmt.Errorf("family %s: plan %s: %s", f.ID, req.Id, err)
+		}
+		reqs = append(reqs, req)
+	}
+	return reqs, nil
+}
+
+func (f *Family) createRequest(price money.Money) *pb.CreatePlanRequest {
+	meta := make(map[string]string, len(f.Metadata)+1)
+	for k, v := range f.Metadata {
+		meta[k] = v
+	}
+	meta[MetadataKey] = f.ID
+	req := &pb.CreatePlanRequest{
+		Id:                  f.PlanID(price.Currency),
+		Amount:              uint64(price.Amount),
+		Currency:            price.Currency,
+		Interval:            f.Interval,
+		Name:                f.Name,
+		IntervalCount:       f.IntervalCount,
+		Metadata:            meta,
+		StatementDescriptor: f.StatementDescriptor,
+		TrialPeriodDays:     f.TrialPeriodDays,
+	}
+	if req.IntervalCount == 0 {
+		req.IntervalCount = 1
+	}
+	return req
+}
+
+// Create creates the plans in the family, stopping at the first error.  It returns the plans
+// created.
+func Create(ctx context.Context, plans backend.PlanClient, f *Family, rates Rates) ([]*pb.Plan, error) {
+	reqs, err := f.Plans(rates)
+	if err != nil {
+		return nil, err
+	}
+	var created []*pb.Plan
+	for _, req := range reqs {
+		resp, err := plans.Create(ctx, req)
+		if err := pb.ResponseError(resp.GetError(), err); err != nil {
+			return created, err
+		}
+		created = append(created, resp.GetSuccess())
+	}
+	return created, nil
+}
+
+// List returns the plans in a family, found by the family ID in their metadata and ordered by
+// currency
+func List(ctx context.Context, plans backend.PlanClient, id string) ([]*pb.Plan, error) {
+	stream, err := plans.List(ctx, &pb.ListPlansRequest{Limit: 100})
+	if err != nil {
+		return nil, err
+	}
+	defer stream.Close()
+	var out []*pb.Plan
+	for stream.Next() {
+		resp := stream.Current()
+		if err := pb.ResponseError(resp.GetError(), nil); err != nil {
+			return nil, err
+		}
+		if p := resp.GetSuccess(); p.GetMetadata()[MetadataKey] == id {
+			out = append(out, p)
+		}
+	}
+	if err := stream.Err(); err != nil {
+		return nil, err
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Currency.String() < out[j].Currency.String() })
+	return out, nil
+}
@@ -0,0 +1,94 @@
+package family
+
+import (
+	"testing"
+
+	"github.com/BTBurke/recur/backend/memory"
+	"github.com/BTBurke/recur/money"
+	"github.com/BTBurke/recur/pb"
+	"github.com/stretchr/testify/assert"
+	context "golang.org/x/net/context"
+)
+
+func testFamily() *Family {
+	return &Family{
+		ID:              "gold",
+		Name:            "Gold",
+		Price:           money.New(2000, pb.Currency_USD),
+		Interval:        pb.Interval_Month,
+		TrialPeriodDays: 14,
+		Metadata:        map[string]string{"tier": "gold"},
+		Currencies:      []pb.Currency{pb.Currency_EUR, pb.Currency_JPY, pb.Currency_USD},
+	}
+}
+
+func TestPlans(t *testing.T) {
+	rates, err := LoadRates("testdata/rates.json")
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	reqs, err := testFamily().Plans(rates)
+	if !assert.NoError(t, err) || !assert.Len(t, reqs, 3) {
+		return
+	}
+	assert.Equal(t, &pb.CreatePlanRequest{
+		Id:              "gold-eur",
+		Amount:          1899,
+		Currency:        pb.Currency_EUR,
+		Interval:        pb.Interval_Month,
+		Name:            "Gold",
+		IntervalCount:   1,
+		Metadata:        map[string]string{"tier": "gold", MetadataKey: "gold"},
+		TrialPeriodDays: 14,
+	}, reqs[1])
+	var ids []string
+	for _, req := range reqs {
+		ids = append(ids, req.Id)
+	}
+	assert.Equal(t, []string{"gold-usd", "gold-eur", "gold-jpy"}, ids, "the base currency comes first and is not repeated")
+
+	f := testFamily()
+	f.Currencies = append(f.Currencies, pb.Currency_CAD)
+	_, err = f.Plans(rates)
+	assert.EqualError(t, err, "family gold: no exchange rate for CAD")
+
+	cheap := NewTable(pb.Currency_USD)
+	assert.NoError(t, cheap.SetRate(pb.Currency_GBP, "0.5"))
+	f = testFamily()
+	f.Price = money.New(50, pb.Currency_USD)
+	f.Currencies = []pb.Currency{pb.Currency_GBP}
+	_, err = f.Plans(cheap)
+	assert.Error(t, err, "£0.25 is below the minimum charge in GBP")
+}
+
+func TestCreateAndList(t *testing.T) {
+	plans := memory.NewPlanClient(memory.NewStore())
+	ctx := context.Background()
+	rates, err := LoadRates("testdata/rates.csv")
+	if !assert.NoError(t, err) {
+		return
+	}
+	_, err = plans.Create(ctx, &pb.CreatePlanRequest{Id: "silver", Name: "Silver", Amount: 900, Currency: pb.Currency_USD, Interval: pb.Interval_Month})
+	assert.NoError(t, err)
+
+	created, err := Create(ctx, plans, testFamily(), rates)
+	assert.NoError(t, err)
+	assert.Len(t, created, 3)
+
+	_, err = Create(ctx, plans, testFamily(), rates)
+	assert.Error(t, err, "the plans already exist")
+
+	family, err := List(ctx, plans, "gold")
+	assert.NoError(t, err)
+	var prices []string
+	for _, p := range family {
+		m, _ := money.FromUnsigned(p.Amount, p.Currency)
+		prices = append(prices, p.Id+" "+m.String())
+	}
+	assert.Equal(t, []string{"gold-eur €18.99", "gold-jpy ¥3,000", "gold-usd $20.00"}, prices)
+
+	none, err := List(ctx, plans, "platinum")
+	assert.NoError(t, err)
+	assert.Empty(t, none)
+}
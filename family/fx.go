@@ -0,0 +1,295 @@
+package family
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/big"
+	"path/filepath"
+	"strings"
+
+	"github.com/BTBurke/recur/money"
+	"github.com/BTBurke/recur/pb"
+)
+
+// Rates converts a price into another currency.  Table is an implementation backed by a fixed
+// rate table loaded from a file, but any source of exchange rates can be used.
+type Rates interface {
+	Convert(m money.Money, to pb.Currency) (money.Money, error)
+}
+
+// Rounding is a rule for rounding converted prices in a currency, in the minor unit of the
+// currency.  Prices are rounded up to the next amount that is a multiple of Step plus Ending, so
+// that a Step of 100 and an Ending of 99 prices in EUR at €17.99 rather than €17.23.  A zero Step
+// rounds to the nearest minor unit.
+type Rounding struct {
+	Step   uint64
+	Ending uint64
+}
+
+// ParseRounding returns the rounding rule for a step and ending written in the major unit of the
+// currency, such as "1" and "0.99" for prices ending in .99 or "100" and "" for JPY prices in
+// whole hundreds
+func ParseRounding(step string, ending string, currency pb.Currency) (Rounding, error) {
+	var r Rounding
+	for _, f := range []struct {
+		s   string
+		dst *uint64
+	}{{step, &r.Step}, {ending, &r.Ending}} {
+		if len(strings.TrimSpace(f.s)) == 0 {
+			continue
+		}
+		m, err := money.Parse(f.s, currency)
+		if err != nil {
+			return Rounding{}, err
+		}
+		if m.Amount < 0 {
+			return Rounding{}, fmt.Errorf("rounding %q is negative", f.s)
+		}
+		*f.dst = uint64(m.Amount)
+	}
+	return r, r.validate()
+}
+
+func (r Rounding) validate() error {
+	if r.Ending > 0 && r.Ending >= r.Step {
+		return fmt.Errorf("rounding ending %d must be less than the step %d", r.Ending, r.Step)
+	}
+	return nil
+}
+
+// apply rounds an exact amount in minor units according to the rule
+func (r Rounding) apply(x *big.Rat) *big.Int {
+	if r.Step == 0 {
+		half := new(big.Rat).Add(x, big.NewRat(1, 2))
+		return floor(half)
+	}
+	step := new(big.Int).SetUint64(r.Step)
+	ending := new(big.Int).SetUint64(r.Ending)
+	k := ceil(new(big.Rat).Quo(new(big.Rat).Sub(x, new(big.Rat).SetInt(ending)), new(big.Rat).SetInt(step)))
+	if k.Sign() < 0 {
+		k.SetInt64(0)
+	}
+	return k.Mul(k, step).Add(k, ending)
+}
+
+// floor returns the largest integer less than or equal to a non-negative rational
+func floor(x *big.Rat) *big.Int {
+	return new(big.Int).Quo(x.Num(), x.Denom())
+}
+
+// ceil returns the smallest integer greater than or equal to x
+func ceil(x *big.Rat) *big.Int {
+	q, m := new(big.Int).DivMod(x.Num(), x.Denom(), new(big.Int))
+	if m.Sign() != 0 {
+		q.Add(q, big.NewInt(1))
+	}
+	return q
+}
+
+// Table is a fixed table of exchange rates against a base currency, with optional rounding rules
+// for prices converted into each currency.  It is safe for concurrent use once loaded.
+type Table struct {
+	Base     pb.Currency
+	rates    map[pb.Currency]*big.Rat
+	rounding map[pb.Currency]Rounding
+}
+
+// NewTable returns an empty rate table for the base currency
+func NewTable(base pb.Currency) *Table {
+	return &Table{
+		Base:     base,
+		rates:    map[pb.Currency]*big.Rat{base: big.NewRat(1, 1)},
+		rounding: make(map[pb.Currency]Rounding),
+	}
+}
+
+// SetRate sets the number of units of the currency bought by one unit of the base currency, as a
+// decimal such as "0.92"
+func (t *Table) SetRate(c pb.Currency, rate string) error {
+	r, ok := new(big.Rat).SetString(strings.TrimSpace(rate))
+	if !ok || r.Sign() <= 0 {
+		return fmt.Errorf("family: invalid exchange rate %q for %s", rate, c)
+	}
+	if c == t.Base && r.Cmp(big.NewRat(1, 1)) != 0 {
+		return fmt.Errorf("family: exchange rate for the base currency %s must be 1", c)
+	}
+	t.rates[c] = r
+	return nil
+}
+
+// SetRounding sets the rounding rule for prices converted into the currency
+func (t *Table) SetRounding(c pb.Currency, r Rounding) error {
+	if err := r.validate(); err != nil {
+		return fmt.Errorf("family: %s: %s", c, err)
+	}
+	t.rounding[c] = r
+	return nil
+}
+
+// Convert converts a price into another currency and rounds it by the rule for that currency.
+// Prices are not changed when converted into their own currency and free prices stay free.
+func (t *Table) Convert(m money.Money, to pb.Currency) (money.Money, error) {
+	if m.Currency == to || m.Amount == 0 {
+		return money.New(m.Amount, to), nil
+	}
+	if m.Amount < 0 {
+		return money.Money{}, fmt.Errorf("family: cannot convert negative price %s", m)
+	}
+	from, ok := t.rates[m.Currency]
+	if !ok {
+		return money.Money{}, fmt.Errorf("family: no exchange rate for %s", m.Currency)
+	}
+	rate, ok := t.rates[to]
+	if !ok {
+		return money.Money{}, fmt.Errorf("family: no exchange rate for %s", to)
+	}
+
+	// amount in minor units of to = amount * rate(to) / rate(from) * 10^(exp(to) - exp(from))
+	x := new(big.Rat).SetInt64(m.Amount)
+	x.Mul(x, rate).Quo(x, from)
+	x.Mul(x, new(big.Rat).SetInt(pow10(to.Exponent())))
+	x.Quo(x, new(big.Rat).SetInt(pow10(m.Currency.Exponent())))
+
+	n := t.rounding[to].apply(x)
+	if !n.IsInt64() {
+		return money.Money{}, money.ErrOverflow
+	}
+	return money.New(n.Int64(), to), nil
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+// tableFile is the JSON form of a rate table:
+//
+//	{
+//	  "base": "usd",
+//	  "rates": {"eur": 0.92, "gbp": 0.79, "jpy": 149.5},
+//	  "rounding": {"eur": {"step": "1", "ending": "0.99"}, "jpy": {"step": "100"}}
+//	}
+type tableFile struct {
+	Base     string                 `json:"base"`
+	Rates    map[string]json.Number `json:"rates"`
+	Rounding map[string]struct {
+		Step   string `json:"step"`
+		Ending string `json:"ending"`
+	} `json:"rounding"`
+}
+
+// LoadRates reads a rate table from a file.  Files ending in .csv are decoded as CSV and all
+// others as JSON.
+func LoadRates(path string) (*Table, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		return ParseRatesCSV(bytes.NewReader(b))
+	default:
+		return ParseRatesJSON(b)
+	}
+}
+
+// ParseRatesJSON decodes a rate table from JSON.  Rates are the number of units of each currency
+// bought by one unit of the base currency and rounding rules are written in the major unit of the
+// currency.
+func ParseRatesJSON(b []byte) (*Table, error) {
+	var f tableFile
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&f); err != nil {
+		return nil, fmt.Errorf("family: %s", err)
+	}
+	base, err := pb.ParseCurrency(f.Base)
+	if err != nil {
+		return nil, fmt.Errorf("family: base: %s", err)
+	}
+	t := NewTable(base)
+	for code, rate := range f.Rates {
+		if err := t.set(code, rate.String(), nil); err != nil {
+			return nil, err
+		}
+	}
+	for code, r := range f.Rounding {
+		if err := t.set(code, "", []string{r.Step, r.Ending}); err != nil {
+			return nil, err
+		}
+	}
+	return t, nil
+}
+
+// ParseRatesCSV decodes a rate table from CSV with a header row.  The base, currency and rate
+// columns are required and the step and ending columns of the rounding rule are optional:
+//
+//	base,currency,rate,step,ending
+//	usd,eur,0.92,1,0.99
+//	usd,jpy,149.5,100,
+func ParseRatesCSV(r io.Reader) (*Table, error) {
+	rows, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("family: %s", err)
+	}
+	if len(rows) < 2 {
+		return nil, fmt.Errorf("family: rate table has no rates")
+	}
+	cols := make(map[string]int)
+	for i, name := range rows[0] {
+		cols[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, name := range []string{"base", "currency", "rate"} {
+		if _, ok := cols[name]; !ok {
+			return nil, fmt.Errorf("family: rate table has no %s column", name)
+		}
+	}
+	get := func(row []string, name string) string {
+		if i, ok := cols[name]; ok && i < len(row) {
+			return strings.TrimSpace(row[i])
+		}
+		return ""
+	}
+
+	var t *Table
+	for i, row := range rows[1:] {
+		base, err := pb.ParseCurrency(get(row, "base"))
+		if err != nil {
+			return nil, fmt.Errorf("family: line %d: %s", i+2, err)
+		}
+		switch {
+		case t == nil:
+			t = NewTable(base)
+		case base != t.Base:
+			return nil, fmt.Errorf("family: line %d: base currency %s differs from %s", i+2, base, t.Base)
+		}
+		if err := t.set(get(row, "currency"), get(row, "rate"), []string{get(row, "step"), get(row, "ending")}); err != nil {
+			return nil, fmt.Errorf("family: line %d: %s", i+2, strings.TrimPrefix(err.Error(), "family: "))
+		}
+	}
+	return t, nil
+}
+
+// set parses and sets the rate and rounding rule for a currency code, skipping those not given
+func (t *Table) set(code string, rate string, rounding []string) error {
+	c, err := pb.ParseCurrency(code)
+	if err != nil {
+		return fmt.Errorf("family: %s", err)
+	}
+	if len(rate) > 0 {
+		if err := t.SetRate(c, rate); err != nil {
+			return err
+		}
+	}
+	if len(rounding) == 2 && len(rounding[0]+rounding[1]) > 0 {
+		r, err := ParseRounding(rounding[0], rounding[1], c)
+		if err != nil {
+			return fmt.Errorf("family: %s: %s", c, err)
+		}
+		t.rounding[c] = r
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package family
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/BTBurke/recur/money"
+	"github.com/BTBurke/recur/pb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadRates(t *testing.T) {
+	for _, path := range []string{"testdata/rates.json", "testdata/rates.csv"} {
+		t.Run(path, func(t *testing.T) {
+			table, err := LoadRates(path)
+			if !assert.NoError(t, err) {
+				return
+			}
+			assert.Equal(t, pb.Currency_USD, table.Base)
+
+			usd := money.New(2000, pb.Currency_USD)
+			for to, want := range map[pb.Currency]int64{
+				pb.Currency_USD: 2000,
+				pb.Currency_EUR: 1899,
+				pb.Currency_GBP: 1580,
+				pb.Currency_JPY: 3000,
+				pb.Currency_KWD: 6140,
+			} {
+				m, err := table.Convert(usd, to)
+				assert.NoError(t, err, to.String())
+				assert.Equal(t, money.New(want, to), m, to.String())
+			}
+
+			m, err := table.Convert(money.New(1000, pb.Currency_EUR), pb.Currency_GBP)
+			assert.NoError(t, err)
+			assert.Equal(t, money.New(859, pb.Currency_GBP), m, "converted through the base currency")
+
+			_, err = table.Convert(usd, pb.Currency_CAD)
+			assert.EqualError(t, err, "family: no exchange rate for CAD")
+		})
+	}
+}
+
+func TestRounding(t *testing.T) {
+	table := NewTable(pb.Currency_USD)
+	assert.NoError(t, table.SetRate(pb.Currency_EUR, "1"))
+	assert.NoError(t, table.SetRounding(pb.Currency_EUR, Rounding{Step: 100, Ending: 99}))
+
+	for amount, want := range map[int64]int64{1723: 1799, 1799: 1799, 1800: 1899, 50: 99, 0: 0} {
+		m, err := table.Convert(money.New(amount, pb.Currency_USD), pb.Currency_EUR)
+		assert.NoError(t, err)
+		assert.Equal(t, want, m.Amount, amount)
+	}
+
+	r, err := ParseRounding("10", "9.99", pb.Currency_USD)
+	assert.NoError(t, err)
+	assert.Equal(t, Rounding{Step: 1000, Ending: 999}, r)
+	_, err = ParseRounding("1", "1.50", pb.Currency_USD)
+	assert.Error(t, err, "ending must be less than the step")
+	_, err = ParseRounding("0.5", "", pb.Currency_JPY)
+	assert.Error(t, err, "JPY has no minor unit")
+	assert.Error(t, table.SetRounding(pb.Currency_EUR, Rounding{Ending: 99}))
+}
+
+func TestParseRatesErrors(t *testing.T) {
+	for _, in := range []string{
+		`{"base": "xyz", "rates": {"eur": 0.92}}`,
+		`{"base": "usd", "rates": {"eur": -1}}`,
+		`{"base": "usd", "rates": {"usd": 2}}`,
+		`{"base": "usd", "rates": {"eur": 0.92}, "fees": 1}`,
+		`{"base": "usd", "rounding": {"eur": {"step": "1", "ending": "2"}}}`,
+	} {
+		_, err := ParseRatesJSON([]byte(in))
+		assert.Error(t, err, in)
+	}
+
+	for _, in := range []string{
+		"base,currency,rate\n",
+		"currency,rate\neur,0.92\n",
+		"base,currency,rate\nusd,eur,0.92\ngbp,eur,1.16\n",
+		"base,currency,rate\nusd,eur,abc\n",
+	} {
+		_, err := ParseRatesCSV(strings.NewReader(in))
+		assert.Error(t, err, in)
+	}
+}
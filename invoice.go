@@ -0,0 +1,226 @@
+package recur
+
+import (
+	"fmt"
+	"io"
+
+	"google.golang.org/grpc"
+
+	"github.com/BTBurke/recur/backend"
+	"github.com/BTBurke/recur/pb"
+	log "github.com/sirupsen/logrus"
+	context "golang.org/x/net/context"
+)
+
+// InvoiceClient is the library facade for invoice operations.  It satisfies pb.InvoicesClient so that it
+// can be used interchangeably with a GRPC client connected to a recur service.
+type InvoiceClient struct {
+	backend backend.InvoiceClient
+	client  *Client
+}
+
+var _ pb.InvoicesClient = (*InvoiceClient)(nil)
+
+// GetInvoice is the GRPC endpoint to get an invoice.
+func (c *InvoiceClient) GetInvoice(ctx context.Context, req *pb.GetInvoiceRequest, opts ...grpc.CallOption) (*pb.InvoiceResponse, error) {
+	return c.get(ctx, req)
+}
+
+// Get gets an invoice with a default context
+func (c *InvoiceClient) Get(req *pb.GetInvoiceRequest) (*pb.InvoiceResponse, error) {
+	return c.get(context.Background(), req)
+}
+
+// GetWithCtx gets an invoice with a custom context
+func (c *InvoiceClient) GetWithCtx(ctx context.Context, req *pb.GetInvoiceRequest) (*pb.InvoiceResponse, error) {
+	return c.get(ctx, req)
+}
+
+func (c *InvoiceClient) get(ctx context.Context, req *pb.GetInvoiceRequest) (*pb.InvoiceResponse, error) {
+	ctx, cancel := c.client.withTimeout(ctx)
+	defer cancel()
+
+	resp, err := c.backend.Get(ctx, req)
+	logResponse(c.client.Logger.WithFields(log.Fields{"action": "get", "invoice": req.GetId()}), resp.GetError(), err)
+	return resp, pb.ResponseError(resp.GetError(), err)
+}
+
+// UpcomingInvoice is the GRPC endpoint to preview the next invoice for a customer.
+func (c *InvoiceClient) UpcomingInvoice(ctx context.Context, req *pb.UpcomingInvoiceRequest, opts ...grpc.CallOption) (*pb.InvoiceResponse, error) {
+	return c.upcoming(ctx, req)
+}
+
+// Upcoming previews the next invoice for a customer with a default context
+func (c *InvoiceClient) Upcoming(req *pb.UpcomingInvoiceRequest) (*pb.InvoiceResponse, error) {
+	return c.upcoming(context.Background(), req)
+}
+
+// UpcomingWithCtx previews the next invoice for a customer with a custom context
+func (c *InvoiceClient) UpcomingWithCtx(ctx context.Context, req *pb.UpcomingInvoiceRequest) (*pb.InvoiceResponse, error) {
+	return c.upcoming(ctx, req)
+}
+
+func (c *InvoiceClient) upcoming(ctx context.Context, req *pb.UpcomingInvoiceRequest) (*pb.InvoiceResponse, error) {
+	ctx, cancel := c.client.withTimeout(ctx)
+	defer cancel()
+
+	resp, err := c.backend.Upcoming(ctx, req)
+	logResponse(c.client.Logger.WithFields(log.Fields{"action": "upcoming", "customer": req.GetCustomer()}), resp.GetError(), err)
+	return resp, pb.ResponseError(resp.GetError(), err)
+}
+
+// PayInvoice is the GRPC endpoint to pay an invoice.
+func (c *InvoiceClient) PayInvoice(ctx context.Context, req *pb.PayInvoiceRequest, opts ...grpc.CallOption) (*pb.InvoiceResponse, error) {
+	return c.pay(ctx, req)
+}
+
+// Pay pays an invoice with a default context
+func (c *InvoiceClient) Pay(req *pb.PayInvoiceRequest) (*pb.InvoiceResponse, error) {
+	return c.pay(context.Background(), req)
+}
+
+// PayWithCtx pays an invoice with a custom context
+func (c *InvoiceClient) PayWithCtx(ctx context.Context, req *pb.PayInvoiceRequest) (*pb.InvoiceResponse, error) {
+	return c.pay(ctx, req)
+}
+
+func (c *InvoiceClient) pay(ctx context.Context, req *pb.PayInvoiceRequest) (*pb.InvoiceResponse, error) {
+	ctx, cancel := c.client.withTimeout(ctx)
+	defer cancel()
+
+	resp, err := c.backend.Pay(ctx, req)
+	logResponse(c.client.Logger.WithFields(log.Fields{"action": "pay", "invoice": req.GetId()}), resp.GetError(), err)
+	return resp, pb.ResponseError(resp.GetError(), err)
+}
+
+// VoidInvoice is the GRPC endpoint to void an invoice.
+func (c *InvoiceClient) VoidInvoice(ctx context.Context, req *pb.VoidInvoiceRequest, opts ...grpc.CallOption) (*pb.InvoiceResponse, error) {
+	return c.void(ctx, req)
+}
+
+// Void voids an invoice with a default context
+func (c *InvoiceClient) Void(req *pb.VoidInvoiceRequest) (*pb.InvoiceResponse, error) {
+	return c.void(context.Background(), req)
+}
+
+// VoidWithCtx voids an invoice with a custom context
+func (c *InvoiceClient) VoidWithCtx(ctx context.Context, req *pb.VoidInvoiceRequest) (*pb.InvoiceResponse, error) {
+	return c.void(ctx, req)
+}
+
+func (c *InvoiceClient) void(ctx context.Context, req *pb.VoidInvoiceRequest) (*pb.InvoiceResponse, error) {
+	ctx, cancel := c.client.withTimeout(ctx)
+	defer cancel()
+
+	resp, err := c.backend.Void(ctx, req)
+	logResponse(c.client.Logger.WithFields(log.Fields{"action": "void", "invoice": req.GetId()}), resp.GetError(), err)
+	return resp, pb.ResponseError(resp.GetError(), err)
+}
+
+// MarkUncollectibleInvoice is the GRPC endpoint to mark an invoice as uncollectible.
+func (c *InvoiceClient) MarkUncollectibleInvoice(ctx context.Context, req *pb.MarkUncollectibleInvoiceRequest, opts ...grpc.CallOption) (*pb.InvoiceResponse, error) {
+	return c.markUncollectible(ctx, req)
+}
+
+// MarkUncollectible marks an invoice as uncollectible with a default context
+func (c *InvoiceClient) MarkUncollectible(req *pb.MarkUncollectibleInvoiceRequest) (*pb.InvoiceResponse, error) {
+	return c.markUncollectible(context.Background(), req)
+}
+
+// MarkUncollectibleWithCtx marks an invoice as uncollectible with a custom context
+func (c *InvoiceClient) MarkUncollectibleWithCtx(ctx context.Context, req *pb.MarkUncollectibleInvoiceRequest) (*pb.InvoiceResponse, error) {
+	return c.markUncollectible(ctx, req)
+}
+
+func (c *InvoiceClient) markUncollectible(ctx context.Context, req *pb.MarkUncollectibleInvoiceRequest) (*pb.InvoiceResponse, error) {
+	ctx, cancel := c.client.withTimeout(ctx)
+	defer cancel()
+
+	resp, err := c.backend.MarkUncollectible(ctx, req)
+	logResponse(c.client.Logger.WithFields(log.Fields{"action": "mark_uncollectible", "invoice": req.GetId()}), resp.GetError(), err)
+	return resp, pb.ResponseError(resp.GetError(), err)
+}
+
+// ListInvoices is the GRPC endpoint to list invoices.
+func (c *InvoiceClient) ListInvoices(ctx context.Context, req *pb.ListInvoicesRequest, opts ...grpc.CallOption) (pb.Invoices_ListInvoicesClient, error) {
+	ctx, cancel := c.client.withTimeout(ctx)
+	stream, err := c.list(ctx, req)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	return &invoiceListClient{listClient: listClient{ctx: ctx, cancel: cancel}, stream: stream}, nil
+}
+
+// List lists invoices with a default context.  The client timeout applies to the entire
+// iteration of the returned stream.
+func (c *InvoiceClient) List(req *pb.ListInvoicesRequest) (backend.InvoiceStreamer, error) {
+	return c.ListWithCtx(context.Background(), req)
+}
+
+// ListWithCtx lists invoices with a custom context
+func (c *InvoiceClient) ListWithCtx(ctx context.Context, req *pb.ListInvoicesRequest) (backend.InvoiceStreamer, error) {
+	ctx, cancel := c.client.withTimeout(ctx)
+	stream, err := c.list(ctx, req)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	return &cancelInvoiceStreamer{InvoiceStreamer: stream, cancel: cancel}, nil
+}
+
+func (c *InvoiceClient) list(ctx context.Context, req *pb.ListInvoicesRequest) (backend.InvoiceStreamer, error) {
+	stream, err := c.backend.List(ctx, req)
+	logList(c.client.Logger.WithFields(log.Fields{"action": "list", "resource": "invoice"}), err)
+	return stream, err
+}
+
+// cancelInvoiceStreamer releases the context of a list request when the stream is exhausted
+type cancelInvoiceStreamer struct {
+	backend.InvoiceStreamer
+	cancel context.CancelFunc
+}
+
+func (s *cancelInvoiceStreamer) Next() bool {
+	if s.InvoiceStreamer.Next() {
+		return true
+	}
+	s.cancel()
+	return false
+}
+
+// Close stops iteration and releases the context of the list request
+func (s *cancelInvoiceStreamer) Close() {
+	s.InvoiceStreamer.Close()
+	s.cancel()
+}
+
+// invoiceListClient adapts an InvoiceStreamer to the GRPC client stream interface
+type invoiceListClient struct {
+	listClient
+	stream backend.InvoiceStreamer
+}
+
+func (s *invoiceListClient) Recv() (*pb.InvoiceResponse, error) {
+	if !s.stream.Next() {
+		s.cancel()
+		if err := s.stream.Err(); err != nil {
+			return nil, err
+		}
+		return nil, io.EOF
+	}
+	return s.stream.Current(), nil
+}
+
+func (s *invoiceListClient) RecvMsg(m interface{}) error {
+	resp, err := s.Recv()
+	if err != nil {
+		return err
+	}
+	out, ok := m.(*pb.InvoiceResponse)
+	if !ok {
+		return fmt.Errorf("unexpected message type %T", m)
+	}
+	*out = *resp
+	return nil
+}
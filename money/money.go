@@ -0,0 +1,249 @@
+// Package money handles amounts in the minor unit of a currency, as used by pb.Plan and the other
+// billing resources.  The number of decimal places of each currency comes from
+// pb.Currency.Exponent, so that ¥1,000 is stored as 1000 and $10.00 as 1000.
+//
+// Arithmetic returns an error rather than overflowing or mixing currencies.
+package money
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/BTBurke/recur/pb"
+)
+
+var (
+	// ErrCurrencyMismatch is returned when combining amounts in different currencies
+	ErrCurrencyMismatch = errors.New("money: currencies do not match")
+	// ErrOverflow is returned when the result of an operation does not fit in an int64
+	ErrOverflow = errors.New("money: amount overflows")
+)
+
+// Money is an amount in the minor unit of a currency, e.g. cents for USD
+type Money struct {
+	Amount   int64
+	Currency pb.Currency
+}
+
+// New returns an amount in the minor unit of the currency
+func New(amount int64, currency pb.Currency) Money {
+	return Money{Amount: amount, Currency: currency}
+}
+
+// FromUnsigned returns an unsigned amount, such as pb.Plan.Amount, as Money
+func FromUnsigned(amount uint64, currency pb.Currency) (Money, error) {
+	if amount > math.MaxInt64 {
+		return Money{}, ErrOverflow
+	}
+	return New(int64(amount), currency), nil
+}
+
+// symbols used when formatting amounts.  Currencies without a symbol are prefixed by their ISO
+// code.
+var symbols = map[pb.Currency]string{
+	pb.Currency_USD: "$",
+	pb.Currency_EUR: "€",
+	pb.Currency_GBP: "£",
+	pb.Currency_JPY: "¥",
+	pb.Currency_KRW: "₩",
+	pb.Currency_INR: "₹",
+	pb.Currency_ILS: "₪",
+	pb.Currency_NGN: "₦",
+	pb.Currency_PHP: "₱",
+	pb.Currency_THB: "฿",
+	pb.Currency_VND: "₫",
+	pb.Currency_RUB: "₽",
+	pb.Currency_UAH: "₴",
+	pb.Currency_TRY: "₺",
+}
+
+// Symbol returns the symbol of the currency, or its ISO code if it has no symbol
+func Symbol(c pb.Currency) string {
+	if s, ok := symbols[c]; ok {
+		return s
+	}
+	return c.String()
+}
+
+// String formats the amount for display with the currency symbol and thousands separators, e.g.
+// "$1,234.50", "¥1,000" or "KWD 1.500"
+func (m Money) String() string {
+	sym := Symbol(m.Currency)
+	if _, ok := symbols[m.Currency]; !ok {
+		sym += " "
+	}
+	sign, digits := m.split()
+	whole, frac := digits[:len(digits)-m.Currency.Exponent()], digits[len(digits)-m.Currency.Exponent():]
+	for i := len(whole) - 3; i > 0; i -= 3 {
+		whole = whole[:i] + "," + whole[i:]
+	}
+	if len(frac) > 0 {
+		frac = "." + frac
+	}
+	return sign + sym + whole + frac
+}
+
+// Decimal formats the amount as a plain decimal in the major unit without a symbol or separators,
+// e.g. "1234.50" or "1000", as used by APIs that take decimal amounts
+func (m Money) Decimal() string {
+	sign, digits := m.split()
+	exp := m.Currency.Exponent()
+	if exp == 0 {
+		return sign + digits
+	}
+	return sign + digits[:len(digits)-exp] + "." + digits[len(digits)-exp:]
+}
+
+// split returns the sign and the absolute amount padded with zeros so that there is at least one
+// digit before the decimal point
+func (m Money) split() (string, string) {
+	sign := ""
+	abs := uint64(m.Amount)
+	if m.Amount < 0 {
+		sign = "-"
+		abs = uint64(-(m.Amount + 1)) + 1
+	}
+	digits := strconv.FormatUint(abs, 10)
+	for len(digits) <= m.Currency.Exponent() {
+		digits = "0" + digits
+	}
+	return sign, digits
+}
+
+// Parse parses an amount in the major unit of the currency, such as "10.00", "$1,234.5",
+// "¥1,000", "-0.50" or "1.500 KWD".  The currency symbol or ISO code is optional but must match
+// the currency when given.  Amounts with more decimal places than the currency allows are
+// rejected rather than rounded.
+func Parse(s string, currency pb.Currency) (Money, error) {
+	fail := func(reason string) (Money, error) {
+		return Money{}, fmt.Errorf("money: invalid %s amount %q: %s", currency, s, reason)
+	}
+
+	str := strings.TrimSpace(s)
+	neg := strings.HasPrefix(str, "-")
+	if neg {
+		str = strings.TrimSpace(str[1:])
+	}
+	str = trimCurrency(str, currency)
+	if len(str) == 0 {
+		return fail("no digits")
+	}
+
+	whole, frac := str, ""
+	if i := strings.IndexByte(str, '.'); i >= 0 {
+		whole, frac = str[:i], str[i+1:]
+	}
+	if strings.Contains(whole, ",") {
+		groups := strings.Split(whole, ",")
+		for i, g := range groups {
+			if (i == 0 && (len(g) == 0 || len(g) > 3)) || (i > 0 && len(g) != 3) {
+				return fail("misplaced thousands separator")
+			}
+		}
+		whole = strings.Join(groups, "")
+	}
+	if len(whole) == 0 {
+		whole = "0"
+	}
+	for _, part := range []string{whole, frac} {
+		for _, r := range part {
+			if r < '0' || r > '9' {
+				return fail("unexpected character")
+			}
+		}
+	}
+
+	exp := currency.Exponent()
+	if len(strings.TrimRight(frac, "0")) > exp {
+		return fail(fmt.Sprintf("%s has %d decimal places", currency, exp))
+	}
+	frac = (frac + strings.Repeat("0", exp))[:exp]
+
+	abs, err := strconv.ParseUint(whole+frac, 10, 64)
+	if err != nil || abs > math.MaxInt64 {
+		return Money{}, ErrOverflow
+	}
+	amount := int64(abs)
+	if neg {
+		amount = -amount
+	}
+	return New(amount, currency), nil
+}
+
+// trimCurrency removes the symbol or ISO code of the currency from either end of the amount
+func trimCurrency(s string, currency pb.Currency) string {
+	for _, mark := range []string{symbols[currency], currency.String()} {
+		if len(mark) == 0 {
+			continue
+		}
+		switch {
+		case strings.HasPrefix(strings.ToUpper(s), strings.ToUpper(mark)):
+			return strings.TrimLeftFunc(s[len(mark):], unicode.IsSpace)
+		case strings.HasSuffix(strings.ToUpper(s), strings.ToUpper(mark)):
+			return strings.TrimRightFunc(s[:len(s)-len(mark)], unicode.IsSpace)
+		}
+	}
+	return s
+}
+
+// Add returns the sum of two amounts in the same currency
+func (m Money) Add(o Money) (Money, error) {
+	if m.Currency != o.Currency {
+		return Money{}, ErrCurrencyMismatch
+	}
+	sum := m.Amount + o.Amount
+	if (o.Amount > 0 && sum < m.Amount) || (o.Amount < 0 && sum > m.Amount) {
+		return Money{}, ErrOverflow
+	}
+	return New(sum, m.Currency), nil
+}
+
+// Sub returns the difference of two amounts in the same currency
+func (m Money) Sub(o Money) (Money, error) {
+	if m.Currency != o.Currency {
+		return Money{}, ErrCurrencyMismatch
+	}
+	diff := m.Amount - o.Amount
+	if (o.Amount > 0 && diff > m.Amount) || (o.Amount < 0 && diff < m.Amount) {
+		return Money{}, ErrOverflow
+	}
+	return New(diff, m.Currency), nil
+}
+
+// Mul returns the amount multiplied by n, e.g. a plan amount by a subscription quantity
+func (m Money) Mul(n int64) (Money, error) {
+	if m.Amount == 0 || n == 0 {
+		return New(0, m.Currency), nil
+	}
+	product := m.Amount * n
+	if product/n != m.Amount || (m.Amount == -1 && n == math.MinInt64) || (n == -1 && m.Amount == math.MinInt64) {
+		return Money{}, ErrOverflow
+	}
+	return New(product, m.Currency), nil
+}
+
+// Cmp compares two amounts in the same currency, returning -1, 0 or 1
+func (m Money) Cmp(o Money) (int, error) {
+	if m.Currency != o.Currency {
+		return 0, ErrCurrencyMismatch
+	}
+	switch {
+	case m.Amount < o.Amount:
+		return -1, nil
+	case m.Amount > o.Amount:
+		return 1, nil
+	default:
+		return 0, nil
+	}
+}
+
+// BelowMinimum reports whether a non-zero charge is smaller than the minimum Stripe will charge
+// in the currency.  Zero amounts, such as free plans, are never below the minimum.
+func (m Money) BelowMinimum() bool {
+	min := m.Currency.MinimumCharge()
+	return m.Amount > 0 && uint64(m.Amount) < min
+}
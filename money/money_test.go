@@ -0,0 +1,134 @@
+package money
+
+import (
+	"math"
+	"testing"
+
+	"github.com/BTBurke/recur/pb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestString(t *testing.T) {
+	tt := []struct {
+		m    Money
+		want string
+	}{
+		{New(1000, pb.Currency_USD), "$10.00"},
+		{New(123456, pb.Currency_USD), "$1,234.56"},
+		{New(5, pb.Currency_EUR), "€0.05"},
+		{New(1000, pb.Currency_JPY), "¥1,000"},
+		{New(1000000, pb.Currency_KRW), "₩1,000,000"},
+		{New(1500, pb.Currency_KWD), "KWD 1.500"},
+		{New(-250, pb.Currency_GBP), "-£2.50"},
+		{New(0, pb.Currency_CAD), "CAD 0.00"},
+		{New(math.MinInt64, pb.Currency_JPY), "-¥9,223,372,036,854,775,808"},
+	}
+	for _, tc := range tt {
+		assert.Equal(t, tc.want, tc.m.String())
+	}
+}
+
+func TestDecimal(t *testing.T) {
+	assert.Equal(t, "10.00", New(1000, pb.Currency_USD).Decimal())
+	assert.Equal(t, "0.05", New(5, pb.Currency_USD).Decimal())
+	assert.Equal(t, "1000", New(1000, pb.Currency_JPY).Decimal())
+	assert.Equal(t, "0.001", New(1, pb.Currency_BHD).Decimal())
+	assert.Equal(t, "-1.50", New(-150, pb.Currency_USD).Decimal())
+}
+
+func TestParse(t *testing.T) {
+	tt := []struct {
+		in       string
+		currency pb.Currency
+		want     int64
+	}{
+		{"10.00", pb.Currency_USD, 1000},
+		{"$10", pb.Currency_USD, 1000},
+		{"$1,234.5", pb.Currency_USD, 123450},
+		{"usd 7.25", pb.Currency_USD, 725},
+		{".5", pb.Currency_USD, 50},
+		{"-0.50", pb.Currency_USD, -50},
+		{"¥1,000", pb.Currency_JPY, 1000},
+		{"1000 JPY", pb.Currency_JPY, 1000},
+		{"1000.00", pb.Currency_JPY, 1000},
+		{"1.5 KWD", pb.Currency_KWD, 1500},
+		{"KWD 0.125", pb.Currency_KWD, 125},
+	}
+	for _, tc := range tt {
+		m, err := Parse(tc.in, tc.currency)
+		assert.NoError(t, err, tc.in)
+		assert.Equal(t, New(tc.want, tc.currency), m, tc.in)
+	}
+
+	for _, tc := range []struct {
+		in       string
+		currency pb.Currency
+	}{
+		{"", pb.Currency_USD},
+		{"$", pb.Currency_USD},
+		{"1.005", pb.Currency_USD},
+		{"1000.5", pb.Currency_JPY},
+		{"1.0005", pb.Currency_KWD},
+		{"$10", pb.Currency_CAD},
+		{"10 EUR", pb.Currency_USD},
+		{"1,00", pb.Currency_USD},
+		{"1,0000", pb.Currency_USD},
+		{"ten", pb.Currency_USD},
+		{"99999999999999999999", pb.Currency_JPY},
+	} {
+		_, err := Parse(tc.in, tc.currency)
+		assert.Error(t, err, tc.in)
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	for _, m := range []Money{New(123456789, pb.Currency_USD), New(98765, pb.Currency_JPY), New(1001, pb.Currency_OMR), New(-42, pb.Currency_EUR)} {
+		parsed, err := Parse(m.String(), m.Currency)
+		assert.NoError(t, err)
+		assert.Equal(t, m, parsed)
+	}
+}
+
+func TestArithmetic(t *testing.T) {
+	usd := func(n int64) Money { return New(n, pb.Currency_USD) }
+
+	sum, err := usd(1050).Add(usd(250))
+	assert.NoError(t, err)
+	assert.Equal(t, usd(1300), sum)
+
+	diff, err := usd(250).Sub(usd(1050))
+	assert.NoError(t, err)
+	assert.Equal(t, usd(-800), diff)
+
+	product, err := usd(1999).Mul(3)
+	assert.NoError(t, err)
+	assert.Equal(t, usd(5997), product)
+
+	cmp, err := usd(1).Cmp(usd(2))
+	assert.NoError(t, err)
+	assert.Equal(t, -1, cmp)
+
+	_, err = usd(100).Add(New(100, pb.Currency_JPY))
+	assert.Equal(t, ErrCurrencyMismatch, err)
+	_, err = usd(100).Cmp(New(100, pb.Currency_JPY))
+	assert.Equal(t, ErrCurrencyMismatch, err)
+
+	_, err = usd(math.MaxInt64).Add(usd(1))
+	assert.Equal(t, ErrOverflow, err)
+	_, err = usd(math.MinInt64).Sub(usd(1))
+	assert.Equal(t, ErrOverflow, err)
+	_, err = usd(math.MaxInt64 / 2).Mul(3)
+	assert.Equal(t, ErrOverflow, err)
+	_, err = usd(math.MinInt64).Mul(-1)
+	assert.Equal(t, ErrOverflow, err)
+	_, err = FromUnsigned(math.MaxUint64, pb.Currency_USD)
+	assert.Equal(t, ErrOverflow, err)
+}
+
+func TestBelowMinimum(t *testing.T) {
+	assert.True(t, New(49, pb.Currency_USD).BelowMinimum())
+	assert.False(t, New(50, pb.Currency_USD).BelowMinimum())
+	assert.False(t, New(0, pb.Currency_USD).BelowMinimum())
+	assert.True(t, New(10, pb.Currency_JPY).BelowMinimum())
+	assert.False(t, New(50, pb.Currency_JPY).BelowMinimum())
+}
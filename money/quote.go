@@ -0,0 +1,95 @@
+package money
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/BTBurke/recur/pb"
+)
+
+// Quote returns the price of a plan for one billing period at a quantity, which is the number of
+// licenses for a licensed plan or the units used for a metered plan.  It computes the same price
+// that Stripe would charge so that quotes can be shown without a round trip:
+//
+//   - a per unit plan costs its amount for each unit
+//   - a graduated tiered plan prices the units falling in each tier at that tier's unit amount and
+//     adds the flat amount of every tier reached
+//   - a volume tiered plan prices every unit at the unit amount of the tier the total quantity
+//     falls in and adds that tier's flat amount
+//
+// A quantity of zero costs nothing.
+func Quote(p *pb.Plan, quantity uint64) (Money, error) {
+	zero := New(0, p.GetCurrency())
+	if quantity == 0 {
+		return zero, nil
+	}
+	if quantity > math.MaxInt64 {
+		return Money{}, ErrOverflow
+	}
+	qty := int64(quantity)
+
+	if p.GetBillingScheme() != pb.BillingScheme_Tiered {
+		amount, err := FromUnsigned(p.GetAmount(), p.GetCurrency())
+		if err != nil {
+			return Money{}, err
+		}
+		return amount.Mul(qty)
+	}
+
+	tiers := p.GetTiers()
+	if len(tiers) == 0 {
+		return Money{}, fmt.Errorf("money: plan %s has no tiers", p.GetId())
+	}
+	switch p.GetTiersMode() {
+	case pb.TiersMode_Graduated:
+		total := zero
+		var prev uint64
+		for _, tier := range tiers {
+			upTo := tier.GetUpTo()
+			if upTo == 0 || upTo > quantity {
+				upTo = quantity
+			}
+			if upTo <= prev {
+				break
+			}
+			price, err := tierPrice(tier, upTo-prev, p.GetCurrency())
+			if err != nil {
+				return Money{}, err
+			}
+			if total, err = total.Add(price); err != nil {
+				return Money{}, err
+			}
+			prev = upTo
+		}
+		return total, nil
+	case pb.TiersMode_Volume:
+		for _, tier := range tiers {
+			if tier.GetUpTo() == 0 || quantity <= tier.GetUpTo() {
+				return tierPrice(tier, quantity, p.GetCurrency())
+			}
+		}
+		return tierPrice(tiers[len(tiers)-1], quantity, p.GetCurrency())
+	default:
+		return Money{}, fmt.Errorf("money: plan %s has no tiers mode", p.GetId())
+	}
+}
+
+// tierPrice returns the price of units in a tier, including its flat amount
+func tierPrice(tier *pb.PlanTier, units uint64, currency pb.Currency) (Money, error) {
+	unit, err := FromUnsigned(tier.GetUnitAmount(), currency)
+	if err != nil {
+		return Money{}, err
+	}
+	flat, err := FromUnsigned(tier.GetFlatAmount(), currency)
+	if err != nil {
+		return Money{}, err
+	}
+	if units > math.MaxInt64 {
+		return Money{}, ErrOverflow
+	}
+	price, err := unit.Mul(int64(units))
+	if err != nil {
+		return Money{}, err
+	}
+	return price.Add(flat)
+}
@@ -0,0 +1,48 @@
+package money
+
+import (
+	"math"
+	"testing"
+
+	"github.com/BTBurke/recur/pb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuote(t *testing.T) {
+	tiers := []*pb.PlanTier{
+		{UpTo: 5, UnitAmount: 1000, FlatAmount: 500},
+		{UpTo: 10, UnitAmount: 800},
+		{UnitAmount: 500, FlatAmount: 100},
+	}
+	perUnit := &pb.Plan{Id: "seat", Amount: 1200, Currency: pb.Currency_USD}
+	graduated := &pb.Plan{Id: "grad", Currency: pb.Currency_USD, BillingScheme: pb.BillingScheme_Tiered, TiersMode: pb.TiersMode_Graduated, Tiers: tiers}
+	volume := &pb.Plan{Id: "vol", Currency: pb.Currency_USD, BillingScheme: pb.BillingScheme_Tiered, TiersMode: pb.TiersMode_Volume, Tiers: tiers}
+
+	tt := []struct {
+		plan     *pb.Plan
+		quantity uint64
+		want     int64
+	}{
+		{perUnit, 0, 0},
+		{perUnit, 3, 3600},
+		{graduated, 0, 0},
+		{graduated, 3, 3500},
+		{graduated, 5, 5500},
+		{graduated, 7, 7100},
+		{graduated, 12, 10600},
+		{volume, 3, 3500},
+		{volume, 5, 5500},
+		{volume, 7, 5600},
+		{volume, 12, 6100},
+	}
+	for _, tc := range tt {
+		m, err := Quote(tc.plan, tc.quantity)
+		assert.NoError(t, err, tc.plan.Id)
+		assert.Equal(t, New(tc.want, pb.Currency_USD), m, "%s x %d", tc.plan.Id, tc.quantity)
+	}
+
+	_, err := Quote(perUnit, math.MaxUint64)
+	assert.Equal(t, ErrOverflow, err)
+	_, err = Quote(&pb.Plan{Id: "bad", BillingScheme: pb.BillingScheme_Tiered, Tiers: tiers}, 1)
+	assert.Error(t, err, "tiers mode is required")
+}
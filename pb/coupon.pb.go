@@ -0,0 +1,1466 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: coupon.proto
+
+/*
+Package pb is a generated protocol buffer package.
+
+It is generated from these files:
+	coupon.proto
+	currencies.proto
+	customer.proto
+	error.proto
+	event.proto
+	invoice.proto
+	plan.proto
+	product.proto
+	source.proto
+	subscription.proto
+	usage.proto
+
+It has these top-level messages:
+	CouponResponse
+	Coupon
+	CreateCouponRequest
+	GetCouponRequest
+	UpdateCouponRequest
+	DeleteCouponRequest
+	DeleteCouponSuccess
+	DeleteCouponResponse
+	ListCouponsRequest
+	Discount
+	DiscountResponse
+	ApplyCouponRequest
+	RemoveDiscountRequest
+	RemoveDiscountSuccess
+	RemoveDiscountResponse
+	CustomerResponse
+	Customer
+	CreateCustomerRequest
+	GetCustomerRequest
+	UpdateCustomerRequest
+	DeleteCustomerRequest
+	DeleteCustomerSuccess
+	DeleteCustomerResponse
+	ListCustomersRequest
+	Error
+	Event
+	EventResponse
+	GetEventRequest
+	ListEventsRequest
+	SubscribeEventsRequest
+	InvoiceResponse
+	Invoice
+	InvoiceLineItem
+	GetInvoiceRequest
+	UpcomingInvoiceRequest
+	PayInvoiceRequest
+	VoidInvoiceRequest
+	MarkUncollectibleInvoiceRequest
+	ListInvoicesRequest
+	PlanTier
+	PlanResponse
+	Plan
+	CreatePlanRequest
+	GetPlanRequest
+	UpdatePlanRequest
+	DeletePlanRequest
+	DeletePlanSuccess
+	DeletePlanResponse
+	ListFilter
+	ListPlansRequest
+	ProductResponse
+	Product
+	CreateProductRequest
+	GetProductRequest
+	UpdateProductRequest
+	DeleteProductRequest
+	DeleteProductSuccess
+	DeleteProductResponse
+	ListProductsRequest
+	SourceResponse
+	PaymentSource
+	CardDetails
+	AttachSourceRequest
+	ListSourcesRequest
+	SetDefaultSourceRequest
+	DetachSourceRequest
+	DetachSourceSuccess
+	DetachSourceResponse
+	SubscriptionResponse
+	Subscription
+	CreateSubscriptionRequest
+	GetSubscriptionRequest
+	UpdateSubscriptionRequest
+	CancelSubscriptionRequest
+	ReactivateSubscriptionRequest
+	ListSubscriptionsRequest
+	UsageRecordResponse
+	UsageRecord
+	CreateUsageRecordRequest
+	UsageRecordSummary
+	UsageRecordSummaryResponse
+	ListUsageRecordSummariesRequest
+*/
+package pb
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type CouponDuration int32
+
+const (
+	CouponDuration_UnknownDuration CouponDuration = 0
+	CouponDuration_Once            CouponDuration = 1
+	CouponDuration_Repeating       CouponDuration = 2
+	CouponDuration_Forever         CouponDuration = 3
+)
+
+var CouponDuration_name = map[int32]string{
+	0: "UnknownDuration",
+	1: "Once",
+	2: "Repeating",
+	3: "Forever",
+}
+var CouponDuration_value = map[string]int32{
+	"UnknownDuration": 0,
+	"Once":            1,
+	"Repeating":       2,
+	"Forever":         3,
+}
+
+func (x CouponDuration) String() string {
+	return proto.EnumName(CouponDuration_name, int32(x))
+}
+func (CouponDuration) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+type CouponResponse struct {
+	// Types that are valid to be assigned to Responses:
+	//	*CouponResponse_Error
+	//	*CouponResponse_Success
+	Responses isCouponResponse_Responses `protobuf_oneof:"responses"`
+}
+
+func (m *CouponResponse) Reset()                    { *m = CouponResponse{} }
+func (m *CouponResponse) String() string            { return proto.CompactTextString(m) }
+func (*CouponResponse) ProtoMessage()               {}
+func (*CouponResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+type isCouponResponse_Responses interface {
+	isCouponResponse_Responses()
+}
+
+type CouponResponse_Error struct {
+	Error *Error `protobuf:"bytes,1,opt,name=error,oneof"`
+}
+type CouponResponse_Success struct {
+	Success *Coupon `protobuf:"bytes,2,opt,name=success,oneof"`
+}
+
+func (*CouponResponse_Error) isCouponResponse_Responses()   {}
+func (*CouponResponse_Success) isCouponResponse_Responses() {}
+
+func (m *CouponResponse) GetResponses() isCouponResponse_Responses {
+	if m != nil {
+		return m.Responses
+	}
+	return nil
+}
+
+func (m *CouponResponse) GetError() *Error {
+	if x, ok := m.GetResponses().(*CouponResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+func (m *CouponResponse) GetSuccess() *Coupon {
+	if x, ok := m.GetResponses().(*CouponResponse_Success); ok {
+		return x.Success
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*CouponResponse) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _CouponResponse_OneofMarshaler, _CouponResponse_OneofUnmarshaler, _CouponResponse_OneofSizer, []interface{}{
+		(*CouponResponse_Error)(nil),
+		(*CouponResponse_Success)(nil),
+	}
+}
+
+func _CouponResponse_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*CouponResponse)
+	// responses
+	switch x := m.Responses.(type) {
+	case *CouponResponse_Error:
+		b.EncodeVarint(1<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Error); err != nil {
+			return err
+		}
+	case *CouponResponse_Success:
+		b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Success); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("CouponResponse.Responses has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _CouponResponse_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*CouponResponse)
+	switch tag {
+	case 1: // responses.error
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Error)
+		err := b.DecodeMessage(msg)
+		m.Responses = &CouponResponse_Error{msg}
+		return true, err
+	case 2: // responses.success
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Coupon)
+		err := b.DecodeMessage(msg)
+		m.Responses = &CouponResponse_Success{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _CouponResponse_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*CouponResponse)
+	// responses
+	switch x := m.Responses.(type) {
+	case *CouponResponse_Error:
+		s := proto.Size(x.Error)
+		n += proto.SizeVarint(1<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *CouponResponse_Success:
+		s := proto.Size(x.Success)
+		n += proto.SizeVarint(2<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+type Coupon struct {
+	Id               string            `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	AmountOff        uint64            `protobuf:"varint,2,opt,name=amount_off,json=amountOff" json:"amount_off,omitempty"`
+	Created          int64             `protobuf:"varint,3,opt,name=created" json:"created,omitempty"`
+	Currency         Currency          `protobuf:"varint,4,opt,name=currency,enum=Currency" json:"currency,omitempty"`
+	Duration         CouponDuration    `protobuf:"varint,5,opt,name=duration,enum=CouponDuration" json:"duration,omitempty"`
+	DurationInMonths uint64            `protobuf:"varint,6,opt,name=duration_in_months,json=durationInMonths" json:"duration_in_months,omitempty"`
+	Livemode         bool              `protobuf:"varint,7,opt,name=livemode" json:"livemode,omitempty"`
+	MaxRedemptions   uint64            `protobuf:"varint,8,opt,name=max_redemptions,json=maxRedemptions" json:"max_redemptions,omitempty"`
+	Metadata         map[string]string `protobuf:"bytes,9,rep,name=metadata" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	PercentOff       uint64            `protobuf:"varint,10,opt,name=percent_off,json=percentOff" json:"percent_off,omitempty"`
+	RedeemBy         int64             `protobuf:"varint,11,opt,name=redeem_by,json=redeemBy" json:"redeem_by,omitempty"`
+	TimesRedeemed    uint64            `protobuf:"varint,12,opt,name=times_redeemed,json=timesRedeemed" json:"times_redeemed,omitempty"`
+	Valid            bool              `protobuf:"varint,13,opt,name=valid" json:"valid,omitempty"`
+}
+
+func (m *Coupon) Reset()                    { *m = Coupon{} }
+func (m *Coupon) String() string            { return proto.CompactTextString(m) }
+func (*Coupon) ProtoMessage()               {}
+func (*Coupon) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
+func (m *Coupon) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Coupon) GetAmountOff() uint64 {
+	if m != nil {
+		return m.AmountOff
+	}
+	return 0
+}
+
+func (m *Coupon) GetCreated() int64 {
+	if m != nil {
+		return m.Created
+	}
+	return 0
+}
+
+func (m *Coupon) GetCurrency() Currency {
+	if m != nil {
+		return m.Currency
+	}
+	return Currency_UNK
+}
+
+func (m *Coupon) GetDuration() CouponDuration {
+	if m != nil {
+		return m.Duration
+	}
+	return CouponDuration_UnknownDuration
+}
+
+func (m *Coupon) GetDurationInMonths() uint64 {
+	if m != nil {
+		return m.DurationInMonths
+	}
+	return 0
+}
+
+func (m *Coupon) GetLivemode() bool {
+	if m != nil {
+		return m.Livemode
+	}
+	return false
+}
+
+func (m *Coupon) GetMaxRedemptions() uint64 {
+	if m != nil {
+		return m.MaxRedemptions
+	}
+	return 0
+}
+
+func (m *Coupon) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *Coupon) GetPercentOff() uint64 {
+	if m != nil {
+		return m.PercentOff
+	}
+	return 0
+}
+
+func (m *Coupon) GetRedeemBy() int64 {
+	if m != nil {
+		return m.RedeemBy
+	}
+	return 0
+}
+
+func (m *Coupon) GetTimesRedeemed() uint64 {
+	if m != nil {
+		return m.TimesRedeemed
+	}
+	return 0
+}
+
+func (m *Coupon) GetValid() bool {
+	if m != nil {
+		return m.Valid
+	}
+	return false
+}
+
+type CreateCouponRequest struct {
+	Id               string            `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Duration         CouponDuration    `protobuf:"varint,2,opt,name=duration,enum=CouponDuration" json:"duration,omitempty"`
+	AmountOff        uint64            `protobuf:"varint,3,opt,name=amount_off,json=amountOff" json:"amount_off,omitempty"`
+	Currency         Currency          `protobuf:"varint,4,opt,name=currency,enum=Currency" json:"currency,omitempty"`
+	PercentOff       uint64            `protobuf:"varint,5,opt,name=percent_off,json=percentOff" json:"percent_off,omitempty"`
+	DurationInMonths uint64            `protobuf:"varint,6,opt,name=duration_in_months,json=durationInMonths" json:"duration_in_months,omitempty"`
+	MaxRedemptions   uint64            `protobuf:"varint,7,opt,name=max_redemptions,json=maxRedemptions" json:"max_redemptions,omitempty"`
+	RedeemBy         int64             `protobuf:"varint,8,opt,name=redeem_by,json=redeemBy" json:"redeem_by,omitempty"`
+	Metadata         map[string]string `protobuf:"bytes,9,rep,name=metadata" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (m *CreateCouponRequest) Reset()                    { *m = CreateCouponRequest{} }
+func (m *CreateCouponRequest) String() string            { return proto.CompactTextString(m) }
+func (*CreateCouponRequest) ProtoMessage()               {}
+func (*CreateCouponRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+
+func (m *CreateCouponRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *CreateCouponRequest) GetDuration() CouponDuration {
+	if m != nil {
+		return m.Duration
+	}
+	return CouponDuration_UnknownDuration
+}
+
+func (m *CreateCouponRequest) GetAmountOff() uint64 {
+	if m != nil {
+		return m.AmountOff
+	}
+	return 0
+}
+
+func (m *CreateCouponRequest) GetCurrency() Currency {
+	if m != nil {
+		return m.Currency
+	}
+	return Currency_UNK
+}
+
+func (m *CreateCouponRequest) GetPercentOff() uint64 {
+	if m != nil {
+		return m.PercentOff
+	}
+	return 0
+}
+
+func (m *CreateCouponRequest) GetDurationInMonths() uint64 {
+	if m != nil {
+		return m.DurationInMonths
+	}
+	return 0
+}
+
+func (m *CreateCouponRequest) GetMaxRedemptions() uint64 {
+	if m != nil {
+		return m.MaxRedemptions
+	}
+	return 0
+}
+
+func (m *CreateCouponRequest) GetRedeemBy() int64 {
+	if m != nil {
+		return m.RedeemBy
+	}
+	return 0
+}
+
+func (m *CreateCouponRequest) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+type GetCouponRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *GetCouponRequest) Reset()                    { *m = GetCouponRequest{} }
+func (m *GetCouponRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetCouponRequest) ProtoMessage()               {}
+func (*GetCouponRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+
+func (m *GetCouponRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type UpdateCouponRequest struct {
+	Id       string            `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Metadata map[string]string `protobuf:"bytes,2,rep,name=metadata" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (m *UpdateCouponRequest) Reset()                    { *m = UpdateCouponRequest{} }
+func (m *UpdateCouponRequest) String() string            { return proto.CompactTextString(m) }
+func (*UpdateCouponRequest) ProtoMessage()               {}
+func (*UpdateCouponRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+
+func (m *UpdateCouponRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *UpdateCouponRequest) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+type DeleteCouponRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *DeleteCouponRequest) Reset()                    { *m = DeleteCouponRequest{} }
+func (m *DeleteCouponRequest) String() string            { return proto.CompactTextString(m) }
+func (*DeleteCouponRequest) ProtoMessage()               {}
+func (*DeleteCouponRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+
+func (m *DeleteCouponRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type DeleteCouponSuccess struct {
+	Deleted bool   `protobuf:"varint,1,opt,name=deleted" json:"deleted,omitempty"`
+	Id      string `protobuf:"bytes,2,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *DeleteCouponSuccess) Reset()                    { *m = DeleteCouponSuccess{} }
+func (m *DeleteCouponSuccess) String() string            { return proto.CompactTextString(m) }
+func (*DeleteCouponSuccess) ProtoMessage()               {}
+func (*DeleteCouponSuccess) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+
+func (m *DeleteCouponSuccess) GetDeleted() bool {
+	if m != nil {
+		return m.Deleted
+	}
+	return false
+}
+
+func (m *DeleteCouponSuccess) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type DeleteCouponResponse struct {
+	// Types that are valid to be assigned to Responses:
+	//	*DeleteCouponResponse_Error
+	//	*DeleteCouponResponse_Success
+	Responses isDeleteCouponResponse_Responses `protobuf_oneof:"responses"`
+}
+
+func (m *DeleteCouponResponse) Reset()                    { *m = DeleteCouponResponse{} }
+func (m *DeleteCouponResponse) String() string            { return proto.CompactTextString(m) }
+func (*DeleteCouponResponse) ProtoMessage()               {}
+func (*DeleteCouponResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+
+type isDeleteCouponResponse_Responses interface {
+	isDeleteCouponResponse_Responses()
+}
+
+type DeleteCouponResponse_Error struct {
+	Error *Error `protobuf:"bytes,1,opt,name=error,oneof"`
+}
+type DeleteCouponResponse_Success struct {
+	Success *DeleteCouponSuccess `protobuf:"bytes,2,opt,name=success,oneof"`
+}
+
+func (*DeleteCouponResponse_Error) isDeleteCouponResponse_Responses()   {}
+func (*DeleteCouponResponse_Success) isDeleteCouponResponse_Responses() {}
+
+func (m *DeleteCouponResponse) GetResponses() isDeleteCouponResponse_Responses {
+	if m != nil {
+		return m.Responses
+	}
+	return nil
+}
+
+func (m *DeleteCouponResponse) GetError() *Error {
+	if x, ok := m.GetResponses().(*DeleteCouponResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+func (m *DeleteCouponResponse) GetSuccess() *DeleteCouponSuccess {
+	if x, ok := m.GetResponses().(*DeleteCouponResponse_Success); ok {
+		return x.Success
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*DeleteCouponResponse) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _DeleteCouponResponse_OneofMarshaler, _DeleteCouponResponse_OneofUnmarshaler, _DeleteCouponResponse_OneofSizer, []interface{}{
+		(*DeleteCouponResponse_Error)(nil),
+		(*DeleteCouponResponse_Success)(nil),
+	}
+}
+
+func _DeleteCouponResponse_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*DeleteCouponResponse)
+	// responses
+	switch x := m.Responses.(type) {
+	case *DeleteCouponResponse_Error:
+		b.EncodeVarint(1<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Error); err != nil {
+			return err
+		}
+	case *DeleteCouponResponse_Success:
+		b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Success); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("DeleteCouponResponse.Responses has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _DeleteCouponResponse_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*DeleteCouponResponse)
+	switch tag {
+	case 1: // responses.error
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Error)
+		err := b.DecodeMessage(msg)
+		m.Responses = &DeleteCouponResponse_Error{msg}
+		return true, err
+	case 2: // responses.success
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(DeleteCouponSuccess)
+		err := b.DecodeMessage(msg)
+		m.Responses = &DeleteCouponResponse_Success{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _DeleteCouponResponse_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*DeleteCouponResponse)
+	// responses
+	switch x := m.Responses.(type) {
+	case *DeleteCouponResponse_Error:
+		s := proto.Size(x.Error)
+		n += proto.SizeVarint(1<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *DeleteCouponResponse_Success:
+		s := proto.Size(x.Success)
+		n += proto.SizeVarint(2<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+type ListCouponsRequest struct {
+	Created       *ListFilter `protobuf:"bytes,1,opt,name=created" json:"created,omitempty"`
+	EndingBefore  string      `protobuf:"bytes,2,opt,name=ending_before,json=endingBefore" json:"ending_before,omitempty"`
+	StartingAfter string      `protobuf:"bytes,3,opt,name=starting_after,json=startingAfter" json:"starting_after,omitempty"`
+	Limit         int32       `protobuf:"varint,4,opt,name=limit" json:"limit,omitempty"`
+}
+
+func (m *ListCouponsRequest) Reset()                    { *m = ListCouponsRequest{} }
+func (m *ListCouponsRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListCouponsRequest) ProtoMessage()               {}
+func (*ListCouponsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+
+func (m *ListCouponsRequest) GetCreated() *ListFilter {
+	if m != nil {
+		return m.Created
+	}
+	return nil
+}
+
+func (m *ListCouponsRequest) GetEndingBefore() string {
+	if m != nil {
+		return m.EndingBefore
+	}
+	return ""
+}
+
+func (m *ListCouponsRequest) GetStartingAfter() string {
+	if m != nil {
+		return m.StartingAfter
+	}
+	return ""
+}
+
+func (m *ListCouponsRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type Discount struct {
+	Coupon       *Coupon `protobuf:"bytes,1,opt,name=coupon" json:"coupon,omitempty"`
+	Customer     string  `protobuf:"bytes,2,opt,name=customer" json:"customer,omitempty"`
+	Subscription string  `protobuf:"bytes,3,opt,name=subscription" json:"subscription,omitempty"`
+	Start        int64   `protobuf:"varint,4,opt,name=start" json:"start,omitempty"`
+	End          int64   `protobuf:"varint,5,opt,name=end" json:"end,omitempty"`
+}
+
+func (m *Discount) Reset()                    { *m = Discount{} }
+func (m *Discount) String() string            { return proto.CompactTextString(m) }
+func (*Discount) ProtoMessage()               {}
+func (*Discount) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
+
+func (m *Discount) GetCoupon() *Coupon {
+	if m != nil {
+		return m.Coupon
+	}
+	return nil
+}
+
+func (m *Discount) GetCustomer() string {
+	if m != nil {
+		return m.Customer
+	}
+	return ""
+}
+
+func (m *Discount) GetSubscription() string {
+	if m != nil {
+		return m.Subscription
+	}
+	return ""
+}
+
+func (m *Discount) GetStart() int64 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+func (m *Discount) GetEnd() int64 {
+	if m != nil {
+		return m.End
+	}
+	return 0
+}
+
+type DiscountResponse struct {
+	// Types that are valid to be assigned to Responses:
+	//	*DiscountResponse_Error
+	//	*DiscountResponse_Success
+	Responses isDiscountResponse_Responses `protobuf_oneof:"responses"`
+}
+
+func (m *DiscountResponse) Reset()                    { *m = DiscountResponse{} }
+func (m *DiscountResponse) String() string            { return proto.CompactTextString(m) }
+func (*DiscountResponse) ProtoMessage()               {}
+func (*DiscountResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+
+type isDiscountResponse_Responses interface {
+	isDiscountResponse_Responses()
+}
+
+type DiscountResponse_Error struct {
+	Error *Error `protobuf:"bytes,1,opt,name=error,oneof"`
+}
+type DiscountResponse_Success struct {
+	Success *Discount `protobuf:"bytes,2,opt,name=success,oneof"`
+}
+
+func (*DiscountResponse_Error) isDiscountResponse_Responses()   {}
+func (*DiscountResponse_Success) isDiscountResponse_Responses() {}
+
+func (m *DiscountResponse) GetResponses() isDiscountResponse_Responses {
+	if m != nil {
+		return m.Responses
+	}
+	return nil
+}
+
+func (m *DiscountResponse) GetError() *Error {
+	if x, ok := m.GetResponses().(*DiscountResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+func (m *DiscountResponse) GetSuccess() *Discount {
+	if x, ok := m.GetResponses().(*DiscountResponse_Success); ok {
+		return x.Success
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*DiscountResponse) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _DiscountResponse_OneofMarshaler, _DiscountResponse_OneofUnmarshaler, _DiscountResponse_OneofSizer, []interface{}{
+		(*DiscountResponse_Error)(nil),
+		(*DiscountResponse_Success)(nil),
+	}
+}
+
+func _DiscountResponse_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*DiscountResponse)
+	// responses
+	switch x := m.Responses.(type) {
+	case *DiscountResponse_Error:
+		b.EncodeVarint(1<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Error); err != nil {
+			return err
+		}
+	case *DiscountResponse_Success:
+		b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Success); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("DiscountResponse.Responses has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _DiscountResponse_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*DiscountResponse)
+	switch tag {
+	case 1: // responses.error
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Error)
+		err := b.DecodeMessage(msg)
+		m.Responses = &DiscountResponse_Error{msg}
+		return true, err
+	case 2: // responses.success
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Discount)
+		err := b.DecodeMessage(msg)
+		m.Responses = &DiscountResponse_Success{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _DiscountResponse_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*DiscountResponse)
+	// responses
+	switch x := m.Responses.(type) {
+	case *DiscountResponse_Error:
+		s := proto.Size(x.Error)
+		n += proto.SizeVarint(1<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *DiscountResponse_Success:
+		s := proto.Size(x.Success)
+		n += proto.SizeVarint(2<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+type ApplyCouponRequest struct {
+	Coupon       string `protobuf:"bytes,1,opt,name=coupon" json:"coupon,omitempty"`
+	Customer     string `protobuf:"bytes,2,opt,name=customer" json:"customer,omitempty"`
+	Subscription string `protobuf:"bytes,3,opt,name=subscription" json:"subscription,omitempty"`
+}
+
+func (m *ApplyCouponRequest) Reset()                    { *m = ApplyCouponRequest{} }
+func (m *ApplyCouponRequest) String() string            { return proto.CompactTextString(m) }
+func (*ApplyCouponRequest) ProtoMessage()               {}
+func (*ApplyCouponRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
+
+func (m *ApplyCouponRequest) GetCoupon() string {
+	if m != nil {
+		return m.Coupon
+	}
+	return ""
+}
+
+func (m *ApplyCouponRequest) GetCustomer() string {
+	if m != nil {
+		return m.Customer
+	}
+	return ""
+}
+
+func (m *ApplyCouponRequest) GetSubscription() string {
+	if m != nil {
+		return m.Subscription
+	}
+	return ""
+}
+
+type RemoveDiscountRequest struct {
+	Customer     string `protobuf:"bytes,1,opt,name=customer" json:"customer,omitempty"`
+	Subscription string `protobuf:"bytes,2,opt,name=subscription" json:"subscription,omitempty"`
+}
+
+func (m *RemoveDiscountRequest) Reset()                    { *m = RemoveDiscountRequest{} }
+func (m *RemoveDiscountRequest) String() string            { return proto.CompactTextString(m) }
+func (*RemoveDiscountRequest) ProtoMessage()               {}
+func (*RemoveDiscountRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
+
+func (m *RemoveDiscountRequest) GetCustomer() string {
+	if m != nil {
+		return m.Customer
+	}
+	return ""
+}
+
+func (m *RemoveDiscountRequest) GetSubscription() string {
+	if m != nil {
+		return m.Subscription
+	}
+	return ""
+}
+
+type RemoveDiscountSuccess struct {
+	Deleted      bool   `protobuf:"varint,1,opt,name=deleted" json:"deleted,omitempty"`
+	Customer     string `protobuf:"bytes,2,opt,name=customer" json:"customer,omitempty"`
+	Subscription string `protobuf:"bytes,3,opt,name=subscription" json:"subscription,omitempty"`
+}
+
+func (m *RemoveDiscountSuccess) Reset()                    { *m = RemoveDiscountSuccess{} }
+func (m *RemoveDiscountSuccess) String() string            { return proto.CompactTextString(m) }
+func (*RemoveDiscountSuccess) ProtoMessage()               {}
+func (*RemoveDiscountSuccess) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
+
+func (m *RemoveDiscountSuccess) GetDeleted() bool {
+	if m != nil {
+		return m.Deleted
+	}
+	return false
+}
+
+func (m *RemoveDiscountSuccess) GetCustomer() string {
+	if m != nil {
+		return m.Customer
+	}
+	return ""
+}
+
+func (m *RemoveDiscountSuccess) GetSubscription() string {
+	if m != nil {
+		return m.Subscription
+	}
+	return ""
+}
+
+type RemoveDiscountResponse struct {
+	// Types that are valid to be assigned to Responses:
+	//	*RemoveDiscountResponse_Error
+	//	*RemoveDiscountResponse_Success
+	Responses isRemoveDiscountResponse_Responses `protobuf_oneof:"responses"`
+}
+
+func (m *RemoveDiscountResponse) Reset()                    { *m = RemoveDiscountResponse{} }
+func (m *RemoveDiscountResponse) String() string            { return proto.CompactTextString(m) }
+func (*RemoveDiscountResponse) ProtoMessage()               {}
+func (*RemoveDiscountResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
+
+type isRemoveDiscountResponse_Responses interface {
+	isRemoveDiscountResponse_Responses()
+}
+
+type RemoveDiscountResponse_Error struct {
+	Error *Error `protobuf:"bytes,1,opt,name=error,oneof"`
+}
+type RemoveDiscountResponse_Success struct {
+	Success *RemoveDiscountSuccess `protobuf:"bytes,2,opt,name=success,oneof"`
+}
+
+func (*RemoveDiscountResponse_Error) isRemoveDiscountResponse_Responses()   {}
+func (*RemoveDiscountResponse_Success) isRemoveDiscountResponse_Responses() {}
+
+func (m *RemoveDiscountResponse) GetResponses() isRemoveDiscountResponse_Responses {
+	if m != nil {
+		return m.Responses
+	}
+	return nil
+}
+
+func (m *RemoveDiscountResponse) GetError() *Error {
+	if x, ok := m.GetResponses().(*RemoveDiscountResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+func (m *RemoveDiscountResponse) GetSuccess() *RemoveDiscountSuccess {
+	if x, ok := m.GetResponses().(*RemoveDiscountResponse_Success); ok {
+		return x.Success
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*RemoveDiscountResponse) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _RemoveDiscountResponse_OneofMarshaler, _RemoveDiscountResponse_OneofUnmarshaler, _RemoveDiscountResponse_OneofSizer, []interface{}{
+		(*RemoveDiscountResponse_Error)(nil),
+		(*RemoveDiscountResponse_Success)(nil),
+	}
+}
+
+func _RemoveDiscountResponse_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*RemoveDiscountResponse)
+	// responses
+	switch x := m.Responses.(type) {
+	case *RemoveDiscountResponse_Error:
+		b.EncodeVarint(1<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Error); err != nil {
+			return err
+		}
+	case *RemoveDiscountResponse_Success:
+		b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Success); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("RemoveDiscountResponse.Responses has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _RemoveDiscountResponse_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*RemoveDiscountResponse)
+	switch tag {
+	case 1: // responses.error
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Error)
+		err := b.DecodeMessage(msg)
+		m.Responses = &RemoveDiscountResponse_Error{msg}
+		return true, err
+	case 2: // responses.success
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(RemoveDiscountSuccess)
+		err := b.DecodeMessage(msg)
+		m.Responses = &RemoveDiscountResponse_Success{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _RemoveDiscountResponse_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*RemoveDiscountResponse)
+	// responses
+	switch x := m.Responses.(type) {
+	case *RemoveDiscountResponse_Error:
+		s := proto.Size(x.Error)
+		n += proto.SizeVarint(1<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *RemoveDiscountResponse_Success:
+		s := proto.Size(x.Success)
+		n += proto.SizeVarint(2<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+func init() {
+	proto.RegisterType((*CouponResponse)(nil), "CouponResponse")
+	proto.RegisterType((*Coupon)(nil), "Coupon")
+	proto.RegisterType((*CreateCouponRequest)(nil), "CreateCouponRequest")
+	proto.RegisterType((*GetCouponRequest)(nil), "GetCouponRequest")
+	proto.RegisterType((*UpdateCouponRequest)(nil), "UpdateCouponRequest")
+	proto.RegisterType((*DeleteCouponRequest)(nil), "DeleteCouponRequest")
+	proto.RegisterType((*DeleteCouponSuccess)(nil), "DeleteCouponSuccess")
+	proto.RegisterType((*DeleteCouponResponse)(nil), "DeleteCouponResponse")
+	proto.RegisterType((*ListCouponsRequest)(nil), "ListCouponsRequest")
+	proto.RegisterType((*Discount)(nil), "Discount")
+	proto.RegisterType((*DiscountResponse)(nil), "DiscountResponse")
+	proto.RegisterType((*ApplyCouponRequest)(nil), "ApplyCouponRequest")
+	proto.RegisterType((*RemoveDiscountRequest)(nil), "RemoveDiscountRequest")
+	proto.RegisterType((*RemoveDiscountSuccess)(nil), "RemoveDiscountSuccess")
+	proto.RegisterType((*RemoveDiscountResponse)(nil), "RemoveDiscountResponse")
+	proto.RegisterEnum("CouponDuration", CouponDuration_name, CouponDuration_value)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for Coupons service
+
+type CouponsClient interface {
+	CreateCoupon(ctx context.Context, in *CreateCouponRequest, opts ...grpc.CallOption) (*CouponResponse, error)
+	UpdateCoupon(ctx context.Context, in *UpdateCouponRequest, opts ...grpc.CallOption) (*CouponResponse, error)
+	DeleteCoupon(ctx context.Context, in *DeleteCouponRequest, opts ...grpc.CallOption) (*DeleteCouponResponse, error)
+	GetCoupon(ctx context.Context, in *GetCouponRequest, opts ...grpc.CallOption) (*CouponResponse, error)
+	ListCoupons(ctx context.Context, in *ListCouponsRequest, opts ...grpc.CallOption) (Coupons_ListCouponsClient, error)
+	ApplyCoupon(ctx context.Context, in *ApplyCouponRequest, opts ...grpc.CallOption) (*DiscountResponse, error)
+	RemoveDiscount(ctx context.Context, in *RemoveDiscountRequest, opts ...grpc.CallOption) (*RemoveDiscountResponse, error)
+}
+
+type couponsClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewCouponsClient(cc *grpc.ClientConn) CouponsClient {
+	return &couponsClient{cc}
+}
+
+func (c *couponsClient) CreateCoupon(ctx context.Context, in *CreateCouponRequest, opts ...grpc.CallOption) (*CouponResponse, error) {
+	out := new(CouponResponse)
+	err := grpc.Invoke(ctx, "/Coupons/CreateCoupon", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *couponsClient) UpdateCoupon(ctx context.Context, in *UpdateCouponRequest, opts ...grpc.CallOption) (*CouponResponse, error) {
+	out := new(CouponResponse)
+	err := grpc.Invoke(ctx, "/Coupons/UpdateCoupon", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *couponsClient) DeleteCoupon(ctx context.Context, in *DeleteCouponRequest, opts ...grpc.CallOption) (*DeleteCouponResponse, error) {
+	out := new(DeleteCouponResponse)
+	err := grpc.Invoke(ctx, "/Coupons/DeleteCoupon", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *couponsClient) GetCoupon(ctx context.Context, in *GetCouponRequest, opts ...grpc.CallOption) (*CouponResponse, error) {
+	out := new(CouponResponse)
+	err := grpc.Invoke(ctx, "/Coupons/GetCoupon", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *couponsClient) ListCoupons(ctx context.Context, in *ListCouponsRequest, opts ...grpc.CallOption) (Coupons_ListCouponsClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Coupons_serviceDesc.Streams[0], c.cc, "/Coupons/ListCoupons", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &couponsListCouponsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Coupons_ListCouponsClient interface {
+	Recv() (*CouponResponse, error)
+	grpc.ClientStream
+}
+
+type couponsListCouponsClient struct {
+	grpc.ClientStream
+}
+
+func (x *couponsListCouponsClient) Recv() (*CouponResponse, error) {
+	m := new(CouponResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *couponsClient) ApplyCoupon(ctx context.Context, in *ApplyCouponRequest, opts ...grpc.CallOption) (*DiscountResponse, error) {
+	out := new(DiscountResponse)
+	err := grpc.Invoke(ctx, "/Coupons/ApplyCoupon", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *couponsClient) RemoveDiscount(ctx context.Context, in *RemoveDiscountRequest, opts ...grpc.CallOption) (*RemoveDiscountResponse, error) {
+	out := new(RemoveDiscountResponse)
+	err := grpc.Invoke(ctx, "/Coupons/RemoveDiscount", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for Coupons service
+
+type CouponsServer interface {
+	CreateCoupon(context.Context, *CreateCouponRequest) (*CouponResponse, error)
+	UpdateCoupon(context.Context, *UpdateCouponRequest) (*CouponResponse, error)
+	DeleteCoupon(context.Context, *DeleteCouponRequest) (*DeleteCouponResponse, error)
+	GetCoupon(context.Context, *GetCouponRequest) (*CouponResponse, error)
+	ListCoupons(*ListCouponsRequest, Coupons_ListCouponsServer) error
+	ApplyCoupon(context.Context, *ApplyCouponRequest) (*DiscountResponse, error)
+	RemoveDiscount(context.Context, *RemoveDiscountRequest) (*RemoveDiscountResponse, error)
+}
+
+func RegisterCouponsServer(s *grpc.Server, srv CouponsServer) {
+	s.RegisterService(&_Coupons_serviceDesc, srv)
+}
+
+func _Coupons_CreateCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCouponRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouponsServer).CreateCoupon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Coupons/CreateCoupon",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouponsServer).CreateCoupon(ctx, req.(*CreateCouponRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Coupons_UpdateCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCouponRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouponsServer).UpdateCoupon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Coupons/UpdateCoupon",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouponsServer).UpdateCoupon(ctx, req.(*UpdateCouponRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Coupons_DeleteCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCouponRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouponsServer).DeleteCoupon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Coupons/DeleteCoupon",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouponsServer).DeleteCoupon(ctx, req.(*DeleteCouponRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Coupons_GetCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCouponRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouponsServer).GetCoupon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Coupons/GetCoupon",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouponsServer).GetCoupon(ctx, req.(*GetCouponRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Coupons_ListCoupons_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListCouponsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CouponsServer).ListCoupons(m, &couponsListCouponsServer{stream})
+}
+
+type Coupons_ListCouponsServer interface {
+	Send(*CouponResponse) error
+	grpc.ServerStream
+}
+
+type couponsListCouponsServer struct {
+	grpc.ServerStream
+}
+
+func (x *couponsListCouponsServer) Send(m *CouponResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Coupons_ApplyCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyCouponRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouponsServer).ApplyCoupon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Coupons/ApplyCoupon",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouponsServer).ApplyCoupon(ctx, req.(*ApplyCouponRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Coupons_RemoveDiscount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveDiscountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouponsServer).RemoveDiscount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Coupons/RemoveDiscount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouponsServer).RemoveDiscount(ctx, req.(*RemoveDiscountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Coupons_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "Coupons",
+	HandlerType: (*CouponsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateCoupon",
+			Handler:    _Coupons_CreateCoupon_Handler,
+		},
+		{
+			MethodName: "UpdateCoupon",
+			Handler:    _Coupons_UpdateCoupon_Handler,
+		},
+		{
+			MethodName: "DeleteCoupon",
+			Handler:    _Coupons_DeleteCoupon_Handler,
+		},
+		{
+			MethodName: "GetCoupon",
+			Handler:    _Coupons_GetCoupon_Handler,
+		},
+		{
+			MethodName: "ApplyCoupon",
+			Handler:    _Coupons_ApplyCoupon_Handler,
+		},
+		{
+			MethodName: "RemoveDiscount",
+			Handler:    _Coupons_RemoveDiscount_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ListCoupons",
+			Handler:       _Coupons_ListCoupons_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "coupon.proto",
+}
+
+func init() { proto.RegisterFile("coupon.proto", fileDescriptor0) }
+
+var fileDescriptor0 = []byte{
+	// 953 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xdf, 0x8f, 0xdb, 0x44,
+	0x10, 0x8e, 0xe3, 0x4b, 0x62, 0x4f, 0x7e, 0x5c, 0xba, 0xc9, 0x1d, 0x56, 0x10, 0x34, 0x72, 0x15,
+	0x11, 0x01, 0xb2, 0xda, 0xf0, 0x00, 0x02, 0x01, 0xea, 0xdd, 0xb5, 0x14, 0x41, 0x55, 0x69, 0x51,
+	0xc5, 0x63, 0xe4, 0xd8, 0x13, 0xb0, 0x1a, 0xff, 0xe8, 0xae, 0x7d, 0x34, 0xcf, 0xfc, 0x09, 0x3c,
+	0xf3, 0x82, 0x78, 0xe2, 0xaf, 0x44, 0xbb, 0x5e, 0xe7, 0x62, 0xc7, 0x47, 0x0e, 0x15, 0xde, 0x3c,
+	0x9f, 0x67, 0xc6, 0x33, 0xfb, 0xcd, 0x7c, 0x6b, 0xe8, 0x79, 0x71, 0x96, 0xc4, 0x91, 0x93, 0xb0,
+	0x38, 0x8d, 0x27, 0x43, 0x2f, 0x63, 0x0c, 0x23, 0x2f, 0x40, 0xae, 0x90, 0x2e, 0x32, 0x16, 0x33,
+	0x65, 0x40, 0xb2, 0x71, 0x95, 0xab, 0xbd, 0x82, 0xc1, 0xa5, 0x0c, 0xa5, 0xc8, 0x93, 0x38, 0xe2,
+	0x48, 0xde, 0x87, 0x96, 0x74, 0xb6, 0xb4, 0xa9, 0x36, 0xef, 0x2e, 0xda, 0xce, 0x13, 0x61, 0x3d,
+	0x6b, 0xd0, 0x1c, 0x26, 0x0f, 0xa0, 0xc3, 0x33, 0xcf, 0x43, 0xce, 0xad, 0xa6, 0xf4, 0xe8, 0x38,
+	0x79, 0x86, 0x67, 0x0d, 0x5a, 0xbc, 0xb9, 0xe8, 0x82, 0xc9, 0x54, 0x42, 0x6e, 0xff, 0x7a, 0x02,
+	0xed, 0xdc, 0x85, 0x0c, 0xa0, 0x19, 0xf8, 0x32, 0xb3, 0x49, 0x9b, 0x81, 0x4f, 0xde, 0x03, 0x70,
+	0xc3, 0x38, 0x8b, 0xd2, 0x65, 0xbc, 0x5e, 0xcb, 0x7c, 0x27, 0xd4, 0xcc, 0x91, 0x17, 0xeb, 0x35,
+	0xb1, 0xa0, 0xe3, 0x31, 0x74, 0x53, 0xf4, 0x2d, 0x7d, 0xaa, 0xcd, 0x75, 0x5a, 0x98, 0x64, 0x06,
+	0x86, 0x6a, 0x72, 0x6b, 0x9d, 0x4c, 0xb5, 0xf9, 0x60, 0x61, 0x3a, 0x97, 0x0a, 0xa0, 0xbb, 0x57,
+	0xe4, 0x23, 0x30, 0xfc, 0x8c, 0xb9, 0x69, 0x10, 0x47, 0x56, 0x4b, 0xba, 0x9d, 0xaa, 0x6a, 0xaf,
+	0x14, 0x4c, 0x77, 0x0e, 0xe4, 0x63, 0x20, 0xc5, 0xf3, 0x32, 0x88, 0x96, 0x61, 0x1c, 0xa5, 0x3f,
+	0x73, 0xab, 0x2d, 0x8b, 0x1a, 0x16, 0x6f, 0xbe, 0x8d, 0x9e, 0x4b, 0x9c, 0x4c, 0xc0, 0xd8, 0x04,
+	0xd7, 0x18, 0xc6, 0x3e, 0x5a, 0x9d, 0xa9, 0x36, 0x37, 0xe8, 0xce, 0x26, 0x1f, 0xc0, 0x69, 0xe8,
+	0xbe, 0x59, 0x32, 0xf4, 0x31, 0x4c, 0x44, 0x14, 0xb7, 0x0c, 0x99, 0x66, 0x10, 0xba, 0x6f, 0xe8,
+	0x0d, 0x4a, 0x1e, 0x81, 0x11, 0x62, 0xea, 0xfa, 0x6e, 0xea, 0x5a, 0xe6, 0x54, 0x9f, 0x77, 0x17,
+	0x67, 0xaa, 0x3e, 0xe7, 0xb9, 0xc2, 0x9f, 0x44, 0x29, 0xdb, 0xd2, 0x9d, 0x1b, 0xb9, 0x0f, 0xdd,
+	0x04, 0x99, 0x87, 0xea, 0xcc, 0x40, 0xe6, 0x05, 0x05, 0x89, 0x43, 0x7b, 0x57, 0x9c, 0xbd, 0x8f,
+	0x18, 0x2e, 0x57, 0x5b, 0xab, 0x2b, 0x8f, 0xcd, 0xc8, 0x81, 0x8b, 0x2d, 0x99, 0xc1, 0x20, 0x0d,
+	0x42, 0xe4, 0xcb, 0x1c, 0x41, 0xdf, 0xea, 0xc9, 0x04, 0x7d, 0x89, 0x52, 0x05, 0x92, 0x31, 0xb4,
+	0xae, 0xdd, 0x4d, 0xe0, 0x5b, 0x7d, 0xd9, 0x59, 0x6e, 0x4c, 0xbe, 0x80, 0x7e, 0xa9, 0x2a, 0x32,
+	0x04, 0xfd, 0x15, 0x6e, 0x15, 0x9f, 0xe2, 0x51, 0x05, 0x66, 0x28, 0xb9, 0x34, 0x69, 0x6e, 0x7c,
+	0xde, 0xfc, 0x4c, 0xb3, 0xff, 0xd2, 0x61, 0x74, 0x29, 0xd9, 0x2b, 0x06, 0xee, 0x75, 0x86, 0x3c,
+	0x3d, 0x18, 0x89, 0x7d, 0xca, 0x9a, 0xc7, 0x28, 0x2b, 0xcf, 0x8f, 0x5e, 0x9d, 0x9f, 0x3b, 0x4e,
+	0x49, 0xe5, 0x48, 0x5b, 0x07, 0x47, 0xfa, 0xef, 0x26, 0xa3, 0x86, 0xfd, 0x4e, 0x2d, 0xfb, 0x25,
+	0xa6, 0x8c, 0x0a, 0x53, 0x5f, 0x1d, 0x8c, 0x86, 0xed, 0xd4, 0x9c, 0xdf, 0x6d, 0x73, 0xf2, 0x76,
+	0x64, 0xd9, 0x30, 0xfc, 0x06, 0xd3, 0x7f, 0x24, 0xca, 0xfe, 0x43, 0x83, 0xd1, 0xcb, 0xc4, 0x3f,
+	0x4a, 0xe8, 0x7e, 0x23, 0x4d, 0xd5, 0x48, 0x4d, 0xdc, 0xff, 0xd3, 0xc8, 0x0c, 0x46, 0x57, 0xb8,
+	0xc1, 0x23, 0x35, 0xda, 0x5f, 0x97, 0xdd, 0x7e, 0xc8, 0x65, 0x4c, 0xe8, 0x8f, 0x2f, 0xe1, 0xdc,
+	0xd7, 0xa0, 0x85, 0xa9, 0x12, 0x34, 0x77, 0x09, 0x32, 0x18, 0x97, 0xbf, 0x73, 0x47, 0x35, 0x7d,
+	0x58, 0x55, 0xd3, 0xb1, 0x53, 0x53, 0xc8, 0xad, 0xd2, 0xfa, 0xbb, 0x06, 0xe4, 0xfb, 0x80, 0x2b,
+	0xa6, 0x78, 0xd1, 0xde, 0xec, 0x46, 0x37, 0xf3, 0xef, 0x76, 0x1d, 0xe1, 0xf5, 0x34, 0xd8, 0xa4,
+	0xc8, 0x6e, 0x44, 0xf4, 0x01, 0xf4, 0x31, 0xf2, 0x83, 0xe8, 0xa7, 0xe5, 0x0a, 0xd7, 0x31, 0x2b,
+	0x8e, 0xaf, 0x97, 0x83, 0x17, 0x12, 0x13, 0x8a, 0xc1, 0x53, 0x97, 0xa5, 0xc2, 0xcd, 0x5d, 0xa7,
+	0xc8, 0xe4, 0x9a, 0x99, 0xb4, 0x5f, 0xa0, 0x8f, 0x05, 0x28, 0x28, 0xd8, 0x04, 0x61, 0x90, 0xca,
+	0x3d, 0x6b, 0xd1, 0xdc, 0xb0, 0x7f, 0xd3, 0xc0, 0xb8, 0x0a, 0xb8, 0x27, 0x16, 0x92, 0xdc, 0x87,
+	0x76, 0x7e, 0x4d, 0x59, 0x5a, 0xe9, 0xe2, 0xa0, 0x0a, 0x16, 0x92, 0xea, 0x65, 0x3c, 0x8d, 0x43,
+	0x64, 0xaa, 0x94, 0x9d, 0x4d, 0x6c, 0xe8, 0xf1, 0x6c, 0xc5, 0x3d, 0x16, 0xc8, 0xe5, 0x51, 0x45,
+	0x94, 0x30, 0x51, 0x83, 0x2c, 0x4a, 0xd6, 0xa0, 0xd3, 0xdc, 0x10, 0xe3, 0x82, 0x91, 0x2f, 0xb7,
+	0x5a, 0xa7, 0xe2, 0xd1, 0x5e, 0xc3, 0xb0, 0x28, 0xea, 0xce, 0x44, 0xcd, 0xaa, 0x44, 0x99, 0x4e,
+	0x91, 0xe3, 0x56, 0x76, 0x36, 0x40, 0x1e, 0x27, 0xc9, 0x66, 0x5b, 0x9e, 0xbd, 0xf3, 0xd2, 0x31,
+	0x98, 0xff, 0x55, 0xf7, 0xf6, 0x8f, 0x70, 0x46, 0x31, 0x8c, 0xaf, 0xf1, 0xa6, 0xb7, 0xfc, 0x83,
+	0xfb, 0x89, 0xb5, 0x23, 0x89, 0x9b, 0x35, 0x89, 0x5f, 0x57, 0x13, 0x1f, 0x5f, 0x8f, 0xb7, 0xed,
+	0x65, 0x0b, 0xe7, 0xd5, 0x5e, 0xee, 0xc8, 0xd3, 0xa2, 0xca, 0xd3, 0xb9, 0x53, 0x5b, 0xfc, 0x6d,
+	0xa4, 0x7d, 0xf8, 0x1d, 0x0c, 0xca, 0xd7, 0x0d, 0x19, 0xc1, 0xe9, 0xcb, 0xe8, 0x55, 0x14, 0xff,
+	0xb2, 0x83, 0x86, 0x0d, 0x62, 0xc0, 0xc9, 0x8b, 0xc8, 0xc3, 0xa1, 0x46, 0xfa, 0x60, 0x52, 0x4c,
+	0xd0, 0x15, 0xbb, 0x30, 0x6c, 0x92, 0x2e, 0x74, 0x9e, 0xc6, 0x0c, 0xaf, 0x91, 0x0d, 0xf5, 0xc5,
+	0x9f, 0x3a, 0x74, 0xd4, 0x6e, 0x92, 0x4f, 0xa1, 0xb7, 0xaf, 0xdf, 0x64, 0x5c, 0x27, 0xe7, 0x93,
+	0xe2, 0xb2, 0x2b, 0x1a, 0xb6, 0x1b, 0x22, 0x70, 0x5f, 0x2f, 0xc9, 0xb8, 0x4e, 0x3e, 0xeb, 0x02,
+	0xbf, 0x84, 0xde, 0xbe, 0x98, 0x90, 0xb2, 0xb6, 0x14, 0x81, 0x67, 0x4e, 0x9d, 0x72, 0xd9, 0x0d,
+	0xf2, 0x08, 0xcc, 0xdd, 0x25, 0x40, 0xee, 0x39, 0xd5, 0x0b, 0xa1, 0xbe, 0xd4, 0xee, 0x9e, 0x1c,
+	0x91, 0x91, 0x73, 0x28, 0x4e, 0x35, 0x61, 0x0f, 0x35, 0x11, 0xb8, 0xb7, 0x2a, 0x64, 0xe4, 0x1c,
+	0x2e, 0xce, 0xe4, 0x9e, 0x53, 0x9d, 0x06, 0xbb, 0x41, 0x2e, 0x61, 0x50, 0xe6, 0x97, 0x54, 0x09,
+	0x2f, 0xc2, 0xdf, 0x71, 0xea, 0x47, 0xca, 0x6e, 0xac, 0xda, 0xf2, 0x67, 0xf8, 0x93, 0xbf, 0x07,
+	0x00, 0x03, 0xa7, 0x16, 0x1f, 0x47, 0x0b, 0x00, 0x00,
+}
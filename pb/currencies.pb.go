@@ -1,27 +1,6 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // source: currencies.proto
 
-/*
-Package pb is a generated protocol buffer package.
-
-It is generated from these files:
-	currencies.proto
-	error.proto
-	plan.proto
-
-It has these top-level messages:
-	Error
-	PlanResponse
-	Plan
-	CreatePlanRequest
-	GetPlanRequest
-	UpdatePlanRequest
-	DeletePlanRequest
-	DeletePlanSuccess
-	DeletePlanResponse
-	ListFilter
-	ListPlansRequest
-*/
 package pb
 
 import proto "github.com/golang/protobuf/proto"
@@ -33,12 +12,6 @@ var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the proto package it is being compiled against.
-// A compilation error at this line likely means your copy of the
-// proto package needs to be updated.
-const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
-
 type Currency int32
 
 const (
@@ -179,6 +152,11 @@ const (
 	Currency_XOF Currency = 134
 	Currency_YER Currency = 135
 	Currency_ZMW Currency = 136
+	Currency_BHD Currency = 137
+	Currency_JOD Currency = 138
+	Currency_KWD Currency = 139
+	Currency_OMR Currency = 140
+	Currency_TND Currency = 141
 )
 
 var Currency_name = map[int32]string{
@@ -319,6 +297,11 @@ var Currency_name = map[int32]string{
 	134: "XOF",
 	135: "YER",
 	136: "ZMW",
+	137: "BHD",
+	138: "JOD",
+	139: "KWD",
+	140: "OMR",
+	141: "TND",
 }
 var Currency_value = map[string]int32{
 	"UNK": 0,
@@ -458,60 +441,67 @@ var Currency_value = map[string]int32{
 	"XOF": 134,
 	"YER": 135,
 	"ZMW": 136,
+	"BHD": 137,
+	"JOD": 138,
+	"KWD": 139,
+	"OMR": 140,
+	"TND": 141,
 }
 
 func (x Currency) String() string {
 	return proto.EnumName(Currency_name, int32(x))
 }
-func (Currency) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+func (Currency) EnumDescriptor() ([]byte, []int) { return fileDescriptor1, []int{0} }
 
 func init() {
 	proto.RegisterEnum("Currency", Currency_name, Currency_value)
 }
 
-func init() { proto.RegisterFile("currencies.proto", fileDescriptor0) }
+func init() { proto.RegisterFile("currencies.proto", fileDescriptor1) }
 
-var fileDescriptor0 = []byte{
-	// 656 bytes of a gzipped FileDescriptorProto
+var fileDescriptor1 = []byte{
+	// 674 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x24, 0xd4, 0x67, 0x77, 0xdc, 0x44,
-	0x14, 0xc6, 0x71, 0x8c, 0x21, 0x71, 0x4c, 0xfb, 0x63, 0x7a, 0xef, 0x2d, 0x40, 0x28, 0xa1, 0x77,
-	0x69, 0xef, 0x4a, 0xbb, 0x3b, 0xd2, 0x68, 0x3c, 0x92, 0x76, 0x57, 0xa2, 0xc7, 0x18, 0x08, 0x25,
-	0x0e, 0x4e, 0x42, 0xef, 0xfd, 0x83, 0xf2, 0x41, 0x38, 0xf3, 0xf8, 0xdd, 0xef, 0x3c, 0x33, 0x73,
-	0xa4, 0xb9, 0x73, 0xcf, 0xdd, 0x66, 0xef, 0xd2, 0xe1, 0xe1, 0xfe, 0xb9, 0xbd, 0xb3, 0xfb, 0x17,
-	0x4e, 0x9d, 0x3f, 0x3c, 0xb8, 0x78, 0x70, 0xf2, 0xbf, 0x13, 0xdb, 0x5b, 0x93, 0xa3, 0xf0, 0xbb,
-	0x9d, 0xe3, 0xdb, 0x9b, 0xbd, 0x77, 0x5c, 0x26, 0xb4, 0xc6, 0x46, 0x42, 0x56, 0x78, 0x2e, 0x17,
-	0xaa, 0x8a, 0xcd, 0x04, 0x1b, 0x8d, 0x2b, 0x94, 0x34, 0x19, 0x57, 0x0a, 0xb1, 0xe5, 0x98, 0x50,
-	0x1b, 0xc7, 0x85, 0x55, 0xc9, 0x96, 0xd0, 0x1b, 0x27, 0x84, 0xd1, 0xb3, 0x9d, 0x90, 0xb7, 0xc6,
-	0x55, 0x82, 0x75, 0x5c, 0x2d, 0xe4, 0xc6, 0x35, 0xc2, 0x68, 0x5c, 0x2b, 0xd4, 0xc6, 0x75, 0x42,
-	0x93, 0x83, 0x90, 0xd5, 0x5c, 0x2f, 0xac, 0x02, 0x3b, 0x42, 0xac, 0xb8, 0x21, 0xa1, 0xcc, 0x03,
-	0x37, 0x2a, 0xf1, 0xc6, 0x4d, 0x42, 0xe9, 0xb9, 0x59, 0x98, 0x17, 0xdc, 0x92, 0xe0, 0x66, 0x91,
-	0x5b, 0x13, 0x26, 0x99, 0x71, 0x9b, 0xb0, 0x9c, 0x72, 0xbb, 0x96, 0x06, 0xe3, 0x8e, 0x84, 0x75,
-	0x56, 0x70, 0xa7, 0x10, 0x0a, 0xee, 0xd2, 0x9e, 0x2a, 0x70, 0xb7, 0xe0, 0x07, 0xee, 0x11, 0x9a,
-	0xc0, 0xbd, 0x3a, 0x55, 0x17, 0xdc, 0xa7, 0xc4, 0x0a, 0xee, 0x17, 0xe2, 0x84, 0x07, 0x12, 0x66,
-	0xd1, 0xf1, 0xa0, 0x92, 0xd1, 0xf1, 0x90, 0x2a, 0xe6, 0x1c, 0x0f, 0x0b, 0x8b, 0x82, 0x47, 0x84,
-	0x26, 0xf0, 0xa8, 0xbe, 0x35, 0x31, 0x1e, 0x4b, 0x98, 0x96, 0x81, 0x93, 0x42, 0x97, 0xf3, 0xb8,
-	0xd0, 0x47, 0x9e, 0x48, 0x28, 0x5c, 0xe0, 0x49, 0x61, 0x61, 0x9c, 0xd2, 0x95, 0x6b, 0xe3, 0x29,
-	0x61, 0x5a, 0xf1, 0xb4, 0x30, 0x0f, 0x3c, 0x23, 0x74, 0xbb, 0x3c, 0x2b, 0xf8, 0x82, 0xd3, 0xc2,
-	0x60, 0x3c, 0xa7, 0x1f, 0xeb, 0x4a, 0x9e, 0x17, 0x7c, 0xc5, 0x0b, 0x82, 0x33, 0x5e, 0x14, 0xfa,
-	0x82, 0x97, 0x12, 0xe6, 0xad, 0xe3, 0x65, 0xc1, 0x47, 0x5e, 0x11, 0x2c, 0xf2, 0xaa, 0x50, 0xb5,
-	0xbc, 0x96, 0xb0, 0xa8, 0x8d, 0xd7, 0x85, 0x30, 0xf0, 0x86, 0xaa, 0x31, 0x76, 0xbc, 0x29, 0x4c,
-	0x5b, 0xde, 0x12, 0xca, 0x96, 0x2c, 0xa1, 0xca, 0x1c, 0xb9, 0x90, 0x07, 0x26, 0x42, 0x5b, 0x61,
-	0x42, 0x34, 0xa6, 0x09, 0x75, 0x13, 0x28, 0x04, 0x67, 0x94, 0x42, 0x99, 0x31, 0x13, 0x56, 0x8e,
-	0xb9, 0x30, 0x44, 0x16, 0xc2, 0x32, 0xe2, 0x84, 0xd8, 0x50, 0x09, 0x7d, 0xa4, 0x16, 0xd6, 0x1e,
-	0x2f, 0x58, 0x45, 0x23, 0xf8, 0x8e, 0x20, 0x64, 0xc6, 0xae, 0x30, 0x7a, 0xa2, 0x50, 0x3b, 0xda,
-	0x04, 0x9f, 0x19, 0x9d, 0x10, 0x22, 0xbd, 0x7a, 0xd5, 0x97, 0x2c, 0x13, 0xba, 0x95, 0xb1, 0xd2,
-	0xd2, 0x68, 0xac, 0x85, 0x79, 0xc3, 0x20, 0x94, 0x9e, 0x51, 0x68, 0x1c, 0x6f, 0x27, 0x04, 0x17,
-	0x79, 0x47, 0xc8, 0x72, 0xde, 0x15, 0x4a, 0xc7, 0x7b, 0xc2, 0x50, 0xf2, 0xbe, 0x30, 0xf5, 0x7c,
-	0x20, 0xcc, 0x02, 0x1f, 0x0a, 0x95, 0xe7, 0x4c, 0xc2, 0x6e, 0x16, 0xd9, 0x4b, 0x88, 0x8d, 0xe7,
-	0x23, 0xa1, 0xcf, 0xd9, 0x17, 0x56, 0x05, 0x1f, 0x27, 0xb4, 0x9d, 0xf1, 0x89, 0x30, 0x0b, 0x7c,
-	0x2a, 0x2c, 0x27, 0x9c, 0x4d, 0x58, 0xb5, 0x1d, 0x9f, 0x29, 0xc9, 0x22, 0x9f, 0xeb, 0x54, 0x6b,
-	0x7c, 0xa1, 0x64, 0x12, 0xf9, 0x52, 0xa8, 0x2a, 0xce, 0x09, 0xa5, 0x71, 0x20, 0xe4, 0xc6, 0x79,
-	0xa1, 0x69, 0xf9, 0x2a, 0x61, 0xcc, 0x22, 0x87, 0x7a, 0xc1, 0xb8, 0xe2, 0x82, 0x9e, 0xc9, 0x45,
-	0x2e, 0x6a, 0x4f, 0x34, 0x2e, 0x09, 0x63, 0xc5, 0xd7, 0xc2, 0xd4, 0xf1, 0x8d, 0x5a, 0x7d, 0x56,
-	0xf0, 0xad, 0x2a, 0xb6, 0x68, 0xd1, 0x24, 0xe9, 0xc6, 0x96, 0xef, 0x85, 0x59, 0xce, 0x0f, 0x42,
-	0x13, 0xf8, 0x51, 0xe8, 0x8c, 0x9f, 0x84, 0x38, 0xf0, 0xb3, 0xa6, 0x4d, 0xb9, 0xe6, 0x97, 0x9d,
-	0xad, 0xed, 0xcd, 0x3e, 0x9b, 0xf1, 0xeb, 0x46, 0x52, 0x36, 0x35, 0x7e, 0x93, 0xfa, 0xa1, 0xe7,
-	0xf7, 0x23, 0x8d, 0x2d, 0x7f, 0x48, 0xcb, 0x7e, 0xc9, 0x9f, 0x47, 0xf2, 0xc6, 0x5f, 0xd2, 0xba,
-	0x29, 0xf8, 0x5b, 0x1a, 0xa6, 0x91, 0x7f, 0xa4, 0xb1, 0x5e, 0xf1, 0xef, 0xc6, 0x99, 0x63, 0x9a,
-	0x76, 0xa7, 0xff, 0x0f, 0x00, 0x00, 0xff, 0xff, 0x0c, 0x03, 0x11, 0xdb, 0x01, 0x05, 0x00, 0x00,
+	0x14, 0xc6, 0x71, 0x8c, 0x21, 0x31, 0x4b, 0xfb, 0x63, 0x7a, 0xef, 0x2d, 0x40, 0x28, 0xa1, 0x77,
+	0x69, 0xef, 0x4a, 0xbb, 0x3b, 0xd2, 0x48, 0x1e, 0x49, 0xab, 0x95, 0xe8, 0x31, 0x06, 0x42, 0x89,
+	0x83, 0x93, 0xd0, 0x7b, 0xaf, 0x6f, 0xf9, 0xbc, 0x9c, 0x79, 0xfc, 0xee, 0x77, 0x9e, 0x99, 0x7b,
+	0x24, 0xdd, 0xab, 0x73, 0x27, 0xec, 0x9e, 0x3f, 0x38, 0xd8, 0x3b, 0xbd, 0x7b, 0x6a, 0xef, 0xec,
+	0xf1, 0x33, 0x07, 0xfb, 0xe7, 0xf6, 0x8f, 0xfd, 0x37, 0x99, 0x6c, 0x4d, 0x0f, 0xc3, 0x2f, 0xb7,
+	0x8f, 0x4e, 0x36, 0x3b, 0xef, 0xb8, 0x40, 0x68, 0x8c, 0x8d, 0x88, 0x24, 0xf3, 0x5c, 0x28, 0x14,
+	0x05, 0x9b, 0x11, 0x36, 0x1a, 0x17, 0x29, 0xa9, 0x12, 0x2e, 0x16, 0x42, 0xc3, 0x11, 0xa1, 0x34,
+	0x8e, 0x0a, 0x7d, 0xce, 0x96, 0xd0, 0x19, 0x97, 0x08, 0xa3, 0x67, 0x12, 0x91, 0x36, 0xc6, 0xa5,
+	0x82, 0xb5, 0x5c, 0x26, 0xa4, 0xc6, 0xe5, 0xc2, 0x68, 0x5c, 0x21, 0x94, 0xc6, 0x95, 0x42, 0x95,
+	0x82, 0x90, 0x94, 0x5c, 0x25, 0xf4, 0x35, 0xdb, 0x42, 0x28, 0xb8, 0x3a, 0x22, 0x4f, 0x6b, 0xae,
+	0x51, 0xe2, 0x8d, 0x6b, 0x85, 0xdc, 0x73, 0x9d, 0xb0, 0xc8, 0xb8, 0x3e, 0xc2, 0xcd, 0x03, 0x37,
+	0x44, 0x4c, 0x13, 0xe3, 0x46, 0x61, 0x35, 0xe3, 0x26, 0x1d, 0x0d, 0xc6, 0xcd, 0x11, 0xeb, 0x24,
+	0xe3, 0x16, 0xa1, 0xce, 0xb8, 0x55, 0x77, 0x8a, 0x9a, 0xdb, 0x04, 0x3f, 0x70, 0xbb, 0x50, 0xd5,
+	0xdc, 0xa1, 0xaa, 0x32, 0xe3, 0x4e, 0x25, 0x96, 0x71, 0x97, 0x10, 0xa6, 0xdc, 0x1d, 0x31, 0x0f,
+	0x8e, 0x7b, 0x94, 0x8c, 0x8e, 0x7b, 0x23, 0xcc, 0x39, 0xee, 0x13, 0x96, 0x19, 0xf7, 0x0b, 0x55,
+	0xcd, 0x03, 0x7a, 0xd6, 0xd4, 0x78, 0x30, 0x62, 0x96, 0xd7, 0x1c, 0x13, 0xda, 0x94, 0x87, 0x84,
+	0x2e, 0xf0, 0x70, 0x44, 0xe6, 0x6a, 0x1e, 0x11, 0x96, 0xc6, 0x71, 0x7d, 0x72, 0x69, 0x3c, 0x2a,
+	0xcc, 0x0a, 0x1e, 0x13, 0x16, 0x35, 0x8f, 0x0b, 0xed, 0x0e, 0x4f, 0x08, 0x3e, 0xe3, 0x84, 0x30,
+	0x18, 0x4f, 0xea, 0xc5, 0xda, 0x9c, 0xa7, 0x04, 0x5f, 0xf0, 0xb4, 0xe0, 0x8c, 0x67, 0x84, 0x2e,
+	0xe3, 0xd9, 0x88, 0x45, 0xe3, 0x78, 0x4e, 0xf0, 0x81, 0xe7, 0x05, 0x0b, 0xbc, 0x20, 0x14, 0x0d,
+	0x2f, 0x46, 0x2c, 0x4b, 0xe3, 0x25, 0xa1, 0x1e, 0x78, 0x59, 0xdd, 0x18, 0x5b, 0x5e, 0x11, 0x66,
+	0x0d, 0xaf, 0x0a, 0x79, 0x43, 0x12, 0x51, 0x24, 0x8e, 0x54, 0x48, 0x6b, 0xa6, 0x42, 0x53, 0x60,
+	0x42, 0x30, 0x66, 0x11, 0x65, 0x55, 0x93, 0x09, 0xce, 0xc8, 0x85, 0x3c, 0x61, 0x2e, 0xf4, 0x8e,
+	0x85, 0x30, 0x04, 0x96, 0xc2, 0x2a, 0xe0, 0x84, 0x50, 0x51, 0x08, 0x5d, 0xa0, 0x14, 0xd6, 0x1e,
+	0x2f, 0x58, 0x41, 0x25, 0xf8, 0x96, 0x5a, 0x48, 0x8c, 0x1d, 0x61, 0xf4, 0x04, 0xa1, 0x74, 0x34,
+	0x11, 0x3e, 0x31, 0x5a, 0xa1, 0x0e, 0x74, 0x11, 0x89, 0xcf, 0x59, 0x45, 0xb4, 0xbd, 0xd1, 0xeb,
+	0x68, 0x34, 0xd6, 0xc2, 0xa2, 0x62, 0x10, 0x72, 0xcf, 0x28, 0x54, 0x8e, 0xd7, 0x22, 0x6a, 0x17,
+	0x78, 0x5d, 0x48, 0x52, 0xde, 0x10, 0x72, 0xc7, 0x9b, 0xc2, 0x90, 0xf3, 0x96, 0x30, 0xf3, 0xbc,
+	0x2d, 0xcc, 0x6b, 0xde, 0x11, 0x0a, 0xcf, 0xc9, 0x88, 0x9d, 0x24, 0xb0, 0x1b, 0x11, 0x2a, 0xcf,
+	0xbb, 0x42, 0x97, 0xb2, 0x27, 0xf4, 0x19, 0xef, 0x45, 0x34, 0xad, 0xf1, 0xbe, 0x30, 0xaf, 0xf9,
+	0x40, 0x58, 0x4d, 0x39, 0x15, 0xd1, 0x37, 0x2d, 0x1f, 0x2a, 0x49, 0x02, 0x1f, 0xa9, 0xaa, 0x31,
+	0x3e, 0x56, 0x32, 0x0d, 0x7c, 0x22, 0x14, 0x05, 0xa7, 0x85, 0xdc, 0xd8, 0x17, 0x52, 0xe3, 0x8c,
+	0x50, 0x35, 0x7c, 0x1a, 0x31, 0x26, 0x81, 0x03, 0x4d, 0x30, 0xf4, 0x9c, 0xd5, 0x98, 0x5c, 0xe0,
+	0x9c, 0xee, 0x04, 0xe3, 0xbc, 0x30, 0x16, 0x7c, 0x26, 0xcc, 0x1c, 0x9f, 0xeb, 0x57, 0x9f, 0x67,
+	0x7c, 0xa1, 0x8e, 0x2d, 0x1b, 0xb4, 0x49, 0xda, 0xb1, 0xe1, 0x2b, 0x61, 0x9e, 0xf2, 0xb5, 0x50,
+	0xd5, 0x7c, 0x23, 0xb4, 0xc6, 0xb7, 0x42, 0x18, 0xf8, 0x2e, 0xa2, 0xcb, 0xd7, 0x7c, 0xbf, 0xbd,
+	0x35, 0xd9, 0xec, 0x92, 0x39, 0x3f, 0x6c, 0x44, 0x25, 0x33, 0xe3, 0x47, 0xa9, 0x1b, 0x3a, 0x7e,
+	0x3a, 0xd4, 0xd8, 0xf0, 0xb3, 0xb4, 0xea, 0x56, 0xfc, 0x72, 0x28, 0x6f, 0xfc, 0x2a, 0xad, 0xab,
+	0x8c, 0xdf, 0xa4, 0x61, 0x16, 0xf8, 0x5d, 0x1a, 0xcb, 0x9e, 0x3f, 0xa4, 0x74, 0x6e, 0xfc, 0x29,
+	0x2d, 0x2b, 0xe3, 0x2f, 0xc9, 0xf5, 0xc6, 0xdf, 0x52, 0x55, 0x06, 0xfe, 0x91, 0x5a, 0x6f, 0xfc,
+	0xbb, 0x71, 0xf2, 0x88, 0xf6, 0xe3, 0x89, 0xff, 0x07, 0x00, 0x5e, 0x58, 0x5a, 0x9f, 0x33, 0x05,
+	0x00, 0x00,
 }
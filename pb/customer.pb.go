@@ -0,0 +1,859 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: customer.proto
+
+package pb
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+type CustomerResponse struct {
+	// Types that are valid to be assigned to Responses:
+	//	*CustomerResponse_Error
+	//	*CustomerResponse_Success
+	Responses isCustomerResponse_Responses `protobuf_oneof:"responses"`
+}
+
+func (m *CustomerResponse) Reset()                    { *m = CustomerResponse{} }
+func (m *CustomerResponse) String() string            { return proto.CompactTextString(m) }
+func (*CustomerResponse) ProtoMessage()               {}
+func (*CustomerResponse) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{0} }
+
+type isCustomerResponse_Responses interface {
+	isCustomerResponse_Responses()
+}
+
+type CustomerResponse_Error struct {
+	Error *Error `protobuf:"bytes,1,opt,name=error,oneof"`
+}
+type CustomerResponse_Success struct {
+	Success *Customer `protobuf:"bytes,2,opt,name=success,oneof"`
+}
+
+func (*CustomerResponse_Error) isCustomerResponse_Responses()   {}
+func (*CustomerResponse_Success) isCustomerResponse_Responses() {}
+
+func (m *CustomerResponse) GetResponses() isCustomerResponse_Responses {
+	if m != nil {
+		return m.Responses
+	}
+	return nil
+}
+
+func (m *CustomerResponse) GetError() *Error {
+	if x, ok := m.GetResponses().(*CustomerResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+func (m *CustomerResponse) GetSuccess() *Customer {
+	if x, ok := m.GetResponses().(*CustomerResponse_Success); ok {
+		return x.Success
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*CustomerResponse) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _CustomerResponse_OneofMarshaler, _CustomerResponse_OneofUnmarshaler, _CustomerResponse_OneofSizer, []interface{}{
+		(*CustomerResponse_Error)(nil),
+		(*CustomerResponse_Success)(nil),
+	}
+}
+
+func _CustomerResponse_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*CustomerResponse)
+	// responses
+	switch x := m.Responses.(type) {
+	case *CustomerResponse_Error:
+		b.EncodeVarint(1<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Error); err != nil {
+			return err
+		}
+	case *CustomerResponse_Success:
+		b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Success); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("CustomerResponse.Responses has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _CustomerResponse_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*CustomerResponse)
+	switch tag {
+	case 1: // responses.error
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Error)
+		err := b.DecodeMessage(msg)
+		m.Responses = &CustomerResponse_Error{msg}
+		return true, err
+	case 2: // responses.success
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Customer)
+		err := b.DecodeMessage(msg)
+		m.Responses = &CustomerResponse_Success{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _CustomerResponse_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*CustomerResponse)
+	// responses
+	switch x := m.Responses.(type) {
+	case *CustomerResponse_Error:
+		s := proto.Size(x.Error)
+		n += proto.SizeVarint(1<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *CustomerResponse_Success:
+		s := proto.Size(x.Success)
+		n += proto.SizeVarint(2<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+type Customer struct {
+	Id             string            `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	AccountBalance int64             `protobuf:"varint,2,opt,name=account_balance,json=accountBalance" json:"account_balance,omitempty"`
+	Created        int64             `protobuf:"varint,3,opt,name=created" json:"created,omitempty"`
+	Currency       Currency          `protobuf:"varint,4,opt,name=currency,enum=Currency" json:"currency,omitempty"`
+	DefaultSource  string            `protobuf:"bytes,5,opt,name=default_source,json=defaultSource" json:"default_source,omitempty"`
+	Delinquent     bool              `protobuf:"varint,6,opt,name=delinquent" json:"delinquent,omitempty"`
+	Description    string            `protobuf:"bytes,7,opt,name=description" json:"description,omitempty"`
+	Email          string            `protobuf:"bytes,8,opt,name=email" json:"email,omitempty"`
+	Livemode       bool              `protobuf:"varint,9,opt,name=livemode" json:"livemode,omitempty"`
+	Metadata       map[string]string `protobuf:"bytes,10,rep,name=metadata" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	BusinessVatId  string            `protobuf:"bytes,11,opt,name=business_vat_id,json=businessVatId" json:"business_vat_id,omitempty"`
+	Discount       *Discount         `protobuf:"bytes,12,opt,name=discount" json:"discount,omitempty"`
+}
+
+func (m *Customer) Reset()                    { *m = Customer{} }
+func (m *Customer) String() string            { return proto.CompactTextString(m) }
+func (*Customer) ProtoMessage()               {}
+func (*Customer) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{1} }
+
+func (m *Customer) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Customer) GetAccountBalance() int64 {
+	if m != nil {
+		return m.AccountBalance
+	}
+	return 0
+}
+
+func (m *Customer) GetCreated() int64 {
+	if m != nil {
+		return m.Created
+	}
+	return 0
+}
+
+func (m *Customer) GetCurrency() Currency {
+	if m != nil {
+		return m.Currency
+	}
+	return Currency_UNK
+}
+
+func (m *Customer) GetDefaultSource() string {
+	if m != nil {
+		return m.DefaultSource
+	}
+	return ""
+}
+
+func (m *Customer) GetDelinquent() bool {
+	if m != nil {
+		return m.Delinquent
+	}
+	return false
+}
+
+func (m *Customer) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *Customer) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+func (m *Customer) GetLivemode() bool {
+	if m != nil {
+		return m.Livemode
+	}
+	return false
+}
+
+func (m *Customer) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *Customer) GetBusinessVatId() string {
+	if m != nil {
+		return m.BusinessVatId
+	}
+	return ""
+}
+
+func (m *Customer) GetDiscount() *Discount {
+	if m != nil {
+		return m.Discount
+	}
+	return nil
+}
+
+type CreateCustomerRequest struct {
+	AccountBalance int64             `protobuf:"varint,1,opt,name=account_balance,json=accountBalance" json:"account_balance,omitempty"`
+	Description    string            `protobuf:"bytes,2,opt,name=description" json:"description,omitempty"`
+	Email          string            `protobuf:"bytes,3,opt,name=email" json:"email,omitempty"`
+	Metadata       map[string]string `protobuf:"bytes,4,rep,name=metadata" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	BusinessVatId  string            `protobuf:"bytes,5,opt,name=business_vat_id,json=businessVatId" json:"business_vat_id,omitempty"`
+}
+
+func (m *CreateCustomerRequest) Reset()                    { *m = CreateCustomerRequest{} }
+func (m *CreateCustomerRequest) String() string            { return proto.CompactTextString(m) }
+func (*CreateCustomerRequest) ProtoMessage()               {}
+func (*CreateCustomerRequest) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{2} }
+
+func (m *CreateCustomerRequest) GetAccountBalance() int64 {
+	if m != nil {
+		return m.AccountBalance
+	}
+	return 0
+}
+
+func (m *CreateCustomerRequest) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *CreateCustomerRequest) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+func (m *CreateCustomerRequest) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *CreateCustomerRequest) GetBusinessVatId() string {
+	if m != nil {
+		return m.BusinessVatId
+	}
+	return ""
+}
+
+type GetCustomerRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *GetCustomerRequest) Reset()                    { *m = GetCustomerRequest{} }
+func (m *GetCustomerRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetCustomerRequest) ProtoMessage()               {}
+func (*GetCustomerRequest) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{3} }
+
+func (m *GetCustomerRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type UpdateCustomerRequest struct {
+	Id             string            `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	AccountBalance int64             `protobuf:"varint,2,opt,name=account_balance,json=accountBalance" json:"account_balance,omitempty"`
+	Description    string            `protobuf:"bytes,3,opt,name=description" json:"description,omitempty"`
+	Email          string            `protobuf:"bytes,4,opt,name=email" json:"email,omitempty"`
+	Metadata       map[string]string `protobuf:"bytes,5,rep,name=metadata" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	BusinessVatId  string            `protobuf:"bytes,6,opt,name=business_vat_id,json=businessVatId" json:"business_vat_id,omitempty"`
+}
+
+func (m *UpdateCustomerRequest) Reset()                    { *m = UpdateCustomerRequest{} }
+func (m *UpdateCustomerRequest) String() string            { return proto.CompactTextString(m) }
+func (*UpdateCustomerRequest) ProtoMessage()               {}
+func (*UpdateCustomerRequest) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{4} }
+
+func (m *UpdateCustomerRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *UpdateCustomerRequest) GetAccountBalance() int64 {
+	if m != nil {
+		return m.AccountBalance
+	}
+	return 0
+}
+
+func (m *UpdateCustomerRequest) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *UpdateCustomerRequest) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+func (m *UpdateCustomerRequest) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *UpdateCustomerRequest) GetBusinessVatId() string {
+	if m != nil {
+		return m.BusinessVatId
+	}
+	return ""
+}
+
+type DeleteCustomerRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *DeleteCustomerRequest) Reset()                    { *m = DeleteCustomerRequest{} }
+func (m *DeleteCustomerRequest) String() string            { return proto.CompactTextString(m) }
+func (*DeleteCustomerRequest) ProtoMessage()               {}
+func (*DeleteCustomerRequest) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{5} }
+
+func (m *DeleteCustomerRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type DeleteCustomerSuccess struct {
+	Deleted bool   `protobuf:"varint,1,opt,name=deleted" json:"deleted,omitempty"`
+	Id      string `protobuf:"bytes,2,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *DeleteCustomerSuccess) Reset()                    { *m = DeleteCustomerSuccess{} }
+func (m *DeleteCustomerSuccess) String() string            { return proto.CompactTextString(m) }
+func (*DeleteCustomerSuccess) ProtoMessage()               {}
+func (*DeleteCustomerSuccess) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{6} }
+
+func (m *DeleteCustomerSuccess) GetDeleted() bool {
+	if m != nil {
+		return m.Deleted
+	}
+	return false
+}
+
+func (m *DeleteCustomerSuccess) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type DeleteCustomerResponse struct {
+	// Types that are valid to be assigned to Responses:
+	//	*DeleteCustomerResponse_Error
+	//	*DeleteCustomerResponse_Success
+	Responses isDeleteCustomerResponse_Responses `protobuf_oneof:"responses"`
+}
+
+func (m *DeleteCustomerResponse) Reset()                    { *m = DeleteCustomerResponse{} }
+func (m *DeleteCustomerResponse) String() string            { return proto.CompactTextString(m) }
+func (*DeleteCustomerResponse) ProtoMessage()               {}
+func (*DeleteCustomerResponse) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{7} }
+
+type isDeleteCustomerResponse_Responses interface {
+	isDeleteCustomerResponse_Responses()
+}
+
+type DeleteCustomerResponse_Error struct {
+	Error *Error `protobuf:"bytes,1,opt,name=error,oneof"`
+}
+type DeleteCustomerResponse_Success struct {
+	Success *DeleteCustomerSuccess `protobuf:"bytes,2,opt,name=success,oneof"`
+}
+
+func (*DeleteCustomerResponse_Error) isDeleteCustomerResponse_Responses()   {}
+func (*DeleteCustomerResponse_Success) isDeleteCustomerResponse_Responses() {}
+
+func (m *DeleteCustomerResponse) GetResponses() isDeleteCustomerResponse_Responses {
+	if m != nil {
+		return m.Responses
+	}
+	return nil
+}
+
+func (m *DeleteCustomerResponse) GetError() *Error {
+	if x, ok := m.GetResponses().(*DeleteCustomerResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+func (m *DeleteCustomerResponse) GetSuccess() *DeleteCustomerSuccess {
+	if x, ok := m.GetResponses().(*DeleteCustomerResponse_Success); ok {
+		return x.Success
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*DeleteCustomerResponse) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _DeleteCustomerResponse_OneofMarshaler, _DeleteCustomerResponse_OneofUnmarshaler, _DeleteCustomerResponse_OneofSizer, []interface{}{
+		(*DeleteCustomerResponse_Error)(nil),
+		(*DeleteCustomerResponse_Success)(nil),
+	}
+}
+
+func _DeleteCustomerResponse_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*DeleteCustomerResponse)
+	// responses
+	switch x := m.Responses.(type) {
+	case *DeleteCustomerResponse_Error:
+		b.EncodeVarint(1<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Error); err != nil {
+			return err
+		}
+	case *DeleteCustomerResponse_Success:
+		b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Success); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("DeleteCustomerResponse.Responses has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _DeleteCustomerResponse_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*DeleteCustomerResponse)
+	switch tag {
+	case 1: // responses.error
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Error)
+		err := b.DecodeMessage(msg)
+		m.Responses = &DeleteCustomerResponse_Error{msg}
+		return true, err
+	case 2: // responses.success
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(DeleteCustomerSuccess)
+		err := b.DecodeMessage(msg)
+		m.Responses = &DeleteCustomerResponse_Success{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _DeleteCustomerResponse_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*DeleteCustomerResponse)
+	// responses
+	switch x := m.Responses.(type) {
+	case *DeleteCustomerResponse_Error:
+		s := proto.Size(x.Error)
+		n += proto.SizeVarint(1<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *DeleteCustomerResponse_Success:
+		s := proto.Size(x.Success)
+		n += proto.SizeVarint(2<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+type ListCustomersRequest struct {
+	Created       *ListFilter `protobuf:"bytes,1,opt,name=created" json:"created,omitempty"`
+	EndingBefore  string      `protobuf:"bytes,2,opt,name=ending_before,json=endingBefore" json:"ending_before,omitempty"`
+	StartingAfter string      `protobuf:"bytes,3,opt,name=starting_after,json=startingAfter" json:"starting_after,omitempty"`
+	Limit         int32       `protobuf:"varint,4,opt,name=limit" json:"limit,omitempty"`
+}
+
+func (m *ListCustomersRequest) Reset()                    { *m = ListCustomersRequest{} }
+func (m *ListCustomersRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListCustomersRequest) ProtoMessage()               {}
+func (*ListCustomersRequest) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{8} }
+
+func (m *ListCustomersRequest) GetCreated() *ListFilter {
+	if m != nil {
+		return m.Created
+	}
+	return nil
+}
+
+func (m *ListCustomersRequest) GetEndingBefore() string {
+	if m != nil {
+		return m.EndingBefore
+	}
+	return ""
+}
+
+func (m *ListCustomersRequest) GetStartingAfter() string {
+	if m != nil {
+		return m.StartingAfter
+	}
+	return ""
+}
+
+func (m *ListCustomersRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*CustomerResponse)(nil), "CustomerResponse")
+	proto.RegisterType((*Customer)(nil), "Customer")
+	proto.RegisterType((*CreateCustomerRequest)(nil), "CreateCustomerRequest")
+	proto.RegisterType((*GetCustomerRequest)(nil), "GetCustomerRequest")
+	proto.RegisterType((*UpdateCustomerRequest)(nil), "UpdateCustomerRequest")
+	proto.RegisterType((*DeleteCustomerRequest)(nil), "DeleteCustomerRequest")
+	proto.RegisterType((*DeleteCustomerSuccess)(nil), "DeleteCustomerSuccess")
+	proto.RegisterType((*DeleteCustomerResponse)(nil), "DeleteCustomerResponse")
+	proto.RegisterType((*ListCustomersRequest)(nil), "ListCustomersRequest")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for Customers service
+
+type CustomersClient interface {
+	UpdateCustomer(ctx context.Context, in *UpdateCustomerRequest, opts ...grpc.CallOption) (*CustomerResponse, error)
+	CreateCustomer(ctx context.Context, in *CreateCustomerRequest, opts ...grpc.CallOption) (*CustomerResponse, error)
+	DeleteCustomer(ctx context.Context, in *DeleteCustomerRequest, opts ...grpc.CallOption) (*DeleteCustomerResponse, error)
+	GetCustomer(ctx context.Context, in *GetCustomerRequest, opts ...grpc.CallOption) (*CustomerResponse, error)
+	ListCustomers(ctx context.Context, in *ListCustomersRequest, opts ...grpc.CallOption) (Customers_ListCustomersClient, error)
+}
+
+type customersClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewCustomersClient(cc *grpc.ClientConn) CustomersClient {
+	return &customersClient{cc}
+}
+
+func (c *customersClient) UpdateCustomer(ctx context.Context, in *UpdateCustomerRequest, opts ...grpc.CallOption) (*CustomerResponse, error) {
+	out := new(CustomerResponse)
+	err := grpc.Invoke(ctx, "/Customers/UpdateCustomer", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customersClient) CreateCustomer(ctx context.Context, in *CreateCustomerRequest, opts ...grpc.CallOption) (*CustomerResponse, error) {
+	out := new(CustomerResponse)
+	err := grpc.Invoke(ctx, "/Customers/CreateCustomer", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customersClient) DeleteCustomer(ctx context.Context, in *DeleteCustomerRequest, opts ...grpc.CallOption) (*DeleteCustomerResponse, error) {
+	out := new(DeleteCustomerResponse)
+	err := grpc.Invoke(ctx, "/Customers/DeleteCustomer", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customersClient) GetCustomer(ctx context.Context, in *GetCustomerRequest, opts ...grpc.CallOption) (*CustomerResponse, error) {
+	out := new(CustomerResponse)
+	err := grpc.Invoke(ctx, "/Customers/GetCustomer", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customersClient) ListCustomers(ctx context.Context, in *ListCustomersRequest, opts ...grpc.CallOption) (Customers_ListCustomersClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Customers_serviceDesc.Streams[0], c.cc, "/Customers/ListCustomers", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &customersListCustomersClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Customers_ListCustomersClient interface {
+	Recv() (*CustomerResponse, error)
+	grpc.ClientStream
+}
+
+type customersListCustomersClient struct {
+	grpc.ClientStream
+}
+
+func (x *customersListCustomersClient) Recv() (*CustomerResponse, error) {
+	m := new(CustomerResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Server API for Customers service
+
+type CustomersServer interface {
+	UpdateCustomer(context.Context, *UpdateCustomerRequest) (*CustomerResponse, error)
+	CreateCustomer(context.Context, *CreateCustomerRequest) (*CustomerResponse, error)
+	DeleteCustomer(context.Context, *DeleteCustomerRequest) (*DeleteCustomerResponse, error)
+	GetCustomer(context.Context, *GetCustomerRequest) (*CustomerResponse, error)
+	ListCustomers(*ListCustomersRequest, Customers_ListCustomersServer) error
+}
+
+func RegisterCustomersServer(s *grpc.Server, srv CustomersServer) {
+	s.RegisterService(&_Customers_serviceDesc, srv)
+}
+
+func _Customers_UpdateCustomer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCustomerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomersServer).UpdateCustomer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Customers/UpdateCustomer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomersServer).UpdateCustomer(ctx, req.(*UpdateCustomerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Customers_CreateCustomer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCustomerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomersServer).CreateCustomer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Customers/CreateCustomer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomersServer).CreateCustomer(ctx, req.(*CreateCustomerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Customers_DeleteCustomer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCustomerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomersServer).DeleteCustomer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Customers/DeleteCustomer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomersServer).DeleteCustomer(ctx, req.(*DeleteCustomerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Customers_GetCustomer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCustomerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomersServer).GetCustomer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Customers/GetCustomer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomersServer).GetCustomer(ctx, req.(*GetCustomerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Customers_ListCustomers_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListCustomersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CustomersServer).ListCustomers(m, &customersListCustomersServer{stream})
+}
+
+type Customers_ListCustomersServer interface {
+	Send(*CustomerResponse) error
+	grpc.ServerStream
+}
+
+type customersListCustomersServer struct {
+	grpc.ServerStream
+}
+
+func (x *customersListCustomersServer) Send(m *CustomerResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _Customers_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "Customers",
+	HandlerType: (*CustomersServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateCustomer",
+			Handler:    _Customers_UpdateCustomer_Handler,
+		},
+		{
+			MethodName: "CreateCustomer",
+			Handler:    _Customers_CreateCustomer_Handler,
+		},
+		{
+			MethodName: "DeleteCustomer",
+			Handler:    _Customers_DeleteCustomer_Handler,
+		},
+		{
+			MethodName: "GetCustomer",
+			Handler:    _Customers_GetCustomer_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ListCustomers",
+			Handler:       _Customers_ListCustomers_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "customer.proto",
+}
+
+func init() { proto.RegisterFile("customer.proto", fileDescriptor2) }
+
+var fileDescriptor2 = []byte{
+	// 705 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xc7, 0x63, 0xa7, 0x49, 0x93, 0x71, 0x93, 0x96, 0xa5, 0x49, 0xad, 0x1c, 0xaa, 0x28, 0x34,
+	0x34, 0x27, 0x0b, 0xa5, 0x07, 0x10, 0x08, 0x89, 0x7e, 0x41, 0x91, 0xe0, 0xe2, 0x0a, 0xae, 0xd1,
+	0xc6, 0x9e, 0xa0, 0x15, 0x8e, 0x9d, 0xee, 0xae, 0x2b, 0xe5, 0x69, 0xfa, 0x08, 0xbc, 0x05, 0xcf,
+	0xc1, 0xa3, 0x20, 0xaf, 0xd7, 0x6e, 0x62, 0xdc, 0x42, 0x81, 0x5b, 0xe6, 0xbf, 0xb3, 0xe3, 0xd9,
+	0xdf, 0x7c, 0x04, 0xda, 0x5e, 0x2c, 0x64, 0x34, 0x47, 0xee, 0x2c, 0x78, 0x24, 0xa3, 0xde, 0x96,
+	0x17, 0xc5, 0x8b, 0x28, 0xd4, 0xd6, 0x8e, 0x17, 0x73, 0x8e, 0xa1, 0xc7, 0x50, 0x68, 0xc5, 0x42,
+	0xce, 0xa3, 0xcc, 0x19, 0x16, 0x01, 0xd5, 0xae, 0x83, 0x19, 0xec, 0x9c, 0xea, 0x50, 0x2e, 0x8a,
+	0x45, 0x14, 0x0a, 0x24, 0xfb, 0x50, 0x53, 0xee, 0xb6, 0xd1, 0x37, 0x46, 0xd6, 0xb8, 0xee, 0x9c,
+	0x27, 0xd6, 0x45, 0xc5, 0x4d, 0x65, 0x32, 0x84, 0x4d, 0x11, 0x7b, 0x1e, 0x0a, 0x61, 0x9b, 0xca,
+	0xa3, 0xe9, 0x64, 0x31, 0x2e, 0x2a, 0x6e, 0x76, 0x76, 0x62, 0x41, 0x93, 0xeb, 0x90, 0x62, 0xf0,
+	0xa3, 0x0a, 0x8d, 0xcc, 0x89, 0xb4, 0xc1, 0x64, 0xbe, 0x8a, 0xde, 0x74, 0x4d, 0xe6, 0x93, 0x43,
+	0xd8, 0xa6, 0x9e, 0x17, 0xc5, 0xa1, 0x9c, 0x4c, 0x69, 0x40, 0x43, 0x0f, 0x55, 0xe0, 0xaa, 0xdb,
+	0xd6, 0xf2, 0x49, 0xaa, 0x12, 0x1b, 0x36, 0x3d, 0x8e, 0x54, 0xa2, 0x6f, 0x57, 0x95, 0x43, 0x66,
+	0x92, 0x21, 0x34, 0xf4, 0xa3, 0x97, 0xf6, 0x46, 0xdf, 0x18, 0xb5, 0x55, 0x52, 0xa9, 0xe0, 0xe6,
+	0x47, 0x64, 0x08, 0x6d, 0x1f, 0x67, 0x34, 0x0e, 0xe4, 0x44, 0x44, 0x31, 0xf7, 0xd0, 0xae, 0xa9,
+	0x2c, 0x5a, 0x5a, 0xbd, 0x54, 0x22, 0xd9, 0x07, 0xf0, 0x31, 0x60, 0xe1, 0x55, 0x8c, 0xa1, 0xb4,
+	0xeb, 0x7d, 0x63, 0xd4, 0x70, 0x57, 0x14, 0xd2, 0x07, 0xcb, 0x47, 0xe1, 0x71, 0xb6, 0x90, 0x2c,
+	0x0a, 0xed, 0x4d, 0x15, 0x63, 0x55, 0x22, 0xbb, 0x50, 0xc3, 0x39, 0x65, 0x81, 0xdd, 0x50, 0x67,
+	0xa9, 0x41, 0x7a, 0xd0, 0x08, 0xd8, 0x35, 0xce, 0x23, 0x1f, 0xed, 0xa6, 0x8a, 0x9a, 0xdb, 0xe4,
+	0x08, 0x1a, 0x73, 0x94, 0xd4, 0xa7, 0x92, 0xda, 0xd0, 0xaf, 0x8e, 0xac, 0xf1, 0x5e, 0x8e, 0xd5,
+	0xf9, 0xa8, 0x4f, 0xce, 0x43, 0xc9, 0x97, 0x6e, 0xee, 0x48, 0x9e, 0xc2, 0xf6, 0x34, 0x16, 0x2c,
+	0x44, 0x21, 0x26, 0xd7, 0x54, 0x4e, 0x98, 0x6f, 0x5b, 0xe9, 0x83, 0x32, 0xf9, 0x33, 0x95, 0xef,
+	0x15, 0x1e, 0x9f, 0x09, 0xc5, 0xd2, 0xde, 0xd2, 0x35, 0x3b, 0xd3, 0x82, 0x9b, 0x1f, 0xf5, 0x5e,
+	0x41, 0x6b, 0xed, 0x4b, 0x64, 0x07, 0xaa, 0x5f, 0x71, 0xa9, 0x4b, 0x95, 0xfc, 0x4c, 0x1e, 0x76,
+	0x4d, 0x83, 0x38, 0xad, 0x50, 0xd3, 0x4d, 0x8d, 0x97, 0xe6, 0x0b, 0x63, 0x70, 0x63, 0x42, 0xe7,
+	0x54, 0x95, 0xe3, 0xb6, 0xa3, 0xae, 0x62, 0x14, 0xb2, 0xac, 0xbe, 0x46, 0x69, 0x7d, 0x0b, 0x5c,
+	0xcd, 0x7b, 0xb8, 0x56, 0x57, 0xb9, 0xbe, 0x59, 0x61, 0xb7, 0xa1, 0xd8, 0x1d, 0x38, 0xa5, 0xa9,
+	0x3c, 0x04, 0x64, 0xad, 0x04, 0xe4, 0xbf, 0x11, 0x3a, 0x00, 0xf2, 0x0e, 0x65, 0x91, 0x4e, 0x61,
+	0x1a, 0x06, 0xdf, 0x4c, 0xe8, 0x7c, 0x5a, 0xf8, 0x25, 0x1c, 0xff, 0x7a, 0x6e, 0x0a, 0x5c, 0xab,
+	0xf7, 0x70, 0xdd, 0xb8, 0x8b, 0x6b, 0x4d, 0x73, 0x2d, 0x4d, 0xed, 0x21, 0x5c, 0xeb, 0xff, 0x9d,
+	0xeb, 0x21, 0x74, 0xce, 0x30, 0xc0, 0xdf, 0x02, 0x1b, 0x1c, 0x17, 0x1d, 0x2f, 0xd3, 0x5d, 0x95,
+	0x2c, 0x16, 0x5f, 0x1d, 0xa4, 0xde, 0x0d, 0x37, 0x33, 0x75, 0x08, 0x33, 0x0f, 0xb1, 0x84, 0x6e,
+	0xf1, 0x5b, 0x7f, 0xb8, 0x36, 0xc7, 0xc5, 0xb5, 0xd9, 0x75, 0x4a, 0x93, 0xb9, 0x73, 0x87, 0xde,
+	0x18, 0xb0, 0xfb, 0x81, 0x89, 0xbc, 0x81, 0x44, 0xf6, 0xcc, 0xe1, 0xed, 0x5a, 0x4c, 0xbf, 0x6d,
+	0x39, 0x89, 0xdf, 0x5b, 0x16, 0x48, 0xe4, 0xb7, 0x3b, 0xf2, 0x09, 0xb4, 0x30, 0xf4, 0x59, 0xf8,
+	0x65, 0x32, 0xc5, 0x59, 0xc4, 0x33, 0x90, 0x5b, 0xa9, 0x78, 0xa2, 0xb4, 0x64, 0x43, 0x0a, 0x49,
+	0xb9, 0x4c, 0xdc, 0xe8, 0x4c, 0x22, 0xd7, 0xdd, 0xd2, 0xca, 0xd4, 0xe3, 0x44, 0x4c, 0x8a, 0x11,
+	0xb0, 0x39, 0x93, 0xaa, 0x5f, 0x6a, 0x6e, 0x6a, 0x8c, 0xbf, 0x9b, 0xd0, 0xcc, 0xb3, 0x23, 0xaf,
+	0xa1, 0xbd, 0xde, 0x2c, 0xa4, 0x5b, 0xde, 0x3d, 0xbd, 0x47, 0x4e, 0x91, 0xe6, 0xa0, 0x92, 0x5c,
+	0x5f, 0x9f, 0x61, 0xd2, 0x2d, 0x1f, 0xea, 0xf2, 0xeb, 0xa7, 0xd0, 0x5e, 0xc7, 0x4b, 0x8a, 0xbc,
+	0xb3, 0xeb, 0x7b, 0x4e, 0x79, 0x45, 0x07, 0x15, 0xf2, 0x1c, 0xac, 0x95, 0x89, 0x25, 0x8f, 0x9d,
+	0x5f, 0xe7, 0xf7, 0xae, 0xe4, 0x5b, 0x6b, 0xa5, 0x22, 0x1d, 0xa7, 0xac, 0x74, 0xa5, 0x97, 0x9f,
+	0x19, 0xd3, 0xba, 0xfa, 0x77, 0x3e, 0xfa, 0x39, 0x00, 0xf4, 0xb9, 0xd9, 0x05, 0xe8, 0x07, 0x00,
+	0x00,
+}
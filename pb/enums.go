@@ -0,0 +1,70 @@
+package pb
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ParseCurrency returns the currency for an ISO 4217 code such as "usd".  Codes are case insensitive.
+func ParseCurrency(code string) (Currency, error) {
+	c, ok := Currency_value[strings.ToUpper(strings.TrimSpace(code))]
+	if !ok || c == int32(Currency_UNK) {
+		return Currency_UNK, fmt.Errorf("unknown currency %q", code)
+	}
+	return Currency(c), nil
+}
+
+// ParseInterval returns the billing interval for a name such as "month".  Names are case
+// insensitive.
+func ParseInterval(name string) (Interval, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	for v, n := range Interval_name {
+		if v != int32(Interval_NotSet) && strings.ToLower(n) == name {
+			return Interval(v), nil
+		}
+	}
+	return Interval_NotSet, fmt.Errorf("unknown interval %q, must be one of day, week, month or year", name)
+}
+
+// currencies without a minor unit, as listed by Stripe
+var zeroDecimal = map[Currency]bool{
+	Currency_BIF: true, Currency_CLP: true, Currency_DJF: true, Currency_GNF: true,
+	Currency_JPY: true, Currency_KMF: true, Currency_KRW: true, Currency_MGA: true,
+	Currency_PYG: true, Currency_RWF: true, Currency_UGX: true, Currency_VND: true,
+	Currency_VUV: true, Currency_XAF: true, Currency_XOF: true, Currency_XPF: true,
+}
+
+// currencies with a minor unit of a thousandth
+var threeDecimal = map[Currency]bool{
+	Currency_BHD: true, Currency_JOD: true, Currency_KWD: true, Currency_OMR: true, Currency_TND: true,
+}
+
+// Exponent returns the number of decimal places of the currency's minor unit, e.g. 2 for USD
+// (cents), 0 for JPY and 3 for KWD.  Amounts are always given in the minor unit.
+func (c Currency) Exponent() int {
+	switch {
+	case zeroDecimal[c]:
+		return 0
+	case threeDecimal[c]:
+		return 3
+	default:
+		return 2
+	}
+}
+
+// Stripe's minimum charge amounts in the minor unit of each currency
+var minimumCharge = map[Currency]uint64{
+	Currency_USD: 50, Currency_AED: 200, Currency_AUD: 50, Currency_BGN: 100,
+	Currency_BRL: 50, Currency_CAD: 50, Currency_CHF: 50, Currency_CZK: 1500,
+	Currency_DKK: 250, Currency_EUR: 50, Currency_GBP: 30, Currency_HKD: 400,
+	Currency_HUF: 17500, Currency_INR: 50, Currency_JPY: 50, Currency_MXN: 1000,
+	Currency_MYR: 200, Currency_NOK: 300, Currency_NZD: 50, Currency_PLN: 200,
+	Currency_RON: 200, Currency_SEK: 300, Currency_SGD: 50, Currency_THB: 1000,
+}
+
+// MinimumCharge returns the smallest amount Stripe will charge in the currency, in its minor unit,
+// or zero if Stripe does not publish a minimum for the currency.  Charges in other currencies are
+// converted and must be at least the equivalent of the USD minimum.
+func (c Currency) MinimumCharge() uint64 {
+	return minimumCharge[c]
+}
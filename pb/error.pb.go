@@ -49,7 +49,7 @@ var ErrorType_value = map[string]int32{
 func (x ErrorType) String() string {
 	return proto.EnumName(ErrorType_name, int32(x))
 }
-func (ErrorType) EnumDescriptor() ([]byte, []int) { return fileDescriptor1, []int{0} }
+func (ErrorType) EnumDescriptor() ([]byte, []int) { return fileDescriptor3, []int{0} }
 
 type CardErrors int32
 
@@ -103,7 +103,7 @@ var CardErrors_value = map[string]int32{
 func (x CardErrors) String() string {
 	return proto.EnumName(CardErrors_name, int32(x))
 }
-func (CardErrors) EnumDescriptor() ([]byte, []int) { return fileDescriptor1, []int{1} }
+func (CardErrors) EnumDescriptor() ([]byte, []int) { return fileDescriptor3, []int{1} }
 
 type Error struct {
 	Type           ErrorType  `protobuf:"varint,1,opt,name=type,enum=ErrorType" json:"type,omitempty"`
@@ -113,12 +113,13 @@ type Error struct {
 	Code           CardErrors `protobuf:"varint,5,opt,name=code,enum=CardErrors" json:"code,omitempty"`
 	Param          string     `protobuf:"bytes,6,opt,name=param" json:"param,omitempty"`
 	RequestId      string     `protobuf:"bytes,7,opt,name=request_id,json=requestId" json:"request_id,omitempty"`
+	IdempotencyKey string     `protobuf:"bytes,8,opt,name=idempotency_key,json=idempotencyKey" json:"idempotency_key,omitempty"`
 }
 
 func (m *Error) Reset()                    { *m = Error{} }
 func (m *Error) String() string            { return proto.CompactTextString(m) }
 func (*Error) ProtoMessage()               {}
-func (*Error) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{0} }
+func (*Error) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{0} }
 
 func (m *Error) GetType() ErrorType {
 	if m != nil {
@@ -169,41 +170,49 @@ func (m *Error) GetRequestId() string {
 	return ""
 }
 
+func (m *Error) GetIdempotencyKey() string {
+	if m != nil {
+		return m.IdempotencyKey
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Error)(nil), "Error")
 	proto.RegisterEnum("ErrorType", ErrorType_name, ErrorType_value)
 	proto.RegisterEnum("CardErrors", CardErrors_name, CardErrors_value)
 }
 
-func init() { proto.RegisterFile("error.proto", fileDescriptor1) }
+func init() { proto.RegisterFile("error.proto", fileDescriptor3) }
 
-var fileDescriptor1 = []byte{
-	// 428 bytes of a gzipped FileDescriptorProto
+var fileDescriptor3 = []byte{
+	// 446 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x92, 0xcf, 0x8e, 0xd3, 0x30,
-	0x10, 0xc6, 0x37, 0x6d, 0xd2, 0x34, 0x93, 0x6e, 0xd7, 0x0c, 0x7f, 0x14, 0x40, 0x40, 0xc5, 0xa9,
-	0xda, 0x43, 0x0f, 0xf0, 0x04, 0x55, 0xd9, 0x43, 0x24, 0x76, 0x55, 0x05, 0x38, 0xc0, 0xa5, 0xf2,
-	0xda, 0xa3, 0xd6, 0x62, 0x63, 0x07, 0xdb, 0x5d, 0xd8, 0x07, 0xe0, 0x35, 0x79, 0x0d, 0xae, 0xc8,
-	0x6e, 0x28, 0x42, 0x7b, 0x9c, 0xdf, 0x37, 0xca, 0xf7, 0x9b, 0xc8, 0x50, 0x92, 0xb5, 0xc6, 0x2e,
-	0x3a, 0x6b, 0xbc, 0x79, 0xfd, 0x2b, 0x81, 0xec, 0x22, 0xcc, 0xf8, 0x12, 0x52, 0x7f, 0xd7, 0x51,
-	0x95, 0xcc, 0x92, 0xf9, 0xf4, 0x0d, 0x2c, 0x22, 0xfd, 0x78, 0xd7, 0x51, 0x13, 0x39, 0x3e, 0x87,
-	0x42, 0xec, 0xb8, 0xdd, 0xd2, 0x46, 0xc9, 0x6a, 0x30, 0x4b, 0xe6, 0x45, 0x33, 0x3e, 0x80, 0x5a,
-	0x62, 0x05, 0x79, 0x4b, 0xce, 0xf1, 0x2d, 0x55, 0xc3, 0x18, 0xfd, 0x1d, 0x71, 0x0e, 0x6c, 0xe7,
-	0x7d, 0xb7, 0x71, 0x9e, 0xfb, 0xbd, 0xdb, 0x08, 0x23, 0xa9, 0x4a, 0x67, 0xc9, 0x3c, 0x6b, 0xa6,
-	0x81, 0x7f, 0x88, 0x78, 0x65, 0x24, 0xe1, 0x2b, 0x48, 0x63, 0x9a, 0x45, 0x81, 0x72, 0xb1, 0xe2,
-	0x56, 0x46, 0x09, 0xd7, 0xc4, 0x00, 0x1f, 0x41, 0xd6, 0x71, 0xcb, 0xdb, 0x6a, 0x14, 0x2b, 0x0e,
-	0x03, 0xbe, 0x00, 0xb0, 0xf4, 0x6d, 0x4f, 0xce, 0x07, 0xb1, 0x3c, 0x46, 0x45, 0x4f, 0x6a, 0x79,
-	0xfe, 0x33, 0x81, 0xe2, 0x78, 0x0a, 0x96, 0x90, 0x7f, 0xd2, 0x5f, 0xb5, 0xf9, 0xae, 0xd9, 0x09,
-	0xe6, 0x30, 0x5c, 0xae, 0x6b, 0x96, 0xe0, 0x03, 0x38, 0x5d, 0xae, 0xeb, 0x95, 0xd1, 0x9a, 0x84,
-	0x57, 0x46, 0xb3, 0x01, 0x22, 0x4c, 0x97, 0x7b, 0xbf, 0x23, 0xed, 0x95, 0xe0, 0x91, 0x0d, 0x71,
-	0x0c, 0x69, 0x70, 0x62, 0x69, 0x48, 0x6b, 0x7d, 0xcb, 0x6f, 0x94, 0x6c, 0x0e, 0x45, 0x2c, 0xc3,
-	0x29, 0xc0, 0x9a, 0x6c, 0xab, 0x9c, 0x0b, 0xdb, 0x23, 0x3c, 0x85, 0xa2, 0xe1, 0x9e, 0xde, 0xab,
-	0x56, 0x79, 0x96, 0x9f, 0xff, 0x4e, 0x00, 0xfe, 0x5d, 0x14, 0xbe, 0x75, 0x65, 0x34, 0xb1, 0x13,
-	0x7c, 0x08, 0x67, 0xb5, 0x16, 0xc6, 0x5a, 0x12, 0xfe, 0x6a, 0xdf, 0x5e, 0x93, 0x3d, 0x18, 0xf5,
-	0x05, 0x3d, 0x1a, 0xe0, 0x33, 0x78, 0xd2, 0xa3, 0x8b, 0x1f, 0x9d, 0xb2, 0x51, 0xea, 0xd2, 0x68,
-	0xbf, 0x63, 0x43, 0x7c, 0x0a, 0x8f, 0xef, 0x65, 0x9f, 0x89, 0x5b, 0x96, 0x06, 0xad, 0x3e, 0x5a,
-	0xdd, 0x0a, 0x96, 0x85, 0x3f, 0x10, 0x77, 0x48, 0xb2, 0x11, 0x32, 0x98, 0x1c, 0xbb, 0x43, 0x9c,
-	0xff, 0x47, 0xbe, 0xa8, 0x8e, 0x8d, 0x71, 0x02, 0xe3, 0x77, 0x24, 0x6e, 0x94, 0x26, 0xc9, 0x8a,
-	0x60, 0xbb, 0xb6, 0x46, 0x90, 0x73, 0x4a, 0x6f, 0xe3, 0x2d, 0x0c, 0xf0, 0x0c, 0xca, 0xe3, 0xa9,
-	0x24, 0x59, 0x19, 0x4a, 0x2e, 0x55, 0x5c, 0x61, 0x93, 0xeb, 0x51, 0x7c, 0x69, 0x6f, 0xff, 0x04,
-	0x00, 0x00, 0xff, 0xff, 0xae, 0x7f, 0x48, 0xa4, 0x78, 0x02, 0x00, 0x00,
+	0x10, 0xc6, 0x37, 0x6d, 0xd2, 0x34, 0xd3, 0x6e, 0x6b, 0x86, 0x3f, 0x0a, 0x20, 0xa0, 0xe2, 0x42,
+	0xb5, 0x87, 0x1e, 0xe0, 0x09, 0xaa, 0xb2, 0x87, 0x08, 0x76, 0x55, 0x05, 0x38, 0xc0, 0xa5, 0xf2,
+	0xda, 0xa3, 0xd6, 0xda, 0x8d, 0x1d, 0x6c, 0x77, 0xa1, 0x0f, 0x80, 0x78, 0x64, 0xae, 0xc8, 0x6e,
+	0x29, 0xa0, 0x3d, 0xce, 0xef, 0x1b, 0x65, 0x7e, 0x5f, 0x64, 0x18, 0x90, 0xb5, 0xc6, 0xce, 0x5a,
+	0x6b, 0xbc, 0x79, 0xf9, 0xb3, 0x03, 0xd9, 0x79, 0x98, 0xf1, 0x39, 0xa4, 0x7e, 0xd7, 0x52, 0x99,
+	0x4c, 0x92, 0xe9, 0xe8, 0x35, 0xcc, 0x22, 0xfd, 0xb8, 0x6b, 0xa9, 0x8e, 0x1c, 0x9f, 0x42, 0x21,
+	0x36, 0xdc, 0xae, 0x69, 0xa5, 0x64, 0xd9, 0x99, 0x24, 0xd3, 0xa2, 0xee, 0xef, 0x41, 0x25, 0xb1,
+	0x84, 0xbc, 0x21, 0xe7, 0xf8, 0x9a, 0xca, 0x6e, 0x8c, 0xfe, 0x8c, 0x38, 0x05, 0xb6, 0xf1, 0xbe,
+	0x5d, 0x39, 0xcf, 0xfd, 0xd6, 0xad, 0x84, 0x91, 0x54, 0xa6, 0x93, 0x64, 0x9a, 0xd5, 0xa3, 0xc0,
+	0x3f, 0x44, 0xbc, 0x30, 0x92, 0xf0, 0x05, 0xa4, 0x31, 0xcd, 0xa2, 0xc0, 0x60, 0xb6, 0xe0, 0x56,
+	0x46, 0x09, 0x57, 0xc7, 0x00, 0x1f, 0x40, 0xd6, 0x72, 0xcb, 0x9b, 0xb2, 0x17, 0x4f, 0xec, 0x07,
+	0x7c, 0x06, 0x60, 0xe9, 0xeb, 0x96, 0x9c, 0x0f, 0x62, 0x79, 0x8c, 0x8a, 0x03, 0xa9, 0x24, 0xbe,
+	0x82, 0xb1, 0x92, 0xd4, 0xb4, 0xc6, 0x93, 0x16, 0xbb, 0xd5, 0x35, 0xed, 0xca, 0x7e, 0xdc, 0x19,
+	0xfd, 0x83, 0xdf, 0xd1, 0xee, 0xec, 0x47, 0x02, 0xc5, 0xb1, 0x33, 0x0e, 0x20, 0xff, 0xa4, 0xaf,
+	0xb5, 0xf9, 0xa6, 0xd9, 0x09, 0xe6, 0xd0, 0x9d, 0x2f, 0x2b, 0x96, 0xe0, 0x3d, 0x38, 0x9d, 0x2f,
+	0xab, 0x85, 0xd1, 0x9a, 0x84, 0x57, 0x46, 0xb3, 0x0e, 0x22, 0x8c, 0xe6, 0x5b, 0xbf, 0x21, 0xed,
+	0x95, 0xe0, 0x91, 0x75, 0xb1, 0x0f, 0x69, 0x90, 0x67, 0x69, 0x48, 0x2b, 0x7d, 0xcb, 0x6f, 0x94,
+	0xac, 0xf7, 0x46, 0x2c, 0xc3, 0x11, 0xc0, 0x92, 0x6c, 0xa3, 0x9c, 0x0b, 0xdb, 0x3d, 0x3c, 0x85,
+	0xa2, 0xe6, 0x9e, 0xde, 0xab, 0x46, 0x79, 0x96, 0x9f, 0xfd, 0x4a, 0x00, 0xfe, 0x56, 0x0f, 0xdf,
+	0xba, 0x34, 0x9a, 0xd8, 0x09, 0xde, 0x87, 0x71, 0xa5, 0x85, 0xb1, 0x96, 0x84, 0xbf, 0xdc, 0x36,
+	0x57, 0x64, 0xf7, 0x46, 0x87, 0x03, 0x07, 0xd4, 0xc1, 0x27, 0xf0, 0xe8, 0x80, 0xce, 0xbf, 0xb7,
+	0xca, 0x46, 0xa9, 0x0b, 0xa3, 0xfd, 0x86, 0x75, 0xf1, 0x31, 0x3c, 0xbc, 0x93, 0x7d, 0x26, 0x6e,
+	0x59, 0x1a, 0xb4, 0x0e, 0xd1, 0xe2, 0x56, 0xb0, 0x2c, 0xfc, 0x81, 0xb8, 0x43, 0x92, 0xf5, 0x90,
+	0xc1, 0xf0, 0x78, 0x3b, 0xc4, 0xf9, 0x7f, 0xe4, 0x8b, 0x6a, 0x59, 0x1f, 0x87, 0xd0, 0x7f, 0x4b,
+	0xe2, 0x46, 0x69, 0x92, 0xac, 0x08, 0xb6, 0x4b, 0x6b, 0x04, 0x39, 0xa7, 0xf4, 0x3a, 0x76, 0x61,
+	0x80, 0x63, 0x18, 0x1c, 0xab, 0x92, 0x64, 0x83, 0x70, 0xe4, 0x42, 0xc5, 0x15, 0x36, 0xbc, 0xea,
+	0xc5, 0x27, 0xf9, 0xe6, 0xf7, 0x00, 0xb3, 0x89, 0x3a, 0xce, 0xa1, 0x02, 0x00, 0x00,
 }
@@ -0,0 +1,149 @@
+package pb
+
+import (
+	"fmt"
+
+	"github.com/golang/protobuf/ptypes"
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Error implements the error interface so that an error response from the backend can be
+// returned and checked like any other Go error
+func (e *Error) Error() string {
+	switch {
+	case len(e.GetRequestId()) > 0:
+		return fmt.Sprintf("%s error: %s (request %s)", e.GetType(), e.GetMessage(), e.GetRequestId())
+	default:
+		return fmt.Sprintf("%s error: %s", e.GetType(), e.GetMessage())
+	}
+}
+
+// GRPCCode returns the GRPC status code that corresponds to the error
+func (e *Error) GRPCCode() codes.Code {
+	switch e.GetType() {
+	case ErrorType_InvalidRequest:
+		if e.GetHttpStatusCode() == 404 {
+			return codes.NotFound
+		}
+		return codes.InvalidArgument
+	case ErrorType_Card:
+		return codes.FailedPrecondition
+	case ErrorType_Authentication:
+		return codes.Unauthenticated
+	case ErrorType_Permission:
+		return codes.PermissionDenied
+	case ErrorType_RateLimit:
+		return codes.ResourceExhausted
+	case ErrorType_APIConnection:
+		return codes.Unavailable
+	case ErrorType_API:
+		return codes.Internal
+	default:
+		return codes.Unknown
+	}
+}
+
+// Status returns a GRPC status error with the error attached as a detail, so that GRPC clients
+// can recover it with AsError
+func (e *Error) Status() error {
+	st := &spb.Status{
+		Code:    int32(e.GRPCCode()),
+		Message: e.GetMessage(),
+	}
+	if detail, err := ptypes.MarshalAny(e); err == nil {
+		st.Details = append(st.Details, detail)
+	}
+	return status.ErrorProto(st)
+}
+
+// AsError returns the backend error from err.  The error may be an *Error returned by the
+// library or a GRPC status error returned by a recur service.
+func AsError(err error) (*Error, bool) {
+	switch e := err.(type) {
+	case nil:
+		return nil, false
+	case *Error:
+		return e, e != nil
+	}
+	st, ok := status.FromError(err)
+	if !ok {
+		return nil, false
+	}
+	for _, detail := range st.Proto().GetDetails() {
+		e := new(Error)
+		if ptypes.Is(detail, e) && ptypes.UnmarshalAny(detail, e) == nil {
+			return e, true
+		}
+	}
+	return nil, false
+}
+
+// IsCardError returns true when the card was declined or could not be charged
+func IsCardError(err error) bool {
+	e, ok := AsError(err)
+	return ok && e.GetType() == ErrorType_Card
+}
+
+// IsRateLimited returns true when the backend rejected the request because too many requests
+// were made
+func IsRateLimited(err error) bool {
+	if e, ok := AsError(err); ok {
+		return e.GetType() == ErrorType_RateLimit || e.GetHttpStatusCode() == 429
+	}
+	return grpcCode(err) == codes.ResourceExhausted
+}
+
+// IsNotFound returns true when the requested resource does not exist
+func IsNotFound(err error) bool {
+	if e, ok := AsError(err); ok {
+		return e.GetHttpStatusCode() == 404
+	}
+	return grpcCode(err) == codes.NotFound
+}
+
+// IsRetryable returns true when the request may succeed if it is sent again
+func IsRetryable(err error) bool {
+	if e, ok := AsError(err); ok {
+		switch e.GetType() {
+		case ErrorType_RateLimit, ErrorType_APIConnection:
+			return true
+		case ErrorType_API:
+			return e.GetHttpStatusCode() == 0 || e.GetHttpStatusCode() >= 500
+		default:
+			return e.GetHttpStatusCode() == 429
+		}
+	}
+	switch grpcCode(err) {
+	case codes.ResourceExhausted, codes.Unavailable:
+		return true
+	default:
+		return false
+	}
+}
+
+// grpcCode returns the status code of a GRPC error, or codes.Unknown for other errors
+func grpcCode(err error) codes.Code {
+	if err == nil {
+		return codes.OK
+	}
+	st, ok := status.FromError(err)
+	if !ok {
+		return codes.Unknown
+	}
+	return st.Code()
+}
+
+// ResponseError returns err, or the error response from the backend when err is nil, so that
+// a failed request can be checked with a single error
+func ResponseError(e *Error, err error) error {
+	switch {
+	case err != nil:
+		return err
+	case e != nil:
+		return e
+	default:
+		return nil
+	}
+}
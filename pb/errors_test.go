@@ -0,0 +1,60 @@
+package pb
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestErrorPredicates(t *testing.T) {
+	card := &Error{Type: ErrorType_Card, Code: CardErrors_Declined, Message: "declined", HttpStatusCode: 402}
+	rate := &Error{Type: ErrorType_RateLimit, Message: "slow down", HttpStatusCode: 429}
+	notFound := &Error{Type: ErrorType_InvalidRequest, Message: "No such plan", HttpStatusCode: 404}
+	api := &Error{Type: ErrorType_API, Message: "server error", HttpStatusCode: 500}
+
+	tt := []struct {
+		Name      string
+		Err       error
+		Card      bool
+		Rate      bool
+		NotFound  bool
+		Retryable bool
+	}{
+		{Name: "nil", Err: nil},
+		{Name: "other error", Err: fmt.Errorf("test")},
+		{Name: "card", Err: card, Card: true},
+		{Name: "rate limit", Err: rate, Rate: true, Retryable: true},
+		{Name: "not found", Err: notFound, NotFound: true},
+		{Name: "api", Err: api, Retryable: true},
+		{Name: "card over grpc", Err: card.Status(), Card: true},
+		{Name: "not found over grpc", Err: notFound.Status(), NotFound: true},
+		{Name: "grpc unavailable", Err: status.Error(codes.Unavailable, "down"), Retryable: true},
+		{Name: "nil response error", Err: ResponseError(nil, nil)},
+	}
+	for _, tc := range tt {
+		t.Run(tc.Name, func(t *testing.T) {
+			assert.Equal(t, tc.Card, IsCardError(tc.Err), "card")
+			assert.Equal(t, tc.Rate, IsRateLimited(tc.Err), "rate limited")
+			assert.Equal(t, tc.NotFound, IsNotFound(tc.Err), "not found")
+			assert.Equal(t, tc.Retryable, IsRetryable(tc.Err), "retryable")
+		})
+	}
+}
+
+func TestErrorStatus(t *testing.T) {
+	e := &Error{Type: ErrorType_Card, Code: CardErrors_Declined, Message: "declined", RequestId: "req_1"}
+	st, _ := status.FromError(e.Status())
+	assert.Equal(t, codes.FailedPrecondition, st.Code())
+	assert.Equal(t, "declined", st.Message())
+
+	got, ok := AsError(e.Status())
+	if assert.True(t, ok) {
+		assert.Equal(t, e.Code, got.Code)
+		assert.Equal(t, "req_1", got.RequestId)
+	}
+	assert.Nil(t, ResponseError(nil, nil))
+	assert.Equal(t, e, ResponseError(e, nil))
+}
@@ -0,0 +1,735 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: event.proto
+
+package pb
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+type Event struct {
+	Id              string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Type            string `protobuf:"bytes,2,opt,name=type" json:"type,omitempty"`
+	Created         int64  `protobuf:"varint,3,opt,name=created" json:"created,omitempty"`
+	Livemode        bool   `protobuf:"varint,4,opt,name=livemode" json:"livemode,omitempty"`
+	Account         string `protobuf:"bytes,5,opt,name=account" json:"account,omitempty"`
+	RequestId       string `protobuf:"bytes,6,opt,name=request_id,json=requestId" json:"request_id,omitempty"`
+	IdempotencyKey  string `protobuf:"bytes,7,opt,name=idempotency_key,json=idempotencyKey" json:"idempotency_key,omitempty"`
+	PendingWebhooks uint64 `protobuf:"varint,8,opt,name=pending_webhooks,json=pendingWebhooks" json:"pending_webhooks,omitempty"`
+	Object          string `protobuf:"bytes,9,opt,name=object" json:"object,omitempty"`
+	// Types that are valid to be assigned to Data:
+	//	*Event_Plan
+	//	*Event_Customer
+	//	*Event_Subscription
+	//	*Event_Invoice
+	Data               isEvent_Data `protobuf_oneof:"data"`
+	PreviousAttributes []string     `protobuf:"bytes,14,rep,name=previous_attributes,json=previousAttributes" json:"previous_attributes,omitempty"`
+	RawObject          []byte       `protobuf:"bytes,15,opt,name=raw_object,json=rawObject,proto3" json:"raw_object,omitempty"`
+}
+
+func (m *Event) Reset()                    { *m = Event{} }
+func (m *Event) String() string            { return proto.CompactTextString(m) }
+func (*Event) ProtoMessage()               {}
+func (*Event) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{0} }
+
+type isEvent_Data interface {
+	isEvent_Data()
+}
+
+type Event_Plan struct {
+	Plan *Plan `protobuf:"bytes,10,opt,name=plan,oneof"`
+}
+type Event_Customer struct {
+	Customer *Customer `protobuf:"bytes,11,opt,name=customer,oneof"`
+}
+type Event_Subscription struct {
+	Subscription *Subscription `protobuf:"bytes,12,opt,name=subscription,oneof"`
+}
+type Event_Invoice struct {
+	Invoice *Invoice `protobuf:"bytes,13,opt,name=invoice,oneof"`
+}
+
+func (*Event_Plan) isEvent_Data()         {}
+func (*Event_Customer) isEvent_Data()     {}
+func (*Event_Subscription) isEvent_Data() {}
+func (*Event_Invoice) isEvent_Data()      {}
+
+func (m *Event) GetData() isEvent_Data {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *Event) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Event) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *Event) GetCreated() int64 {
+	if m != nil {
+		return m.Created
+	}
+	return 0
+}
+
+func (m *Event) GetLivemode() bool {
+	if m != nil {
+		return m.Livemode
+	}
+	return false
+}
+
+func (m *Event) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *Event) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
+}
+
+func (m *Event) GetIdempotencyKey() string {
+	if m != nil {
+		return m.IdempotencyKey
+	}
+	return ""
+}
+
+func (m *Event) GetPendingWebhooks() uint64 {
+	if m != nil {
+		return m.PendingWebhooks
+	}
+	return 0
+}
+
+func (m *Event) GetObject() string {
+	if m != nil {
+		return m.Object
+	}
+	return ""
+}
+
+func (m *Event) GetPlan() *Plan {
+	if x, ok := m.GetData().(*Event_Plan); ok {
+		return x.Plan
+	}
+	return nil
+}
+
+func (m *Event) GetCustomer() *Customer {
+	if x, ok := m.GetData().(*Event_Customer); ok {
+		return x.Customer
+	}
+	return nil
+}
+
+func (m *Event) GetSubscription() *Subscription {
+	if x, ok := m.GetData().(*Event_Subscription); ok {
+		return x.Subscription
+	}
+	return nil
+}
+
+func (m *Event) GetInvoice() *Invoice {
+	if x, ok := m.GetData().(*Event_Invoice); ok {
+		return x.Invoice
+	}
+	return nil
+}
+
+func (m *Event) GetPreviousAttributes() []string {
+	if m != nil {
+		return m.PreviousAttributes
+	}
+	return nil
+}
+
+func (m *Event) GetRawObject() []byte {
+	if m != nil {
+		return m.RawObject
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*Event) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _Event_OneofMarshaler, _Event_OneofUnmarshaler, _Event_OneofSizer, []interface{}{
+		(*Event_Plan)(nil),
+		(*Event_Customer)(nil),
+		(*Event_Subscription)(nil),
+		(*Event_Invoice)(nil),
+	}
+}
+
+func _Event_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*Event)
+	// data
+	switch x := m.Data.(type) {
+	case *Event_Plan:
+		b.EncodeVarint(10<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Plan); err != nil {
+			return err
+		}
+	case *Event_Customer:
+		b.EncodeVarint(11<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Customer); err != nil {
+			return err
+		}
+	case *Event_Subscription:
+		b.EncodeVarint(12<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Subscription); err != nil {
+			return err
+		}
+	case *Event_Invoice:
+		b.EncodeVarint(13<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Invoice); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("Event.Data has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _Event_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*Event)
+	switch tag {
+	case 10: // data.plan
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Plan)
+		err := b.DecodeMessage(msg)
+		m.Data = &Event_Plan{msg}
+		return true, err
+	case 11: // data.customer
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Customer)
+		err := b.DecodeMessage(msg)
+		m.Data = &Event_Customer{msg}
+		return true, err
+	case 12: // data.subscription
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Subscription)
+		err := b.DecodeMessage(msg)
+		m.Data = &Event_Subscription{msg}
+		return true, err
+	case 13: // data.invoice
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Invoice)
+		err := b.DecodeMessage(msg)
+		m.Data = &Event_Invoice{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _Event_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*Event)
+	// data
+	switch x := m.Data.(type) {
+	case *Event_Plan:
+		s := proto.Size(x.Plan)
+		n += proto.SizeVarint(10<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Event_Customer:
+		s := proto.Size(x.Customer)
+		n += proto.SizeVarint(11<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Event_Subscription:
+		s := proto.Size(x.Subscription)
+		n += proto.SizeVarint(12<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Event_Invoice:
+		s := proto.Size(x.Invoice)
+		n += proto.SizeVarint(13<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+type EventResponse struct {
+	// Types that are valid to be assigned to Responses:
+	//	*EventResponse_Error
+	//	*EventResponse_Success
+	Responses isEventResponse_Responses `protobuf_oneof:"responses"`
+}
+
+func (m *EventResponse) Reset()                    { *m = EventResponse{} }
+func (m *EventResponse) String() string            { return proto.CompactTextString(m) }
+func (*EventResponse) ProtoMessage()               {}
+func (*EventResponse) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{1} }
+
+type isEventResponse_Responses interface {
+	isEventResponse_Responses()
+}
+
+type EventResponse_Error struct {
+	Error *Error `protobuf:"bytes,1,opt,name=error,oneof"`
+}
+type EventResponse_Success struct {
+	Success *Event `protobuf:"bytes,2,opt,name=success,oneof"`
+}
+
+func (*EventResponse_Error) isEventResponse_Responses()   {}
+func (*EventResponse_Success) isEventResponse_Responses() {}
+
+func (m *EventResponse) GetResponses() isEventResponse_Responses {
+	if m != nil {
+		return m.Responses
+	}
+	return nil
+}
+
+func (m *EventResponse) GetError() *Error {
+	if x, ok := m.GetResponses().(*EventResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+func (m *EventResponse) GetSuccess() *Event {
+	if x, ok := m.GetResponses().(*EventResponse_Success); ok {
+		return x.Success
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*EventResponse) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _EventResponse_OneofMarshaler, _EventResponse_OneofUnmarshaler, _EventResponse_OneofSizer, []interface{}{
+		(*EventResponse_Error)(nil),
+		(*EventResponse_Success)(nil),
+	}
+}
+
+func _EventResponse_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*EventResponse)
+	// responses
+	switch x := m.Responses.(type) {
+	case *EventResponse_Error:
+		b.EncodeVarint(1<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Error); err != nil {
+			return err
+		}
+	case *EventResponse_Success:
+		b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Success); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("EventResponse.Responses has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _EventResponse_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*EventResponse)
+	switch tag {
+	case 1: // responses.error
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Error)
+		err := b.DecodeMessage(msg)
+		m.Responses = &EventResponse_Error{msg}
+		return true, err
+	case 2: // responses.success
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Event)
+		err := b.DecodeMessage(msg)
+		m.Responses = &EventResponse_Success{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _EventResponse_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*EventResponse)
+	// responses
+	switch x := m.Responses.(type) {
+	case *EventResponse_Error:
+		s := proto.Size(x.Error)
+		n += proto.SizeVarint(1<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *EventResponse_Success:
+		s := proto.Size(x.Success)
+		n += proto.SizeVarint(2<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+type GetEventRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *GetEventRequest) Reset()                    { *m = GetEventRequest{} }
+func (m *GetEventRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetEventRequest) ProtoMessage()               {}
+func (*GetEventRequest) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{2} }
+
+func (m *GetEventRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type ListEventsRequest struct {
+	Type          string      `protobuf:"bytes,1,opt,name=type" json:"type,omitempty"`
+	Created       *ListFilter `protobuf:"bytes,2,opt,name=created" json:"created,omitempty"`
+	EndingBefore  string      `protobuf:"bytes,3,opt,name=ending_before,json=endingBefore" json:"ending_before,omitempty"`
+	StartingAfter string      `protobuf:"bytes,4,opt,name=starting_after,json=startingAfter" json:"starting_after,omitempty"`
+	Limit         int32       `protobuf:"varint,5,opt,name=limit" json:"limit,omitempty"`
+}
+
+func (m *ListEventsRequest) Reset()                    { *m = ListEventsRequest{} }
+func (m *ListEventsRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListEventsRequest) ProtoMessage()               {}
+func (*ListEventsRequest) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{3} }
+
+func (m *ListEventsRequest) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *ListEventsRequest) GetCreated() *ListFilter {
+	if m != nil {
+		return m.Created
+	}
+	return nil
+}
+
+func (m *ListEventsRequest) GetEndingBefore() string {
+	if m != nil {
+		return m.EndingBefore
+	}
+	return ""
+}
+
+func (m *ListEventsRequest) GetStartingAfter() string {
+	if m != nil {
+		return m.StartingAfter
+	}
+	return ""
+}
+
+func (m *ListEventsRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type SubscribeEventsRequest struct {
+	Types []string `protobuf:"bytes,1,rep,name=types" json:"types,omitempty"`
+}
+
+func (m *SubscribeEventsRequest) Reset()                    { *m = SubscribeEventsRequest{} }
+func (m *SubscribeEventsRequest) String() string            { return proto.CompactTextString(m) }
+func (*SubscribeEventsRequest) ProtoMessage()               {}
+func (*SubscribeEventsRequest) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{4} }
+
+func (m *SubscribeEventsRequest) GetTypes() []string {
+	if m != nil {
+		return m.Types
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Event)(nil), "Event")
+	proto.RegisterType((*EventResponse)(nil), "EventResponse")
+	proto.RegisterType((*GetEventRequest)(nil), "GetEventRequest")
+	proto.RegisterType((*ListEventsRequest)(nil), "ListEventsRequest")
+	proto.RegisterType((*SubscribeEventsRequest)(nil), "SubscribeEventsRequest")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for Events service
+
+type EventsClient interface {
+	GetEvent(ctx context.Context, in *GetEventRequest, opts ...grpc.CallOption) (*EventResponse, error)
+	ListEvents(ctx context.Context, in *ListEventsRequest, opts ...grpc.CallOption) (Events_ListEventsClient, error)
+	SubscribeEvents(ctx context.Context, in *SubscribeEventsRequest, opts ...grpc.CallOption) (Events_SubscribeEventsClient, error)
+}
+
+type eventsClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewEventsClient(cc *grpc.ClientConn) EventsClient {
+	return &eventsClient{cc}
+}
+
+func (c *eventsClient) GetEvent(ctx context.Context, in *GetEventRequest, opts ...grpc.CallOption) (*EventResponse, error) {
+	out := new(EventResponse)
+	err := grpc.Invoke(ctx, "/Events/GetEvent", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventsClient) ListEvents(ctx context.Context, in *ListEventsRequest, opts ...grpc.CallOption) (Events_ListEventsClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Events_serviceDesc.Streams[0], c.cc, "/Events/ListEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &eventsListEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Events_ListEventsClient interface {
+	Recv() (*EventResponse, error)
+	grpc.ClientStream
+}
+
+type eventsListEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *eventsListEventsClient) Recv() (*EventResponse, error) {
+	m := new(EventResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *eventsClient) SubscribeEvents(ctx context.Context, in *SubscribeEventsRequest, opts ...grpc.CallOption) (Events_SubscribeEventsClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Events_serviceDesc.Streams[1], c.cc, "/Events/SubscribeEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &eventsSubscribeEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Events_SubscribeEventsClient interface {
+	Recv() (*Event, error)
+	grpc.ClientStream
+}
+
+type eventsSubscribeEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *eventsSubscribeEventsClient) Recv() (*Event, error) {
+	m := new(Event)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Server API for Events service
+
+type EventsServer interface {
+	GetEvent(context.Context, *GetEventRequest) (*EventResponse, error)
+	ListEvents(*ListEventsRequest, Events_ListEventsServer) error
+	SubscribeEvents(*SubscribeEventsRequest, Events_SubscribeEventsServer) error
+}
+
+func RegisterEventsServer(s *grpc.Server, srv EventsServer) {
+	s.RegisterService(&_Events_serviceDesc, srv)
+}
+
+func _Events_GetEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventsServer).GetEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Events/GetEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventsServer).GetEvent(ctx, req.(*GetEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Events_ListEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(EventsServer).ListEvents(m, &eventsListEventsServer{stream})
+}
+
+type Events_ListEventsServer interface {
+	Send(*EventResponse) error
+	grpc.ServerStream
+}
+
+type eventsListEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *eventsListEventsServer) Send(m *EventResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Events_SubscribeEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(EventsServer).SubscribeEvents(m, &eventsSubscribeEventsServer{stream})
+}
+
+type Events_SubscribeEventsServer interface {
+	Send(*Event) error
+	grpc.ServerStream
+}
+
+type eventsSubscribeEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *eventsSubscribeEventsServer) Send(m *Event) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _Events_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "Events",
+	HandlerType: (*EventsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetEvent",
+			Handler:    _Events_GetEvent_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ListEvents",
+			Handler:       _Events_ListEvents_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeEvents",
+			Handler:       _Events_SubscribeEvents_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "event.proto",
+}
+
+func init() { proto.RegisterFile("event.proto", fileDescriptor4) }
+
+var fileDescriptor4 = []byte{
+	// 607 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x54, 0x5d, 0x6f, 0xd3, 0x4a,
+	0x10, 0x8d, 0x93, 0x38, 0x89, 0xc7, 0xf9, 0xe8, 0xdd, 0x5b, 0xf5, 0xae, 0x72, 0x75, 0xaf, 0x8c,
+	0xa1, 0xaa, 0x79, 0x31, 0xa8, 0x45, 0xbc, 0xb7, 0xa8, 0x90, 0x0a, 0x24, 0xd0, 0xf2, 0xc0, 0x63,
+	0xf0, 0xc7, 0x14, 0x96, 0x26, 0x5e, 0xb3, 0xbb, 0x4e, 0x95, 0x3f, 0xc4, 0x33, 0xff, 0x10, 0xe4,
+	0xf5, 0xba, 0x4d, 0x5b, 0xde, 0x7c, 0xce, 0x9c, 0x99, 0x9d, 0x9c, 0x39, 0x0a, 0xf8, 0xb8, 0xc1,
+	0x42, 0xc7, 0xa5, 0x14, 0x5a, 0xcc, 0xa7, 0x59, 0xa5, 0xb4, 0x58, 0xa3, 0xb4, 0xd8, 0x47, 0x29,
+	0x45, 0x0b, 0x26, 0xbc, 0xd8, 0x08, 0x9e, 0xa1, 0x85, 0x50, 0xae, 0x92, 0xc2, 0x7e, 0x13, 0x55,
+	0xa5, 0x2a, 0x93, 0xbc, 0xd4, 0x5c, 0x58, 0x2e, 0xfc, 0xd5, 0x03, 0xf7, 0xbc, 0x9e, 0x4d, 0xa6,
+	0xd0, 0xe5, 0x39, 0x75, 0x02, 0x27, 0xf2, 0x58, 0x97, 0xe7, 0x84, 0x40, 0x5f, 0x6f, 0x4b, 0xa4,
+	0x5d, 0xc3, 0x98, 0x6f, 0x42, 0x61, 0x98, 0x49, 0x4c, 0x34, 0xe6, 0xb4, 0x17, 0x38, 0x51, 0x8f,
+	0xb5, 0x90, 0xcc, 0x61, 0xb4, 0xe2, 0x1b, 0x5c, 0x8b, 0x1c, 0x69, 0x3f, 0x70, 0xa2, 0x11, 0xbb,
+	0xc1, 0x75, 0x57, 0x92, 0x65, 0xa2, 0x2a, 0x34, 0x75, 0xcd, 0xb0, 0x16, 0x92, 0xff, 0x00, 0x24,
+	0x7e, 0xaf, 0x50, 0xe9, 0x25, 0xcf, 0xe9, 0xc0, 0x14, 0x3d, 0xcb, 0x5c, 0xe4, 0xe4, 0x08, 0x66,
+	0x3c, 0xc7, 0x75, 0x29, 0x34, 0x16, 0xd9, 0x76, 0x79, 0x85, 0x5b, 0x3a, 0x34, 0x9a, 0xe9, 0x0e,
+	0xfd, 0x16, 0xb7, 0xe4, 0x29, 0xec, 0x95, 0x58, 0xe4, 0xbc, 0xf8, 0xb2, 0xbc, 0xc6, 0xf4, 0xab,
+	0x10, 0x57, 0x8a, 0x8e, 0x02, 0x27, 0xea, 0xb3, 0x99, 0xe5, 0x3f, 0x59, 0x9a, 0x1c, 0xc0, 0x40,
+	0xa4, 0xdf, 0x30, 0xd3, 0xd4, 0x33, 0xa3, 0x2c, 0x22, 0xff, 0x42, 0xbf, 0xb6, 0x8a, 0x42, 0xe0,
+	0x44, 0xfe, 0xb1, 0x1b, 0x7f, 0x58, 0x25, 0xc5, 0xa2, 0xc3, 0x0c, 0x49, 0x8e, 0x60, 0xd4, 0x7a,
+	0x4e, 0x7d, 0x23, 0xf0, 0xe2, 0x57, 0x96, 0x58, 0x74, 0xd8, 0x4d, 0x91, 0x9c, 0xc0, 0x78, 0xd7,
+	0x64, 0x3a, 0x36, 0xe2, 0x49, 0xfc, 0x71, 0x87, 0x5c, 0x74, 0xd8, 0x1d, 0x11, 0x79, 0x02, 0x43,
+	0x7b, 0x34, 0x3a, 0x31, 0xfa, 0x51, 0x7c, 0xd1, 0xe0, 0x45, 0x87, 0xb5, 0x25, 0xf2, 0x0c, 0xfe,
+	0x2e, 0x25, 0x6e, 0xb8, 0xa8, 0xd4, 0x32, 0xd1, 0x5a, 0xf2, 0xb4, 0xd2, 0xa8, 0xe8, 0x34, 0xe8,
+	0x45, 0x1e, 0x23, 0x6d, 0xe9, 0xf4, 0xa6, 0x62, 0xcc, 0x4d, 0xae, 0x97, 0xf6, 0xd7, 0xce, 0x02,
+	0x27, 0x1a, 0x33, 0x4f, 0x26, 0xd7, 0xef, 0x0d, 0x71, 0x36, 0x80, 0x7e, 0x9e, 0xe8, 0x24, 0xfc,
+	0x0c, 0x13, 0x13, 0x00, 0x86, 0xaa, 0x14, 0x85, 0x42, 0xf2, 0x3f, 0xb8, 0x26, 0x50, 0x26, 0x0b,
+	0xfe, 0xf1, 0x20, 0x3e, 0xaf, 0xd1, 0xa2, 0xc3, 0x1a, 0x9a, 0x84, 0x30, 0x54, 0x55, 0x96, 0xa1,
+	0x52, 0xb4, 0xdb, 0x2a, 0xea, 0x01, 0xf5, 0xb2, 0xb6, 0x70, 0xe6, 0x83, 0x27, 0xed, 0x3c, 0x15,
+	0x3e, 0x82, 0xd9, 0x1b, 0xd4, 0xf6, 0x11, 0x73, 0xdb, 0xfb, 0x61, 0x0b, 0x7f, 0x3a, 0xf0, 0xd7,
+	0x3b, 0xae, 0x1a, 0x91, 0x6a, 0x55, 0x6d, 0x04, 0x9d, 0x9d, 0x08, 0x1e, 0xde, 0x46, 0xb0, 0x79,
+	0xdd, 0x8f, 0xeb, 0xc6, 0xd7, 0x7c, 0xa5, 0x51, 0xde, 0xe6, 0xf1, 0x31, 0x4c, 0x6c, 0x20, 0x52,
+	0xbc, 0x14, 0x12, 0x4d, 0x5e, 0x3d, 0x36, 0x6e, 0xc8, 0x33, 0xc3, 0x91, 0x43, 0x98, 0x2a, 0x9d,
+	0x48, 0x5d, 0xcb, 0x92, 0x4b, 0x8d, 0xd2, 0x44, 0xd7, 0x63, 0x93, 0x96, 0x3d, 0xad, 0x49, 0xb2,
+	0x0f, 0xee, 0x8a, 0xaf, 0x79, 0x93, 0x5e, 0x97, 0x35, 0x20, 0x8c, 0xe1, 0xc0, 0x5e, 0x35, 0xc5,
+	0xbb, 0x6b, 0xef, 0x83, 0x5b, 0xaf, 0xaa, 0xa8, 0x63, 0x6e, 0xd3, 0x80, 0xe3, 0x1f, 0x0e, 0x0c,
+	0x1a, 0x1d, 0x89, 0x61, 0xd4, 0x1a, 0x42, 0xf6, 0xe2, 0x7b, 0xde, 0xcc, 0xa7, 0xf1, 0x9d, 0x7b,
+	0x84, 0x1d, 0xf2, 0x02, 0xe0, 0xd6, 0x1c, 0x42, 0xe2, 0x07, 0x4e, 0x3d, 0xec, 0x79, 0xee, 0x90,
+	0x97, 0x30, 0xbb, 0xb7, 0x20, 0xf9, 0x27, 0xfe, 0xf3, 0xca, 0x73, 0x7b, 0xc2, 0xba, 0x2f, 0x1d,
+	0x98, 0x7f, 0x86, 0x93, 0xdf, 0x03, 0x00, 0x3b, 0x9d, 0xcb, 0x2d, 0x74, 0x04, 0x00, 0x00,
+}
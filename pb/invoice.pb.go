@@ -0,0 +1,1119 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: invoice.proto
+
+package pb
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+type InvoiceStatus int32
+
+const (
+	InvoiceStatus_UnknownInvoiceStatus InvoiceStatus = 0
+	InvoiceStatus_Draft                InvoiceStatus = 1
+	InvoiceStatus_Open                 InvoiceStatus = 2
+	InvoiceStatus_Paid                 InvoiceStatus = 3
+	InvoiceStatus_Uncollectible        InvoiceStatus = 4
+	InvoiceStatus_Void                 InvoiceStatus = 5
+)
+
+var InvoiceStatus_name = map[int32]string{
+	0: "UnknownInvoiceStatus",
+	1: "Draft",
+	2: "Open",
+	3: "Paid",
+	4: "Uncollectible",
+	5: "Void",
+}
+var InvoiceStatus_value = map[string]int32{
+	"UnknownInvoiceStatus": 0,
+	"Draft":                1,
+	"Open":                 2,
+	"Paid":                 3,
+	"Uncollectible":        4,
+	"Void":                 5,
+}
+
+func (x InvoiceStatus) String() string {
+	return proto.EnumName(InvoiceStatus_name, int32(x))
+}
+func (InvoiceStatus) EnumDescriptor() ([]byte, []int) { return fileDescriptor5, []int{0} }
+
+type InvoiceLineType int32
+
+const (
+	InvoiceLineType_UnknownLineType  InvoiceLineType = 0
+	InvoiceLineType_InvoiceItemLine  InvoiceLineType = 1
+	InvoiceLineType_SubscriptionLine InvoiceLineType = 2
+)
+
+var InvoiceLineType_name = map[int32]string{
+	0: "UnknownLineType",
+	1: "InvoiceItemLine",
+	2: "SubscriptionLine",
+}
+var InvoiceLineType_value = map[string]int32{
+	"UnknownLineType":  0,
+	"InvoiceItemLine":  1,
+	"SubscriptionLine": 2,
+}
+
+func (x InvoiceLineType) String() string {
+	return proto.EnumName(InvoiceLineType_name, int32(x))
+}
+func (InvoiceLineType) EnumDescriptor() ([]byte, []int) { return fileDescriptor5, []int{1} }
+
+type InvoiceResponse struct {
+	// Types that are valid to be assigned to Responses:
+	//	*InvoiceResponse_Error
+	//	*InvoiceResponse_Success
+	Responses isInvoiceResponse_Responses `protobuf_oneof:"responses"`
+}
+
+func (m *InvoiceResponse) Reset()                    { *m = InvoiceResponse{} }
+func (m *InvoiceResponse) String() string            { return proto.CompactTextString(m) }
+func (*InvoiceResponse) ProtoMessage()               {}
+func (*InvoiceResponse) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{0} }
+
+type isInvoiceResponse_Responses interface {
+	isInvoiceResponse_Responses()
+}
+
+type InvoiceResponse_Error struct {
+	Error *Error `protobuf:"bytes,1,opt,name=error,oneof"`
+}
+type InvoiceResponse_Success struct {
+	Success *Invoice `protobuf:"bytes,2,opt,name=success,oneof"`
+}
+
+func (*InvoiceResponse_Error) isInvoiceResponse_Responses()   {}
+func (*InvoiceResponse_Success) isInvoiceResponse_Responses() {}
+
+func (m *InvoiceResponse) GetResponses() isInvoiceResponse_Responses {
+	if m != nil {
+		return m.Responses
+	}
+	return nil
+}
+
+func (m *InvoiceResponse) GetError() *Error {
+	if x, ok := m.GetResponses().(*InvoiceResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+func (m *InvoiceResponse) GetSuccess() *Invoice {
+	if x, ok := m.GetResponses().(*InvoiceResponse_Success); ok {
+		return x.Success
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*InvoiceResponse) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _InvoiceResponse_OneofMarshaler, _InvoiceResponse_OneofUnmarshaler, _InvoiceResponse_OneofSizer, []interface{}{
+		(*InvoiceResponse_Error)(nil),
+		(*InvoiceResponse_Success)(nil),
+	}
+}
+
+func _InvoiceResponse_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*InvoiceResponse)
+	// responses
+	switch x := m.Responses.(type) {
+	case *InvoiceResponse_Error:
+		b.EncodeVarint(1<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Error); err != nil {
+			return err
+		}
+	case *InvoiceResponse_Success:
+		b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Success); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("InvoiceResponse.Responses has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _InvoiceResponse_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*InvoiceResponse)
+	switch tag {
+	case 1: // responses.error
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Error)
+		err := b.DecodeMessage(msg)
+		m.Responses = &InvoiceResponse_Error{msg}
+		return true, err
+	case 2: // responses.success
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Invoice)
+		err := b.DecodeMessage(msg)
+		m.Responses = &InvoiceResponse_Success{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _InvoiceResponse_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*InvoiceResponse)
+	// responses
+	switch x := m.Responses.(type) {
+	case *InvoiceResponse_Error:
+		s := proto.Size(x.Error)
+		n += proto.SizeVarint(1<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *InvoiceResponse_Success:
+		s := proto.Size(x.Success)
+		n += proto.SizeVarint(2<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+type Invoice struct {
+	Id                  string             `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Customer            string             `protobuf:"bytes,2,opt,name=customer" json:"customer,omitempty"`
+	Subscription        string             `protobuf:"bytes,3,opt,name=subscription" json:"subscription,omitempty"`
+	Status              InvoiceStatus      `protobuf:"varint,4,opt,name=status,enum=InvoiceStatus" json:"status,omitempty"`
+	AmountDue           int64              `protobuf:"varint,5,opt,name=amount_due,json=amountDue" json:"amount_due,omitempty"`
+	Subtotal            int64              `protobuf:"varint,6,opt,name=subtotal" json:"subtotal,omitempty"`
+	Tax                 int64              `protobuf:"varint,7,opt,name=tax" json:"tax,omitempty"`
+	TaxPercent          float64            `protobuf:"fixed64,8,opt,name=tax_percent,json=taxPercent" json:"tax_percent,omitempty"`
+	Total               int64              `protobuf:"varint,9,opt,name=total" json:"total,omitempty"`
+	Currency            Currency           `protobuf:"varint,10,opt,name=currency,enum=Currency" json:"currency,omitempty"`
+	StartingBalance     int64              `protobuf:"varint,11,opt,name=starting_balance,json=startingBalance" json:"starting_balance,omitempty"`
+	EndingBalance       int64              `protobuf:"varint,12,opt,name=ending_balance,json=endingBalance" json:"ending_balance,omitempty"`
+	AttemptCount        uint64             `protobuf:"varint,13,opt,name=attempt_count,json=attemptCount" json:"attempt_count,omitempty"`
+	Attempted           bool               `protobuf:"varint,14,opt,name=attempted" json:"attempted,omitempty"`
+	Paid                bool               `protobuf:"varint,15,opt,name=paid" json:"paid,omitempty"`
+	Closed              bool               `protobuf:"varint,16,opt,name=closed" json:"closed,omitempty"`
+	Forgiven            bool               `protobuf:"varint,17,opt,name=forgiven" json:"forgiven,omitempty"`
+	Date                int64              `protobuf:"varint,18,opt,name=date" json:"date,omitempty"`
+	DueDate             int64              `protobuf:"varint,19,opt,name=due_date,json=dueDate" json:"due_date,omitempty"`
+	PeriodStart         int64              `protobuf:"varint,20,opt,name=period_start,json=periodStart" json:"period_start,omitempty"`
+	PeriodEnd           int64              `protobuf:"varint,21,opt,name=period_end,json=periodEnd" json:"period_end,omitempty"`
+	NextPaymentAttempt  int64              `protobuf:"varint,22,opt,name=next_payment_attempt,json=nextPaymentAttempt" json:"next_payment_attempt,omitempty"`
+	Description         string             `protobuf:"bytes,23,opt,name=description" json:"description,omitempty"`
+	StatementDescriptor string             `protobuf:"bytes,24,opt,name=statement_descriptor,json=statementDescriptor" json:"statement_descriptor,omitempty"`
+	ReceiptNumber       string             `protobuf:"bytes,25,opt,name=receipt_number,json=receiptNumber" json:"receipt_number,omitempty"`
+	Number              string             `protobuf:"bytes,26,opt,name=number" json:"number,omitempty"`
+	Charge              string             `protobuf:"bytes,27,opt,name=charge" json:"charge,omitempty"`
+	Lines               []*InvoiceLineItem `protobuf:"bytes,28,rep,name=lines" json:"lines,omitempty"`
+	LinesHasMore        bool               `protobuf:"varint,29,opt,name=lines_has_more,json=linesHasMore" json:"lines_has_more,omitempty"`
+	Metadata            map[string]string  `protobuf:"bytes,30,rep,name=metadata" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Livemode            bool               `protobuf:"varint,31,opt,name=livemode" json:"livemode,omitempty"`
+}
+
+func (m *Invoice) Reset()                    { *m = Invoice{} }
+func (m *Invoice) String() string            { return proto.CompactTextString(m) }
+func (*Invoice) ProtoMessage()               {}
+func (*Invoice) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{1} }
+
+func (m *Invoice) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Invoice) GetCustomer() string {
+	if m != nil {
+		return m.Customer
+	}
+	return ""
+}
+
+func (m *Invoice) GetSubscription() string {
+	if m != nil {
+		return m.Subscription
+	}
+	return ""
+}
+
+func (m *Invoice) GetStatus() InvoiceStatus {
+	if m != nil {
+		return m.Status
+	}
+	return InvoiceStatus_UnknownInvoiceStatus
+}
+
+func (m *Invoice) GetAmountDue() int64 {
+	if m != nil {
+		return m.AmountDue
+	}
+	return 0
+}
+
+func (m *Invoice) GetSubtotal() int64 {
+	if m != nil {
+		return m.Subtotal
+	}
+	return 0
+}
+
+func (m *Invoice) GetTax() int64 {
+	if m != nil {
+		return m.Tax
+	}
+	return 0
+}
+
+func (m *Invoice) GetTaxPercent() float64 {
+	if m != nil {
+		return m.TaxPercent
+	}
+	return 0
+}
+
+func (m *Invoice) GetTotal() int64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *Invoice) GetCurrency() Currency {
+	if m != nil {
+		return m.Currency
+	}
+	return Currency_UNK
+}
+
+func (m *Invoice) GetStartingBalance() int64 {
+	if m != nil {
+		return m.StartingBalance
+	}
+	return 0
+}
+
+func (m *Invoice) GetEndingBalance() int64 {
+	if m != nil {
+		return m.EndingBalance
+	}
+	return 0
+}
+
+func (m *Invoice) GetAttemptCount() uint64 {
+	if m != nil {
+		return m.AttemptCount
+	}
+	return 0
+}
+
+func (m *Invoice) GetAttempted() bool {
+	if m != nil {
+		return m.Attempted
+	}
+	return false
+}
+
+func (m *Invoice) GetPaid() bool {
+	if m != nil {
+		return m.Paid
+	}
+	return false
+}
+
+func (m *Invoice) GetClosed() bool {
+	if m != nil {
+		return m.Closed
+	}
+	return false
+}
+
+func (m *Invoice) GetForgiven() bool {
+	if m != nil {
+		return m.Forgiven
+	}
+	return false
+}
+
+func (m *Invoice) GetDate() int64 {
+	if m != nil {
+		return m.Date
+	}
+	return 0
+}
+
+func (m *Invoice) GetDueDate() int64 {
+	if m != nil {
+		return m.DueDate
+	}
+	return 0
+}
+
+func (m *Invoice) GetPeriodStart() int64 {
+	if m != nil {
+		return m.PeriodStart
+	}
+	return 0
+}
+
+func (m *Invoice) GetPeriodEnd() int64 {
+	if m != nil {
+		return m.PeriodEnd
+	}
+	return 0
+}
+
+func (m *Invoice) GetNextPaymentAttempt() int64 {
+	if m != nil {
+		return m.NextPaymentAttempt
+	}
+	return 0
+}
+
+func (m *Invoice) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *Invoice) GetStatementDescriptor() string {
+	if m != nil {
+		return m.StatementDescriptor
+	}
+	return ""
+}
+
+func (m *Invoice) GetReceiptNumber() string {
+	if m != nil {
+		return m.ReceiptNumber
+	}
+	return ""
+}
+
+func (m *Invoice) GetNumber() string {
+	if m != nil {
+		return m.Number
+	}
+	return ""
+}
+
+func (m *Invoice) GetCharge() string {
+	if m != nil {
+		return m.Charge
+	}
+	return ""
+}
+
+func (m *Invoice) GetLines() []*InvoiceLineItem {
+	if m != nil {
+		return m.Lines
+	}
+	return nil
+}
+
+func (m *Invoice) GetLinesHasMore() bool {
+	if m != nil {
+		return m.LinesHasMore
+	}
+	return false
+}
+
+func (m *Invoice) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *Invoice) GetLivemode() bool {
+	if m != nil {
+		return m.Livemode
+	}
+	return false
+}
+
+type InvoiceLineItem struct {
+	Id           string            `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Type         InvoiceLineType   `protobuf:"varint,2,opt,name=type,enum=InvoiceLineType" json:"type,omitempty"`
+	Amount       int64             `protobuf:"varint,3,opt,name=amount" json:"amount,omitempty"`
+	Currency     Currency          `protobuf:"varint,4,opt,name=currency,enum=Currency" json:"currency,omitempty"`
+	Description  string            `protobuf:"bytes,5,opt,name=description" json:"description,omitempty"`
+	Discountable bool              `protobuf:"varint,6,opt,name=discountable" json:"discountable,omitempty"`
+	PeriodStart  int64             `protobuf:"varint,7,opt,name=period_start,json=periodStart" json:"period_start,omitempty"`
+	PeriodEnd    int64             `protobuf:"varint,8,opt,name=period_end,json=periodEnd" json:"period_end,omitempty"`
+	Plan         *Plan             `protobuf:"bytes,9,opt,name=plan" json:"plan,omitempty"`
+	Proration    bool              `protobuf:"varint,10,opt,name=proration" json:"proration,omitempty"`
+	Quantity     int64             `protobuf:"varint,11,opt,name=quantity" json:"quantity,omitempty"`
+	Subscription string            `protobuf:"bytes,12,opt,name=subscription" json:"subscription,omitempty"`
+	Metadata     map[string]string `protobuf:"bytes,13,rep,name=metadata" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (m *InvoiceLineItem) Reset()                    { *m = InvoiceLineItem{} }
+func (m *InvoiceLineItem) String() string            { return proto.CompactTextString(m) }
+func (*InvoiceLineItem) ProtoMessage()               {}
+func (*InvoiceLineItem) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{2} }
+
+func (m *InvoiceLineItem) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *InvoiceLineItem) GetType() InvoiceLineType {
+	if m != nil {
+		return m.Type
+	}
+	return InvoiceLineType_UnknownLineType
+}
+
+func (m *InvoiceLineItem) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *InvoiceLineItem) GetCurrency() Currency {
+	if m != nil {
+		return m.Currency
+	}
+	return Currency_UNK
+}
+
+func (m *InvoiceLineItem) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *InvoiceLineItem) GetDiscountable() bool {
+	if m != nil {
+		return m.Discountable
+	}
+	return false
+}
+
+func (m *InvoiceLineItem) GetPeriodStart() int64 {
+	if m != nil {
+		return m.PeriodStart
+	}
+	return 0
+}
+
+func (m *InvoiceLineItem) GetPeriodEnd() int64 {
+	if m != nil {
+		return m.PeriodEnd
+	}
+	return 0
+}
+
+func (m *InvoiceLineItem) GetPlan() *Plan {
+	if m != nil {
+		return m.Plan
+	}
+	return nil
+}
+
+func (m *InvoiceLineItem) GetProration() bool {
+	if m != nil {
+		return m.Proration
+	}
+	return false
+}
+
+func (m *InvoiceLineItem) GetQuantity() int64 {
+	if m != nil {
+		return m.Quantity
+	}
+	return 0
+}
+
+func (m *InvoiceLineItem) GetSubscription() string {
+	if m != nil {
+		return m.Subscription
+	}
+	return ""
+}
+
+func (m *InvoiceLineItem) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+type GetInvoiceRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *GetInvoiceRequest) Reset()                    { *m = GetInvoiceRequest{} }
+func (m *GetInvoiceRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetInvoiceRequest) ProtoMessage()               {}
+func (*GetInvoiceRequest) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{3} }
+
+func (m *GetInvoiceRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type UpcomingInvoiceRequest struct {
+	Customer      string `protobuf:"bytes,1,opt,name=customer" json:"customer,omitempty"`
+	Subscription  string `protobuf:"bytes,2,opt,name=subscription" json:"subscription,omitempty"`
+	Plan          string `protobuf:"bytes,3,opt,name=plan" json:"plan,omitempty"`
+	Quantity      uint64 `protobuf:"varint,4,opt,name=quantity" json:"quantity,omitempty"`
+	NoProrate     bool   `protobuf:"varint,5,opt,name=no_prorate,json=noProrate" json:"no_prorate,omitempty"`
+	ProrationDate int64  `protobuf:"varint,6,opt,name=proration_date,json=prorationDate" json:"proration_date,omitempty"`
+}
+
+func (m *UpcomingInvoiceRequest) Reset()                    { *m = UpcomingInvoiceRequest{} }
+func (m *UpcomingInvoiceRequest) String() string            { return proto.CompactTextString(m) }
+func (*UpcomingInvoiceRequest) ProtoMessage()               {}
+func (*UpcomingInvoiceRequest) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{4} }
+
+func (m *UpcomingInvoiceRequest) GetCustomer() string {
+	if m != nil {
+		return m.Customer
+	}
+	return ""
+}
+
+func (m *UpcomingInvoiceRequest) GetSubscription() string {
+	if m != nil {
+		return m.Subscription
+	}
+	return ""
+}
+
+func (m *UpcomingInvoiceRequest) GetPlan() string {
+	if m != nil {
+		return m.Plan
+	}
+	return ""
+}
+
+func (m *UpcomingInvoiceRequest) GetQuantity() uint64 {
+	if m != nil {
+		return m.Quantity
+	}
+	return 0
+}
+
+func (m *UpcomingInvoiceRequest) GetNoProrate() bool {
+	if m != nil {
+		return m.NoProrate
+	}
+	return false
+}
+
+func (m *UpcomingInvoiceRequest) GetProrationDate() int64 {
+	if m != nil {
+		return m.ProrationDate
+	}
+	return 0
+}
+
+type PayInvoiceRequest struct {
+	Id     string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Source string `protobuf:"bytes,2,opt,name=source" json:"source,omitempty"`
+}
+
+func (m *PayInvoiceRequest) Reset()                    { *m = PayInvoiceRequest{} }
+func (m *PayInvoiceRequest) String() string            { return proto.CompactTextString(m) }
+func (*PayInvoiceRequest) ProtoMessage()               {}
+func (*PayInvoiceRequest) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{5} }
+
+func (m *PayInvoiceRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *PayInvoiceRequest) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+type VoidInvoiceRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *VoidInvoiceRequest) Reset()                    { *m = VoidInvoiceRequest{} }
+func (m *VoidInvoiceRequest) String() string            { return proto.CompactTextString(m) }
+func (*VoidInvoiceRequest) ProtoMessage()               {}
+func (*VoidInvoiceRequest) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{6} }
+
+func (m *VoidInvoiceRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type MarkUncollectibleInvoiceRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *MarkUncollectibleInvoiceRequest) Reset()         { *m = MarkUncollectibleInvoiceRequest{} }
+func (m *MarkUncollectibleInvoiceRequest) String() string { return proto.CompactTextString(m) }
+func (*MarkUncollectibleInvoiceRequest) ProtoMessage()    {}
+func (*MarkUncollectibleInvoiceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor5, []int{7}
+}
+
+func (m *MarkUncollectibleInvoiceRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type ListInvoicesRequest struct {
+	Customer      string        `protobuf:"bytes,1,opt,name=customer" json:"customer,omitempty"`
+	Subscription  string        `protobuf:"bytes,2,opt,name=subscription" json:"subscription,omitempty"`
+	Status        InvoiceStatus `protobuf:"varint,3,opt,name=status,enum=InvoiceStatus" json:"status,omitempty"`
+	Created       *ListFilter   `protobuf:"bytes,4,opt,name=created" json:"created,omitempty"`
+	EndingBefore  string        `protobuf:"bytes,5,opt,name=ending_before,json=endingBefore" json:"ending_before,omitempty"`
+	StartingAfter string        `protobuf:"bytes,6,opt,name=starting_after,json=startingAfter" json:"starting_after,omitempty"`
+	Limit         int32         `protobuf:"varint,7,opt,name=limit" json:"limit,omitempty"`
+}
+
+func (m *ListInvoicesRequest) Reset()                    { *m = ListInvoicesRequest{} }
+func (m *ListInvoicesRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListInvoicesRequest) ProtoMessage()               {}
+func (*ListInvoicesRequest) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{8} }
+
+func (m *ListInvoicesRequest) GetCustomer() string {
+	if m != nil {
+		return m.Customer
+	}
+	return ""
+}
+
+func (m *ListInvoicesRequest) GetSubscription() string {
+	if m != nil {
+		return m.Subscription
+	}
+	return ""
+}
+
+func (m *ListInvoicesRequest) GetStatus() InvoiceStatus {
+	if m != nil {
+		return m.Status
+	}
+	return InvoiceStatus_UnknownInvoiceStatus
+}
+
+func (m *ListInvoicesRequest) GetCreated() *ListFilter {
+	if m != nil {
+		return m.Created
+	}
+	return nil
+}
+
+func (m *ListInvoicesRequest) GetEndingBefore() string {
+	if m != nil {
+		return m.EndingBefore
+	}
+	return ""
+}
+
+func (m *ListInvoicesRequest) GetStartingAfter() string {
+	if m != nil {
+		return m.StartingAfter
+	}
+	return ""
+}
+
+func (m *ListInvoicesRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*InvoiceResponse)(nil), "InvoiceResponse")
+	proto.RegisterType((*Invoice)(nil), "Invoice")
+	proto.RegisterType((*InvoiceLineItem)(nil), "InvoiceLineItem")
+	proto.RegisterType((*GetInvoiceRequest)(nil), "GetInvoiceRequest")
+	proto.RegisterType((*UpcomingInvoiceRequest)(nil), "UpcomingInvoiceRequest")
+	proto.RegisterType((*PayInvoiceRequest)(nil), "PayInvoiceRequest")
+	proto.RegisterType((*VoidInvoiceRequest)(nil), "VoidInvoiceRequest")
+	proto.RegisterType((*MarkUncollectibleInvoiceRequest)(nil), "MarkUncollectibleInvoiceRequest")
+	proto.RegisterType((*ListInvoicesRequest)(nil), "ListInvoicesRequest")
+	proto.RegisterEnum("InvoiceStatus", InvoiceStatus_name, InvoiceStatus_value)
+	proto.RegisterEnum("InvoiceLineType", InvoiceLineType_name, InvoiceLineType_value)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for Invoices service
+
+type InvoicesClient interface {
+	GetInvoice(ctx context.Context, in *GetInvoiceRequest, opts ...grpc.CallOption) (*InvoiceResponse, error)
+	UpcomingInvoice(ctx context.Context, in *UpcomingInvoiceRequest, opts ...grpc.CallOption) (*InvoiceResponse, error)
+	PayInvoice(ctx context.Context, in *PayInvoiceRequest, opts ...grpc.CallOption) (*InvoiceResponse, error)
+	VoidInvoice(ctx context.Context, in *VoidInvoiceRequest, opts ...grpc.CallOption) (*InvoiceResponse, error)
+	MarkUncollectibleInvoice(ctx context.Context, in *MarkUncollectibleInvoiceRequest, opts ...grpc.CallOption) (*InvoiceResponse, error)
+	ListInvoices(ctx context.Context, in *ListInvoicesRequest, opts ...grpc.CallOption) (Invoices_ListInvoicesClient, error)
+}
+
+type invoicesClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewInvoicesClient(cc *grpc.ClientConn) InvoicesClient {
+	return &invoicesClient{cc}
+}
+
+func (c *invoicesClient) GetInvoice(ctx context.Context, in *GetInvoiceRequest, opts ...grpc.CallOption) (*InvoiceResponse, error) {
+	out := new(InvoiceResponse)
+	err := grpc.Invoke(ctx, "/Invoices/GetInvoice", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoicesClient) UpcomingInvoice(ctx context.Context, in *UpcomingInvoiceRequest, opts ...grpc.CallOption) (*InvoiceResponse, error) {
+	out := new(InvoiceResponse)
+	err := grpc.Invoke(ctx, "/Invoices/UpcomingInvoice", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoicesClient) PayInvoice(ctx context.Context, in *PayInvoiceRequest, opts ...grpc.CallOption) (*InvoiceResponse, error) {
+	out := new(InvoiceResponse)
+	err := grpc.Invoke(ctx, "/Invoices/PayInvoice", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoicesClient) VoidInvoice(ctx context.Context, in *VoidInvoiceRequest, opts ...grpc.CallOption) (*InvoiceResponse, error) {
+	out := new(InvoiceResponse)
+	err := grpc.Invoke(ctx, "/Invoices/VoidInvoice", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoicesClient) MarkUncollectibleInvoice(ctx context.Context, in *MarkUncollectibleInvoiceRequest, opts ...grpc.CallOption) (*InvoiceResponse, error) {
+	out := new(InvoiceResponse)
+	err := grpc.Invoke(ctx, "/Invoices/MarkUncollectibleInvoice", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoicesClient) ListInvoices(ctx context.Context, in *ListInvoicesRequest, opts ...grpc.CallOption) (Invoices_ListInvoicesClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Invoices_serviceDesc.Streams[0], c.cc, "/Invoices/ListInvoices", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &invoicesListInvoicesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Invoices_ListInvoicesClient interface {
+	Recv() (*InvoiceResponse, error)
+	grpc.ClientStream
+}
+
+type invoicesListInvoicesClient struct {
+	grpc.ClientStream
+}
+
+func (x *invoicesListInvoicesClient) Recv() (*InvoiceResponse, error) {
+	m := new(InvoiceResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Server API for Invoices service
+
+type InvoicesServer interface {
+	GetInvoice(context.Context, *GetInvoiceRequest) (*InvoiceResponse, error)
+	UpcomingInvoice(context.Context, *UpcomingInvoiceRequest) (*InvoiceResponse, error)
+	PayInvoice(context.Context, *PayInvoiceRequest) (*InvoiceResponse, error)
+	VoidInvoice(context.Context, *VoidInvoiceRequest) (*InvoiceResponse, error)
+	MarkUncollectibleInvoice(context.Context, *MarkUncollectibleInvoiceRequest) (*InvoiceResponse, error)
+	ListInvoices(*ListInvoicesRequest, Invoices_ListInvoicesServer) error
+}
+
+func RegisterInvoicesServer(s *grpc.Server, srv InvoicesServer) {
+	s.RegisterService(&_Invoices_serviceDesc, srv)
+}
+
+func _Invoices_GetInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInvoiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoicesServer).GetInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Invoices/GetInvoice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoicesServer).GetInvoice(ctx, req.(*GetInvoiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Invoices_UpcomingInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpcomingInvoiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoicesServer).UpcomingInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Invoices/UpcomingInvoice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoicesServer).UpcomingInvoice(ctx, req.(*UpcomingInvoiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Invoices_PayInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PayInvoiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoicesServer).PayInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Invoices/PayInvoice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoicesServer).PayInvoice(ctx, req.(*PayInvoiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Invoices_VoidInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoidInvoiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoicesServer).VoidInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Invoices/VoidInvoice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoicesServer).VoidInvoice(ctx, req.(*VoidInvoiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Invoices_MarkUncollectibleInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkUncollectibleInvoiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoicesServer).MarkUncollectibleInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Invoices/MarkUncollectibleInvoice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoicesServer).MarkUncollectibleInvoice(ctx, req.(*MarkUncollectibleInvoiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Invoices_ListInvoices_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListInvoicesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(InvoicesServer).ListInvoices(m, &invoicesListInvoicesServer{stream})
+}
+
+type Invoices_ListInvoicesServer interface {
+	Send(*InvoiceResponse) error
+	grpc.ServerStream
+}
+
+type invoicesListInvoicesServer struct {
+	grpc.ServerStream
+}
+
+func (x *invoicesListInvoicesServer) Send(m *InvoiceResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _Invoices_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "Invoices",
+	HandlerType: (*InvoicesServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetInvoice",
+			Handler:    _Invoices_GetInvoice_Handler,
+		},
+		{
+			MethodName: "UpcomingInvoice",
+			Handler:    _Invoices_UpcomingInvoice_Handler,
+		},
+		{
+			MethodName: "PayInvoice",
+			Handler:    _Invoices_PayInvoice_Handler,
+		},
+		{
+			MethodName: "VoidInvoice",
+			Handler:    _Invoices_VoidInvoice_Handler,
+		},
+		{
+			MethodName: "MarkUncollectibleInvoice",
+			Handler:    _Invoices_MarkUncollectibleInvoice_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ListInvoices",
+			Handler:       _Invoices_ListInvoices_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "invoice.proto",
+}
+
+func init() { proto.RegisterFile("invoice.proto", fileDescriptor5) }
+
+var fileDescriptor5 = []byte{
+	// 1213 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x36, 0x65, 0xc9, 0xa6, 0x46, 0x7f, 0xf4, 0x5a, 0x75, 0x36, 0x6e, 0x7e, 0x54, 0xc5, 0x09,
+	0xd4, 0x1c, 0x88, 0xc4, 0x0d, 0x8a, 0x22, 0x01, 0x0a, 0x24, 0x71, 0xda, 0x04, 0x48, 0x5a, 0x95,
+	0x69, 0x7a, 0x15, 0x56, 0xe4, 0xd8, 0x21, 0x42, 0xed, 0x32, 0xcb, 0xa5, 0x6b, 0xdd, 0x7a, 0xea,
+	0x0b, 0xf5, 0x3d, 0xfa, 0x44, 0x3d, 0x14, 0xbb, 0x4b, 0xd2, 0xfa, 0x4b, 0x7c, 0x68, 0x6f, 0x3b,
+	0xdf, 0x37, 0x3b, 0xe2, 0xce, 0x7c, 0x33, 0x23, 0xe8, 0xc4, 0xfc, 0x5c, 0xc4, 0x21, 0xfa, 0xa9,
+	0x14, 0x4a, 0x1c, 0x7a, 0x61, 0x2e, 0x25, 0xf2, 0x30, 0xc6, 0xac, 0x40, 0x5a, 0x28, 0xa5, 0x90,
+	0x85, 0x01, 0x69, 0xc2, 0xb8, 0x3d, 0x0f, 0x23, 0xe8, 0xbd, 0xb2, 0x77, 0x03, 0xcc, 0x52, 0xc1,
+	0x33, 0x24, 0xb7, 0xa0, 0x61, 0xbc, 0xa9, 0x33, 0x70, 0x46, 0xad, 0xe3, 0x1d, 0xff, 0x85, 0xb6,
+	0x5e, 0x6e, 0x05, 0x16, 0x26, 0x47, 0xb0, 0x9b, 0xe5, 0x61, 0x88, 0x59, 0x46, 0x6b, 0xc6, 0xc3,
+	0xf5, 0x8b, 0x10, 0x2f, 0xb7, 0x82, 0x92, 0x7a, 0xd6, 0x82, 0xa6, 0x2c, 0x22, 0x66, 0xc3, 0xbf,
+	0x5c, 0xd8, 0x2d, 0x7c, 0x48, 0x17, 0x6a, 0x71, 0x64, 0x62, 0x37, 0x83, 0x5a, 0x1c, 0x91, 0x43,
+	0x70, 0xc3, 0x3c, 0x53, 0x62, 0x86, 0xd2, 0xc4, 0x6b, 0x06, 0x95, 0x4d, 0x86, 0xd0, 0xce, 0xf2,
+	0x69, 0x16, 0xca, 0x38, 0x55, 0xb1, 0xe0, 0x74, 0xdb, 0xf0, 0x4b, 0x18, 0xb9, 0x07, 0x3b, 0x99,
+	0x62, 0x2a, 0xcf, 0x68, 0x7d, 0xe0, 0x8c, 0xba, 0xc7, 0xdd, 0xf2, 0x6b, 0xde, 0x1a, 0x34, 0x28,
+	0x58, 0x72, 0x13, 0x80, 0xcd, 0x44, 0xce, 0xd5, 0x24, 0xca, 0x91, 0x36, 0x06, 0xce, 0x68, 0x3b,
+	0x68, 0x5a, 0xe4, 0x24, 0x47, 0xfd, 0x19, 0x59, 0x3e, 0x55, 0x42, 0xb1, 0x84, 0xee, 0x18, 0xb2,
+	0xb2, 0x89, 0x07, 0xdb, 0x8a, 0x5d, 0xd0, 0x5d, 0x03, 0xeb, 0x23, 0xb9, 0x0d, 0x2d, 0xc5, 0x2e,
+	0x26, 0x29, 0xca, 0x10, 0xb9, 0xa2, 0xee, 0xc0, 0x19, 0x39, 0x01, 0x28, 0x76, 0x31, 0xb6, 0x08,
+	0xe9, 0x43, 0xc3, 0xc6, 0x6a, 0x9a, 0x4b, 0xd6, 0x20, 0x77, 0xc1, 0x2d, 0x4a, 0x33, 0xa7, 0x60,
+	0xbe, 0xb6, 0xe9, 0x3f, 0x2f, 0x80, 0xa0, 0xa2, 0xc8, 0xd7, 0xe0, 0x65, 0x8a, 0x49, 0x15, 0xf3,
+	0xb3, 0xc9, 0x94, 0x25, 0x8c, 0x87, 0x48, 0x5b, 0x26, 0x4e, 0xaf, 0xc4, 0x9f, 0x59, 0x98, 0xdc,
+	0x85, 0x2e, 0xf2, 0x68, 0xd1, 0xb1, 0x6d, 0x1c, 0x3b, 0x16, 0x2d, 0xdd, 0xee, 0x40, 0x87, 0x29,
+	0x85, 0xb3, 0x54, 0x4d, 0x42, 0xfd, 0x62, 0xda, 0x19, 0x38, 0xa3, 0x7a, 0xd0, 0x2e, 0xc0, 0xe7,
+	0x1a, 0x23, 0x37, 0xa0, 0x59, 0xd8, 0x18, 0xd1, 0xee, 0xc0, 0x19, 0xb9, 0xc1, 0x25, 0x40, 0x08,
+	0xd4, 0x53, 0x16, 0x47, 0xb4, 0x67, 0x08, 0x73, 0x26, 0x07, 0xb0, 0x13, 0x26, 0x22, 0xc3, 0x88,
+	0x7a, 0x06, 0x2d, 0x2c, 0x9d, 0xcc, 0x53, 0x21, 0xcf, 0xe2, 0x73, 0xe4, 0x74, 0xcf, 0x30, 0x95,
+	0xad, 0xe3, 0x44, 0x4c, 0x21, 0x25, 0xe6, 0x3b, 0xcd, 0x99, 0x5c, 0x07, 0x37, 0xca, 0x71, 0x62,
+	0xf0, 0x7d, 0x83, 0xef, 0x46, 0x39, 0x9e, 0x68, 0xea, 0x2b, 0x68, 0xa7, 0x28, 0x63, 0x11, 0x4d,
+	0xcc, 0xd3, 0x69, 0xdf, 0xd0, 0x2d, 0x8b, 0xbd, 0xd5, 0x90, 0xae, 0x6c, 0xe1, 0x82, 0x3c, 0xa2,
+	0x5f, 0xd8, 0xca, 0x5a, 0xe4, 0x05, 0x8f, 0xc8, 0x03, 0xe8, 0x73, 0xbc, 0x50, 0x93, 0x94, 0xcd,
+	0x67, 0xc8, 0xd5, 0xa4, 0x78, 0x12, 0x3d, 0x30, 0x8e, 0x44, 0x73, 0x63, 0x4b, 0x3d, 0xb5, 0x0c,
+	0x19, 0x40, 0x2b, 0xc2, 0x4b, 0xd5, 0x5d, 0x33, 0xaa, 0x5b, 0x84, 0xc8, 0x43, 0xe8, 0x6b, 0x59,
+	0xa1, 0x09, 0x58, 0x12, 0x42, 0x52, 0x6a, 0x5c, 0xf7, 0x2b, 0xee, 0xa4, 0xa2, 0x74, 0xa5, 0x24,
+	0x86, 0x18, 0xa7, 0x6a, 0xc2, 0xf3, 0xd9, 0x14, 0x25, 0xbd, 0x6e, 0x9c, 0x3b, 0x05, 0xfa, 0x93,
+	0x01, 0x75, 0x4a, 0x0b, 0xfa, 0xd0, 0xd0, 0x3b, 0xbc, 0xc2, 0xc3, 0xf7, 0x4c, 0x9e, 0x21, 0xfd,
+	0xd2, 0xe2, 0xd6, 0x22, 0xf7, 0xa0, 0x91, 0xc4, 0x1c, 0x33, 0x7a, 0x63, 0xb0, 0x3d, 0x6a, 0x1d,
+	0x7b, 0xa5, 0xfa, 0x5f, 0xc7, 0x1c, 0x5f, 0x29, 0x9c, 0x05, 0x96, 0x26, 0x47, 0xd0, 0x35, 0x87,
+	0xc9, 0x7b, 0x96, 0x4d, 0x66, 0x42, 0x22, 0xbd, 0x69, 0x0a, 0xd3, 0x36, 0xe8, 0x4b, 0x96, 0xbd,
+	0x11, 0x12, 0xc9, 0x31, 0xb8, 0x33, 0x54, 0x2c, 0x62, 0x8a, 0xd1, 0x5b, 0x26, 0xe0, 0x41, 0x19,
+	0xd0, 0x7f, 0x53, 0x10, 0x2f, 0xb8, 0x92, 0xf3, 0xa0, 0xf2, 0xd3, 0xc5, 0x4e, 0xe2, 0x73, 0x9c,
+	0x89, 0x08, 0xe9, 0x6d, 0x5b, 0xec, 0xd2, 0x3e, 0x7c, 0x02, 0x9d, 0xa5, 0x6b, 0xba, 0x95, 0x3e,
+	0xe0, 0xbc, 0x68, 0x7f, 0x7d, 0xd4, 0x9d, 0x72, 0xce, 0x92, 0x1c, 0x8b, 0xe6, 0xb7, 0xc6, 0xe3,
+	0xda, 0x77, 0xce, 0xf0, 0x8f, 0x3a, 0xf4, 0x56, 0x5e, 0xb3, 0x36, 0x3d, 0x8e, 0xa0, 0xae, 0xe6,
+	0xa9, 0xbd, 0xdc, 0x5d, 0x7e, 0xfd, 0xaf, 0xf3, 0x14, 0x03, 0xc3, 0xea, 0xe4, 0xd9, 0x4e, 0x37,
+	0x13, 0x64, 0x3b, 0x28, 0xac, 0xa5, 0x7e, 0xac, 0x7f, 0xba, 0x1f, 0x57, 0xf4, 0xd0, 0x58, 0xd7,
+	0xc3, 0x10, 0xda, 0x51, 0x9c, 0x99, 0xd6, 0x62, 0xd3, 0x04, 0xcd, 0x04, 0x71, 0x83, 0x25, 0x6c,
+	0x4d, 0xc9, 0xbb, 0x57, 0x29, 0xd9, 0x5d, 0x55, 0xf2, 0x75, 0xa8, 0xeb, 0xd1, 0x6d, 0x66, 0x4a,
+	0xeb, 0xb8, 0xe1, 0x8f, 0x13, 0xc6, 0x03, 0x03, 0xe9, 0xde, 0x4d, 0xa5, 0x90, 0xcc, 0x7c, 0x20,
+	0xd8, 0xde, 0xad, 0x00, 0x5d, 0xa2, 0x8f, 0x39, 0xe3, 0x2a, 0x56, 0xf3, 0x62, 0x90, 0x54, 0xf6,
+	0xda, 0x8c, 0x6d, 0x6f, 0x98, 0xb1, 0x8f, 0x17, 0x64, 0xd1, 0x31, 0xb2, 0xb8, 0xb5, 0xaa, 0xb3,
+	0x4f, 0xc9, 0xe3, 0xbf, 0x49, 0xe0, 0x0e, 0xec, 0xfd, 0x88, 0xaa, 0xda, 0x50, 0x1f, 0x73, 0xcc,
+	0xd4, 0xaa, 0x06, 0x86, 0x7f, 0x3b, 0x70, 0xf0, 0x2e, 0x0d, 0xc5, 0x2c, 0xe6, 0x67, 0x2b, 0xae,
+	0x8b, 0xcb, 0xc5, 0xb9, 0x62, 0xb9, 0xd4, 0x36, 0x3c, 0x9c, 0x14, 0x19, 0xb7, 0x8b, 0xc7, 0x9c,
+	0x97, 0x92, 0x59, 0x37, 0x63, 0xf4, 0x32, 0x99, 0x37, 0x01, 0xb8, 0x98, 0xd8, 0xc4, 0xdb, 0x25,
+	0xe3, 0x06, 0x4d, 0x2e, 0xc6, 0x16, 0xd0, 0x33, 0xa0, 0x2a, 0x8a, 0x9d, 0x76, 0x76, 0xd5, 0x74,
+	0x2a, 0x54, 0xcf, 0xbc, 0xe1, 0x13, 0xd8, 0x1b, 0xb3, 0xf9, 0xe7, 0x5f, 0xad, 0x35, 0x9d, 0x89,
+	0x5c, 0x86, 0x65, 0xd6, 0x0a, 0x6b, 0x78, 0x04, 0xe4, 0x37, 0x11, 0x47, 0x57, 0xe4, 0xec, 0x21,
+	0xdc, 0x7e, 0xc3, 0xe4, 0x87, 0x77, 0x3c, 0x14, 0x49, 0x82, 0xa1, 0x8a, 0xa7, 0x09, 0x5e, 0x71,
+	0xe5, 0xcf, 0x1a, 0xec, 0xbf, 0x8e, 0xb3, 0xb2, 0x1a, 0xd9, 0xff, 0x95, 0xe3, 0xcb, 0x05, 0xbe,
+	0xfd, 0xd9, 0x05, 0x7e, 0x17, 0x76, 0x43, 0x89, 0x4c, 0x2f, 0xa7, 0xba, 0x69, 0x80, 0x96, 0xaf,
+	0x3f, 0xe7, 0x87, 0x38, 0x51, 0x28, 0x83, 0x92, 0xd3, 0xab, 0xae, 0xdc, 0x88, 0x78, 0x2a, 0xa4,
+	0xad, 0x42, 0x33, 0x68, 0x17, 0x0b, 0xd1, 0x60, 0xba, 0x10, 0xd5, 0x86, 0x65, 0xa7, 0x0a, 0xa5,
+	0x29, 0x44, 0x33, 0xe8, 0x94, 0xe8, 0x53, 0x0d, 0x6a, 0x61, 0x26, 0xf1, 0x2c, 0xb6, 0xbd, 0xda,
+	0x08, 0xac, 0x71, 0x1f, 0xa1, 0xb3, 0xf4, 0x85, 0x84, 0x42, 0xff, 0x1d, 0xff, 0xc0, 0xc5, 0xef,
+	0x7c, 0x09, 0xf7, 0xb6, 0x48, 0x13, 0x1a, 0x27, 0x92, 0x9d, 0x2a, 0xcf, 0x21, 0x2e, 0xd4, 0x7f,
+	0x4e, 0x91, 0x7b, 0x35, 0x7d, 0x1a, 0xb3, 0x38, 0xf2, 0xb6, 0xc9, 0x1e, 0x74, 0x96, 0x2a, 0xe0,
+	0xd5, 0x35, 0xa9, 0xcb, 0xe7, 0x35, 0xee, 0xff, 0x02, 0xbd, 0x95, 0x69, 0x46, 0xf6, 0xa1, 0x57,
+	0xfc, 0x50, 0x09, 0x79, 0x5b, 0x64, 0xbf, 0xf2, 0xd3, 0x7d, 0xa8, 0x09, 0xcf, 0x21, 0x7d, 0xf0,
+	0xde, 0x2e, 0x24, 0xd9, 0xa0, 0xb5, 0xe3, 0x7f, 0x6a, 0xe0, 0x96, 0xe5, 0x23, 0x8f, 0x00, 0x2e,
+	0x7b, 0x8b, 0x10, 0x7f, 0xad, 0xd1, 0x0e, 0x3d, 0x7f, 0xe5, 0xbf, 0xe1, 0x70, 0x8b, 0x7c, 0x0f,
+	0xbd, 0x95, 0x5e, 0x23, 0xd7, 0xfc, 0xcd, 0xdd, 0xb7, 0xf1, 0xfe, 0x23, 0x80, 0x4b, 0x6d, 0x13,
+	0xe2, 0xaf, 0x09, 0x7d, 0xe3, 0xad, 0x6f, 0xa1, 0xb5, 0x20, 0x6a, 0xb2, 0xef, 0xaf, 0x4b, 0x7c,
+	0xe3, 0xbd, 0x31, 0xd0, 0x4f, 0xc9, 0x9c, 0x0c, 0xfc, 0x2b, 0x3a, 0x60, 0x63, 0xc4, 0xc7, 0xd0,
+	0x5e, 0x6c, 0x02, 0xd2, 0xf7, 0x37, 0xf4, 0xc4, 0xa6, 0x9b, 0x0f, 0x9c, 0xe9, 0x8e, 0xf9, 0xcf,
+	0xfd, 0xcd, 0xbf, 0x03, 0x00, 0xd8, 0xcd, 0x4a, 0xae, 0xaf, 0x0b, 0x00, 0x00,
+}
@@ -45,7 +45,163 @@ var Interval_value = map[string]int32{
 func (x Interval) String() string {
 	return proto.EnumName(Interval_name, int32(x))
 }
-func (Interval) EnumDescriptor() ([]byte, []int) { return fileDescriptor2, []int{0} }
+func (Interval) EnumDescriptor() ([]byte, []int) { return fileDescriptor6, []int{0} }
+
+type BillingScheme int32
+
+const (
+	BillingScheme_PerUnit BillingScheme = 0
+	BillingScheme_Tiered  BillingScheme = 1
+)
+
+var BillingScheme_name = map[int32]string{
+	0: "PerUnit",
+	1: "Tiered",
+}
+var BillingScheme_value = map[string]int32{
+	"PerUnit": 0,
+	"Tiered":  1,
+}
+
+func (x BillingScheme) String() string {
+	return proto.EnumName(BillingScheme_name, int32(x))
+}
+func (BillingScheme) EnumDescriptor() ([]byte, []int) { return fileDescriptor6, []int{1} }
+
+type TiersMode int32
+
+const (
+	TiersMode_UnknownTiersMode TiersMode = 0
+	TiersMode_Graduated        TiersMode = 1
+	TiersMode_Volume           TiersMode = 2
+)
+
+var TiersMode_name = map[int32]string{
+	0: "UnknownTiersMode",
+	1: "Graduated",
+	2: "Volume",
+}
+var TiersMode_value = map[string]int32{
+	"UnknownTiersMode": 0,
+	"Graduated":        1,
+	"Volume":           2,
+}
+
+func (x TiersMode) String() string {
+	return proto.EnumName(TiersMode_name, int32(x))
+}
+func (TiersMode) EnumDescriptor() ([]byte, []int) { return fileDescriptor6, []int{2} }
+
+type UsageType int32
+
+const (
+	UsageType_Licensed UsageType = 0
+	UsageType_Metered  UsageType = 1
+)
+
+var UsageType_name = map[int32]string{
+	0: "Licensed",
+	1: "Metered",
+}
+var UsageType_value = map[string]int32{
+	"Licensed": 0,
+	"Metered":  1,
+}
+
+func (x UsageType) String() string {
+	return proto.EnumName(UsageType_name, int32(x))
+}
+func (UsageType) EnumDescriptor() ([]byte, []int) { return fileDescriptor6, []int{3} }
+
+type AggregateUsage int32
+
+const (
+	AggregateUsage_UnknownAggregateUsage AggregateUsage = 0
+	AggregateUsage_Sum                   AggregateUsage = 1
+	AggregateUsage_LastDuringPeriod      AggregateUsage = 2
+	AggregateUsage_LastEver              AggregateUsage = 3
+	AggregateUsage_Max                   AggregateUsage = 4
+)
+
+var AggregateUsage_name = map[int32]string{
+	0: "UnknownAggregateUsage",
+	1: "Sum",
+	2: "LastDuringPeriod",
+	3: "LastEver",
+	4: "Max",
+}
+var AggregateUsage_value = map[string]int32{
+	"UnknownAggregateUsage": 0,
+	"Sum":                   1,
+	"LastDuringPeriod":      2,
+	"LastEver":              3,
+	"Max":                   4,
+}
+
+func (x AggregateUsage) String() string {
+	return proto.EnumName(AggregateUsage_name, int32(x))
+}
+func (AggregateUsage) EnumDescriptor() ([]byte, []int) { return fileDescriptor6, []int{4} }
+
+// ActiveState selects resources by whether they are active.  In an update it sets the active
+// flag, and ActiveUnset leaves it unchanged.
+type ActiveState int32
+
+const (
+	ActiveState_ActiveUnset ActiveState = 0
+	ActiveState_ActiveTrue  ActiveState = 1
+	ActiveState_ActiveFalse ActiveState = 2
+)
+
+var ActiveState_name = map[int32]string{
+	0: "ActiveUnset",
+	1: "ActiveTrue",
+	2: "ActiveFalse",
+}
+var ActiveState_value = map[string]int32{
+	"ActiveUnset": 0,
+	"ActiveTrue":  1,
+	"ActiveFalse": 2,
+}
+
+func (x ActiveState) String() string {
+	return proto.EnumName(ActiveState_name, int32(x))
+}
+func (ActiveState) EnumDescriptor() ([]byte, []int) { return fileDescriptor6, []int{5} }
+
+// PlanTier is a price band of a tiered plan.  The last tier has up_to = 0 and covers every
+// quantity above the previous tier.
+type PlanTier struct {
+	UpTo       uint64 `protobuf:"varint,1,opt,name=up_to,json=upTo" json:"up_to,omitempty"`
+	UnitAmount uint64 `protobuf:"varint,2,opt,name=unit_amount,json=unitAmount" json:"unit_amount,omitempty"`
+	FlatAmount uint64 `protobuf:"varint,3,opt,name=flat_amount,json=flatAmount" json:"flat_amount,omitempty"`
+}
+
+func (m *PlanTier) Reset()                    { *m = PlanTier{} }
+func (m *PlanTier) String() string            { return proto.CompactTextString(m) }
+func (*PlanTier) ProtoMessage()               {}
+func (*PlanTier) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{0} }
+
+func (m *PlanTier) GetUpTo() uint64 {
+	if m != nil {
+		return m.UpTo
+	}
+	return 0
+}
+
+func (m *PlanTier) GetUnitAmount() uint64 {
+	if m != nil {
+		return m.UnitAmount
+	}
+	return 0
+}
+
+func (m *PlanTier) GetFlatAmount() uint64 {
+	if m != nil {
+		return m.FlatAmount
+	}
+	return 0
+}
 
 type PlanResponse struct {
 	// Types that are valid to be assigned to Responses:
@@ -57,7 +213,7 @@ type PlanResponse struct {
 func (m *PlanResponse) Reset()                    { *m = PlanResponse{} }
 func (m *PlanResponse) String() string            { return proto.CompactTextString(m) }
 func (*PlanResponse) ProtoMessage()               {}
-func (*PlanResponse) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{0} }
+func (*PlanResponse) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{1} }
 
 type isPlanResponse_Responses interface {
 	isPlanResponse_Responses()
@@ -180,12 +336,19 @@ type Plan struct {
 	Name                string            `protobuf:"bytes,9,opt,name=name" json:"name,omitempty"`
 	StatementDescriptor string            `protobuf:"bytes,10,opt,name=statement_descriptor,json=statementDescriptor" json:"statement_descriptor,omitempty"`
 	TrialPeriodDays     uint64            `protobuf:"varint,11,opt,name=trial_period_days,json=trialPeriodDays" json:"trial_period_days,omitempty"`
+	BillingScheme       BillingScheme     `protobuf:"varint,12,opt,name=billing_scheme,json=billingScheme,enum=BillingScheme" json:"billing_scheme,omitempty"`
+	Tiers               []*PlanTier       `protobuf:"bytes,13,rep,name=tiers" json:"tiers,omitempty"`
+	TiersMode           TiersMode         `protobuf:"varint,14,opt,name=tiers_mode,json=tiersMode,enum=TiersMode" json:"tiers_mode,omitempty"`
+	UsageType           UsageType         `protobuf:"varint,15,opt,name=usage_type,json=usageType,enum=UsageType" json:"usage_type,omitempty"`
+	AggregateUsage      AggregateUsage    `protobuf:"varint,16,opt,name=aggregate_usage,json=aggregateUsage,enum=AggregateUsage" json:"aggregate_usage,omitempty"`
+	Product             string            `protobuf:"bytes,17,opt,name=product" json:"product,omitempty"`
+	Active              bool              `protobuf:"varint,18,opt,name=active" json:"active,omitempty"`
 }
 
 func (m *Plan) Reset()                    { *m = Plan{} }
 func (m *Plan) String() string            { return proto.CompactTextString(m) }
 func (*Plan) ProtoMessage()               {}
-func (*Plan) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{1} }
+func (*Plan) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{2} }
 
 func (m *Plan) GetId() string {
 	if m != nil {
@@ -264,6 +427,55 @@ func (m *Plan) GetTrialPeriodDays() uint64 {
 	return 0
 }
 
+func (m *Plan) GetBillingScheme() BillingScheme {
+	if m != nil {
+		return m.BillingScheme
+	}
+	return BillingScheme_PerUnit
+}
+
+func (m *Plan) GetTiers() []*PlanTier {
+	if m != nil {
+		return m.Tiers
+	}
+	return nil
+}
+
+func (m *Plan) GetTiersMode() TiersMode {
+	if m != nil {
+		return m.TiersMode
+	}
+	return TiersMode_UnknownTiersMode
+}
+
+func (m *Plan) GetUsageType() UsageType {
+	if m != nil {
+		return m.UsageType
+	}
+	return UsageType_Licensed
+}
+
+func (m *Plan) GetAggregateUsage() AggregateUsage {
+	if m != nil {
+		return m.AggregateUsage
+	}
+	return AggregateUsage_UnknownAggregateUsage
+}
+
+func (m *Plan) GetProduct() string {
+	if m != nil {
+		return m.Product
+	}
+	return ""
+}
+
+func (m *Plan) GetActive() bool {
+	if m != nil {
+		return m.Active
+	}
+	return false
+}
+
 type CreatePlanRequest struct {
 	Id                  string            `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
 	Amount              uint64            `protobuf:"varint,2,opt,name=amount" json:"amount,omitempty"`
@@ -274,12 +486,18 @@ type CreatePlanRequest struct {
 	Metadata            map[string]string `protobuf:"bytes,7,rep,name=metadata" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	StatementDescriptor string            `protobuf:"bytes,8,opt,name=statement_descriptor,json=statementDescriptor" json:"statement_descriptor,omitempty"`
 	TrialPeriodDays     uint64            `protobuf:"varint,9,opt,name=trial_period_days,json=trialPeriodDays" json:"trial_period_days,omitempty"`
+	BillingScheme       BillingScheme     `protobuf:"varint,10,opt,name=billing_scheme,json=billingScheme,enum=BillingScheme" json:"billing_scheme,omitempty"`
+	Tiers               []*PlanTier       `protobuf:"bytes,11,rep,name=tiers" json:"tiers,omitempty"`
+	TiersMode           TiersMode         `protobuf:"varint,12,opt,name=tiers_mode,json=tiersMode,enum=TiersMode" json:"tiers_mode,omitempty"`
+	UsageType           UsageType         `protobuf:"varint,13,opt,name=usage_type,json=usageType,enum=UsageType" json:"usage_type,omitempty"`
+	AggregateUsage      AggregateUsage    `protobuf:"varint,14,opt,name=aggregate_usage,json=aggregateUsage,enum=AggregateUsage" json:"aggregate_usage,omitempty"`
+	Product             string            `protobuf:"bytes,15,opt,name=product" json:"product,omitempty"`
 }
 
 func (m *CreatePlanRequest) Reset()                    { *m = CreatePlanRequest{} }
 func (m *CreatePlanRequest) String() string            { return proto.CompactTextString(m) }
 func (*CreatePlanRequest) ProtoMessage()               {}
-func (*CreatePlanRequest) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{2} }
+func (*CreatePlanRequest) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{3} }
 
 func (m *CreatePlanRequest) GetId() string {
 	if m != nil {
@@ -344,6 +562,48 @@ func (m *CreatePlanRequest) GetTrialPeriodDays() uint64 {
 	return 0
 }
 
+func (m *CreatePlanRequest) GetBillingScheme() BillingScheme {
+	if m != nil {
+		return m.BillingScheme
+	}
+	return BillingScheme_PerUnit
+}
+
+func (m *CreatePlanRequest) GetTiers() []*PlanTier {
+	if m != nil {
+		return m.Tiers
+	}
+	return nil
+}
+
+func (m *CreatePlanRequest) GetTiersMode() TiersMode {
+	if m != nil {
+		return m.TiersMode
+	}
+	return TiersMode_UnknownTiersMode
+}
+
+func (m *CreatePlanRequest) GetUsageType() UsageType {
+	if m != nil {
+		return m.UsageType
+	}
+	return UsageType_Licensed
+}
+
+func (m *CreatePlanRequest) GetAggregateUsage() AggregateUsage {
+	if m != nil {
+		return m.AggregateUsage
+	}
+	return AggregateUsage_UnknownAggregateUsage
+}
+
+func (m *CreatePlanRequest) GetProduct() string {
+	if m != nil {
+		return m.Product
+	}
+	return ""
+}
+
 type GetPlanRequest struct {
 	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
 }
@@ -351,7 +611,7 @@ type GetPlanRequest struct {
 func (m *GetPlanRequest) Reset()                    { *m = GetPlanRequest{} }
 func (m *GetPlanRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetPlanRequest) ProtoMessage()               {}
-func (*GetPlanRequest) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{3} }
+func (*GetPlanRequest) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{4} }
 
 func (m *GetPlanRequest) GetId() string {
 	if m != nil {
@@ -366,12 +626,13 @@ type UpdatePlanRequest struct {
 	Name                string            `protobuf:"bytes,3,opt,name=name" json:"name,omitempty"`
 	StatementDescriptor string            `protobuf:"bytes,4,opt,name=statement_descriptor,json=statementDescriptor" json:"statement_descriptor,omitempty"`
 	TrialPeriodDays     uint64            `protobuf:"varint,5,opt,name=trial_period_days,json=trialPeriodDays" json:"trial_period_days,omitempty"`
+	Active              ActiveState       `protobuf:"varint,6,opt,name=active,enum=ActiveState" json:"active,omitempty"`
 }
 
 func (m *UpdatePlanRequest) Reset()                    { *m = UpdatePlanRequest{} }
 func (m *UpdatePlanRequest) String() string            { return proto.CompactTextString(m) }
 func (*UpdatePlanRequest) ProtoMessage()               {}
-func (*UpdatePlanRequest) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{4} }
+func (*UpdatePlanRequest) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{5} }
 
 func (m *UpdatePlanRequest) GetId() string {
 	if m != nil {
@@ -408,6 +669,13 @@ func (m *UpdatePlanRequest) GetTrialPeriodDays() uint64 {
 	return 0
 }
 
+func (m *UpdatePlanRequest) GetActive() ActiveState {
+	if m != nil {
+		return m.Active
+	}
+	return ActiveState_ActiveUnset
+}
+
 type DeletePlanRequest struct {
 	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
 }
@@ -415,7 +683,7 @@ type DeletePlanRequest struct {
 func (m *DeletePlanRequest) Reset()                    { *m = DeletePlanRequest{} }
 func (m *DeletePlanRequest) String() string            { return proto.CompactTextString(m) }
 func (*DeletePlanRequest) ProtoMessage()               {}
-func (*DeletePlanRequest) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{5} }
+func (*DeletePlanRequest) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{6} }
 
 func (m *DeletePlanRequest) GetId() string {
 	if m != nil {
@@ -432,7 +700,7 @@ type DeletePlanSuccess struct {
 func (m *DeletePlanSuccess) Reset()                    { *m = DeletePlanSuccess{} }
 func (m *DeletePlanSuccess) String() string            { return proto.CompactTextString(m) }
 func (*DeletePlanSuccess) ProtoMessage()               {}
-func (*DeletePlanSuccess) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{6} }
+func (*DeletePlanSuccess) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{7} }
 
 func (m *DeletePlanSuccess) GetDeleted() bool {
 	if m != nil {
@@ -458,7 +726,7 @@ type DeletePlanResponse struct {
 func (m *DeletePlanResponse) Reset()                    { *m = DeletePlanResponse{} }
 func (m *DeletePlanResponse) String() string            { return proto.CompactTextString(m) }
 func (*DeletePlanResponse) ProtoMessage()               {}
-func (*DeletePlanResponse) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{7} }
+func (*DeletePlanResponse) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{8} }
 
 type isDeletePlanResponse_Responses interface {
 	isDeletePlanResponse_Responses()
@@ -579,7 +847,7 @@ type ListFilter struct {
 func (m *ListFilter) Reset()                    { *m = ListFilter{} }
 func (m *ListFilter) String() string            { return proto.CompactTextString(m) }
 func (*ListFilter) ProtoMessage()               {}
-func (*ListFilter) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{8} }
+func (*ListFilter) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{9} }
 
 func (m *ListFilter) GetGt() int64 {
 	if m != nil {
@@ -614,12 +882,14 @@ type ListPlansRequest struct {
 	EndingBefore  string      `protobuf:"bytes,2,opt,name=ending_before,json=endingBefore" json:"ending_before,omitempty"`
 	StartingAfter string      `protobuf:"bytes,3,opt,name=starting_after,json=startingAfter" json:"starting_after,omitempty"`
 	Limit         int32       `protobuf:"varint,4,opt,name=limit" json:"limit,omitempty"`
+	Product       string      `protobuf:"bytes,5,opt,name=product" json:"product,omitempty"`
+	Active        ActiveState `protobuf:"varint,6,opt,name=active,enum=ActiveState" json:"active,omitempty"`
 }
 
 func (m *ListPlansRequest) Reset()                    { *m = ListPlansRequest{} }
 func (m *ListPlansRequest) String() string            { return proto.CompactTextString(m) }
 func (*ListPlansRequest) ProtoMessage()               {}
-func (*ListPlansRequest) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{9} }
+func (*ListPlansRequest) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{10} }
 
 func (m *ListPlansRequest) GetCreated() *ListFilter {
 	if m != nil {
@@ -649,7 +919,22 @@ func (m *ListPlansRequest) GetLimit() int32 {
 	return 0
 }
 
+func (m *ListPlansRequest) GetProduct() string {
+	if m != nil {
+		return m.Product
+	}
+	return ""
+}
+
+func (m *ListPlansRequest) GetActive() ActiveState {
+	if m != nil {
+		return m.Active
+	}
+	return ActiveState_ActiveUnset
+}
+
 func init() {
+	proto.RegisterType((*PlanTier)(nil), "PlanTier")
 	proto.RegisterType((*PlanResponse)(nil), "PlanResponse")
 	proto.RegisterType((*Plan)(nil), "Plan")
 	proto.RegisterType((*CreatePlanRequest)(nil), "CreatePlanRequest")
@@ -661,6 +946,11 @@ func init() {
 	proto.RegisterType((*ListFilter)(nil), "ListFilter")
 	proto.RegisterType((*ListPlansRequest)(nil), "ListPlansRequest")
 	proto.RegisterEnum("Interval", Interval_name, Interval_value)
+	proto.RegisterEnum("BillingScheme", BillingScheme_name, BillingScheme_value)
+	proto.RegisterEnum("TiersMode", TiersMode_name, TiersMode_value)
+	proto.RegisterEnum("UsageType", UsageType_name, UsageType_value)
+	proto.RegisterEnum("AggregateUsage", AggregateUsage_name, AggregateUsage_value)
+	proto.RegisterEnum("ActiveState", ActiveState_name, ActiveState_value)
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -895,57 +1185,81 @@ var _Plans_serviceDesc = grpc.ServiceDesc{
 	Metadata: "plan.proto",
 }
 
-func init() { proto.RegisterFile("plan.proto", fileDescriptor2) }
-
-var fileDescriptor2 = []byte{
-	// 772 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4d, 0x6f, 0xeb, 0x44,
-	0x14, 0x8d, 0xbf, 0x12, 0xfb, 0xe6, 0x25, 0xcf, 0x99, 0x3e, 0x21, 0x2b, 0x0b, 0x14, 0xfc, 0x54,
-	0x29, 0x2a, 0x92, 0xa1, 0x61, 0x01, 0xe2, 0x4b, 0xa2, 0x4d, 0xa1, 0x48, 0x14, 0x55, 0x53, 0x21,
-	0xc4, 0x86, 0x68, 0x1a, 0xdf, 0x06, 0xab, 0x8e, 0x9d, 0x8e, 0x27, 0x95, 0xf2, 0x07, 0xd8, 0xb3,
-	0x46, 0xe2, 0x87, 0xf0, 0xeb, 0xd0, 0x8c, 0x3f, 0xe2, 0xd6, 0xa4, 0x4d, 0x17, 0xdd, 0xcd, 0x3d,
-	0x73, 0xef, 0xcc, 0x9d, 0x73, 0xae, 0x8f, 0x0c, 0xb0, 0x8a, 0x59, 0x12, 0xac, 0x78, 0x2a, 0xd2,
-	0xa1, 0x3b, 0x5f, 0x73, 0x8e, 0xc9, 0x3c, 0xc2, 0xac, 0x40, 0xba, 0xc8, 0x79, 0xca, 0xf3, 0xc0,
-	0xff, 0x1d, 0xde, 0x5c, 0xc6, 0x2c, 0xa1, 0x98, 0xad, 0xd2, 0x24, 0x43, 0xf2, 0x21, 0x58, 0x6a,
-	0xdb, 0xd3, 0x46, 0xda, 0xb8, 0x3b, 0x69, 0x07, 0x67, 0x32, 0x3a, 0x6f, 0xd1, 0x1c, 0x26, 0x1f,
-	0x41, 0x27, 0x5b, 0xcf, 0xe7, 0x98, 0x65, 0x9e, 0xae, 0x32, 0xac, 0x40, 0xd6, 0x9f, 0xb7, 0x68,
-	0x89, 0x9f, 0x74, 0xc1, 0xe1, 0xc5, 0x71, 0x99, 0xff, 0xaf, 0x01, 0xa6, 0x4c, 0x20, 0x7d, 0xd0,
-	0xa3, 0x50, 0x9d, 0xea, 0x50, 0x3d, 0x0a, 0xc9, 0x07, 0xd0, 0x66, 0xcb, 0x74, 0x9d, 0x08, 0x75,
-	0x8e, 0x49, 0x8b, 0x88, 0x78, 0xd0, 0x99, 0x73, 0x64, 0x02, 0x43, 0xcf, 0x18, 0x69, 0x63, 0x83,
-	0x96, 0x21, 0x39, 0x04, 0xbb, 0x78, 0xcb, 0xc6, 0x33, 0x47, 0xda, 0xb8, 0x3f, 0x71, 0x82, 0xd3,
-	0x02, 0xa0, 0xd5, 0x96, 0x4c, 0x8b, 0x12, 0x81, 0xfc, 0x9e, 0xc5, 0x9e, 0x55, 0xa4, 0xfd, 0x58,
-	0x00, 0xb4, 0xda, 0x22, 0x87, 0xd0, 0x2f, 0xd7, 0xb3, 0xb9, 0xea, 0xa3, 0xad, 0xfa, 0xe8, 0x95,
-	0xe8, 0xa9, 0x6a, 0x67, 0x08, 0x76, 0x1c, 0xdd, 0xe3, 0x32, 0x0d, 0xd1, 0xeb, 0x8c, 0xb4, 0xb1,
-	0x4d, 0xab, 0x98, 0x7c, 0x02, 0xf6, 0x12, 0x05, 0x0b, 0x99, 0x60, 0x9e, 0x3d, 0x32, 0xc6, 0xdd,
-	0xc9, 0x81, 0x22, 0x23, 0xb8, 0x28, 0xd0, 0xb3, 0x44, 0xf0, 0x0d, 0xad, 0x92, 0x08, 0x01, 0x33,
-	0x61, 0x4b, 0xf4, 0x1c, 0xc5, 0x82, 0x5a, 0x93, 0x63, 0x78, 0x97, 0x09, 0x26, 0x70, 0x89, 0x89,
-	0x98, 0x85, 0x98, 0xcd, 0x79, 0xb4, 0x12, 0x29, 0xf7, 0x40, 0xe5, 0x1c, 0x54, 0x7b, 0xd3, 0x6a,
-	0x8b, 0x1c, 0xc1, 0x40, 0xf0, 0x88, 0xc5, 0xb3, 0x15, 0xf2, 0x28, 0x0d, 0x67, 0x21, 0xdb, 0x64,
-	0x5e, 0x57, 0x75, 0xff, 0x56, 0x6d, 0x5c, 0x2a, 0x7c, 0xca, 0x36, 0xd9, 0xf0, 0x2b, 0xe8, 0x3d,
-	0xe8, 0x86, 0xb8, 0x60, 0xdc, 0xe2, 0xa6, 0x10, 0x42, 0x2e, 0xc9, 0x3b, 0xb0, 0xee, 0x59, 0xbc,
-	0x46, 0x25, 0x84, 0x43, 0xf3, 0xe0, 0x4b, 0xfd, 0x0b, 0xcd, 0xff, 0xc7, 0x80, 0xc1, 0xa9, 0x62,
-	0x3f, 0x9f, 0x91, 0xbb, 0x35, 0x66, 0x62, 0x6f, 0x25, 0xeb, 0x7a, 0x19, 0xfb, 0xe9, 0x65, 0xee,
-	0xd6, 0xab, 0xe4, 0xce, 0xaa, 0x71, 0xb7, 0xa7, 0x86, 0x5f, 0xd7, 0x74, 0xea, 0x28, 0x9d, 0x46,
-	0x41, 0xe3, 0x59, 0x3b, 0x45, 0xdb, 0x25, 0x90, 0xfd, 0x42, 0x81, 0x9c, 0x57, 0x10, 0x68, 0x04,
-	0xfd, 0x1f, 0x50, 0x3c, 0x21, 0x8e, 0xff, 0x97, 0x0e, 0x83, 0x5f, 0x56, 0xe1, 0x33, 0x12, 0xd6,
-	0x19, 0xd2, 0x0b, 0x86, 0x1a, 0x55, 0xcf, 0x8e, 0xb5, 0xb1, 0xc7, 0x58, 0x9b, 0x2f, 0x64, 0xcd,
-	0x7a, 0x05, 0xd6, 0xde, 0xc3, 0x60, 0x8a, 0x31, 0x3e, 0x49, 0x89, 0xff, 0x4d, 0x3d, 0xe9, 0x2a,
-	0xb7, 0x36, 0x69, 0x4e, 0xa1, 0x02, 0xf3, 0x4c, 0x9b, 0x96, 0x61, 0x51, 0xae, 0x57, 0xe5, 0x77,
-	0x40, 0xea, 0x77, 0xec, 0xe9, 0xae, 0xc1, 0x63, 0x77, 0x25, 0x41, 0xa3, 0x89, 0x9d, 0x56, 0x7b,
-	0x09, 0xf0, 0x53, 0x94, 0x89, 0xef, 0xa3, 0x58, 0x20, 0x97, 0x0d, 0x2d, 0x84, 0xba, 0xc7, 0xa0,
-	0xfa, 0x42, 0x48, 0x82, 0x16, 0x22, 0x27, 0xc3, 0xa0, 0x72, 0x29, 0x33, 0x62, 0x51, 0x98, 0xac,
-	0x1e, 0xab, 0x8c, 0x58, 0xa0, 0x52, 0xc8, 0xa0, 0x72, 0xe9, 0xff, 0xad, 0x81, 0x2b, 0x8f, 0x94,
-	0xb7, 0x67, 0x25, 0x51, 0x87, 0x5b, 0x83, 0xce, 0x5f, 0xd1, 0x0d, 0xb6, 0xd7, 0x6e, 0xdd, 0xfa,
-	0x3d, 0xf4, 0x30, 0x09, 0xa3, 0x64, 0x31, 0xbb, 0xc6, 0x9b, 0x94, 0x97, 0x32, 0xbc, 0xc9, 0xc1,
-	0x13, 0x85, 0xc9, 0x0f, 0x38, 0x13, 0x8c, 0x0b, 0x99, 0xc6, 0x6e, 0x04, 0xf2, 0x62, 0x86, 0x7a,
-	0x25, 0xfa, 0x9d, 0x04, 0xa5, 0x94, 0x71, 0xb4, 0x8c, 0x84, 0xea, 0xcd, 0xa2, 0x79, 0x70, 0xf4,
-	0x2d, 0xd8, 0xa5, 0x4f, 0x10, 0x80, 0xf6, 0xcf, 0xa9, 0xb8, 0x42, 0xe1, 0xb6, 0x48, 0x07, 0x8c,
-	0x29, 0xdb, 0xb8, 0x1a, 0xb1, 0xc1, 0xfc, 0x15, 0xf1, 0xd6, 0xd5, 0x89, 0x03, 0xd6, 0x45, 0x9a,
-	0x88, 0x3f, 0x5c, 0x43, 0x82, 0xbf, 0x21, 0xe3, 0xae, 0x39, 0xf9, 0x53, 0x07, 0x4b, 0xbd, 0x8c,
-	0x1c, 0x03, 0x6c, 0xa7, 0x9d, 0x90, 0xe6, 0xe8, 0x0f, 0x7b, 0x41, 0x5d, 0x47, 0xbf, 0x25, 0x4b,
-	0xb6, 0x16, 0x42, 0x48, 0xd3, 0x4f, 0x9a, 0x25, 0x9f, 0x03, 0x6c, 0xc5, 0x24, 0x75, 0x65, 0xcb,
-	0x92, 0x83, 0xa0, 0x39, 0x33, 0x7e, 0x8b, 0x7c, 0x0c, 0x9d, 0xe2, 0x2b, 0x27, 0x6f, 0x83, 0x87,
-	0xdf, 0xfb, 0xff, 0x35, 0xe6, 0x54, 0x92, 0x91, 0x41, 0xf0, 0x58, 0xbe, 0x46, 0xc1, 0xa7, 0xda,
-	0x75, 0x5b, 0xfd, 0x0a, 0x7c, 0xf6, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb7, 0xa0, 0xf9, 0x7d,
-	0x37, 0x08, 0x00, 0x00,
+func init() { proto.RegisterFile("plan.proto", fileDescriptor6) }
+
+var fileDescriptor6 = []byte{
+	// 1162 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xed, 0x6e, 0xe3, 0x44,
+	0x17, 0x8e, 0xed, 0x38, 0x89, 0x4f, 0xbe, 0x9c, 0xe9, 0xbe, 0xaf, 0x4c, 0x7f, 0xb0, 0x21, 0x4b,
+	0x51, 0x09, 0x92, 0x61, 0x8b, 0x10, 0x2b, 0x58, 0x40, 0xfd, 0xda, 0x5d, 0xa4, 0x16, 0x55, 0x6e,
+	0x0b, 0xe2, 0x0f, 0xd1, 0x34, 0x3e, 0xcd, 0x5a, 0x75, 0x6c, 0xef, 0xcc, 0xb8, 0x90, 0x1b, 0xe0,
+	0x3a, 0xb8, 0x0b, 0x6e, 0x84, 0x7f, 0xdc, 0x0c, 0x9a, 0xf1, 0x47, 0x9c, 0xcd, 0x76, 0x37, 0x15,
+	0xfc, 0x9b, 0xf3, 0xcc, 0x33, 0x73, 0x8e, 0xcf, 0x3c, 0xe7, 0x1c, 0x19, 0x20, 0x09, 0x69, 0xe4,
+	0x26, 0x2c, 0x16, 0xf1, 0xb6, 0x3d, 0x4d, 0x19, 0xc3, 0x68, 0x1a, 0x20, 0xcf, 0x91, 0x36, 0x32,
+	0x16, 0xb3, 0xcc, 0x18, 0x51, 0x68, 0x9d, 0x85, 0x34, 0xba, 0x08, 0x90, 0x91, 0x2d, 0x30, 0xd3,
+	0x64, 0x22, 0x62, 0x47, 0x1b, 0x6a, 0xbb, 0x75, 0xaf, 0x9e, 0x26, 0x17, 0x31, 0x79, 0x08, 0xed,
+	0x34, 0x0a, 0xc4, 0x84, 0xce, 0xe3, 0x34, 0x12, 0x8e, 0xae, 0xb6, 0x40, 0x42, 0xfb, 0x0a, 0x91,
+	0x84, 0xeb, 0x90, 0x96, 0x04, 0x23, 0x23, 0x48, 0x28, 0x23, 0x8c, 0x7e, 0x81, 0x8e, 0x74, 0xe1,
+	0x21, 0x4f, 0xe2, 0x88, 0x23, 0x79, 0x1f, 0x4c, 0x15, 0x81, 0x72, 0xd3, 0xde, 0x6b, 0xb8, 0xc7,
+	0xd2, 0x7a, 0x51, 0xf3, 0x32, 0x98, 0x7c, 0x00, 0x4d, 0x9e, 0x4e, 0xa7, 0xc8, 0xb9, 0xf2, 0xd6,
+	0xde, 0x33, 0x5d, 0x79, 0xfe, 0x45, 0xcd, 0x2b, 0xf0, 0x83, 0x36, 0x58, 0x2c, 0xbf, 0x8e, 0x8f,
+	0xfe, 0x32, 0xa1, 0x2e, 0x09, 0xa4, 0x07, 0x7a, 0xe0, 0xab, 0x5b, 0x2d, 0x4f, 0x0f, 0x7c, 0xf2,
+	0x7f, 0x68, 0xac, 0x44, 0x9d, 0x5b, 0xc4, 0x81, 0xe6, 0x94, 0x21, 0x15, 0xe8, 0xab, 0x68, 0x0d,
+	0xaf, 0x30, 0xc9, 0x0e, 0xb4, 0xf2, 0x74, 0x2d, 0x9c, 0xfa, 0x50, 0xdb, 0xed, 0xed, 0x59, 0xee,
+	0x61, 0x0e, 0x78, 0xe5, 0x96, 0xa4, 0x05, 0x91, 0x40, 0x76, 0x4b, 0x43, 0xc7, 0xcc, 0x69, 0xdf,
+	0xe7, 0x80, 0x57, 0x6e, 0x91, 0x1d, 0xe8, 0x15, 0xeb, 0xc9, 0x54, 0xc5, 0xd1, 0x50, 0x71, 0x74,
+	0x0b, 0xf4, 0x50, 0x85, 0xb3, 0x0d, 0xad, 0x30, 0xb8, 0xc5, 0x79, 0xec, 0xa3, 0xd3, 0x1c, 0x6a,
+	0xbb, 0x2d, 0xaf, 0xb4, 0xc9, 0xa7, 0xd0, 0x9a, 0xa3, 0xa0, 0x3e, 0x15, 0xd4, 0x69, 0x0d, 0x8d,
+	0xdd, 0xf6, 0xde, 0x96, 0x4a, 0x86, 0x7b, 0x9a, 0xa3, 0xc7, 0x91, 0x60, 0x0b, 0xaf, 0x24, 0x11,
+	0x02, 0xf5, 0x88, 0xce, 0xd1, 0xb1, 0x54, 0x16, 0xd4, 0x9a, 0x3c, 0x86, 0x07, 0x5c, 0x50, 0x81,
+	0x73, 0x8c, 0xc4, 0xc4, 0x47, 0x3e, 0x65, 0x41, 0x22, 0x62, 0xe6, 0x80, 0xe2, 0x6c, 0x95, 0x7b,
+	0x47, 0xe5, 0x16, 0x19, 0xc3, 0x40, 0xb0, 0x80, 0x86, 0x93, 0x04, 0x59, 0x10, 0xfb, 0x13, 0x9f,
+	0x2e, 0xb8, 0xd3, 0x56, 0xd1, 0xf7, 0xd5, 0xc6, 0x99, 0xc2, 0x8f, 0xe8, 0x82, 0x93, 0x2f, 0xa0,
+	0x77, 0x15, 0x84, 0x61, 0x10, 0xcd, 0x26, 0x7c, 0xfa, 0x12, 0xe7, 0xe8, 0x74, 0x54, 0x4e, 0x7a,
+	0xee, 0x41, 0x06, 0x9f, 0x2b, 0xd4, 0xeb, 0x5e, 0x55, 0x4d, 0xf2, 0x10, 0x4c, 0x11, 0x20, 0xe3,
+	0x4e, 0x57, 0x7d, 0x97, 0xe5, 0x16, 0x3a, 0xf4, 0x32, 0x9c, 0x7c, 0x0c, 0xa0, 0x16, 0x13, 0x95,
+	0x99, 0x9e, 0xba, 0x13, 0x5c, 0xc9, 0xe0, 0xa7, 0xb1, 0x8f, 0x9e, 0x25, 0x8a, 0xa5, 0xa4, 0xa6,
+	0x9c, 0xce, 0x70, 0x22, 0x16, 0x09, 0x3a, 0xfd, 0x9c, 0x7a, 0x29, 0xa1, 0x8b, 0x45, 0x82, 0x9e,
+	0x95, 0x16, 0x4b, 0xf2, 0x04, 0xfa, 0x74, 0x36, 0x63, 0x38, 0xa3, 0x02, 0x27, 0x0a, 0x76, 0x6c,
+	0xc5, 0xef, 0xbb, 0xfb, 0x05, 0xae, 0x0e, 0x7a, 0x3d, 0xba, 0x62, 0x4b, 0xd9, 0x24, 0x2c, 0xf6,
+	0xd3, 0xa9, 0x70, 0x06, 0x2a, 0x73, 0x85, 0xa9, 0x84, 0x36, 0x15, 0xc1, 0x2d, 0x3a, 0x44, 0xbd,
+	0x5f, 0x6e, 0x6d, 0x7f, 0x0d, 0xdd, 0x95, 0x77, 0x22, 0x36, 0x18, 0x37, 0xb8, 0xc8, 0x25, 0x2a,
+	0x97, 0xe4, 0x01, 0x98, 0xb7, 0x34, 0x4c, 0x51, 0x49, 0xd4, 0xf2, 0x32, 0xe3, 0x2b, 0xfd, 0x89,
+	0x36, 0xfa, 0xc3, 0x84, 0xc1, 0xa1, 0xd2, 0x65, 0x56, 0x3d, 0xaf, 0x52, 0xe4, 0x62, 0x63, 0x8d,
+	0x57, 0x95, 0x6c, 0x6c, 0xa6, 0xe4, 0xfa, 0xdd, 0x4a, 0x2e, 0x54, 0x65, 0x56, 0x54, 0xb5, 0xa1,
+	0xba, 0x9f, 0x56, 0x14, 0xdc, 0x54, 0x2f, 0x3d, 0x74, 0xd7, 0x3e, 0xeb, 0x4e, 0x39, 0xdf, 0x25,
+	0xdd, 0xd6, 0x3d, 0xa5, 0x6b, 0x6d, 0x2a, 0x5d, 0xb8, 0x97, 0x74, 0xdb, 0x1b, 0x49, 0xb7, 0xb3,
+	0xb9, 0x74, 0xbb, 0xf7, 0x94, 0x6e, 0xef, 0xde, 0xd2, 0xed, 0xaf, 0x48, 0xf7, 0xdf, 0x49, 0x74,
+	0x08, 0xbd, 0xe7, 0x28, 0xde, 0x22, 0xcf, 0xd1, 0x9f, 0x3a, 0x0c, 0x2e, 0x13, 0xff, 0x1d, 0x22,
+	0xae, 0x6a, 0x44, 0xcf, 0x35, 0xb2, 0x76, 0xea, 0x9d, 0x2d, 0xcf, 0xd8, 0xa0, 0xe5, 0xd5, 0xef,
+	0xa9, 0x1b, 0xf3, 0xcd, 0xba, 0xf9, 0xb0, 0x2c, 0xf8, 0x86, 0x7a, 0x80, 0x8e, 0xbb, 0xaf, 0xcc,
+	0x73, 0x79, 0xef, 0x7f, 0x53, 0xfe, 0x8f, 0x60, 0x70, 0x84, 0x21, 0xbe, 0x35, 0x71, 0xa3, 0x6f,
+	0xaa, 0xa4, 0xf3, 0x6c, 0x38, 0xca, 0xc7, 0xf6, 0x15, 0x98, 0x31, 0x5b, 0x5e, 0x61, 0xe6, 0xc7,
+	0xf5, 0xf2, 0xf8, 0x2b, 0x20, 0x55, 0x1f, 0x1b, 0xce, 0x67, 0xf7, 0xf5, 0xf9, 0x4c, 0xdc, 0xb5,
+	0x20, 0xee, 0x1c, 0xd6, 0x67, 0x00, 0x27, 0x01, 0x17, 0xcf, 0x82, 0x50, 0x20, 0x93, 0x01, 0xcd,
+	0x84, 0xf2, 0x63, 0x78, 0xfa, 0x4c, 0xc8, 0x04, 0xcd, 0x44, 0x96, 0x0c, 0xc3, 0x93, 0x4b, 0xc9,
+	0x08, 0x45, 0x3e, 0xa6, 0xf5, 0x50, 0x31, 0x42, 0x81, 0xea, 0x1d, 0x0d, 0x4f, 0x2e, 0x47, 0x7f,
+	0x6b, 0x60, 0xcb, 0x2b, 0xa5, 0x77, 0x5e, 0x24, 0x6a, 0x67, 0x39, 0xe2, 0xb3, 0xaf, 0x68, 0xbb,
+	0x4b, 0xb7, 0xcb, 0x79, 0xff, 0x08, 0xba, 0x18, 0xf9, 0xb2, 0xfc, 0xaf, 0xf0, 0x3a, 0x66, 0xc5,
+	0x33, 0x74, 0x32, 0xf0, 0x40, 0x61, 0xb2, 0xd1, 0x71, 0x41, 0x99, 0x90, 0x34, 0x7a, 0x2d, 0x90,
+	0xe5, 0x4a, 0xeb, 0x16, 0xe8, 0xbe, 0x04, 0xe5, 0x53, 0x86, 0xc1, 0x3c, 0x10, 0x2a, 0x36, 0xd3,
+	0xcb, 0x8c, 0x6a, 0xe5, 0x99, 0xab, 0x43, 0x63, 0x23, 0x0d, 0x8d, 0xbf, 0x85, 0x56, 0xd1, 0x8f,
+	0x09, 0x40, 0xe3, 0x87, 0x58, 0x9c, 0xa3, 0xb0, 0x6b, 0xa4, 0x09, 0xc6, 0x11, 0x5d, 0xd8, 0x1a,
+	0x69, 0x41, 0xfd, 0x27, 0xc4, 0x1b, 0x5b, 0x27, 0x16, 0x98, 0xa7, 0x71, 0x24, 0x5e, 0xda, 0x86,
+	0x04, 0x7f, 0x46, 0xca, 0xec, 0xfa, 0x78, 0x17, 0xba, 0x2b, 0xad, 0x8c, 0xb4, 0xa1, 0x79, 0x86,
+	0xec, 0x32, 0x0a, 0xe4, 0x2d, 0x00, 0x0d, 0xd9, 0x94, 0xd0, 0xb7, 0xb5, 0xf1, 0x53, 0xb0, 0xca,
+	0x06, 0x45, 0x1e, 0x80, 0x7d, 0x19, 0xdd, 0x44, 0xf1, 0xaf, 0x51, 0x89, 0xd9, 0x35, 0xd2, 0x05,
+	0xeb, 0x39, 0xa3, 0x7e, 0x2a, 0x73, 0x67, 0x6b, 0xf2, 0xf4, 0x8f, 0x71, 0x98, 0xce, 0xd1, 0xd6,
+	0xc7, 0x1f, 0x81, 0x55, 0xf6, 0x2c, 0xd2, 0x81, 0xd6, 0x49, 0x30, 0xc5, 0x88, 0xa3, 0x6f, 0xd7,
+	0xa4, 0xc7, 0x53, 0x14, 0xb9, 0x17, 0x0a, 0xbd, 0xd5, 0x5e, 0x45, 0xde, 0x83, 0xff, 0xe5, 0xae,
+	0x56, 0x37, 0xb2, 0x8f, 0x3c, 0x4f, 0xe7, 0xb6, 0x26, 0xc3, 0x39, 0xa1, 0x5c, 0x1c, 0xa5, 0x2c,
+	0x88, 0x66, 0x59, 0x1d, 0xda, 0xba, 0x72, 0x43, 0xb9, 0x38, 0xbe, 0x45, 0x66, 0x1b, 0x92, 0x7c,
+	0x4a, 0x7f, 0xb3, 0xeb, 0xe3, 0xef, 0xa0, 0x5d, 0xc9, 0x24, 0xe9, 0x17, 0xe6, 0x65, 0xc4, 0x55,
+	0xea, 0x7a, 0x00, 0x19, 0x70, 0xc1, 0x52, 0xb4, 0xb5, 0x25, 0xe1, 0x19, 0x0d, 0x39, 0xda, 0xfa,
+	0xde, 0xef, 0x3a, 0x98, 0x4a, 0x4d, 0xe4, 0x31, 0xc0, 0xb2, 0x0f, 0x11, 0xb2, 0xde, 0x94, 0xb6,
+	0xbb, 0x6e, 0xb5, 0x76, 0x46, 0x35, 0x79, 0x64, 0x39, 0xde, 0x08, 0x59, 0x9f, 0x75, 0xeb, 0x47,
+	0xbe, 0x04, 0x58, 0x16, 0x10, 0xa9, 0x56, 0x53, 0x71, 0x64, 0xcb, 0x5d, 0xaf, 0xd3, 0x51, 0x8d,
+	0x7c, 0x02, 0xcd, 0xbc, 0xff, 0x92, 0xbe, 0xbb, 0xda, 0x89, 0xdf, 0x14, 0x98, 0x55, 0x96, 0x09,
+	0x19, 0xb8, 0xaf, 0x97, 0xcc, 0xda, 0x81, 0xcf, 0xb4, 0xab, 0x86, 0xfa, 0x47, 0xf8, 0xfc, 0x9f,
+	0x01, 0x00, 0xa2, 0x4e, 0x77, 0x08, 0x50, 0x0c, 0x00, 0x00,
 }
@@ -0,0 +1,840 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: product.proto
+
+package pb
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+type ProductResponse struct {
+	// Types that are valid to be assigned to Responses:
+	//	*ProductResponse_Error
+	//	*ProductResponse_Success
+	Responses isProductResponse_Responses `protobuf_oneof:"responses"`
+}
+
+func (m *ProductResponse) Reset()                    { *m = ProductResponse{} }
+func (m *ProductResponse) String() string            { return proto.CompactTextString(m) }
+func (*ProductResponse) ProtoMessage()               {}
+func (*ProductResponse) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{0} }
+
+type isProductResponse_Responses interface {
+	isProductResponse_Responses()
+}
+
+type ProductResponse_Error struct {
+	Error *Error `protobuf:"bytes,1,opt,name=error,oneof"`
+}
+type ProductResponse_Success struct {
+	Success *Product `protobuf:"bytes,2,opt,name=success,oneof"`
+}
+
+func (*ProductResponse_Error) isProductResponse_Responses()   {}
+func (*ProductResponse_Success) isProductResponse_Responses() {}
+
+func (m *ProductResponse) GetResponses() isProductResponse_Responses {
+	if m != nil {
+		return m.Responses
+	}
+	return nil
+}
+
+func (m *ProductResponse) GetError() *Error {
+	if x, ok := m.GetResponses().(*ProductResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+func (m *ProductResponse) GetSuccess() *Product {
+	if x, ok := m.GetResponses().(*ProductResponse_Success); ok {
+		return x.Success
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*ProductResponse) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _ProductResponse_OneofMarshaler, _ProductResponse_OneofUnmarshaler, _ProductResponse_OneofSizer, []interface{}{
+		(*ProductResponse_Error)(nil),
+		(*ProductResponse_Success)(nil),
+	}
+}
+
+func _ProductResponse_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*ProductResponse)
+	// responses
+	switch x := m.Responses.(type) {
+	case *ProductResponse_Error:
+		b.EncodeVarint(1<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Error); err != nil {
+			return err
+		}
+	case *ProductResponse_Success:
+		b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Success); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("ProductResponse.Responses has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _ProductResponse_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*ProductResponse)
+	switch tag {
+	case 1: // responses.error
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Error)
+		err := b.DecodeMessage(msg)
+		m.Responses = &ProductResponse_Error{msg}
+		return true, err
+	case 2: // responses.success
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Product)
+		err := b.DecodeMessage(msg)
+		m.Responses = &ProductResponse_Success{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _ProductResponse_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*ProductResponse)
+	// responses
+	switch x := m.Responses.(type) {
+	case *ProductResponse_Error:
+		s := proto.Size(x.Error)
+		n += proto.SizeVarint(1<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ProductResponse_Success:
+		s := proto.Size(x.Success)
+		n += proto.SizeVarint(2<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+// Product is what a customer subscribes to.  Plans belong to a product and set the price and
+// billing interval.
+type Product struct {
+	Id                  string            `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Name                string            `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	Active              bool              `protobuf:"varint,3,opt,name=active" json:"active,omitempty"`
+	Created             int64             `protobuf:"varint,4,opt,name=created" json:"created,omitempty"`
+	Updated             int64             `protobuf:"varint,5,opt,name=updated" json:"updated,omitempty"`
+	Livemode            bool              `protobuf:"varint,6,opt,name=livemode" json:"livemode,omitempty"`
+	Metadata            map[string]string `protobuf:"bytes,7,rep,name=metadata" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	StatementDescriptor string            `protobuf:"bytes,8,opt,name=statement_descriptor,json=statementDescriptor" json:"statement_descriptor,omitempty"`
+	UnitLabel           string            `protobuf:"bytes,9,opt,name=unit_label,json=unitLabel" json:"unit_label,omitempty"`
+}
+
+func (m *Product) Reset()                    { *m = Product{} }
+func (m *Product) String() string            { return proto.CompactTextString(m) }
+func (*Product) ProtoMessage()               {}
+func (*Product) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{1} }
+
+func (m *Product) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Product) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Product) GetActive() bool {
+	if m != nil {
+		return m.Active
+	}
+	return false
+}
+
+func (m *Product) GetCreated() int64 {
+	if m != nil {
+		return m.Created
+	}
+	return 0
+}
+
+func (m *Product) GetUpdated() int64 {
+	if m != nil {
+		return m.Updated
+	}
+	return 0
+}
+
+func (m *Product) GetLivemode() bool {
+	if m != nil {
+		return m.Livemode
+	}
+	return false
+}
+
+func (m *Product) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *Product) GetStatementDescriptor() string {
+	if m != nil {
+		return m.StatementDescriptor
+	}
+	return ""
+}
+
+func (m *Product) GetUnitLabel() string {
+	if m != nil {
+		return m.UnitLabel
+	}
+	return ""
+}
+
+type CreateProductRequest struct {
+	Id                  string            `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Name                string            `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	Metadata            map[string]string `protobuf:"bytes,3,rep,name=metadata" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	StatementDescriptor string            `protobuf:"bytes,4,opt,name=statement_descriptor,json=statementDescriptor" json:"statement_descriptor,omitempty"`
+	UnitLabel           string            `protobuf:"bytes,5,opt,name=unit_label,json=unitLabel" json:"unit_label,omitempty"`
+}
+
+func (m *CreateProductRequest) Reset()                    { *m = CreateProductRequest{} }
+func (m *CreateProductRequest) String() string            { return proto.CompactTextString(m) }
+func (*CreateProductRequest) ProtoMessage()               {}
+func (*CreateProductRequest) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{2} }
+
+func (m *CreateProductRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *CreateProductRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CreateProductRequest) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *CreateProductRequest) GetStatementDescriptor() string {
+	if m != nil {
+		return m.StatementDescriptor
+	}
+	return ""
+}
+
+func (m *CreateProductRequest) GetUnitLabel() string {
+	if m != nil {
+		return m.UnitLabel
+	}
+	return ""
+}
+
+type GetProductRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *GetProductRequest) Reset()                    { *m = GetProductRequest{} }
+func (m *GetProductRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetProductRequest) ProtoMessage()               {}
+func (*GetProductRequest) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{3} }
+
+func (m *GetProductRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type UpdateProductRequest struct {
+	Id                  string            `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Name                string            `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	Metadata            map[string]string `protobuf:"bytes,3,rep,name=metadata" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	StatementDescriptor string            `protobuf:"bytes,4,opt,name=statement_descriptor,json=statementDescriptor" json:"statement_descriptor,omitempty"`
+	UnitLabel           string            `protobuf:"bytes,5,opt,name=unit_label,json=unitLabel" json:"unit_label,omitempty"`
+	Active              ActiveState       `protobuf:"varint,6,opt,name=active,enum=ActiveState" json:"active,omitempty"`
+}
+
+func (m *UpdateProductRequest) Reset()                    { *m = UpdateProductRequest{} }
+func (m *UpdateProductRequest) String() string            { return proto.CompactTextString(m) }
+func (*UpdateProductRequest) ProtoMessage()               {}
+func (*UpdateProductRequest) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{4} }
+
+func (m *UpdateProductRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *UpdateProductRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *UpdateProductRequest) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *UpdateProductRequest) GetStatementDescriptor() string {
+	if m != nil {
+		return m.StatementDescriptor
+	}
+	return ""
+}
+
+func (m *UpdateProductRequest) GetUnitLabel() string {
+	if m != nil {
+		return m.UnitLabel
+	}
+	return ""
+}
+
+func (m *UpdateProductRequest) GetActive() ActiveState {
+	if m != nil {
+		return m.Active
+	}
+	return ActiveState_ActiveUnset
+}
+
+type DeleteProductRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *DeleteProductRequest) Reset()                    { *m = DeleteProductRequest{} }
+func (m *DeleteProductRequest) String() string            { return proto.CompactTextString(m) }
+func (*DeleteProductRequest) ProtoMessage()               {}
+func (*DeleteProductRequest) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{5} }
+
+func (m *DeleteProductRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type DeleteProductSuccess struct {
+	Deleted bool   `protobuf:"varint,1,opt,name=deleted" json:"deleted,omitempty"`
+	Id      string `protobuf:"bytes,2,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *DeleteProductSuccess) Reset()                    { *m = DeleteProductSuccess{} }
+func (m *DeleteProductSuccess) String() string            { return proto.CompactTextString(m) }
+func (*DeleteProductSuccess) ProtoMessage()               {}
+func (*DeleteProductSuccess) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{6} }
+
+func (m *DeleteProductSuccess) GetDeleted() bool {
+	if m != nil {
+		return m.Deleted
+	}
+	return false
+}
+
+func (m *DeleteProductSuccess) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type DeleteProductResponse struct {
+	// Types that are valid to be assigned to Responses:
+	//	*DeleteProductResponse_Error
+	//	*DeleteProductResponse_Success
+	Responses isDeleteProductResponse_Responses `protobuf_oneof:"responses"`
+}
+
+func (m *DeleteProductResponse) Reset()                    { *m = DeleteProductResponse{} }
+func (m *DeleteProductResponse) String() string            { return proto.CompactTextString(m) }
+func (*DeleteProductResponse) ProtoMessage()               {}
+func (*DeleteProductResponse) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{7} }
+
+type isDeleteProductResponse_Responses interface {
+	isDeleteProductResponse_Responses()
+}
+
+type DeleteProductResponse_Error struct {
+	Error *Error `protobuf:"bytes,1,opt,name=error,oneof"`
+}
+type DeleteProductResponse_Success struct {
+	Success *DeleteProductSuccess `protobuf:"bytes,2,opt,name=success,oneof"`
+}
+
+func (*DeleteProductResponse_Error) isDeleteProductResponse_Responses()   {}
+func (*DeleteProductResponse_Success) isDeleteProductResponse_Responses() {}
+
+func (m *DeleteProductResponse) GetResponses() isDeleteProductResponse_Responses {
+	if m != nil {
+		return m.Responses
+	}
+	return nil
+}
+
+func (m *DeleteProductResponse) GetError() *Error {
+	if x, ok := m.GetResponses().(*DeleteProductResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+func (m *DeleteProductResponse) GetSuccess() *DeleteProductSuccess {
+	if x, ok := m.GetResponses().(*DeleteProductResponse_Success); ok {
+		return x.Success
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*DeleteProductResponse) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _DeleteProductResponse_OneofMarshaler, _DeleteProductResponse_OneofUnmarshaler, _DeleteProductResponse_OneofSizer, []interface{}{
+		(*DeleteProductResponse_Error)(nil),
+		(*DeleteProductResponse_Success)(nil),
+	}
+}
+
+func _DeleteProductResponse_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*DeleteProductResponse)
+	// responses
+	switch x := m.Responses.(type) {
+	case *DeleteProductResponse_Error:
+		b.EncodeVarint(1<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Error); err != nil {
+			return err
+		}
+	case *DeleteProductResponse_Success:
+		b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Success); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("DeleteProductResponse.Responses has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _DeleteProductResponse_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*DeleteProductResponse)
+	switch tag {
+	case 1: // responses.error
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Error)
+		err := b.DecodeMessage(msg)
+		m.Responses = &DeleteProductResponse_Error{msg}
+		return true, err
+	case 2: // responses.success
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(DeleteProductSuccess)
+		err := b.DecodeMessage(msg)
+		m.Responses = &DeleteProductResponse_Success{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _DeleteProductResponse_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*DeleteProductResponse)
+	// responses
+	switch x := m.Responses.(type) {
+	case *DeleteProductResponse_Error:
+		s := proto.Size(x.Error)
+		n += proto.SizeVarint(1<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *DeleteProductResponse_Success:
+		s := proto.Size(x.Success)
+		n += proto.SizeVarint(2<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+type ListProductsRequest struct {
+	Created       *ListFilter `protobuf:"bytes,1,opt,name=created" json:"created,omitempty"`
+	EndingBefore  string      `protobuf:"bytes,2,opt,name=ending_before,json=endingBefore" json:"ending_before,omitempty"`
+	StartingAfter string      `protobuf:"bytes,3,opt,name=starting_after,json=startingAfter" json:"starting_after,omitempty"`
+	Limit         int32       `protobuf:"varint,4,opt,name=limit" json:"limit,omitempty"`
+	Active        ActiveState `protobuf:"varint,5,opt,name=active,enum=ActiveState" json:"active,omitempty"`
+}
+
+func (m *ListProductsRequest) Reset()                    { *m = ListProductsRequest{} }
+func (m *ListProductsRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListProductsRequest) ProtoMessage()               {}
+func (*ListProductsRequest) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{8} }
+
+func (m *ListProductsRequest) GetCreated() *ListFilter {
+	if m != nil {
+		return m.Created
+	}
+	return nil
+}
+
+func (m *ListProductsRequest) GetEndingBefore() string {
+	if m != nil {
+		return m.EndingBefore
+	}
+	return ""
+}
+
+func (m *ListProductsRequest) GetStartingAfter() string {
+	if m != nil {
+		return m.StartingAfter
+	}
+	return ""
+}
+
+func (m *ListProductsRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListProductsRequest) GetActive() ActiveState {
+	if m != nil {
+		return m.Active
+	}
+	return ActiveState_ActiveUnset
+}
+
+func init() {
+	proto.RegisterType((*ProductResponse)(nil), "ProductResponse")
+	proto.RegisterType((*Product)(nil), "Product")
+	proto.RegisterType((*CreateProductRequest)(nil), "CreateProductRequest")
+	proto.RegisterType((*GetProductRequest)(nil), "GetProductRequest")
+	proto.RegisterType((*UpdateProductRequest)(nil), "UpdateProductRequest")
+	proto.RegisterType((*DeleteProductRequest)(nil), "DeleteProductRequest")
+	proto.RegisterType((*DeleteProductSuccess)(nil), "DeleteProductSuccess")
+	proto.RegisterType((*DeleteProductResponse)(nil), "DeleteProductResponse")
+	proto.RegisterType((*ListProductsRequest)(nil), "ListProductsRequest")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for Products service
+
+type ProductsClient interface {
+	CreateProduct(ctx context.Context, in *CreateProductRequest, opts ...grpc.CallOption) (*ProductResponse, error)
+	GetProduct(ctx context.Context, in *GetProductRequest, opts ...grpc.CallOption) (*ProductResponse, error)
+	UpdateProduct(ctx context.Context, in *UpdateProductRequest, opts ...grpc.CallOption) (*ProductResponse, error)
+	DeleteProduct(ctx context.Context, in *DeleteProductRequest, opts ...grpc.CallOption) (*DeleteProductResponse, error)
+	ListProducts(ctx context.Context, in *ListProductsRequest, opts ...grpc.CallOption) (Products_ListProductsClient, error)
+}
+
+type productsClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewProductsClient(cc *grpc.ClientConn) ProductsClient {
+	return &productsClient{cc}
+}
+
+func (c *productsClient) CreateProduct(ctx context.Context, in *CreateProductRequest, opts ...grpc.CallOption) (*ProductResponse, error) {
+	out := new(ProductResponse)
+	err := grpc.Invoke(ctx, "/Products/CreateProduct", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productsClient) GetProduct(ctx context.Context, in *GetProductRequest, opts ...grpc.CallOption) (*ProductResponse, error) {
+	out := new(ProductResponse)
+	err := grpc.Invoke(ctx, "/Products/GetProduct", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productsClient) UpdateProduct(ctx context.Context, in *UpdateProductRequest, opts ...grpc.CallOption) (*ProductResponse, error) {
+	out := new(ProductResponse)
+	err := grpc.Invoke(ctx, "/Products/UpdateProduct", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productsClient) DeleteProduct(ctx context.Context, in *DeleteProductRequest, opts ...grpc.CallOption) (*DeleteProductResponse, error) {
+	out := new(DeleteProductResponse)
+	err := grpc.Invoke(ctx, "/Products/DeleteProduct", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productsClient) ListProducts(ctx context.Context, in *ListProductsRequest, opts ...grpc.CallOption) (Products_ListProductsClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Products_serviceDesc.Streams[0], c.cc, "/Products/ListProducts", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &productsListProductsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Products_ListProductsClient interface {
+	Recv() (*ProductResponse, error)
+	grpc.ClientStream
+}
+
+type productsListProductsClient struct {
+	grpc.ClientStream
+}
+
+func (x *productsListProductsClient) Recv() (*ProductResponse, error) {
+	m := new(ProductResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Server API for Products service
+
+type ProductsServer interface {
+	CreateProduct(context.Context, *CreateProductRequest) (*ProductResponse, error)
+	GetProduct(context.Context, *GetProductRequest) (*ProductResponse, error)
+	UpdateProduct(context.Context, *UpdateProductRequest) (*ProductResponse, error)
+	DeleteProduct(context.Context, *DeleteProductRequest) (*DeleteProductResponse, error)
+	ListProducts(*ListProductsRequest, Products_ListProductsServer) error
+}
+
+func RegisterProductsServer(s *grpc.Server, srv ProductsServer) {
+	s.RegisterService(&_Products_serviceDesc, srv)
+}
+
+func _Products_CreateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductsServer).CreateProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Products/CreateProduct",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductsServer).CreateProduct(ctx, req.(*CreateProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Products_GetProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductsServer).GetProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Products/GetProduct",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductsServer).GetProduct(ctx, req.(*GetProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Products_UpdateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductsServer).UpdateProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Products/UpdateProduct",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductsServer).UpdateProduct(ctx, req.(*UpdateProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Products_DeleteProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductsServer).DeleteProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Products/DeleteProduct",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductsServer).DeleteProduct(ctx, req.(*DeleteProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Products_ListProducts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListProductsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ProductsServer).ListProducts(m, &productsListProductsServer{stream})
+}
+
+type Products_ListProductsServer interface {
+	Send(*ProductResponse) error
+	grpc.ServerStream
+}
+
+type productsListProductsServer struct {
+	grpc.ServerStream
+}
+
+func (x *productsListProductsServer) Send(m *ProductResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _Products_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "Products",
+	HandlerType: (*ProductsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateProduct",
+			Handler:    _Products_CreateProduct_Handler,
+		},
+		{
+			MethodName: "GetProduct",
+			Handler:    _Products_GetProduct_Handler,
+		},
+		{
+			MethodName: "UpdateProduct",
+			Handler:    _Products_UpdateProduct_Handler,
+		},
+		{
+			MethodName: "DeleteProduct",
+			Handler:    _Products_DeleteProduct_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ListProducts",
+			Handler:       _Products_ListProducts_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "product.proto",
+}
+
+func init() { proto.RegisterFile("product.proto", fileDescriptor7) }
+
+var fileDescriptor7 = []byte{
+	// 629 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xd1, 0x4e, 0xd4, 0x4c,
+	0x14, 0xa6, 0x5d, 0x76, 0xb7, 0x7b, 0x96, 0xf2, 0xf3, 0x0f, 0xbb, 0xa4, 0xd9, 0x44, 0xb3, 0x29,
+	0x60, 0xf6, 0x6a, 0x22, 0xab, 0x17, 0x06, 0x2f, 0x04, 0x04, 0xe5, 0x02, 0x13, 0x53, 0xe2, 0x35,
+	0x19, 0xda, 0x83, 0x69, 0xec, 0xb6, 0x75, 0x66, 0x8a, 0xe1, 0x15, 0x7c, 0x1a, 0xaf, 0x7c, 0x02,
+	0x1f, 0xc2, 0xc7, 0x31, 0x33, 0xed, 0x14, 0x76, 0x2d, 0x8a, 0x91, 0x78, 0x37, 0xdf, 0x39, 0xdf,
+	0x7c, 0xd3, 0xf9, 0xce, 0x9c, 0x53, 0x70, 0x73, 0x9e, 0x45, 0x45, 0x28, 0x69, 0xce, 0x33, 0x99,
+	0x8d, 0xfa, 0xc8, 0x79, 0xc6, 0x2b, 0x00, 0x79, 0xc2, 0xd2, 0x72, 0xed, 0x47, 0xf0, 0xdf, 0xdb,
+	0x92, 0x19, 0xa0, 0xc8, 0xb3, 0x54, 0x20, 0x79, 0x08, 0x6d, 0xcd, 0xf6, 0xac, 0xb1, 0x35, 0xe9,
+	0x4f, 0x3b, 0xf4, 0x48, 0xa1, 0xe3, 0xa5, 0xa0, 0x0c, 0x93, 0x2d, 0xe8, 0x8a, 0x22, 0x0c, 0x51,
+	0x08, 0xcf, 0xd6, 0x0c, 0x87, 0x56, 0x12, 0xc7, 0x4b, 0x81, 0x49, 0x1d, 0xf4, 0xa1, 0xc7, 0x2b,
+	0x45, 0xe1, 0x7f, 0xb7, 0xa1, 0x5b, 0x71, 0xc8, 0x2a, 0xd8, 0x71, 0xa4, 0xb5, 0x7b, 0x81, 0x1d,
+	0x47, 0x84, 0xc0, 0x72, 0xca, 0x66, 0xa8, 0xb5, 0x7a, 0x81, 0x5e, 0x93, 0x0d, 0xe8, 0xb0, 0x50,
+	0xc6, 0x97, 0xe8, 0xb5, 0xc6, 0xd6, 0xc4, 0x09, 0x2a, 0x44, 0x3c, 0xe8, 0x86, 0x1c, 0x99, 0xc4,
+	0xc8, 0x5b, 0x1e, 0x5b, 0x93, 0x56, 0x60, 0xa0, 0xca, 0x14, 0x79, 0xa4, 0x33, 0xed, 0x32, 0x53,
+	0x41, 0x32, 0x02, 0x27, 0x89, 0x2f, 0x71, 0x96, 0x45, 0xe8, 0x75, 0xb4, 0x5a, 0x8d, 0xc9, 0x14,
+	0x9c, 0x19, 0x4a, 0x16, 0x31, 0xc9, 0xbc, 0xee, 0xb8, 0x35, 0xe9, 0x4f, 0x37, 0xcc, 0x5d, 0xe8,
+	0x9b, 0x2a, 0x71, 0x94, 0x4a, 0x7e, 0x15, 0xd4, 0x3c, 0xb2, 0x03, 0x03, 0x21, 0x99, 0xc4, 0x19,
+	0xa6, 0xf2, 0x2c, 0x42, 0x11, 0xf2, 0x38, 0x97, 0x19, 0xf7, 0x1c, 0xfd, 0xfd, 0xeb, 0x75, 0xee,
+	0xb0, 0x4e, 0x91, 0x07, 0x00, 0x45, 0x1a, 0xcb, 0xb3, 0x84, 0x9d, 0x63, 0xe2, 0xf5, 0x34, 0xb1,
+	0xa7, 0x22, 0x27, 0x2a, 0x30, 0x7a, 0x0e, 0xee, 0xdc, 0x61, 0x64, 0x0d, 0x5a, 0x1f, 0xf0, 0xaa,
+	0xf2, 0x48, 0x2d, 0xc9, 0x00, 0xda, 0x97, 0x2c, 0x29, 0x8c, 0x4b, 0x25, 0xd8, 0xb5, 0x9f, 0x59,
+	0xfe, 0x67, 0x1b, 0x06, 0x2f, 0xb5, 0x09, 0x75, 0x1d, 0x3f, 0x16, 0x28, 0xee, 0xe6, 0xf3, 0x8b,
+	0x1b, 0xf7, 0x6f, 0xe9, 0xfb, 0x6f, 0xd2, 0x26, 0xb1, 0x3f, 0x36, 0x63, 0xf9, 0xae, 0x66, 0xb4,
+	0xef, 0xd5, 0x8c, 0x4d, 0xf8, 0xff, 0x35, 0xca, 0x5f, 0x1b, 0xe1, 0x7f, 0xb1, 0x61, 0xf0, 0x4e,
+	0x3f, 0x8e, 0x7b, 0x72, 0xac, 0x49, 0xec, 0xdf, 0x39, 0x46, 0xb6, 0xea, 0x66, 0x51, 0xcf, 0x7b,
+	0x75, 0xba, 0x42, 0xf7, 0x35, 0x3c, 0x55, 0x52, 0xa6, 0x75, 0xfe, 0xce, 0xd7, 0x47, 0x30, 0x38,
+	0xc4, 0x04, 0x7f, 0xe7, 0x98, 0xbf, 0xb7, 0xc0, 0x3b, 0x2d, 0x87, 0x81, 0xea, 0xce, 0x48, 0xc7,
+	0x4b, 0xb2, 0x13, 0x18, 0x58, 0x29, 0xd8, 0xb5, 0xc2, 0x27, 0x18, 0x2e, 0x9c, 0x74, 0xc7, 0xa9,
+	0xb4, 0xb3, 0x38, 0x95, 0x86, 0xb4, 0xe9, 0x53, 0x6e, 0x1d, 0x51, 0xdf, 0x2c, 0x58, 0x3f, 0x89,
+	0x85, 0x79, 0x3c, 0xc2, 0x5c, 0x71, 0xfb, 0x7a, 0xe4, 0x94, 0x27, 0xf7, 0xa9, 0xa2, 0xbd, 0x8a,
+	0x13, 0x89, 0xfc, 0x7a, 0xfe, 0x6c, 0x82, 0x8b, 0x69, 0x14, 0xa7, 0xef, 0xcf, 0xce, 0xf1, 0x22,
+	0xe3, 0xc6, 0xc3, 0x95, 0x32, 0x78, 0xa0, 0x63, 0x64, 0x1b, 0x56, 0x85, 0x64, 0x5c, 0x2a, 0x1a,
+	0xbb, 0x90, 0xc8, 0xf5, 0x78, 0xeb, 0x05, 0xae, 0x89, 0xee, 0xab, 0xa0, 0xaa, 0x43, 0x12, 0xcf,
+	0x62, 0xa9, 0xdf, 0x44, 0x3b, 0x28, 0xc1, 0x8d, 0x32, 0xb7, 0x6f, 0x2f, 0xf3, 0xf4, 0xab, 0x0d,
+	0x8e, 0xb9, 0x02, 0xd9, 0x05, 0x77, 0xae, 0x9b, 0xc9, 0xb0, 0xb1, 0xbb, 0x47, 0x6b, 0x74, 0xc1,
+	0x6d, 0x7f, 0x89, 0x3c, 0x05, 0xb8, 0x6e, 0x25, 0x42, 0xe8, 0x4f, 0x7d, 0xd5, 0xb8, 0x6b, 0x17,
+	0xdc, 0xb9, 0x6e, 0x20, 0xc3, 0xc6, 0xee, 0x68, 0xdc, 0xbb, 0x07, 0xee, 0x5c, 0xc5, 0xc8, 0x42,
+	0x05, 0xcd, 0xde, 0x0d, 0xda, 0xf8, 0x42, 0xf4, 0xe9, 0x2b, 0x37, 0x4b, 0x48, 0x06, 0xb4, 0xa1,
+	0xa2, 0x4d, 0x67, 0x3f, 0xb6, 0xce, 0x3b, 0xfa, 0x7f, 0xf8, 0xe4, 0xc7, 0x00, 0xa2, 0x9c, 0x5e,
+	0x60, 0x39, 0x07, 0x00, 0x00,
+}
@@ -0,0 +1,836 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: source.proto
+
+package pb
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+type SourceType int32
+
+const (
+	SourceType_UnknownSourceType     SourceType = 0
+	SourceType_CardSource            SourceType = 1
+	SourceType_BankAccountSource     SourceType = 2
+	SourceType_BitcoinReceiverSource SourceType = 3
+	SourceType_SourceObject          SourceType = 4
+)
+
+var SourceType_name = map[int32]string{
+	0: "UnknownSourceType",
+	1: "CardSource",
+	2: "BankAccountSource",
+	3: "BitcoinReceiverSource",
+	4: "SourceObject",
+}
+var SourceType_value = map[string]int32{
+	"UnknownSourceType":     0,
+	"CardSource":            1,
+	"BankAccountSource":     2,
+	"BitcoinReceiverSource": 3,
+	"SourceObject":          4,
+}
+
+func (x SourceType) String() string {
+	return proto.EnumName(SourceType_name, int32(x))
+}
+func (SourceType) EnumDescriptor() ([]byte, []int) { return fileDescriptor8, []int{0} }
+
+type SourceResponse struct {
+	// Types that are valid to be assigned to Responses:
+	//	*SourceResponse_Error
+	//	*SourceResponse_Success
+	Responses isSourceResponse_Responses `protobuf_oneof:"responses"`
+}
+
+func (m *SourceResponse) Reset()                    { *m = SourceResponse{} }
+func (m *SourceResponse) String() string            { return proto.CompactTextString(m) }
+func (*SourceResponse) ProtoMessage()               {}
+func (*SourceResponse) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{0} }
+
+type isSourceResponse_Responses interface {
+	isSourceResponse_Responses()
+}
+
+type SourceResponse_Error struct {
+	Error *Error `protobuf:"bytes,1,opt,name=error,oneof"`
+}
+type SourceResponse_Success struct {
+	Success *PaymentSource `protobuf:"bytes,2,opt,name=success,oneof"`
+}
+
+func (*SourceResponse_Error) isSourceResponse_Responses()   {}
+func (*SourceResponse_Success) isSourceResponse_Responses() {}
+
+func (m *SourceResponse) GetResponses() isSourceResponse_Responses {
+	if m != nil {
+		return m.Responses
+	}
+	return nil
+}
+
+func (m *SourceResponse) GetError() *Error {
+	if x, ok := m.GetResponses().(*SourceResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+func (m *SourceResponse) GetSuccess() *PaymentSource {
+	if x, ok := m.GetResponses().(*SourceResponse_Success); ok {
+		return x.Success
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*SourceResponse) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _SourceResponse_OneofMarshaler, _SourceResponse_OneofUnmarshaler, _SourceResponse_OneofSizer, []interface{}{
+		(*SourceResponse_Error)(nil),
+		(*SourceResponse_Success)(nil),
+	}
+}
+
+func _SourceResponse_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*SourceResponse)
+	// responses
+	switch x := m.Responses.(type) {
+	case *SourceResponse_Error:
+		b.EncodeVarint(1<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Error); err != nil {
+			return err
+		}
+	case *SourceResponse_Success:
+		b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Success); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("SourceResponse.Responses has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _SourceResponse_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*SourceResponse)
+	switch tag {
+	case 1: // responses.error
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Error)
+		err := b.DecodeMessage(msg)
+		m.Responses = &SourceResponse_Error{msg}
+		return true, err
+	case 2: // responses.success
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(PaymentSource)
+		err := b.DecodeMessage(msg)
+		m.Responses = &SourceResponse_Success{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _SourceResponse_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*SourceResponse)
+	// responses
+	switch x := m.Responses.(type) {
+	case *SourceResponse_Error:
+		s := proto.Size(x.Error)
+		n += proto.SizeVarint(1<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *SourceResponse_Success:
+		s := proto.Size(x.Success)
+		n += proto.SizeVarint(2<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+type PaymentSource struct {
+	Id       string       `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Customer string       `protobuf:"bytes,2,opt,name=customer" json:"customer,omitempty"`
+	Type     SourceType   `protobuf:"varint,3,opt,name=type,enum=SourceType" json:"type,omitempty"`
+	Card     *CardDetails `protobuf:"bytes,4,opt,name=card" json:"card,omitempty"`
+}
+
+func (m *PaymentSource) Reset()                    { *m = PaymentSource{} }
+func (m *PaymentSource) String() string            { return proto.CompactTextString(m) }
+func (*PaymentSource) ProtoMessage()               {}
+func (*PaymentSource) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{1} }
+
+func (m *PaymentSource) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *PaymentSource) GetCustomer() string {
+	if m != nil {
+		return m.Customer
+	}
+	return ""
+}
+
+func (m *PaymentSource) GetType() SourceType {
+	if m != nil {
+		return m.Type
+	}
+	return SourceType_UnknownSourceType
+}
+
+func (m *PaymentSource) GetCard() *CardDetails {
+	if m != nil {
+		return m.Card
+	}
+	return nil
+}
+
+type CardDetails struct {
+	Brand       string            `protobuf:"bytes,1,opt,name=brand" json:"brand,omitempty"`
+	Last4       string            `protobuf:"bytes,2,opt,name=last4" json:"last4,omitempty"`
+	ExpMonth    uint32            `protobuf:"varint,3,opt,name=exp_month,json=expMonth" json:"exp_month,omitempty"`
+	ExpYear     uint32            `protobuf:"varint,4,opt,name=exp_year,json=expYear" json:"exp_year,omitempty"`
+	Funding     string            `protobuf:"bytes,5,opt,name=funding" json:"funding,omitempty"`
+	Country     string            `protobuf:"bytes,6,opt,name=country" json:"country,omitempty"`
+	Fingerprint string            `protobuf:"bytes,7,opt,name=fingerprint" json:"fingerprint,omitempty"`
+	Name        string            `protobuf:"bytes,8,opt,name=name" json:"name,omitempty"`
+	AddressZip  string            `protobuf:"bytes,9,opt,name=address_zip,json=addressZip" json:"address_zip,omitempty"`
+	CvcCheck    string            `protobuf:"bytes,10,opt,name=cvc_check,json=cvcCheck" json:"cvc_check,omitempty"`
+	Metadata    map[string]string `protobuf:"bytes,11,rep,name=metadata" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (m *CardDetails) Reset()                    { *m = CardDetails{} }
+func (m *CardDetails) String() string            { return proto.CompactTextString(m) }
+func (*CardDetails) ProtoMessage()               {}
+func (*CardDetails) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{2} }
+
+func (m *CardDetails) GetBrand() string {
+	if m != nil {
+		return m.Brand
+	}
+	return ""
+}
+
+func (m *CardDetails) GetLast4() string {
+	if m != nil {
+		return m.Last4
+	}
+	return ""
+}
+
+func (m *CardDetails) GetExpMonth() uint32 {
+	if m != nil {
+		return m.ExpMonth
+	}
+	return 0
+}
+
+func (m *CardDetails) GetExpYear() uint32 {
+	if m != nil {
+		return m.ExpYear
+	}
+	return 0
+}
+
+func (m *CardDetails) GetFunding() string {
+	if m != nil {
+		return m.Funding
+	}
+	return ""
+}
+
+func (m *CardDetails) GetCountry() string {
+	if m != nil {
+		return m.Country
+	}
+	return ""
+}
+
+func (m *CardDetails) GetFingerprint() string {
+	if m != nil {
+		return m.Fingerprint
+	}
+	return ""
+}
+
+func (m *CardDetails) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CardDetails) GetAddressZip() string {
+	if m != nil {
+		return m.AddressZip
+	}
+	return ""
+}
+
+func (m *CardDetails) GetCvcCheck() string {
+	if m != nil {
+		return m.CvcCheck
+	}
+	return ""
+}
+
+func (m *CardDetails) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+type AttachSourceRequest struct {
+	Customer string            `protobuf:"bytes,1,opt,name=customer" json:"customer,omitempty"`
+	Token    string            `protobuf:"bytes,2,opt,name=token" json:"token,omitempty"`
+	Metadata map[string]string `protobuf:"bytes,3,rep,name=metadata" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (m *AttachSourceRequest) Reset()                    { *m = AttachSourceRequest{} }
+func (m *AttachSourceRequest) String() string            { return proto.CompactTextString(m) }
+func (*AttachSourceRequest) ProtoMessage()               {}
+func (*AttachSourceRequest) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{3} }
+
+func (m *AttachSourceRequest) GetCustomer() string {
+	if m != nil {
+		return m.Customer
+	}
+	return ""
+}
+
+func (m *AttachSourceRequest) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *AttachSourceRequest) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+type ListSourcesRequest struct {
+	Customer      string `protobuf:"bytes,1,opt,name=customer" json:"customer,omitempty"`
+	EndingBefore  string `protobuf:"bytes,2,opt,name=ending_before,json=endingBefore" json:"ending_before,omitempty"`
+	StartingAfter string `protobuf:"bytes,3,opt,name=starting_after,json=startingAfter" json:"starting_after,omitempty"`
+	Limit         int32  `protobuf:"varint,4,opt,name=limit" json:"limit,omitempty"`
+}
+
+func (m *ListSourcesRequest) Reset()                    { *m = ListSourcesRequest{} }
+func (m *ListSourcesRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListSourcesRequest) ProtoMessage()               {}
+func (*ListSourcesRequest) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{4} }
+
+func (m *ListSourcesRequest) GetCustomer() string {
+	if m != nil {
+		return m.Customer
+	}
+	return ""
+}
+
+func (m *ListSourcesRequest) GetEndingBefore() string {
+	if m != nil {
+		return m.EndingBefore
+	}
+	return ""
+}
+
+func (m *ListSourcesRequest) GetStartingAfter() string {
+	if m != nil {
+		return m.StartingAfter
+	}
+	return ""
+}
+
+func (m *ListSourcesRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type SetDefaultSourceRequest struct {
+	Customer string `protobuf:"bytes,1,opt,name=customer" json:"customer,omitempty"`
+	Source   string `protobuf:"bytes,2,opt,name=source" json:"source,omitempty"`
+}
+
+func (m *SetDefaultSourceRequest) Reset()                    { *m = SetDefaultSourceRequest{} }
+func (m *SetDefaultSourceRequest) String() string            { return proto.CompactTextString(m) }
+func (*SetDefaultSourceRequest) ProtoMessage()               {}
+func (*SetDefaultSourceRequest) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{5} }
+
+func (m *SetDefaultSourceRequest) GetCustomer() string {
+	if m != nil {
+		return m.Customer
+	}
+	return ""
+}
+
+func (m *SetDefaultSourceRequest) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+type DetachSourceRequest struct {
+	Customer string `protobuf:"bytes,1,opt,name=customer" json:"customer,omitempty"`
+	Source   string `protobuf:"bytes,2,opt,name=source" json:"source,omitempty"`
+}
+
+func (m *DetachSourceRequest) Reset()                    { *m = DetachSourceRequest{} }
+func (m *DetachSourceRequest) String() string            { return proto.CompactTextString(m) }
+func (*DetachSourceRequest) ProtoMessage()               {}
+func (*DetachSourceRequest) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{6} }
+
+func (m *DetachSourceRequest) GetCustomer() string {
+	if m != nil {
+		return m.Customer
+	}
+	return ""
+}
+
+func (m *DetachSourceRequest) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+type DetachSourceSuccess struct {
+	Deleted bool   `protobuf:"varint,1,opt,name=deleted" json:"deleted,omitempty"`
+	Id      string `protobuf:"bytes,2,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *DetachSourceSuccess) Reset()                    { *m = DetachSourceSuccess{} }
+func (m *DetachSourceSuccess) String() string            { return proto.CompactTextString(m) }
+func (*DetachSourceSuccess) ProtoMessage()               {}
+func (*DetachSourceSuccess) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{7} }
+
+func (m *DetachSourceSuccess) GetDeleted() bool {
+	if m != nil {
+		return m.Deleted
+	}
+	return false
+}
+
+func (m *DetachSourceSuccess) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type DetachSourceResponse struct {
+	// Types that are valid to be assigned to Responses:
+	//	*DetachSourceResponse_Error
+	//	*DetachSourceResponse_Success
+	Responses isDetachSourceResponse_Responses `protobuf_oneof:"responses"`
+}
+
+func (m *DetachSourceResponse) Reset()                    { *m = DetachSourceResponse{} }
+func (m *DetachSourceResponse) String() string            { return proto.CompactTextString(m) }
+func (*DetachSourceResponse) ProtoMessage()               {}
+func (*DetachSourceResponse) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{8} }
+
+type isDetachSourceResponse_Responses interface {
+	isDetachSourceResponse_Responses()
+}
+
+type DetachSourceResponse_Error struct {
+	Error *Error `protobuf:"bytes,1,opt,name=error,oneof"`
+}
+type DetachSourceResponse_Success struct {
+	Success *DetachSourceSuccess `protobuf:"bytes,2,opt,name=success,oneof"`
+}
+
+func (*DetachSourceResponse_Error) isDetachSourceResponse_Responses()   {}
+func (*DetachSourceResponse_Success) isDetachSourceResponse_Responses() {}
+
+func (m *DetachSourceResponse) GetResponses() isDetachSourceResponse_Responses {
+	if m != nil {
+		return m.Responses
+	}
+	return nil
+}
+
+func (m *DetachSourceResponse) GetError() *Error {
+	if x, ok := m.GetResponses().(*DetachSourceResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+func (m *DetachSourceResponse) GetSuccess() *DetachSourceSuccess {
+	if x, ok := m.GetResponses().(*DetachSourceResponse_Success); ok {
+		return x.Success
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*DetachSourceResponse) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _DetachSourceResponse_OneofMarshaler, _DetachSourceResponse_OneofUnmarshaler, _DetachSourceResponse_OneofSizer, []interface{}{
+		(*DetachSourceResponse_Error)(nil),
+		(*DetachSourceResponse_Success)(nil),
+	}
+}
+
+func _DetachSourceResponse_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*DetachSourceResponse)
+	// responses
+	switch x := m.Responses.(type) {
+	case *DetachSourceResponse_Error:
+		b.EncodeVarint(1<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Error); err != nil {
+			return err
+		}
+	case *DetachSourceResponse_Success:
+		b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Success); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("DetachSourceResponse.Responses has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _DetachSourceResponse_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*DetachSourceResponse)
+	switch tag {
+	case 1: // responses.error
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Error)
+		err := b.DecodeMessage(msg)
+		m.Responses = &DetachSourceResponse_Error{msg}
+		return true, err
+	case 2: // responses.success
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(DetachSourceSuccess)
+		err := b.DecodeMessage(msg)
+		m.Responses = &DetachSourceResponse_Success{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _DetachSourceResponse_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*DetachSourceResponse)
+	// responses
+	switch x := m.Responses.(type) {
+	case *DetachSourceResponse_Error:
+		s := proto.Size(x.Error)
+		n += proto.SizeVarint(1<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *DetachSourceResponse_Success:
+		s := proto.Size(x.Success)
+		n += proto.SizeVarint(2<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+func init() {
+	proto.RegisterType((*SourceResponse)(nil), "SourceResponse")
+	proto.RegisterType((*PaymentSource)(nil), "PaymentSource")
+	proto.RegisterType((*CardDetails)(nil), "CardDetails")
+	proto.RegisterType((*AttachSourceRequest)(nil), "AttachSourceRequest")
+	proto.RegisterType((*ListSourcesRequest)(nil), "ListSourcesRequest")
+	proto.RegisterType((*SetDefaultSourceRequest)(nil), "SetDefaultSourceRequest")
+	proto.RegisterType((*DetachSourceRequest)(nil), "DetachSourceRequest")
+	proto.RegisterType((*DetachSourceSuccess)(nil), "DetachSourceSuccess")
+	proto.RegisterType((*DetachSourceResponse)(nil), "DetachSourceResponse")
+	proto.RegisterEnum("SourceType", SourceType_name, SourceType_value)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for Sources service
+
+type SourcesClient interface {
+	AttachSource(ctx context.Context, in *AttachSourceRequest, opts ...grpc.CallOption) (*SourceResponse, error)
+	ListSources(ctx context.Context, in *ListSourcesRequest, opts ...grpc.CallOption) (Sources_ListSourcesClient, error)
+	SetDefaultSource(ctx context.Context, in *SetDefaultSourceRequest, opts ...grpc.CallOption) (*CustomerResponse, error)
+	DetachSource(ctx context.Context, in *DetachSourceRequest, opts ...grpc.CallOption) (*DetachSourceResponse, error)
+}
+
+type sourcesClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewSourcesClient(cc *grpc.ClientConn) SourcesClient {
+	return &sourcesClient{cc}
+}
+
+func (c *sourcesClient) AttachSource(ctx context.Context, in *AttachSourceRequest, opts ...grpc.CallOption) (*SourceResponse, error) {
+	out := new(SourceResponse)
+	err := grpc.Invoke(ctx, "/Sources/AttachSource", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sourcesClient) ListSources(ctx context.Context, in *ListSourcesRequest, opts ...grpc.CallOption) (Sources_ListSourcesClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Sources_serviceDesc.Streams[0], c.cc, "/Sources/ListSources", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &sourcesListSourcesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Sources_ListSourcesClient interface {
+	Recv() (*SourceResponse, error)
+	grpc.ClientStream
+}
+
+type sourcesListSourcesClient struct {
+	grpc.ClientStream
+}
+
+func (x *sourcesListSourcesClient) Recv() (*SourceResponse, error) {
+	m := new(SourceResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *sourcesClient) SetDefaultSource(ctx context.Context, in *SetDefaultSourceRequest, opts ...grpc.CallOption) (*CustomerResponse, error) {
+	out := new(CustomerResponse)
+	err := grpc.Invoke(ctx, "/Sources/SetDefaultSource", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sourcesClient) DetachSource(ctx context.Context, in *DetachSourceRequest, opts ...grpc.CallOption) (*DetachSourceResponse, error) {
+	out := new(DetachSourceResponse)
+	err := grpc.Invoke(ctx, "/Sources/DetachSource", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for Sources service
+
+type SourcesServer interface {
+	AttachSource(context.Context, *AttachSourceRequest) (*SourceResponse, error)
+	ListSources(*ListSourcesRequest, Sources_ListSourcesServer) error
+	SetDefaultSource(context.Context, *SetDefaultSourceRequest) (*CustomerResponse, error)
+	DetachSource(context.Context, *DetachSourceRequest) (*DetachSourceResponse, error)
+}
+
+func RegisterSourcesServer(s *grpc.Server, srv SourcesServer) {
+	s.RegisterService(&_Sources_serviceDesc, srv)
+}
+
+func _Sources_AttachSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttachSourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SourcesServer).AttachSource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Sources/AttachSource",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SourcesServer).AttachSource(ctx, req.(*AttachSourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sources_ListSources_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListSourcesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SourcesServer).ListSources(m, &sourcesListSourcesServer{stream})
+}
+
+type Sources_ListSourcesServer interface {
+	Send(*SourceResponse) error
+	grpc.ServerStream
+}
+
+type sourcesListSourcesServer struct {
+	grpc.ServerStream
+}
+
+func (x *sourcesListSourcesServer) Send(m *SourceResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Sources_SetDefaultSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDefaultSourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SourcesServer).SetDefaultSource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Sources/SetDefaultSource",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SourcesServer).SetDefaultSource(ctx, req.(*SetDefaultSourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sources_DetachSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DetachSourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SourcesServer).DetachSource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Sources/DetachSource",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SourcesServer).DetachSource(ctx, req.(*DetachSourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Sources_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "Sources",
+	HandlerType: (*SourcesServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AttachSource",
+			Handler:    _Sources_AttachSource_Handler,
+		},
+		{
+			MethodName: "SetDefaultSource",
+			Handler:    _Sources_SetDefaultSource_Handler,
+		},
+		{
+			MethodName: "DetachSource",
+			Handler:    _Sources_DetachSource_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ListSources",
+			Handler:       _Sources_ListSources_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "source.proto",
+}
+
+func init() { proto.RegisterFile("source.proto", fileDescriptor8) }
+
+var fileDescriptor8 = []byte{
+	// 755 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x5f, 0x6f, 0xfb, 0x34,
+	0x14, 0x6d, 0xfa, 0xbf, 0x37, 0x6d, 0xe9, 0xbc, 0x0e, 0xb2, 0x22, 0xb1, 0x2a, 0x08, 0x69, 0xda,
+	0x43, 0x34, 0x15, 0xc4, 0x10, 0x08, 0x50, 0xbb, 0x4d, 0x1a, 0x12, 0x13, 0x28, 0x83, 0x07, 0x78,
+	0xa9, 0x5c, 0xe7, 0x76, 0x0b, 0x6d, 0x9d, 0x60, 0x3b, 0xdd, 0xca, 0x03, 0x1f, 0x81, 0x07, 0x3e,
+	0x13, 0x5f, 0x0b, 0x09, 0xc5, 0x71, 0xb6, 0x74, 0xeb, 0xa4, 0x09, 0xe9, 0xf7, 0xe6, 0x73, 0xee,
+	0xcd, 0xf5, 0xf1, 0xf5, 0xf1, 0x0d, 0xb4, 0x65, 0x94, 0x08, 0x86, 0x5e, 0x2c, 0x22, 0x15, 0x0d,
+	0xba, 0x2c, 0x91, 0x2a, 0x5a, 0xa1, 0x30, 0xd8, 0x46, 0x21, 0x22, 0x03, 0xdc, 0x10, 0xba, 0x37,
+	0x3a, 0xd9, 0x47, 0x19, 0x47, 0x5c, 0x22, 0xf9, 0x08, 0x6a, 0x3a, 0xc1, 0xb1, 0x86, 0xd6, 0xb1,
+	0x3d, 0xaa, 0x7b, 0x97, 0x29, 0xba, 0x2a, 0xf9, 0x19, 0x4d, 0x4e, 0xa0, 0x21, 0x13, 0xc6, 0x50,
+	0x4a, 0xa7, 0xac, 0x33, 0xba, 0xde, 0x8f, 0x74, 0xb3, 0x42, 0xae, 0xb2, 0x42, 0x57, 0x25, 0x3f,
+	0x4f, 0x98, 0xd8, 0xd0, 0x12, 0xa6, 0xae, 0x74, 0xff, 0x84, 0xce, 0x56, 0x22, 0xe9, 0x42, 0x39,
+	0x0c, 0xf4, 0x36, 0x2d, 0xbf, 0x1c, 0x06, 0x64, 0x00, 0xcd, 0x5c, 0xaa, 0x2e, 0xdd, 0xf2, 0x1f,
+	0x31, 0x39, 0x82, 0xaa, 0xda, 0xc4, 0xe8, 0x54, 0x86, 0xd6, 0x71, 0x77, 0x64, 0x7b, 0x59, 0x89,
+	0x9f, 0x36, 0x31, 0xfa, 0x3a, 0x40, 0x86, 0x50, 0x65, 0x54, 0x04, 0x4e, 0x55, 0x6b, 0x6a, 0x7b,
+	0xe7, 0x54, 0x04, 0x17, 0xa8, 0x68, 0xb8, 0x94, 0xbe, 0x8e, 0xb8, 0x7f, 0x55, 0xc0, 0x2e, 0xb0,
+	0xa4, 0x0f, 0xb5, 0x99, 0xa0, 0x3c, 0x57, 0x90, 0x81, 0x94, 0x5d, 0x52, 0xa9, 0x3e, 0x33, 0x0a,
+	0x32, 0x40, 0x3e, 0x84, 0x16, 0x3e, 0xc4, 0xd3, 0x55, 0xc4, 0xd5, 0x9d, 0xd6, 0xd0, 0xf1, 0x9b,
+	0xf8, 0x10, 0x5f, 0xa7, 0x98, 0x1c, 0x42, 0xba, 0x9e, 0x6e, 0x90, 0x0a, 0xbd, 0x7d, 0xc7, 0x6f,
+	0xe0, 0x43, 0xfc, 0x0b, 0x52, 0x41, 0x1c, 0x68, 0xcc, 0x13, 0x1e, 0x84, 0xfc, 0xd6, 0xa9, 0xe9,
+	0x7a, 0x39, 0x4c, 0x23, 0x2c, 0x4a, 0xb8, 0x12, 0x1b, 0xa7, 0x9e, 0x45, 0x0c, 0x24, 0x43, 0xb0,
+	0xe7, 0x21, 0xbf, 0x45, 0x11, 0x8b, 0x90, 0x2b, 0xa7, 0xa1, 0xa3, 0x45, 0x8a, 0x10, 0xa8, 0x72,
+	0xba, 0x42, 0xa7, 0xa9, 0x43, 0x7a, 0x4d, 0x8e, 0xc0, 0xa6, 0x41, 0x20, 0x50, 0xca, 0xe9, 0x1f,
+	0x61, 0xec, 0xb4, 0x74, 0x08, 0x0c, 0xf5, 0x6b, 0x18, 0xa7, 0x47, 0x60, 0x6b, 0x36, 0x65, 0x77,
+	0xc8, 0x16, 0x0e, 0x98, 0xf6, 0xae, 0xd9, 0x79, 0x8a, 0xc9, 0xe7, 0xd0, 0x5c, 0xa1, 0xa2, 0x01,
+	0x55, 0xd4, 0xb1, 0x87, 0x95, 0x63, 0x7b, 0x34, 0x28, 0x76, 0xd0, 0xbb, 0x36, 0xc1, 0xcb, 0x54,
+	0xa1, 0xff, 0x98, 0x3b, 0xf8, 0x0a, 0x3a, 0x5b, 0x21, 0xd2, 0x83, 0xca, 0x02, 0x37, 0xa6, 0xa5,
+	0xe9, 0x32, 0x6d, 0xe8, 0x9a, 0x2e, 0x13, 0xcc, 0x1b, 0xaa, 0xc1, 0x97, 0xe5, 0x2f, 0x2c, 0xf7,
+	0x1f, 0x0b, 0xf6, 0xc7, 0x4a, 0x51, 0x76, 0x97, 0x5b, 0xf0, 0xf7, 0x04, 0xa5, 0xda, 0xf2, 0x81,
+	0xf5, 0xcc, 0x07, 0x7d, 0xa8, 0xa9, 0x68, 0x81, 0x3c, 0xaf, 0xa6, 0x01, 0xf9, 0xa6, 0x20, 0xbf,
+	0xa2, 0xe5, 0xbb, 0xde, 0x8e, 0xca, 0xef, 0xe6, 0x18, 0x7f, 0x5b, 0x40, 0xbe, 0x0f, 0xa5, 0x71,
+	0xb5, 0x7c, 0xcb, 0x29, 0x3e, 0x86, 0x0e, 0x6a, 0x1b, 0x4c, 0x67, 0x38, 0x8f, 0x44, 0x5e, 0xb4,
+	0x9d, 0x91, 0x13, 0xcd, 0x91, 0x4f, 0xa0, 0x2b, 0x15, 0x15, 0x2a, 0x4d, 0xa3, 0x73, 0x85, 0x42,
+	0x1b, 0xaf, 0xe5, 0x77, 0x72, 0x76, 0x9c, 0x92, 0xda, 0xb0, 0xe1, 0x2a, 0x54, 0xda, 0x7a, 0x35,
+	0x3f, 0x03, 0xee, 0x35, 0x7c, 0x70, 0x83, 0xea, 0x02, 0xe7, 0x34, 0x59, 0xaa, 0xb7, 0xb7, 0xf7,
+	0x7d, 0xa8, 0x67, 0xb3, 0xc3, 0x28, 0x32, 0xc8, 0xfd, 0x0e, 0xf6, 0x2f, 0xf0, 0x45, 0x3f, 0xff,
+	0x57, 0xa9, 0x6f, 0xb7, 0x4b, 0xdd, 0x64, 0xa3, 0x22, 0x7d, 0x0f, 0x01, 0x2e, 0x51, 0x61, 0xf6,
+	0x1e, 0x9b, 0x7e, 0x0e, 0xcd, 0x98, 0x28, 0xe7, 0x63, 0xc2, 0x4d, 0xa0, 0xbf, 0xad, 0xe5, 0x8d,
+	0x83, 0xeb, 0xf4, 0xf9, 0xe0, 0xea, 0x7b, 0x3b, 0x84, 0xbc, 0x36, 0xbe, 0x4e, 0xee, 0x01, 0x9e,
+	0x86, 0x0e, 0x39, 0x80, 0xbd, 0x9f, 0xf9, 0x82, 0x47, 0xf7, 0xfc, 0x89, 0xec, 0x95, 0x48, 0x17,
+	0x20, 0x7d, 0x36, 0x19, 0xd7, 0xb3, 0xd2, 0xb4, 0x09, 0xe5, 0x8b, 0x31, 0xd3, 0x8f, 0xdb, 0xd0,
+	0x65, 0x72, 0x08, 0x07, 0x93, 0x50, 0xb1, 0x28, 0xe4, 0x3e, 0x32, 0x0c, 0xd7, 0x28, 0x4c, 0xa8,
+	0x42, 0x7a, 0xd0, 0xce, 0xd6, 0x3f, 0xcc, 0x7e, 0x43, 0xa6, 0x7a, 0xd5, 0xd1, 0xbf, 0x16, 0x34,
+	0x32, 0x4a, 0x92, 0x33, 0x68, 0x17, 0x7d, 0x4d, 0xfa, 0xbb, 0x6c, 0x3e, 0x78, 0xcf, 0xdb, 0x6e,
+	0x8d, 0x5b, 0x22, 0x67, 0x60, 0x17, 0x3c, 0x4a, 0xf6, 0xbd, 0x97, 0x8e, 0xdd, 0xf1, 0xd9, 0xa9,
+	0x45, 0xc6, 0xd0, 0x7b, 0x6e, 0x24, 0xe2, 0x78, 0xaf, 0x78, 0x6b, 0xb0, 0xe7, 0x9d, 0x9b, 0xfb,
+	0x2f, 0xec, 0xfd, 0x35, 0xb4, 0x8b, 0x8d, 0x26, 0xdb, 0x7d, 0xcf, 0x3f, 0x3d, 0xf0, 0x76, 0xdd,
+	0xaa, 0x5b, 0x9a, 0xd5, 0xf5, 0x9f, 0xea, 0xd3, 0xff, 0x06, 0x00, 0xaa, 0x0f, 0x16, 0x32, 0xd6,
+	0x06, 0x00, 0x00,
+}
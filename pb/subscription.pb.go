@@ -0,0 +1,878 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: subscription.proto
+
+package pb
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+type SubscriptionStatus int32
+
+const (
+	SubscriptionStatus_UnknownStatus SubscriptionStatus = 0
+	SubscriptionStatus_Trialing      SubscriptionStatus = 1
+	SubscriptionStatus_Active        SubscriptionStatus = 2
+	SubscriptionStatus_PastDue       SubscriptionStatus = 3
+	SubscriptionStatus_Canceled      SubscriptionStatus = 4
+	SubscriptionStatus_Unpaid        SubscriptionStatus = 5
+)
+
+var SubscriptionStatus_name = map[int32]string{
+	0: "UnknownStatus",
+	1: "Trialing",
+	2: "Active",
+	3: "PastDue",
+	4: "Canceled",
+	5: "Unpaid",
+}
+var SubscriptionStatus_value = map[string]int32{
+	"UnknownStatus": 0,
+	"Trialing":      1,
+	"Active":        2,
+	"PastDue":       3,
+	"Canceled":      4,
+	"Unpaid":        5,
+}
+
+func (x SubscriptionStatus) String() string {
+	return proto.EnumName(SubscriptionStatus_name, int32(x))
+}
+func (SubscriptionStatus) EnumDescriptor() ([]byte, []int) { return fileDescriptor9, []int{0} }
+
+type SubscriptionResponse struct {
+	// Types that are valid to be assigned to Responses:
+	//	*SubscriptionResponse_Error
+	//	*SubscriptionResponse_Success
+	Responses isSubscriptionResponse_Responses `protobuf_oneof:"responses"`
+}
+
+func (m *SubscriptionResponse) Reset()                    { *m = SubscriptionResponse{} }
+func (m *SubscriptionResponse) String() string            { return proto.CompactTextString(m) }
+func (*SubscriptionResponse) ProtoMessage()               {}
+func (*SubscriptionResponse) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{0} }
+
+type isSubscriptionResponse_Responses interface {
+	isSubscriptionResponse_Responses()
+}
+
+type SubscriptionResponse_Error struct {
+	Error *Error `protobuf:"bytes,1,opt,name=error,oneof"`
+}
+type SubscriptionResponse_Success struct {
+	Success *Subscription `protobuf:"bytes,2,opt,name=success,oneof"`
+}
+
+func (*SubscriptionResponse_Error) isSubscriptionResponse_Responses()   {}
+func (*SubscriptionResponse_Success) isSubscriptionResponse_Responses() {}
+
+func (m *SubscriptionResponse) GetResponses() isSubscriptionResponse_Responses {
+	if m != nil {
+		return m.Responses
+	}
+	return nil
+}
+
+func (m *SubscriptionResponse) GetError() *Error {
+	if x, ok := m.GetResponses().(*SubscriptionResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+func (m *SubscriptionResponse) GetSuccess() *Subscription {
+	if x, ok := m.GetResponses().(*SubscriptionResponse_Success); ok {
+		return x.Success
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*SubscriptionResponse) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _SubscriptionResponse_OneofMarshaler, _SubscriptionResponse_OneofUnmarshaler, _SubscriptionResponse_OneofSizer, []interface{}{
+		(*SubscriptionResponse_Error)(nil),
+		(*SubscriptionResponse_Success)(nil),
+	}
+}
+
+func _SubscriptionResponse_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*SubscriptionResponse)
+	// responses
+	switch x := m.Responses.(type) {
+	case *SubscriptionResponse_Error:
+		b.EncodeVarint(1<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Error); err != nil {
+			return err
+		}
+	case *SubscriptionResponse_Success:
+		b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Success); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("SubscriptionResponse.Responses has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _SubscriptionResponse_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*SubscriptionResponse)
+	switch tag {
+	case 1: // responses.error
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Error)
+		err := b.DecodeMessage(msg)
+		m.Responses = &SubscriptionResponse_Error{msg}
+		return true, err
+	case 2: // responses.success
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Subscription)
+		err := b.DecodeMessage(msg)
+		m.Responses = &SubscriptionResponse_Success{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _SubscriptionResponse_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*SubscriptionResponse)
+	// responses
+	switch x := m.Responses.(type) {
+	case *SubscriptionResponse_Error:
+		s := proto.Size(x.Error)
+		n += proto.SizeVarint(1<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *SubscriptionResponse_Success:
+		s := proto.Size(x.Success)
+		n += proto.SizeVarint(2<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+type Subscription struct {
+	Id                 string             `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Customer           string             `protobuf:"bytes,2,opt,name=customer" json:"customer,omitempty"`
+	Plan               *Plan              `protobuf:"bytes,3,opt,name=plan" json:"plan,omitempty"`
+	Quantity           uint64             `protobuf:"varint,4,opt,name=quantity" json:"quantity,omitempty"`
+	Status             SubscriptionStatus `protobuf:"varint,5,opt,name=status,enum=SubscriptionStatus" json:"status,omitempty"`
+	CancelAtPeriodEnd  bool               `protobuf:"varint,6,opt,name=cancel_at_period_end,json=cancelAtPeriodEnd" json:"cancel_at_period_end,omitempty"`
+	CanceledAt         int64              `protobuf:"varint,7,opt,name=canceled_at,json=canceledAt" json:"canceled_at,omitempty"`
+	Created            int64              `protobuf:"varint,8,opt,name=created" json:"created,omitempty"`
+	CurrentPeriodStart int64              `protobuf:"varint,9,opt,name=current_period_start,json=currentPeriodStart" json:"current_period_start,omitempty"`
+	CurrentPeriodEnd   int64              `protobuf:"varint,10,opt,name=current_period_end,json=currentPeriodEnd" json:"current_period_end,omitempty"`
+	EndedAt            int64              `protobuf:"varint,11,opt,name=ended_at,json=endedAt" json:"ended_at,omitempty"`
+	Metadata           map[string]string  `protobuf:"bytes,12,rep,name=metadata" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Start              int64              `protobuf:"varint,13,opt,name=start" json:"start,omitempty"`
+	TrialStart         int64              `protobuf:"varint,14,opt,name=trial_start,json=trialStart" json:"trial_start,omitempty"`
+	TrialEnd           int64              `protobuf:"varint,15,opt,name=trial_end,json=trialEnd" json:"trial_end,omitempty"`
+	Discount           *Discount          `protobuf:"bytes,16,opt,name=discount" json:"discount,omitempty"`
+	SubscriptionItem   string             `protobuf:"bytes,17,opt,name=subscription_item,json=subscriptionItem" json:"subscription_item,omitempty"`
+}
+
+func (m *Subscription) Reset()                    { *m = Subscription{} }
+func (m *Subscription) String() string            { return proto.CompactTextString(m) }
+func (*Subscription) ProtoMessage()               {}
+func (*Subscription) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{1} }
+
+func (m *Subscription) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Subscription) GetCustomer() string {
+	if m != nil {
+		return m.Customer
+	}
+	return ""
+}
+
+func (m *Subscription) GetPlan() *Plan {
+	if m != nil {
+		return m.Plan
+	}
+	return nil
+}
+
+func (m *Subscription) GetQuantity() uint64 {
+	if m != nil {
+		return m.Quantity
+	}
+	return 0
+}
+
+func (m *Subscription) GetStatus() SubscriptionStatus {
+	if m != nil {
+		return m.Status
+	}
+	return SubscriptionStatus_UnknownStatus
+}
+
+func (m *Subscription) GetCancelAtPeriodEnd() bool {
+	if m != nil {
+		return m.CancelAtPeriodEnd
+	}
+	return false
+}
+
+func (m *Subscription) GetCanceledAt() int64 {
+	if m != nil {
+		return m.CanceledAt
+	}
+	return 0
+}
+
+func (m *Subscription) GetCreated() int64 {
+	if m != nil {
+		return m.Created
+	}
+	return 0
+}
+
+func (m *Subscription) GetCurrentPeriodStart() int64 {
+	if m != nil {
+		return m.CurrentPeriodStart
+	}
+	return 0
+}
+
+func (m *Subscription) GetCurrentPeriodEnd() int64 {
+	if m != nil {
+		return m.CurrentPeriodEnd
+	}
+	return 0
+}
+
+func (m *Subscription) GetEndedAt() int64 {
+	if m != nil {
+		return m.EndedAt
+	}
+	return 0
+}
+
+func (m *Subscription) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *Subscription) GetStart() int64 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+func (m *Subscription) GetTrialStart() int64 {
+	if m != nil {
+		return m.TrialStart
+	}
+	return 0
+}
+
+func (m *Subscription) GetTrialEnd() int64 {
+	if m != nil {
+		return m.TrialEnd
+	}
+	return 0
+}
+
+func (m *Subscription) GetDiscount() *Discount {
+	if m != nil {
+		return m.Discount
+	}
+	return nil
+}
+
+func (m *Subscription) GetSubscriptionItem() string {
+	if m != nil {
+		return m.SubscriptionItem
+	}
+	return ""
+}
+
+type CreateSubscriptionRequest struct {
+	Customer string            `protobuf:"bytes,1,opt,name=customer" json:"customer,omitempty"`
+	Plan     string            `protobuf:"bytes,2,opt,name=plan" json:"plan,omitempty"`
+	Quantity uint64            `protobuf:"varint,3,opt,name=quantity" json:"quantity,omitempty"`
+	TrialEnd int64             `protobuf:"varint,4,opt,name=trial_end,json=trialEnd" json:"trial_end,omitempty"`
+	Metadata map[string]string `protobuf:"bytes,5,rep,name=metadata" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (m *CreateSubscriptionRequest) Reset()                    { *m = CreateSubscriptionRequest{} }
+func (m *CreateSubscriptionRequest) String() string            { return proto.CompactTextString(m) }
+func (*CreateSubscriptionRequest) ProtoMessage()               {}
+func (*CreateSubscriptionRequest) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{2} }
+
+func (m *CreateSubscriptionRequest) GetCustomer() string {
+	if m != nil {
+		return m.Customer
+	}
+	return ""
+}
+
+func (m *CreateSubscriptionRequest) GetPlan() string {
+	if m != nil {
+		return m.Plan
+	}
+	return ""
+}
+
+func (m *CreateSubscriptionRequest) GetQuantity() uint64 {
+	if m != nil {
+		return m.Quantity
+	}
+	return 0
+}
+
+func (m *CreateSubscriptionRequest) GetTrialEnd() int64 {
+	if m != nil {
+		return m.TrialEnd
+	}
+	return 0
+}
+
+func (m *CreateSubscriptionRequest) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+type GetSubscriptionRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *GetSubscriptionRequest) Reset()                    { *m = GetSubscriptionRequest{} }
+func (m *GetSubscriptionRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetSubscriptionRequest) ProtoMessage()               {}
+func (*GetSubscriptionRequest) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{3} }
+
+func (m *GetSubscriptionRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type UpdateSubscriptionRequest struct {
+	Id            string            `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Plan          string            `protobuf:"bytes,2,opt,name=plan" json:"plan,omitempty"`
+	Quantity      uint64            `protobuf:"varint,3,opt,name=quantity" json:"quantity,omitempty"`
+	NoProrate     bool              `protobuf:"varint,4,opt,name=no_prorate,json=noProrate" json:"no_prorate,omitempty"`
+	ProrationDate int64             `protobuf:"varint,5,opt,name=proration_date,json=prorationDate" json:"proration_date,omitempty"`
+	Metadata      map[string]string `protobuf:"bytes,6,rep,name=metadata" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (m *UpdateSubscriptionRequest) Reset()                    { *m = UpdateSubscriptionRequest{} }
+func (m *UpdateSubscriptionRequest) String() string            { return proto.CompactTextString(m) }
+func (*UpdateSubscriptionRequest) ProtoMessage()               {}
+func (*UpdateSubscriptionRequest) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{4} }
+
+func (m *UpdateSubscriptionRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *UpdateSubscriptionRequest) GetPlan() string {
+	if m != nil {
+		return m.Plan
+	}
+	return ""
+}
+
+func (m *UpdateSubscriptionRequest) GetQuantity() uint64 {
+	if m != nil {
+		return m.Quantity
+	}
+	return 0
+}
+
+func (m *UpdateSubscriptionRequest) GetNoProrate() bool {
+	if m != nil {
+		return m.NoProrate
+	}
+	return false
+}
+
+func (m *UpdateSubscriptionRequest) GetProrationDate() int64 {
+	if m != nil {
+		return m.ProrationDate
+	}
+	return 0
+}
+
+func (m *UpdateSubscriptionRequest) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+type CancelSubscriptionRequest struct {
+	Id          string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	AtPeriodEnd bool   `protobuf:"varint,2,opt,name=at_period_end,json=atPeriodEnd" json:"at_period_end,omitempty"`
+}
+
+func (m *CancelSubscriptionRequest) Reset()                    { *m = CancelSubscriptionRequest{} }
+func (m *CancelSubscriptionRequest) String() string            { return proto.CompactTextString(m) }
+func (*CancelSubscriptionRequest) ProtoMessage()               {}
+func (*CancelSubscriptionRequest) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{5} }
+
+func (m *CancelSubscriptionRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *CancelSubscriptionRequest) GetAtPeriodEnd() bool {
+	if m != nil {
+		return m.AtPeriodEnd
+	}
+	return false
+}
+
+type ReactivateSubscriptionRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *ReactivateSubscriptionRequest) Reset()                    { *m = ReactivateSubscriptionRequest{} }
+func (m *ReactivateSubscriptionRequest) String() string            { return proto.CompactTextString(m) }
+func (*ReactivateSubscriptionRequest) ProtoMessage()               {}
+func (*ReactivateSubscriptionRequest) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{6} }
+
+func (m *ReactivateSubscriptionRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type ListSubscriptionsRequest struct {
+	Customer      string             `protobuf:"bytes,1,opt,name=customer" json:"customer,omitempty"`
+	Plan          string             `protobuf:"bytes,2,opt,name=plan" json:"plan,omitempty"`
+	Status        SubscriptionStatus `protobuf:"varint,3,opt,name=status,enum=SubscriptionStatus" json:"status,omitempty"`
+	Created       *ListFilter        `protobuf:"bytes,4,opt,name=created" json:"created,omitempty"`
+	EndingBefore  string             `protobuf:"bytes,5,opt,name=ending_before,json=endingBefore" json:"ending_before,omitempty"`
+	StartingAfter string             `protobuf:"bytes,6,opt,name=starting_after,json=startingAfter" json:"starting_after,omitempty"`
+	Limit         int32              `protobuf:"varint,7,opt,name=limit" json:"limit,omitempty"`
+}
+
+func (m *ListSubscriptionsRequest) Reset()                    { *m = ListSubscriptionsRequest{} }
+func (m *ListSubscriptionsRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListSubscriptionsRequest) ProtoMessage()               {}
+func (*ListSubscriptionsRequest) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{7} }
+
+func (m *ListSubscriptionsRequest) GetCustomer() string {
+	if m != nil {
+		return m.Customer
+	}
+	return ""
+}
+
+func (m *ListSubscriptionsRequest) GetPlan() string {
+	if m != nil {
+		return m.Plan
+	}
+	return ""
+}
+
+func (m *ListSubscriptionsRequest) GetStatus() SubscriptionStatus {
+	if m != nil {
+		return m.Status
+	}
+	return SubscriptionStatus_UnknownStatus
+}
+
+func (m *ListSubscriptionsRequest) GetCreated() *ListFilter {
+	if m != nil {
+		return m.Created
+	}
+	return nil
+}
+
+func (m *ListSubscriptionsRequest) GetEndingBefore() string {
+	if m != nil {
+		return m.EndingBefore
+	}
+	return ""
+}
+
+func (m *ListSubscriptionsRequest) GetStartingAfter() string {
+	if m != nil {
+		return m.StartingAfter
+	}
+	return ""
+}
+
+func (m *ListSubscriptionsRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*SubscriptionResponse)(nil), "SubscriptionResponse")
+	proto.RegisterType((*Subscription)(nil), "Subscription")
+	proto.RegisterType((*CreateSubscriptionRequest)(nil), "CreateSubscriptionRequest")
+	proto.RegisterType((*GetSubscriptionRequest)(nil), "GetSubscriptionRequest")
+	proto.RegisterType((*UpdateSubscriptionRequest)(nil), "UpdateSubscriptionRequest")
+	proto.RegisterType((*CancelSubscriptionRequest)(nil), "CancelSubscriptionRequest")
+	proto.RegisterType((*ReactivateSubscriptionRequest)(nil), "ReactivateSubscriptionRequest")
+	proto.RegisterType((*ListSubscriptionsRequest)(nil), "ListSubscriptionsRequest")
+	proto.RegisterEnum("SubscriptionStatus", SubscriptionStatus_name, SubscriptionStatus_value)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for Subscriptions service
+
+type SubscriptionsClient interface {
+	UpdateSubscription(ctx context.Context, in *UpdateSubscriptionRequest, opts ...grpc.CallOption) (*SubscriptionResponse, error)
+	CreateSubscription(ctx context.Context, in *CreateSubscriptionRequest, opts ...grpc.CallOption) (*SubscriptionResponse, error)
+	CancelSubscription(ctx context.Context, in *CancelSubscriptionRequest, opts ...grpc.CallOption) (*SubscriptionResponse, error)
+	ReactivateSubscription(ctx context.Context, in *ReactivateSubscriptionRequest, opts ...grpc.CallOption) (*SubscriptionResponse, error)
+	GetSubscription(ctx context.Context, in *GetSubscriptionRequest, opts ...grpc.CallOption) (*SubscriptionResponse, error)
+	ListSubscriptions(ctx context.Context, in *ListSubscriptionsRequest, opts ...grpc.CallOption) (Subscriptions_ListSubscriptionsClient, error)
+}
+
+type subscriptionsClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewSubscriptionsClient(cc *grpc.ClientConn) SubscriptionsClient {
+	return &subscriptionsClient{cc}
+}
+
+func (c *subscriptionsClient) UpdateSubscription(ctx context.Context, in *UpdateSubscriptionRequest, opts ...grpc.CallOption) (*SubscriptionResponse, error) {
+	out := new(SubscriptionResponse)
+	err := grpc.Invoke(ctx, "/Subscriptions/UpdateSubscription", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscriptionsClient) CreateSubscription(ctx context.Context, in *CreateSubscriptionRequest, opts ...grpc.CallOption) (*SubscriptionResponse, error) {
+	out := new(SubscriptionResponse)
+	err := grpc.Invoke(ctx, "/Subscriptions/CreateSubscription", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscriptionsClient) CancelSubscription(ctx context.Context, in *CancelSubscriptionRequest, opts ...grpc.CallOption) (*SubscriptionResponse, error) {
+	out := new(SubscriptionResponse)
+	err := grpc.Invoke(ctx, "/Subscriptions/CancelSubscription", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscriptionsClient) ReactivateSubscription(ctx context.Context, in *ReactivateSubscriptionRequest, opts ...grpc.CallOption) (*SubscriptionResponse, error) {
+	out := new(SubscriptionResponse)
+	err := grpc.Invoke(ctx, "/Subscriptions/ReactivateSubscription", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscriptionsClient) GetSubscription(ctx context.Context, in *GetSubscriptionRequest, opts ...grpc.CallOption) (*SubscriptionResponse, error) {
+	out := new(SubscriptionResponse)
+	err := grpc.Invoke(ctx, "/Subscriptions/GetSubscription", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscriptionsClient) ListSubscriptions(ctx context.Context, in *ListSubscriptionsRequest, opts ...grpc.CallOption) (Subscriptions_ListSubscriptionsClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Subscriptions_serviceDesc.Streams[0], c.cc, "/Subscriptions/ListSubscriptions", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &subscriptionsListSubscriptionsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Subscriptions_ListSubscriptionsClient interface {
+	Recv() (*SubscriptionResponse, error)
+	grpc.ClientStream
+}
+
+type subscriptionsListSubscriptionsClient struct {
+	grpc.ClientStream
+}
+
+func (x *subscriptionsListSubscriptionsClient) Recv() (*SubscriptionResponse, error) {
+	m := new(SubscriptionResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Server API for Subscriptions service
+
+type SubscriptionsServer interface {
+	UpdateSubscription(context.Context, *UpdateSubscriptionRequest) (*SubscriptionResponse, error)
+	CreateSubscription(context.Context, *CreateSubscriptionRequest) (*SubscriptionResponse, error)
+	CancelSubscription(context.Context, *CancelSubscriptionRequest) (*SubscriptionResponse, error)
+	ReactivateSubscription(context.Context, *ReactivateSubscriptionRequest) (*SubscriptionResponse, error)
+	GetSubscription(context.Context, *GetSubscriptionRequest) (*SubscriptionResponse, error)
+	ListSubscriptions(*ListSubscriptionsRequest, Subscriptions_ListSubscriptionsServer) error
+}
+
+func RegisterSubscriptionsServer(s *grpc.Server, srv SubscriptionsServer) {
+	s.RegisterService(&_Subscriptions_serviceDesc, srv)
+}
+
+func _Subscriptions_UpdateSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionsServer).UpdateSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Subscriptions/UpdateSubscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionsServer).UpdateSubscription(ctx, req.(*UpdateSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Subscriptions_CreateSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionsServer).CreateSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Subscriptions/CreateSubscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionsServer).CreateSubscription(ctx, req.(*CreateSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Subscriptions_CancelSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionsServer).CancelSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Subscriptions/CancelSubscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionsServer).CancelSubscription(ctx, req.(*CancelSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Subscriptions_ReactivateSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReactivateSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionsServer).ReactivateSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Subscriptions/ReactivateSubscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionsServer).ReactivateSubscription(ctx, req.(*ReactivateSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Subscriptions_GetSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionsServer).GetSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Subscriptions/GetSubscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionsServer).GetSubscription(ctx, req.(*GetSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Subscriptions_ListSubscriptions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListSubscriptionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SubscriptionsServer).ListSubscriptions(m, &subscriptionsListSubscriptionsServer{stream})
+}
+
+type Subscriptions_ListSubscriptionsServer interface {
+	Send(*SubscriptionResponse) error
+	grpc.ServerStream
+}
+
+type subscriptionsListSubscriptionsServer struct {
+	grpc.ServerStream
+}
+
+func (x *subscriptionsListSubscriptionsServer) Send(m *SubscriptionResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _Subscriptions_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "Subscriptions",
+	HandlerType: (*SubscriptionsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateSubscription",
+			Handler:    _Subscriptions_UpdateSubscription_Handler,
+		},
+		{
+			MethodName: "CreateSubscription",
+			Handler:    _Subscriptions_CreateSubscription_Handler,
+		},
+		{
+			MethodName: "CancelSubscription",
+			Handler:    _Subscriptions_CancelSubscription_Handler,
+		},
+		{
+			MethodName: "ReactivateSubscription",
+			Handler:    _Subscriptions_ReactivateSubscription_Handler,
+		},
+		{
+			MethodName: "GetSubscription",
+			Handler:    _Subscriptions_GetSubscription_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ListSubscriptions",
+			Handler:       _Subscriptions_ListSubscriptions_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "subscription.proto",
+}
+
+func init() { proto.RegisterFile("subscription.proto", fileDescriptor9) }
+
+var fileDescriptor9 = []byte{
+	// 878 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xdd, 0x72, 0xdb, 0x44,
+	0x14, 0x8e, 0x24, 0xdb, 0x91, 0x8e, 0xac, 0x54, 0x5e, 0x42, 0x91, 0xdd, 0x69, 0xf1, 0x98, 0xc9,
+	0x8c, 0xa1, 0x8c, 0xda, 0x09, 0x17, 0x30, 0x70, 0xe5, 0x26, 0x81, 0x76, 0x06, 0x86, 0xb0, 0x21,
+	0xd7, 0x9e, 0x8d, 0xb4, 0x2d, 0x3b, 0xb5, 0x57, 0xea, 0xee, 0xaa, 0x4c, 0xee, 0xb8, 0xe3, 0x41,
+	0xe0, 0x8d, 0x78, 0x21, 0x66, 0x77, 0x15, 0xc7, 0xb2, 0xad, 0x50, 0x60, 0x7a, 0xe7, 0xf3, 0xb3,
+	0x67, 0xcf, 0x7e, 0xe7, 0x3b, 0x9f, 0x05, 0x48, 0x56, 0x57, 0x32, 0x13, 0xac, 0x54, 0xac, 0xe0,
+	0x69, 0x29, 0x0a, 0x55, 0x8c, 0xfa, 0x59, 0x51, 0x95, 0x2b, 0x2b, 0xa4, 0x42, 0x14, 0xa2, 0x36,
+	0xa0, 0x5c, 0x90, 0x3a, 0x30, 0xe1, 0x70, 0x78, 0xb1, 0x76, 0x18, 0x53, 0x59, 0x16, 0x5c, 0x52,
+	0xf4, 0x08, 0xba, 0xe6, 0x48, 0xe2, 0x8c, 0x9d, 0x69, 0x78, 0xdc, 0x4b, 0xcf, 0xb4, 0xf5, 0x7c,
+	0x0f, 0x5b, 0x37, 0xfa, 0x14, 0xf6, 0x65, 0x95, 0x65, 0x54, 0xca, 0xc4, 0x35, 0x19, 0x51, 0xba,
+	0x5e, 0xe7, 0xf9, 0x1e, 0xbe, 0x89, 0x3f, 0x0b, 0x21, 0x10, 0x75, 0x59, 0x39, 0xf9, 0xa3, 0x0b,
+	0xfd, 0xf5, 0x44, 0x74, 0x00, 0x2e, 0xcb, 0xcd, 0x2d, 0x01, 0x76, 0x59, 0x8e, 0x46, 0xe0, 0x67,
+	0x95, 0x54, 0xc5, 0x92, 0x0a, 0x53, 0x39, 0xc0, 0x2b, 0x1b, 0x0d, 0xa1, 0xa3, 0x5b, 0x4f, 0x3c,
+	0x73, 0x63, 0x37, 0x3d, 0x5f, 0x10, 0x8e, 0x8d, 0x4b, 0x1f, 0x7b, 0x53, 0x11, 0xae, 0x98, 0xba,
+	0x4e, 0x3a, 0x63, 0x67, 0xda, 0xc1, 0x2b, 0x1b, 0x3d, 0x86, 0x9e, 0x54, 0x44, 0x55, 0x32, 0xe9,
+	0x8e, 0x9d, 0xe9, 0xc1, 0xf1, 0x07, 0x8d, 0x56, 0x2f, 0x4c, 0x08, 0xd7, 0x29, 0xe8, 0x09, 0x1c,
+	0x66, 0x84, 0x67, 0x74, 0x31, 0x27, 0x6a, 0x5e, 0x52, 0xc1, 0x8a, 0x7c, 0x4e, 0x79, 0x9e, 0xf4,
+	0xc6, 0xce, 0xd4, 0xc7, 0x03, 0x1b, 0x9b, 0xa9, 0x73, 0x13, 0x39, 0xe3, 0x39, 0xfa, 0x18, 0x42,
+	0xeb, 0xa4, 0xf9, 0x9c, 0xa8, 0x64, 0x7f, 0xec, 0x4c, 0x3d, 0x0c, 0x37, 0xae, 0x99, 0x42, 0x09,
+	0xec, 0x67, 0x82, 0x12, 0x45, 0xf3, 0xc4, 0x37, 0xc1, 0x1b, 0x13, 0x3d, 0x85, 0xc3, 0xac, 0x12,
+	0x82, 0xf2, 0xd5, 0x4d, 0x52, 0x11, 0xa1, 0x92, 0xc0, 0xa4, 0xa1, 0x3a, 0x66, 0xaf, 0xba, 0xd0,
+	0x11, 0xf4, 0x39, 0xa0, 0x8d, 0x13, 0xba, 0x37, 0x30, 0xf9, 0x71, 0x23, 0x5f, 0xb7, 0x36, 0x04,
+	0x9f, 0xf2, 0xdc, 0xf6, 0x15, 0xda, 0xab, 0x8d, 0x3d, 0x53, 0xe8, 0x4b, 0xf0, 0x97, 0x54, 0x91,
+	0x9c, 0x28, 0x92, 0xf4, 0xc7, 0xde, 0x34, 0x3c, 0x7e, 0xd0, 0x40, 0x25, 0xfd, 0xa1, 0x8e, 0x9e,
+	0x71, 0x25, 0xae, 0xf1, 0x2a, 0x19, 0x1d, 0x42, 0xd7, 0x36, 0x19, 0x99, 0x82, 0xd6, 0xd0, 0x20,
+	0x28, 0xc1, 0xc8, 0xa2, 0x7e, 0xc0, 0x81, 0x05, 0xc1, 0xb8, 0x6c, 0xe3, 0x0f, 0x20, 0xb0, 0x09,
+	0xba, 0xdf, 0x7b, 0x26, 0xec, 0x1b, 0x87, 0xee, 0xf3, 0x08, 0xfc, 0x9c, 0xc9, 0xac, 0xa8, 0xb8,
+	0x4a, 0x62, 0x33, 0xdb, 0x20, 0x3d, 0xad, 0x1d, 0x78, 0x15, 0x42, 0x8f, 0x61, 0xb0, 0x4e, 0xf4,
+	0x39, 0x53, 0x74, 0x99, 0x0c, 0x0c, 0x47, 0xe2, 0xf5, 0xc0, 0x0b, 0x45, 0x97, 0xa3, 0x6f, 0x20,
+	0x6a, 0x3c, 0x01, 0xc5, 0xe0, 0xbd, 0xa6, 0xd7, 0x35, 0xd3, 0xf4, 0x4f, 0xfd, 0x94, 0xb7, 0x64,
+	0x51, 0xd1, 0x9a, 0x67, 0xd6, 0xf8, 0xda, 0xfd, 0xca, 0x99, 0xfc, 0xee, 0xc2, 0xf0, 0xc4, 0x0c,
+	0xa9, 0xb9, 0x1c, 0x6f, 0x2a, 0x2a, 0x55, 0x83, 0xa2, 0xce, 0x06, 0x45, 0x51, 0x4d, 0x51, 0x5b,
+	0x72, 0x9b, 0x9b, 0xde, 0x06, 0x37, 0x1b, 0xb8, 0x74, 0x36, 0x70, 0x39, 0x5d, 0x1b, 0x52, 0xd7,
+	0x0c, 0x69, 0x9a, 0xb6, 0xb6, 0xd5, 0x36, 0xb1, 0xff, 0x87, 0xc4, 0x14, 0xee, 0x7f, 0x47, 0xd5,
+	0x2e, 0x14, 0x36, 0x16, 0x77, 0xf2, 0xa7, 0x0b, 0xc3, 0xcb, 0x32, 0x6f, 0xc1, 0x6c, 0x73, 0xcd,
+	0xff, 0x2d, 0x4e, 0x0f, 0x01, 0x78, 0x31, 0x2f, 0x45, 0x21, 0x88, 0xa2, 0x06, 0x28, 0x1f, 0x07,
+	0xbc, 0x38, 0xb7, 0x0e, 0x74, 0x04, 0x07, 0x36, 0xa6, 0x79, 0xa1, 0x7b, 0x30, 0xab, 0xee, 0xe1,
+	0x68, 0xe5, 0x3d, 0xd5, 0x69, 0xeb, 0x80, 0xf6, 0x6a, 0x40, 0x5b, 0x7b, 0x7e, 0x3f, 0x80, 0xfe,
+	0x08, 0xc3, 0x13, 0xa3, 0x0d, 0xef, 0x82, 0xd2, 0x04, 0xa2, 0xa6, 0x0a, 0xb9, 0xe6, 0xe1, 0x21,
+	0xb9, 0x5d, 0xf2, 0xc9, 0x13, 0x78, 0x88, 0x29, 0xc9, 0x14, 0x7b, 0xfb, 0x6e, 0xd0, 0x4f, 0x7e,
+	0x73, 0x21, 0xf9, 0x9e, 0xc9, 0xc6, 0x50, 0xe5, 0x7f, 0xe5, 0xf6, 0xad, 0xb6, 0x7a, 0xff, 0xac,
+	0xad, 0x47, 0xb7, 0x4a, 0xd8, 0x31, 0x6b, 0x1e, 0xa6, 0xba, 0x91, 0x6f, 0xd9, 0x42, 0x51, 0x71,
+	0x2b, 0x8b, 0x9f, 0x40, 0x44, 0x79, 0xce, 0xf8, 0xab, 0xf9, 0x15, 0x7d, 0x59, 0x08, 0x3b, 0xcb,
+	0x00, 0xf7, 0xad, 0xf3, 0x99, 0xf1, 0xe9, 0x89, 0x1b, 0xad, 0xd1, 0x69, 0xe4, 0xa5, 0xa2, 0xc2,
+	0x28, 0x74, 0x80, 0xa3, 0x1b, 0xef, 0x4c, 0x3b, 0xf5, 0x20, 0x16, 0x6c, 0xc9, 0xac, 0x2e, 0x77,
+	0xb1, 0x35, 0x3e, 0xfb, 0x05, 0xd0, 0x76, 0x9b, 0x68, 0x00, 0xd1, 0x25, 0x7f, 0xcd, 0x8b, 0x5f,
+	0x6b, 0x47, 0xbc, 0x87, 0xfa, 0xe0, 0xff, 0xac, 0xb7, 0x91, 0xf1, 0x57, 0xb1, 0x83, 0x00, 0x7a,
+	0x33, 0x0d, 0x34, 0x8d, 0x5d, 0x14, 0xc2, 0xfe, 0x39, 0x91, 0xea, 0xb4, 0xa2, 0xb1, 0xa7, 0xd3,
+	0x4e, 0x6a, 0xc1, 0x8f, 0x3b, 0x3a, 0xed, 0x92, 0x97, 0x84, 0xe5, 0x71, 0xf7, 0xf8, 0x2f, 0x0f,
+	0xa2, 0x06, 0xd0, 0xe8, 0x05, 0xa0, 0x6d, 0xca, 0xa1, 0x51, 0x3b, 0x0f, 0x47, 0x1f, 0xa6, 0xbb,
+	0xfe, 0xa2, 0x27, 0x7b, 0xba, 0xd4, 0xb6, 0x1c, 0xa0, 0x51, 0xbb, 0x46, 0xdc, 0x5d, 0x6a, 0x8b,
+	0x96, 0xba, 0x54, 0x1b, 0x57, 0xdb, 0x4b, 0xfd, 0x04, 0xf7, 0x77, 0x13, 0x12, 0x3d, 0x4a, 0xef,
+	0x64, 0x6a, 0x7b, 0xc9, 0x13, 0xb8, 0xb7, 0xa1, 0x42, 0xe8, 0xa3, 0x74, 0xb7, 0x2e, 0xdd, 0xf5,
+	0xc4, 0xc1, 0x16, 0xed, 0xd1, 0x30, 0x6d, 0x5b, 0x85, 0xd6, 0x42, 0x4f, 0x9d, 0xab, 0x9e, 0xf9,
+	0x78, 0xfa, 0xe2, 0xef, 0x01, 0x00, 0xe5, 0x7f, 0x95, 0xb7, 0x79, 0x09, 0x00, 0x00,
+}
@@ -0,0 +1,660 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: usage.proto
+
+package pb
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// UsageAction is how a usage record changes the usage reported at its timestamp
+type UsageAction int32
+
+const (
+	UsageAction_Increment UsageAction = 0
+	UsageAction_Set       UsageAction = 1
+)
+
+var UsageAction_name = map[int32]string{
+	0: "Increment",
+	1: "Set",
+}
+var UsageAction_value = map[string]int32{
+	"Increment": 0,
+	"Set":       1,
+}
+
+func (x UsageAction) String() string {
+	return proto.EnumName(UsageAction_name, int32(x))
+}
+func (UsageAction) EnumDescriptor() ([]byte, []int) { return fileDescriptor10, []int{0} }
+
+type UsageRecordResponse struct {
+	// Types that are valid to be assigned to Responses:
+	//	*UsageRecordResponse_Error
+	//	*UsageRecordResponse_Success
+	Responses isUsageRecordResponse_Responses `protobuf_oneof:"responses"`
+}
+
+func (m *UsageRecordResponse) Reset()                    { *m = UsageRecordResponse{} }
+func (m *UsageRecordResponse) String() string            { return proto.CompactTextString(m) }
+func (*UsageRecordResponse) ProtoMessage()               {}
+func (*UsageRecordResponse) Descriptor() ([]byte, []int) { return fileDescriptor10, []int{0} }
+
+type isUsageRecordResponse_Responses interface {
+	isUsageRecordResponse_Responses()
+}
+
+type UsageRecordResponse_Error struct {
+	Error *Error `protobuf:"bytes,1,opt,name=error,oneof"`
+}
+type UsageRecordResponse_Success struct {
+	Success *UsageRecord `protobuf:"bytes,2,opt,name=success,oneof"`
+}
+
+func (*UsageRecordResponse_Error) isUsageRecordResponse_Responses()   {}
+func (*UsageRecordResponse_Success) isUsageRecordResponse_Responses() {}
+
+func (m *UsageRecordResponse) GetResponses() isUsageRecordResponse_Responses {
+	if m != nil {
+		return m.Responses
+	}
+	return nil
+}
+
+func (m *UsageRecordResponse) GetError() *Error {
+	if x, ok := m.GetResponses().(*UsageRecordResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+func (m *UsageRecordResponse) GetSuccess() *UsageRecord {
+	if x, ok := m.GetResponses().(*UsageRecordResponse_Success); ok {
+		return x.Success
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*UsageRecordResponse) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _UsageRecordResponse_OneofMarshaler, _UsageRecordResponse_OneofUnmarshaler, _UsageRecordResponse_OneofSizer, []interface{}{
+		(*UsageRecordResponse_Error)(nil),
+		(*UsageRecordResponse_Success)(nil),
+	}
+}
+
+func _UsageRecordResponse_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*UsageRecordResponse)
+	// responses
+	switch x := m.Responses.(type) {
+	case *UsageRecordResponse_Error:
+		b.EncodeVarint(1<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Error); err != nil {
+			return err
+		}
+	case *UsageRecordResponse_Success:
+		b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Success); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("UsageRecordResponse.Responses has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _UsageRecordResponse_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*UsageRecordResponse)
+	switch tag {
+	case 1: // responses.error
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Error)
+		err := b.DecodeMessage(msg)
+		m.Responses = &UsageRecordResponse_Error{msg}
+		return true, err
+	case 2: // responses.success
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(UsageRecord)
+		err := b.DecodeMessage(msg)
+		m.Responses = &UsageRecordResponse_Success{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _UsageRecordResponse_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*UsageRecordResponse)
+	// responses
+	switch x := m.Responses.(type) {
+	case *UsageRecordResponse_Error:
+		s := proto.Size(x.Error)
+		n += proto.SizeVarint(1<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *UsageRecordResponse_Success:
+		s := proto.Size(x.Success)
+		n += proto.SizeVarint(2<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+type UsageRecord struct {
+	Id               string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	SubscriptionItem string `protobuf:"bytes,2,opt,name=subscription_item,json=subscriptionItem" json:"subscription_item,omitempty"`
+	Quantity         uint64 `protobuf:"varint,3,opt,name=quantity" json:"quantity,omitempty"`
+	Timestamp        int64  `protobuf:"varint,4,opt,name=timestamp" json:"timestamp,omitempty"`
+	Livemode         bool   `protobuf:"varint,5,opt,name=livemode" json:"livemode,omitempty"`
+}
+
+func (m *UsageRecord) Reset()                    { *m = UsageRecord{} }
+func (m *UsageRecord) String() string            { return proto.CompactTextString(m) }
+func (*UsageRecord) ProtoMessage()               {}
+func (*UsageRecord) Descriptor() ([]byte, []int) { return fileDescriptor10, []int{1} }
+
+func (m *UsageRecord) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *UsageRecord) GetSubscriptionItem() string {
+	if m != nil {
+		return m.SubscriptionItem
+	}
+	return ""
+}
+
+func (m *UsageRecord) GetQuantity() uint64 {
+	if m != nil {
+		return m.Quantity
+	}
+	return 0
+}
+
+func (m *UsageRecord) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *UsageRecord) GetLivemode() bool {
+	if m != nil {
+		return m.Livemode
+	}
+	return false
+}
+
+type CreateUsageRecordRequest struct {
+	SubscriptionItem string      `protobuf:"bytes,1,opt,name=subscription_item,json=subscriptionItem" json:"subscription_item,omitempty"`
+	Quantity         uint64      `protobuf:"varint,2,opt,name=quantity" json:"quantity,omitempty"`
+	Timestamp        int64       `protobuf:"varint,3,opt,name=timestamp" json:"timestamp,omitempty"`
+	Action           UsageAction `protobuf:"varint,4,opt,name=action,enum=UsageAction" json:"action,omitempty"`
+}
+
+func (m *CreateUsageRecordRequest) Reset()                    { *m = CreateUsageRecordRequest{} }
+func (m *CreateUsageRecordRequest) String() string            { return proto.CompactTextString(m) }
+func (*CreateUsageRecordRequest) ProtoMessage()               {}
+func (*CreateUsageRecordRequest) Descriptor() ([]byte, []int) { return fileDescriptor10, []int{2} }
+
+func (m *CreateUsageRecordRequest) GetSubscriptionItem() string {
+	if m != nil {
+		return m.SubscriptionItem
+	}
+	return ""
+}
+
+func (m *CreateUsageRecordRequest) GetQuantity() uint64 {
+	if m != nil {
+		return m.Quantity
+	}
+	return 0
+}
+
+func (m *CreateUsageRecordRequest) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *CreateUsageRecordRequest) GetAction() UsageAction {
+	if m != nil {
+		return m.Action
+	}
+	return UsageAction_Increment
+}
+
+// UsageRecordSummary is the usage of a subscription item in a billing period, aggregated as set
+// by the aggregate usage of its plan
+type UsageRecordSummary struct {
+	Id               string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	SubscriptionItem string `protobuf:"bytes,2,opt,name=subscription_item,json=subscriptionItem" json:"subscription_item,omitempty"`
+	Invoice          string `protobuf:"bytes,3,opt,name=invoice" json:"invoice,omitempty"`
+	PeriodStart      int64  `protobuf:"varint,4,opt,name=period_start,json=periodStart" json:"period_start,omitempty"`
+	PeriodEnd        int64  `protobuf:"varint,5,opt,name=period_end,json=periodEnd" json:"period_end,omitempty"`
+	TotalUsage       uint64 `protobuf:"varint,6,opt,name=total_usage,json=totalUsage" json:"total_usage,omitempty"`
+	Livemode         bool   `protobuf:"varint,7,opt,name=livemode" json:"livemode,omitempty"`
+}
+
+func (m *UsageRecordSummary) Reset()                    { *m = UsageRecordSummary{} }
+func (m *UsageRecordSummary) String() string            { return proto.CompactTextString(m) }
+func (*UsageRecordSummary) ProtoMessage()               {}
+func (*UsageRecordSummary) Descriptor() ([]byte, []int) { return fileDescriptor10, []int{3} }
+
+func (m *UsageRecordSummary) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *UsageRecordSummary) GetSubscriptionItem() string {
+	if m != nil {
+		return m.SubscriptionItem
+	}
+	return ""
+}
+
+func (m *UsageRecordSummary) GetInvoice() string {
+	if m != nil {
+		return m.Invoice
+	}
+	return ""
+}
+
+func (m *UsageRecordSummary) GetPeriodStart() int64 {
+	if m != nil {
+		return m.PeriodStart
+	}
+	return 0
+}
+
+func (m *UsageRecordSummary) GetPeriodEnd() int64 {
+	if m != nil {
+		return m.PeriodEnd
+	}
+	return 0
+}
+
+func (m *UsageRecordSummary) GetTotalUsage() uint64 {
+	if m != nil {
+		return m.TotalUsage
+	}
+	return 0
+}
+
+func (m *UsageRecordSummary) GetLivemode() bool {
+	if m != nil {
+		return m.Livemode
+	}
+	return false
+}
+
+type UsageRecordSummaryResponse struct {
+	// Types that are valid to be assigned to Responses:
+	//	*UsageRecordSummaryResponse_Error
+	//	*UsageRecordSummaryResponse_Success
+	Responses isUsageRecordSummaryResponse_Responses `protobuf_oneof:"responses"`
+}
+
+func (m *UsageRecordSummaryResponse) Reset()                    { *m = UsageRecordSummaryResponse{} }
+func (m *UsageRecordSummaryResponse) String() string            { return proto.CompactTextString(m) }
+func (*UsageRecordSummaryResponse) ProtoMessage()               {}
+func (*UsageRecordSummaryResponse) Descriptor() ([]byte, []int) { return fileDescriptor10, []int{4} }
+
+type isUsageRecordSummaryResponse_Responses interface {
+	isUsageRecordSummaryResponse_Responses()
+}
+
+type UsageRecordSummaryResponse_Error struct {
+	Error *Error `protobuf:"bytes,1,opt,name=error,oneof"`
+}
+type UsageRecordSummaryResponse_Success struct {
+	Success *UsageRecordSummary `protobuf:"bytes,2,opt,name=success,oneof"`
+}
+
+func (*UsageRecordSummaryResponse_Error) isUsageRecordSummaryResponse_Responses()   {}
+func (*UsageRecordSummaryResponse_Success) isUsageRecordSummaryResponse_Responses() {}
+
+func (m *UsageRecordSummaryResponse) GetResponses() isUsageRecordSummaryResponse_Responses {
+	if m != nil {
+		return m.Responses
+	}
+	return nil
+}
+
+func (m *UsageRecordSummaryResponse) GetError() *Error {
+	if x, ok := m.GetResponses().(*UsageRecordSummaryResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+func (m *UsageRecordSummaryResponse) GetSuccess() *UsageRecordSummary {
+	if x, ok := m.GetResponses().(*UsageRecordSummaryResponse_Success); ok {
+		return x.Success
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*UsageRecordSummaryResponse) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _UsageRecordSummaryResponse_OneofMarshaler, _UsageRecordSummaryResponse_OneofUnmarshaler, _UsageRecordSummaryResponse_OneofSizer, []interface{}{
+		(*UsageRecordSummaryResponse_Error)(nil),
+		(*UsageRecordSummaryResponse_Success)(nil),
+	}
+}
+
+func _UsageRecordSummaryResponse_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*UsageRecordSummaryResponse)
+	// responses
+	switch x := m.Responses.(type) {
+	case *UsageRecordSummaryResponse_Error:
+		b.EncodeVarint(1<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Error); err != nil {
+			return err
+		}
+	case *UsageRecordSummaryResponse_Success:
+		b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Success); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("UsageRecordSummaryResponse.Responses has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _UsageRecordSummaryResponse_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*UsageRecordSummaryResponse)
+	switch tag {
+	case 1: // responses.error
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Error)
+		err := b.DecodeMessage(msg)
+		m.Responses = &UsageRecordSummaryResponse_Error{msg}
+		return true, err
+	case 2: // responses.success
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(UsageRecordSummary)
+		err := b.DecodeMessage(msg)
+		m.Responses = &UsageRecordSummaryResponse_Success{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _UsageRecordSummaryResponse_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*UsageRecordSummaryResponse)
+	// responses
+	switch x := m.Responses.(type) {
+	case *UsageRecordSummaryResponse_Error:
+		s := proto.Size(x.Error)
+		n += proto.SizeVarint(1<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *UsageRecordSummaryResponse_Success:
+		s := proto.Size(x.Success)
+		n += proto.SizeVarint(2<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+type ListUsageRecordSummariesRequest struct {
+	SubscriptionItem string `protobuf:"bytes,1,opt,name=subscription_item,json=subscriptionItem" json:"subscription_item,omitempty"`
+	EndingBefore     string `protobuf:"bytes,2,opt,name=ending_before,json=endingBefore" json:"ending_before,omitempty"`
+	StartingAfter    string `protobuf:"bytes,3,opt,name=starting_after,json=startingAfter" json:"starting_after,omitempty"`
+	Limit            int32  `protobuf:"varint,4,opt,name=limit" json:"limit,omitempty"`
+}
+
+func (m *ListUsageRecordSummariesRequest) Reset()         { *m = ListUsageRecordSummariesRequest{} }
+func (m *ListUsageRecordSummariesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListUsageRecordSummariesRequest) ProtoMessage()    {}
+func (*ListUsageRecordSummariesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor10, []int{5}
+}
+
+func (m *ListUsageRecordSummariesRequest) GetSubscriptionItem() string {
+	if m != nil {
+		return m.SubscriptionItem
+	}
+	return ""
+}
+
+func (m *ListUsageRecordSummariesRequest) GetEndingBefore() string {
+	if m != nil {
+		return m.EndingBefore
+	}
+	return ""
+}
+
+func (m *ListUsageRecordSummariesRequest) GetStartingAfter() string {
+	if m != nil {
+		return m.StartingAfter
+	}
+	return ""
+}
+
+func (m *ListUsageRecordSummariesRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*UsageRecordResponse)(nil), "UsageRecordResponse")
+	proto.RegisterType((*UsageRecord)(nil), "UsageRecord")
+	proto.RegisterType((*CreateUsageRecordRequest)(nil), "CreateUsageRecordRequest")
+	proto.RegisterType((*UsageRecordSummary)(nil), "UsageRecordSummary")
+	proto.RegisterType((*UsageRecordSummaryResponse)(nil), "UsageRecordSummaryResponse")
+	proto.RegisterType((*ListUsageRecordSummariesRequest)(nil), "ListUsageRecordSummariesRequest")
+	proto.RegisterEnum("UsageAction", UsageAction_name, UsageAction_value)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for UsageRecords service
+
+type UsageRecordsClient interface {
+	CreateUsageRecord(ctx context.Context, in *CreateUsageRecordRequest, opts ...grpc.CallOption) (*UsageRecordResponse, error)
+	ListUsageRecordSummaries(ctx context.Context, in *ListUsageRecordSummariesRequest, opts ...grpc.CallOption) (UsageRecords_ListUsageRecordSummariesClient, error)
+}
+
+type usageRecordsClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewUsageRecordsClient(cc *grpc.ClientConn) UsageRecordsClient {
+	return &usageRecordsClient{cc}
+}
+
+func (c *usageRecordsClient) CreateUsageRecord(ctx context.Context, in *CreateUsageRecordRequest, opts ...grpc.CallOption) (*UsageRecordResponse, error) {
+	out := new(UsageRecordResponse)
+	err := grpc.Invoke(ctx, "/UsageRecords/CreateUsageRecord", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usageRecordsClient) ListUsageRecordSummaries(ctx context.Context, in *ListUsageRecordSummariesRequest, opts ...grpc.CallOption) (UsageRecords_ListUsageRecordSummariesClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_UsageRecords_serviceDesc.Streams[0], c.cc, "/UsageRecords/ListUsageRecordSummaries", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &usageRecordsListUsageRecordSummariesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type UsageRecords_ListUsageRecordSummariesClient interface {
+	Recv() (*UsageRecordSummaryResponse, error)
+	grpc.ClientStream
+}
+
+type usageRecordsListUsageRecordSummariesClient struct {
+	grpc.ClientStream
+}
+
+func (x *usageRecordsListUsageRecordSummariesClient) Recv() (*UsageRecordSummaryResponse, error) {
+	m := new(UsageRecordSummaryResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Server API for UsageRecords service
+
+type UsageRecordsServer interface {
+	CreateUsageRecord(context.Context, *CreateUsageRecordRequest) (*UsageRecordResponse, error)
+	ListUsageRecordSummaries(*ListUsageRecordSummariesRequest, UsageRecords_ListUsageRecordSummariesServer) error
+}
+
+func RegisterUsageRecordsServer(s *grpc.Server, srv UsageRecordsServer) {
+	s.RegisterService(&_UsageRecords_serviceDesc, srv)
+}
+
+func _UsageRecords_CreateUsageRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUsageRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsageRecordsServer).CreateUsageRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/UsageRecords/CreateUsageRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsageRecordsServer).CreateUsageRecord(ctx, req.(*CreateUsageRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsageRecords_ListUsageRecordSummaries_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListUsageRecordSummariesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(UsageRecordsServer).ListUsageRecordSummaries(m, &usageRecordsListUsageRecordSummariesServer{stream})
+}
+
+type UsageRecords_ListUsageRecordSummariesServer interface {
+	Send(*UsageRecordSummaryResponse) error
+	grpc.ServerStream
+}
+
+type usageRecordsListUsageRecordSummariesServer struct {
+	grpc.ServerStream
+}
+
+func (x *usageRecordsListUsageRecordSummariesServer) Send(m *UsageRecordSummaryResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _UsageRecords_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "UsageRecords",
+	HandlerType: (*UsageRecordsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateUsageRecord",
+			Handler:    _UsageRecords_CreateUsageRecord_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ListUsageRecordSummaries",
+			Handler:       _UsageRecords_ListUsageRecordSummaries_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "usage.proto",
+}
+
+func init() { proto.RegisterFile("usage.proto", fileDescriptor10) }
+
+var fileDescriptor10 = []byte{
+	// 519 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x5d, 0x6f, 0xd3, 0x30,
+	0x14, 0xad, 0xdb, 0xb5, 0x5d, 0x6e, 0xba, 0xaa, 0xf3, 0xf6, 0x10, 0xc2, 0xc7, 0x42, 0x60, 0x52,
+	0x04, 0x52, 0x40, 0xe3, 0x17, 0x6c, 0x68, 0x68, 0x93, 0x78, 0x72, 0xc5, 0x23, 0xaa, 0xd2, 0xe4,
+	0x6e, 0xb2, 0xd4, 0xd8, 0x99, 0xed, 0x4c, 0x1a, 0xbf, 0x86, 0x37, 0x5e, 0x79, 0xe1, 0x4f, 0xf1,
+	0x2b, 0x50, 0x9c, 0x74, 0x64, 0xed, 0x0a, 0x13, 0x3c, 0xde, 0x73, 0x6f, 0xae, 0x8f, 0xcf, 0x39,
+	0x31, 0xb8, 0xa5, 0x4e, 0x2e, 0x31, 0x2e, 0x94, 0x34, 0xd2, 0x77, 0x51, 0x29, 0xa9, 0xea, 0x22,
+	0x5c, 0xc0, 0xde, 0xa7, 0xaa, 0xc7, 0x30, 0x95, 0x2a, 0x63, 0xa8, 0x0b, 0x29, 0x34, 0xd2, 0x67,
+	0xd0, 0xb7, 0x53, 0x1e, 0x09, 0x48, 0xe4, 0x1e, 0x0d, 0xe2, 0xd3, 0xaa, 0x3a, 0xeb, 0xb0, 0x1a,
+	0xa6, 0x11, 0x0c, 0x75, 0x99, 0xa6, 0xa8, 0xb5, 0xd7, 0xb5, 0x13, 0xa3, 0xb8, 0xb5, 0xe6, 0xac,
+	0xc3, 0x96, 0xed, 0x13, 0x17, 0x1c, 0xd5, 0x6c, 0xd5, 0xe1, 0x57, 0x02, 0x6e, 0x6b, 0x8e, 0x8e,
+	0xa1, 0xcb, 0x33, 0x7b, 0x86, 0xc3, 0xba, 0x3c, 0xa3, 0xaf, 0x61, 0x57, 0x97, 0x73, 0x9d, 0x2a,
+	0x5e, 0x18, 0x2e, 0xc5, 0x8c, 0x1b, 0xcc, 0xed, 0x01, 0x0e, 0x9b, 0xb4, 0x1b, 0xe7, 0x06, 0x73,
+	0xea, 0xc3, 0xf6, 0x55, 0x99, 0x08, 0xc3, 0xcd, 0x8d, 0xd7, 0x0b, 0x48, 0xb4, 0xc5, 0x6e, 0x6b,
+	0xfa, 0x04, 0x1c, 0xc3, 0x73, 0xd4, 0x26, 0xc9, 0x0b, 0x6f, 0x2b, 0x20, 0x51, 0x8f, 0xfd, 0x06,
+	0xaa, 0x2f, 0x17, 0xfc, 0x1a, 0x73, 0x99, 0xa1, 0xd7, 0x0f, 0x48, 0xb4, 0xcd, 0x6e, 0xeb, 0xf0,
+	0x1b, 0x01, 0xef, 0xbd, 0xc2, 0xc4, 0xe0, 0x1d, 0x5d, 0xae, 0x4a, 0xd4, 0xe6, 0x7e, 0x7e, 0xe4,
+	0x01, 0xfc, 0xba, 0x7f, 0xe2, 0xd7, 0x5b, 0xe5, 0xf7, 0x12, 0x06, 0x49, 0x5a, 0xed, 0xb1, 0xd4,
+	0xc7, 0x4b, 0x71, 0x8f, 0x2d, 0xc6, 0x9a, 0x5e, 0xf8, 0x93, 0x00, 0x6d, 0x71, 0x9c, 0x96, 0x79,
+	0x9e, 0xa8, 0x9b, 0xff, 0xd3, 0xd4, 0x83, 0x21, 0x17, 0xd7, 0x92, 0xa7, 0x68, 0x59, 0x39, 0x6c,
+	0x59, 0xd2, 0xe7, 0x30, 0x2a, 0x50, 0x71, 0x99, 0xcd, 0xb4, 0x49, 0x94, 0x69, 0x44, 0x75, 0x6b,
+	0x6c, 0x5a, 0x41, 0xf4, 0x29, 0x40, 0x33, 0x82, 0x22, 0xb3, 0xc2, 0xf6, 0x98, 0x53, 0x23, 0xa7,
+	0x22, 0xa3, 0x07, 0xe0, 0x1a, 0x69, 0x92, 0xc5, 0xcc, 0x86, 0xd1, 0x1b, 0x58, 0x49, 0xc0, 0x42,
+	0xf6, 0x1a, 0x77, 0x6c, 0x19, 0xae, 0xd8, 0xf2, 0x05, 0xfc, 0xf5, 0xbb, 0x3e, 0x38, 0xae, 0x6f,
+	0x56, 0xe3, 0xba, 0x17, 0xaf, 0x6f, 0xdb, 0x98, 0xda, 0xef, 0x04, 0x0e, 0x3e, 0x72, 0x6d, 0xd6,
+	0x3e, 0xe1, 0xa8, 0xff, 0x29, 0x19, 0x2f, 0x60, 0x07, 0x45, 0xc6, 0xc5, 0xe5, 0x6c, 0x8e, 0x17,
+	0x52, 0x61, 0x63, 0xc7, 0xa8, 0x06, 0x4f, 0x2c, 0x46, 0x0f, 0x61, 0x6c, 0x95, 0xae, 0xc6, 0x92,
+	0x0b, 0x83, 0xaa, 0x71, 0x64, 0x67, 0x89, 0x1e, 0x57, 0x20, 0xdd, 0x87, 0xfe, 0x82, 0xe7, 0xbc,
+	0x36, 0xa4, 0xcf, 0xea, 0xe2, 0xd5, 0x21, 0xb8, 0xad, 0xc8, 0xd0, 0x1d, 0x70, 0xce, 0x45, 0xaa,
+	0x30, 0x47, 0x61, 0x26, 0x1d, 0x3a, 0x84, 0xde, 0x14, 0xcd, 0x84, 0x1c, 0xfd, 0x20, 0x30, 0x6a,
+	0xdd, 0x4a, 0xd3, 0x0f, 0xb0, 0xbb, 0x16, 0x7e, 0xfa, 0x28, 0xde, 0xf4, 0x43, 0xf8, 0xfb, 0xf1,
+	0x3d, 0xaf, 0x47, 0xd8, 0xa1, 0x9f, 0xc1, 0xdb, 0xa4, 0x18, 0x0d, 0xe2, 0xbf, 0x88, 0xe9, 0x3f,
+	0x8e, 0x37, 0x7b, 0x1d, 0x76, 0xde, 0x92, 0xf9, 0xc0, 0x3e, 0x5e, 0xef, 0x7e, 0x0d, 0x00, 0x04,
+	0x56, 0x00, 0x93, 0xd8, 0x04, 0x00, 0x00,
+}
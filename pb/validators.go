@@ -1,5 +1,7 @@
 package pb
 
+import "fmt"
+
 type ValidationError struct {
 	Message string
 }
@@ -18,11 +20,49 @@ func (req *CreatePlanRequest) Validate() error {
 		return ValidationError{"plan interval is required"}
 	case req.GetCurrency() == 0:
 		return ValidationError{"plan currency is required"}
+	case req.GetAmount() > 0 && req.GetAmount() < req.GetCurrency().MinimumCharge() && !req.unitPriced():
+		return ValidationError{fmt.Sprintf("plan amount must be zero or at least the minimum charge of %d %s in the smallest currency unit", req.GetCurrency().MinimumCharge(), req.GetCurrency())}
+	case req.GetAggregateUsage() != AggregateUsage_UnknownAggregateUsage && req.GetUsageType() != UsageType_Metered:
+		return ValidationError{"aggregate usage can only be set on a metered plan"}
+	case req.GetBillingScheme() == BillingScheme_Tiered:
+		return validateTiers(req)
+	case len(req.GetTiers()) > 0 || req.GetTiersMode() != TiersMode_UnknownTiersMode:
+		return ValidationError{"tiers can only be set on a plan with the tiered billing scheme"}
 	default:
 		return nil
 	}
 }
 
+// unitPriced returns true when the amount of a plan is the price of a single unit, as for metered
+// and tiered plans.  The minimum charge applies to the invoice, not to the unit price.
+func (req *CreatePlanRequest) unitPriced() bool {
+	return req.GetUsageType() == UsageType_Metered || req.GetBillingScheme() == BillingScheme_Tiered
+}
+
+// validateTiers checks that a tiered plan has tiers in ascending order ending with a tier that
+// has no upper bound
+func validateTiers(req *CreatePlanRequest) error {
+	tiers := req.GetTiers()
+	switch {
+	case req.GetAmount() > 0:
+		return ValidationError{"a tiered plan is priced by its tiers and cannot have an amount"}
+	case req.GetTiersMode() == TiersMode_UnknownTiersMode:
+		return ValidationError{"tiers mode is required for a tiered plan"}
+	case len(tiers) == 0:
+		return ValidationError{"tiers are required for a tiered plan"}
+	case tiers[len(tiers)-1].GetUpTo() != 0:
+		return ValidationError{"the last tier must not have an up to quantity"}
+	}
+	var prev uint64
+	for i, tier := range tiers[:len(tiers)-1] {
+		if tier.GetUpTo() <= prev {
+			return ValidationError{fmt.Sprintf("tier %d must have an up to quantity greater than %d", i+1, prev)}
+		}
+		prev = tier.GetUpTo()
+	}
+	return nil
+}
+
 func (req *UpdatePlanRequest) Validate() error {
 	switch {
 	case len(req.GetId()) == 0:
@@ -49,3 +89,340 @@ func (req *GetPlanRequest) Validate() error {
 		return nil
 	}
 }
+
+func (req *CreateCustomerRequest) Validate() error {
+	return nil
+}
+
+func (req *UpdateCustomerRequest) Validate() error {
+	switch {
+	case len(req.GetId()) == 0:
+		return ValidationError{"id is required to update a customer"}
+	default:
+		return nil
+	}
+}
+
+func (req *DeleteCustomerRequest) Validate() error {
+	switch {
+	case len(req.GetId()) == 0:
+		return ValidationError{"id is required to delete a customer"}
+	default:
+		return nil
+	}
+}
+
+func (req *GetCustomerRequest) Validate() error {
+	switch {
+	case len(req.GetId()) == 0:
+		return ValidationError{"id is required to get a customer"}
+	default:
+		return nil
+	}
+}
+
+func (req *CreateSubscriptionRequest) Validate() error {
+	switch {
+	case len(req.GetCustomer()) == 0:
+		return ValidationError{"customer is required to create a subscription"}
+	case len(req.GetPlan()) == 0:
+		return ValidationError{"plan is required to create a subscription"}
+	default:
+		return nil
+	}
+}
+
+func (req *UpdateSubscriptionRequest) Validate() error {
+	switch {
+	case len(req.GetId()) == 0:
+		return ValidationError{"id is required to update a subscription"}
+	case req.GetNoProrate() && req.GetProrationDate() != 0:
+		return ValidationError{"proration date cannot be set when proration is disabled"}
+	default:
+		return nil
+	}
+}
+
+func (req *CancelSubscriptionRequest) Validate() error {
+	switch {
+	case len(req.GetId()) == 0:
+		return ValidationError{"id is required to cancel a subscription"}
+	default:
+		return nil
+	}
+}
+
+func (req *ReactivateSubscriptionRequest) Validate() error {
+	switch {
+	case len(req.GetId()) == 0:
+		return ValidationError{"id is required to reactivate a subscription"}
+	default:
+		return nil
+	}
+}
+
+func (req *GetSubscriptionRequest) Validate() error {
+	switch {
+	case len(req.GetId()) == 0:
+		return ValidationError{"id is required to get a subscription"}
+	default:
+		return nil
+	}
+}
+
+func (req *GetInvoiceRequest) Validate() error {
+	switch {
+	case len(req.GetId()) == 0:
+		return ValidationError{"id is required to get an invoice"}
+	default:
+		return nil
+	}
+}
+
+func (req *UpcomingInvoiceRequest) Validate() error {
+	switch {
+	case len(req.GetCustomer()) == 0:
+		return ValidationError{"customer is required to preview an upcoming invoice"}
+	case req.GetNoProrate() && req.GetProrationDate() != 0:
+		return ValidationError{"proration date cannot be set when proration is disabled"}
+	default:
+		return nil
+	}
+}
+
+func (req *PayInvoiceRequest) Validate() error {
+	switch {
+	case len(req.GetId()) == 0:
+		return ValidationError{"id is required to pay an invoice"}
+	default:
+		return nil
+	}
+}
+
+func (req *VoidInvoiceRequest) Validate() error {
+	switch {
+	case len(req.GetId()) == 0:
+		return ValidationError{"id is required to void an invoice"}
+	default:
+		return nil
+	}
+}
+
+func (req *MarkUncollectibleInvoiceRequest) Validate() error {
+	switch {
+	case len(req.GetId()) == 0:
+		return ValidationError{"id is required to mark an invoice uncollectible"}
+	default:
+		return nil
+	}
+}
+
+func (req *CreateCouponRequest) Validate() error {
+	switch {
+	case req.GetDuration() == CouponDuration_UnknownDuration:
+		return ValidationError{"coupon duration is required"}
+	case req.GetPercentOff() == 0 && req.GetAmountOff() == 0:
+		return ValidationError{"either percent off or amount off is required to create a coupon"}
+	case req.GetPercentOff() > 0 && req.GetAmountOff() > 0:
+		return ValidationError{"percent off and amount off cannot both be set"}
+	case req.GetPercentOff() > 100:
+		return ValidationError{"percent off must be between 1 and 100"}
+	case req.GetAmountOff() > 0 && req.GetCurrency() == 0:
+		return ValidationError{"currency is required when amount off is set"}
+	case req.GetPercentOff() > 0 && req.GetCurrency() != 0:
+		return ValidationError{"currency can only be set when amount off is set"}
+	case req.GetDuration() == CouponDuration_Repeating && req.GetDurationInMonths() == 0:
+		return ValidationError{"duration in months is required for a repeating coupon"}
+	case req.GetDuration() != CouponDuration_Repeating && req.GetDurationInMonths() != 0:
+		return ValidationError{"duration in months can only be set for a repeating coupon"}
+	case req.GetRedeemBy() < 0:
+		return ValidationError{"redeem by must be a unix timestamp"}
+	default:
+		return nil
+	}
+}
+
+func (req *UpdateCouponRequest) Validate() error {
+	switch {
+	case len(req.GetId()) == 0:
+		return ValidationError{"id is required to update a coupon"}
+	default:
+		return nil
+	}
+}
+
+func (req *DeleteCouponRequest) Validate() error {
+	switch {
+	case len(req.GetId()) == 0:
+		return ValidationError{"id is required to delete a coupon"}
+	default:
+		return nil
+	}
+}
+
+func (req *GetCouponRequest) Validate() error {
+	switch {
+	case len(req.GetId()) == 0:
+		return ValidationError{"id is required to get a coupon"}
+	default:
+		return nil
+	}
+}
+
+func (req *ApplyCouponRequest) Validate() error {
+	switch {
+	case len(req.GetCoupon()) == 0:
+		return ValidationError{"coupon is required to apply a discount"}
+	case len(req.GetCustomer()) == 0 && len(req.GetSubscription()) == 0:
+		return ValidationError{"either customer or subscription is required to apply a discount"}
+	case len(req.GetCustomer()) > 0 && len(req.GetSubscription()) > 0:
+		return ValidationError{"customer and subscription cannot both be set"}
+	default:
+		return nil
+	}
+}
+
+func (req *RemoveDiscountRequest) Validate() error {
+	switch {
+	case len(req.GetCustomer()) == 0 && len(req.GetSubscription()) == 0:
+		return ValidationError{"either customer or subscription is required to remove a discount"}
+	case len(req.GetCustomer()) > 0 && len(req.GetSubscription()) > 0:
+		return ValidationError{"customer and subscription cannot both be set"}
+	default:
+		return nil
+	}
+}
+
+func (req *AttachSourceRequest) Validate() error {
+	switch {
+	case len(req.GetCustomer()) == 0:
+		return ValidationError{"customer is required to attach a source"}
+	case len(req.GetToken()) == 0:
+		return ValidationError{"token is required to attach a source"}
+	case looksLikeCardNumber(req.GetToken()):
+		return ValidationError{"card numbers cannot be attached directly, tokenize the card first"}
+	default:
+		return nil
+	}
+}
+
+func (req *ListSourcesRequest) Validate() error {
+	switch {
+	case len(req.GetCustomer()) == 0:
+		return ValidationError{"customer is required to list sources"}
+	default:
+		return nil
+	}
+}
+
+func (req *SetDefaultSourceRequest) Validate() error {
+	switch {
+	case len(req.GetCustomer()) == 0:
+		return ValidationError{"customer is required to set the default source"}
+	case len(req.GetSource()) == 0:
+		return ValidationError{"source is required to set the default source"}
+	default:
+		return nil
+	}
+}
+
+func (req *DetachSourceRequest) Validate() error {
+	switch {
+	case len(req.GetCustomer()) == 0:
+		return ValidationError{"customer is required to detach a source"}
+	case len(req.GetSource()) == 0:
+		return ValidationError{"source is required to detach a source"}
+	default:
+		return nil
+	}
+}
+
+// looksLikeCardNumber returns true when s is made up of 12 to 19 digits, optionally separated by
+// spaces or dashes.  Tokens and source IDs always have an alphabetic prefix.
+func looksLikeCardNumber(s string) bool {
+	var digits int
+	for _, r := range s {
+		switch {
+		case r >= '0' && r <= '9':
+			digits++
+		case r == ' ' || r == '-':
+		default:
+			return false
+		}
+	}
+	return digits >= 12 && digits <= 19
+}
+
+func (req *GetEventRequest) Validate() error {
+	switch {
+	case len(req.GetId()) == 0:
+		return ValidationError{"id is required to get an event"}
+	default:
+		return nil
+	}
+}
+
+func (req *SubscribeEventsRequest) Validate() error {
+	for _, t := range req.GetTypes() {
+		if len(t) == 0 {
+			return ValidationError{"event types cannot be empty"}
+		}
+	}
+	return nil
+}
+
+func (req *CreateUsageRecordRequest) Validate() error {
+	switch {
+	case len(req.GetSubscriptionItem()) == 0:
+		return ValidationError{"subscription item is required to create a usage record"}
+	case req.GetTimestamp() < 0:
+		return ValidationError{"usage record timestamp cannot be negative"}
+	default:
+		return nil
+	}
+}
+
+func (req *ListUsageRecordSummariesRequest) Validate() error {
+	switch {
+	case len(req.GetSubscriptionItem()) == 0:
+		return ValidationError{"subscription item is required to list usage record summaries"}
+	default:
+		return nil
+	}
+}
+
+func (req *CreateProductRequest) Validate() error {
+	switch {
+	case len(req.GetName()) == 0:
+		return ValidationError{"name is required to create a product"}
+	default:
+		return nil
+	}
+}
+
+func (req *UpdateProductRequest) Validate() error {
+	switch {
+	case len(req.GetId()) == 0:
+		return ValidationError{"id is required to update a product"}
+	default:
+		return nil
+	}
+}
+
+func (req *DeleteProductRequest) Validate() error {
+	switch {
+	case len(req.GetId()) == 0:
+		return ValidationError{"id is required to delete a product"}
+	default:
+		return nil
+	}
+}
+
+func (req *GetProductRequest) Validate() error {
+	switch {
+	case len(req.GetId()) == 0:
+		return ValidationError{"id is required to get a product"}
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,108 @@
+package pb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCouponValidation(t *testing.T) {
+	tt := []struct {
+		Name  string
+		Req   *CreateCouponRequest
+		Valid bool
+	}{
+		{Name: "percent off", Req: &CreateCouponRequest{Duration: CouponDuration_Once, PercentOff: 25}, Valid: true},
+		{Name: "amount off", Req: &CreateCouponRequest{Duration: CouponDuration_Forever, AmountOff: 500, Currency: Currency_USD}, Valid: true},
+		{Name: "repeating", Req: &CreateCouponRequest{Duration: CouponDuration_Repeating, PercentOff: 10, DurationInMonths: 3}, Valid: true},
+		{Name: "no duration", Req: &CreateCouponRequest{PercentOff: 25}},
+		{Name: "no discount", Req: &CreateCouponRequest{Duration: CouponDuration_Once}},
+		{Name: "both discounts", Req: &CreateCouponRequest{Duration: CouponDuration_Once, PercentOff: 25, AmountOff: 500, Currency: Currency_USD}},
+		{Name: "percent over 100", Req: &CreateCouponRequest{Duration: CouponDuration_Once, PercentOff: 101}},
+		{Name: "amount without currency", Req: &CreateCouponRequest{Duration: CouponDuration_Once, AmountOff: 500}},
+		{Name: "percent with currency", Req: &CreateCouponRequest{Duration: CouponDuration_Once, PercentOff: 25, Currency: Currency_USD}},
+		{Name: "repeating without months", Req: &CreateCouponRequest{Duration: CouponDuration_Repeating, PercentOff: 10}},
+		{Name: "months without repeating", Req: &CreateCouponRequest{Duration: CouponDuration_Forever, PercentOff: 10, DurationInMonths: 3}},
+	}
+	for _, tc := range tt {
+		t.Run(tc.Name, func(t *testing.T) {
+			err := tc.Req.Validate()
+			switch {
+			case tc.Valid:
+				assert.NoError(t, err)
+			default:
+				assert.IsType(t, ValidationError{}, err)
+			}
+		})
+	}
+
+	assert.Error(t, (&ApplyCouponRequest{Customer: "cus_test"}).Validate())
+	assert.Error(t, (&ApplyCouponRequest{Coupon: "SAVE"}).Validate())
+	assert.Error(t, (&ApplyCouponRequest{Coupon: "SAVE", Customer: "cus_test", Subscription: "sub_test"}).Validate())
+	assert.NoError(t, (&ApplyCouponRequest{Coupon: "SAVE", Subscription: "sub_test"}).Validate())
+	assert.Error(t, (&RemoveDiscountRequest{}).Validate())
+	assert.NoError(t, (&RemoveDiscountRequest{Customer: "cus_test"}).Validate())
+}
+
+func TestAttachSourceValidation(t *testing.T) {
+	assert.NoError(t, (&AttachSourceRequest{Customer: "cus_test", Token: "tok_visa"}).Validate())
+	assert.Error(t, (&AttachSourceRequest{Token: "tok_visa"}).Validate())
+	assert.Error(t, (&AttachSourceRequest{Customer: "cus_test"}).Validate())
+	assert.Error(t, (&AttachSourceRequest{Customer: "cus_test", Token: "4242424242424242"}).Validate())
+	assert.Error(t, (&AttachSourceRequest{Customer: "cus_test", Token: "4242 4242 4242 4242"}).Validate())
+	assert.NoError(t, (&AttachSourceRequest{Customer: "cus_test", Token: "src_4242424242424242"}).Validate())
+}
+
+func TestPlanMinimumCharge(t *testing.T) {
+	plan := func(amount uint64, c Currency) *CreatePlanRequest {
+		return &CreatePlanRequest{Id: "p", Name: "P", Interval: Interval_Month, Amount: amount, Currency: c}
+	}
+	assert.NoError(t, plan(0, Currency_USD).Validate(), "free plans are allowed")
+	assert.NoError(t, plan(50, Currency_USD).Validate())
+	assert.Error(t, plan(49, Currency_USD).Validate())
+	assert.NoError(t, plan(50, Currency_JPY).Validate())
+	assert.Error(t, plan(10, Currency_JPY).Validate())
+	assert.NoError(t, plan(1, Currency_KWD).Validate(), "no published minimum")
+	metered := plan(1, Currency_USD)
+	metered.UsageType = UsageType_Metered
+	assert.NoError(t, metered.Validate(), "the amount of a metered plan is a unit price")
+
+	assert.Equal(t, 2, Currency_USD.Exponent())
+	assert.Equal(t, 0, Currency_JPY.Exponent())
+	assert.Equal(t, 3, Currency_KWD.Exponent())
+}
+
+func TestPlanPricingValidation(t *testing.T) {
+	plan := func(f func(*CreatePlanRequest)) *CreatePlanRequest {
+		req := &CreatePlanRequest{Id: "p", Name: "P", Interval: Interval_Month, Currency: Currency_USD}
+		f(req)
+		return req
+	}
+	tiered := func(req *CreatePlanRequest) {
+		req.BillingScheme = BillingScheme_Tiered
+		req.TiersMode = TiersMode_Graduated
+		req.Tiers = []*PlanTier{{UpTo: 10, UnitAmount: 1000}, {UpTo: 100, UnitAmount: 800}, {UnitAmount: 500}}
+	}
+
+	assert.NoError(t, plan(tiered).Validate())
+	assert.NoError(t, plan(func(req *CreatePlanRequest) {
+		req.Amount = 100
+		req.UsageType = UsageType_Metered
+		req.AggregateUsage = AggregateUsage_Max
+	}).Validate())
+
+	tt := map[string]func(*CreatePlanRequest){
+		"aggregate usage on licensed plan": func(req *CreatePlanRequest) { req.AggregateUsage = AggregateUsage_Sum },
+		"tiers on per unit plan":           func(req *CreatePlanRequest) { req.Tiers = []*PlanTier{{UnitAmount: 100}} },
+		"tiers mode on per unit plan":      func(req *CreatePlanRequest) { req.TiersMode = TiersMode_Volume },
+		"amount on tiered plan":            func(req *CreatePlanRequest) { tiered(req); req.Amount = 1000 },
+		"missing tiers mode":               func(req *CreatePlanRequest) { tiered(req); req.TiersMode = TiersMode_UnknownTiersMode },
+		"missing tiers":                    func(req *CreatePlanRequest) { tiered(req); req.Tiers = nil },
+		"bounded last tier":                func(req *CreatePlanRequest) { tiered(req); req.Tiers[2].UpTo = 1000 },
+		"unbounded middle tier":            func(req *CreatePlanRequest) { tiered(req); req.Tiers[1].UpTo = 0 },
+		"descending tiers":                 func(req *CreatePlanRequest) { tiered(req); req.Tiers[1].UpTo = 5 },
+	}
+	for name, f := range tt {
+		assert.Error(t, plan(f).Validate(), name)
+	}
+}
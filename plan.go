@@ -1,32 +1,202 @@
 package recur
 
 import (
+	"fmt"
+	"io"
+
 	"google.golang.org/grpc"
 
 	"github.com/BTBurke/recur/backend"
 	"github.com/BTBurke/recur/pb"
+	log "github.com/sirupsen/logrus"
 	context "golang.org/x/net/context"
 )
 
+// PlanClient is the library facade for plan operations.  It satisfies pb.PlansClient so that it
+// can be used interchangeably with a GRPC client connected to a recur service.
 type PlanClient struct {
 	backend backend.PlanClient
+	client  *Client
 }
 
+var _ pb.PlansClient = (*PlanClient)(nil)
+
 // CreatePlan is the GRPC endpoint to create a plan.
 func (c *PlanClient) CreatePlan(ctx context.Context, req *pb.CreatePlanRequest, opts ...grpc.CallOption) (*pb.PlanResponse, error) {
-	return nil, nil
+	return c.create(ctx, req)
 }
 
 // Create creates a plan with a default context
 func (c *PlanClient) Create(req *pb.CreatePlanRequest) (*pb.PlanResponse, error) {
-	return nil, nil
+	return c.create(context.Background(), req)
 }
 
 // CreateWithCtx creates a plan with a custom context
 func (c *PlanClient) CreateWithCtx(ctx context.Context, req *pb.CreatePlanRequest) (*pb.PlanResponse, error) {
-	return nil, nil
+	return c.create(ctx, req)
 }
 
 func (c *PlanClient) create(ctx context.Context, req *pb.CreatePlanRequest) (*pb.PlanResponse, error) {
-	return nil, nil
+	ctx, cancel := c.client.withTimeout(ctx)
+	defer cancel()
+
+	resp, err := c.backend.Create(ctx, req)
+	logResponse(c.client.Logger.WithFields(log.Fields{"action": "create", "plan": req.GetId()}), resp.GetError(), err)
+	return resp, pb.ResponseError(resp.GetError(), err)
+}
+
+// UpdatePlan is the GRPC endpoint to update a plan.
+func (c *PlanClient) UpdatePlan(ctx context.Context, req *pb.UpdatePlanRequest, opts ...grpc.CallOption) (*pb.PlanResponse, error) {
+	return c.update(ctx, req)
+}
+
+// Update updates a plan with a default context
+func (c *PlanClient) Update(req *pb.UpdatePlanRequest) (*pb.PlanResponse, error) {
+	return c.update(context.Background(), req)
+}
+
+// UpdateWithCtx updates a plan with a custom context
+func (c *PlanClient) UpdateWithCtx(ctx context.Context, req *pb.UpdatePlanRequest) (*pb.PlanResponse, error) {
+	return c.update(ctx, req)
+}
+
+func (c *PlanClient) update(ctx context.Context, req *pb.UpdatePlanRequest) (*pb.PlanResponse, error) {
+	ctx, cancel := c.client.withTimeout(ctx)
+	defer cancel()
+
+	resp, err := c.backend.Update(ctx, req)
+	logResponse(c.client.Logger.WithFields(log.Fields{"action": "update", "plan": req.GetId()}), resp.GetError(), err)
+	return resp, pb.ResponseError(resp.GetError(), err)
+}
+
+// DeletePlan is the GRPC endpoint to delete a plan.
+func (c *PlanClient) DeletePlan(ctx context.Context, req *pb.DeletePlanRequest, opts ...grpc.CallOption) (*pb.DeletePlanResponse, error) {
+	return c.delete(ctx, req)
+}
+
+// Delete deletes a plan with a default context
+func (c *PlanClient) Delete(req *pb.DeletePlanRequest) (*pb.DeletePlanResponse, error) {
+	return c.delete(context.Background(), req)
+}
+
+// DeleteWithCtx deletes a plan with a custom context
+func (c *PlanClient) DeleteWithCtx(ctx context.Context, req *pb.DeletePlanRequest) (*pb.DeletePlanResponse, error) {
+	return c.delete(ctx, req)
+}
+
+func (c *PlanClient) delete(ctx context.Context, req *pb.DeletePlanRequest) (*pb.DeletePlanResponse, error) {
+	ctx, cancel := c.client.withTimeout(ctx)
+	defer cancel()
+
+	resp, err := c.backend.Delete(ctx, req)
+	logResponse(c.client.Logger.WithFields(log.Fields{"action": "delete", "plan": req.GetId()}), resp.GetError(), err)
+	return resp, pb.ResponseError(resp.GetError(), err)
+}
+
+// GetPlan is the GRPC endpoint to get a plan.
+func (c *PlanClient) GetPlan(ctx context.Context, req *pb.GetPlanRequest, opts ...grpc.CallOption) (*pb.PlanResponse, error) {
+	return c.get(ctx, req)
+}
+
+// Get gets a plan with a default context
+func (c *PlanClient) Get(req *pb.GetPlanRequest) (*pb.PlanResponse, error) {
+	return c.get(context.Background(), req)
+}
+
+// GetWithCtx gets a plan with a custom context
+func (c *PlanClient) GetWithCtx(ctx context.Context, req *pb.GetPlanRequest) (*pb.PlanResponse, error) {
+	return c.get(ctx, req)
+}
+
+func (c *PlanClient) get(ctx context.Context, req *pb.GetPlanRequest) (*pb.PlanResponse, error) {
+	ctx, cancel := c.client.withTimeout(ctx)
+	defer cancel()
+
+	resp, err := c.backend.Get(ctx, req)
+	logResponse(c.client.Logger.WithFields(log.Fields{"action": "get", "plan": req.GetId()}), resp.GetError(), err)
+	return resp, pb.ResponseError(resp.GetError(), err)
+}
+
+// ListPlans is the GRPC endpoint to list plans.
+func (c *PlanClient) ListPlans(ctx context.Context, req *pb.ListPlansRequest, opts ...grpc.CallOption) (pb.Plans_ListPlansClient, error) {
+	ctx, cancel := c.client.withTimeout(ctx)
+	stream, err := c.list(ctx, req)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	return &planListClient{listClient: listClient{ctx: ctx, cancel: cancel}, stream: stream}, nil
+}
+
+// List lists plans with a default context.  The client timeout applies to the entire
+// iteration of the returned stream.
+func (c *PlanClient) List(req *pb.ListPlansRequest) (backend.PlanStreamer, error) {
+	return c.ListWithCtx(context.Background(), req)
+}
+
+// ListWithCtx lists plans with a custom context
+func (c *PlanClient) ListWithCtx(ctx context.Context, req *pb.ListPlansRequest) (backend.PlanStreamer, error) {
+	ctx, cancel := c.client.withTimeout(ctx)
+	stream, err := c.list(ctx, req)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	return &cancelPlanStreamer{PlanStreamer: stream, cancel: cancel}, nil
+}
+
+func (c *PlanClient) list(ctx context.Context, req *pb.ListPlansRequest) (backend.PlanStreamer, error) {
+	stream, err := c.backend.List(ctx, req)
+	logList(c.client.Logger.WithFields(log.Fields{"action": "list", "resource": "plan"}), err)
+	return stream, err
+}
+
+// cancelPlanStreamer releases the context of a list request when the stream is exhausted
+type cancelPlanStreamer struct {
+	backend.PlanStreamer
+	cancel context.CancelFunc
+}
+
+func (s *cancelPlanStreamer) Next() bool {
+	if s.PlanStreamer.Next() {
+		return true
+	}
+	s.cancel()
+	return false
+}
+
+// Close stops iteration and releases the context of the list request
+func (s *cancelPlanStreamer) Close() {
+	s.PlanStreamer.Close()
+	s.cancel()
+}
+
+// planListClient adapts a PlanStreamer to the GRPC client stream interface
+type planListClient struct {
+	listClient
+	stream backend.PlanStreamer
+}
+
+func (s *planListClient) Recv() (*pb.PlanResponse, error) {
+	if !s.stream.Next() {
+		s.cancel()
+		if err := s.stream.Err(); err != nil {
+			return nil, err
+		}
+		return nil, io.EOF
+	}
+	return s.stream.Current(), nil
+}
+
+func (s *planListClient) RecvMsg(m interface{}) error {
+	resp, err := s.Recv()
+	if err != nil {
+		return err
+	}
+	out, ok := m.(*pb.PlanResponse)
+	if !ok {
+		return fmt.Errorf("unexpected message type %T", m)
+	}
+	*out = *resp
+	return nil
 }
@@ -0,0 +1,204 @@
+package recur
+
+import (
+	"testing"
+	"time"
+
+	"github.com/BTBurke/recur/backend"
+	"github.com/BTBurke/recur/pb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	context "golang.org/x/net/context"
+)
+
+type mockPlanBackend struct {
+	delay time.Duration
+	mock.Mock
+}
+
+func (m *mockPlanBackend) wait(ctx context.Context) error {
+	select {
+	case <-time.After(m.delay):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (m *mockPlanBackend) Create(ctx context.Context, req *pb.CreatePlanRequest) (*pb.PlanResponse, error) {
+	args := m.Called(req)
+	if err := m.wait(ctx); err != nil {
+		return nil, err
+	}
+	return args.Get(0).(*pb.PlanResponse), args.Error(1)
+}
+
+func (m *mockPlanBackend) Update(ctx context.Context, req *pb.UpdatePlanRequest) (*pb.PlanResponse, error) {
+	args := m.Called(req)
+	if err := m.wait(ctx); err != nil {
+		return nil, err
+	}
+	return args.Get(0).(*pb.PlanResponse), args.Error(1)
+}
+
+func (m *mockPlanBackend) Delete(ctx context.Context, req *pb.DeletePlanRequest) (*pb.DeletePlanResponse, error) {
+	args := m.Called(req)
+	if err := m.wait(ctx); err != nil {
+		return nil, err
+	}
+	return args.Get(0).(*pb.DeletePlanResponse), args.Error(1)
+}
+
+func (m *mockPlanBackend) Get(ctx context.Context, req *pb.GetPlanRequest) (*pb.PlanResponse, error) {
+	args := m.Called(req)
+	if err := m.wait(ctx); err != nil {
+		return nil, err
+	}
+	return args.Get(0).(*pb.PlanResponse), args.Error(1)
+}
+
+func (m *mockPlanBackend) List(ctx context.Context, req *pb.ListPlansRequest) (backend.PlanStreamer, error) {
+	args := m.Called(req)
+	return args.Get(0).(backend.PlanStreamer), args.Error(1)
+}
+
+type sliceStreamer struct {
+	plans []*pb.PlanResponse
+	idx   int
+}
+
+func (s *sliceStreamer) Next() bool {
+	s.idx++
+	return s.idx <= len(s.plans)
+}
+
+func (s *sliceStreamer) Current() *pb.PlanResponse {
+	return s.plans[s.idx-1]
+}
+
+func (s *sliceStreamer) Err() error {
+	return nil
+}
+
+func (s *sliceStreamer) Close() {}
+
+func newTestClient(b backend.PlanClient, timeout time.Duration) *Client {
+	c, _ := NewClient(StripeClient, "", Timeout(timeout))
+	c.Plan.backend = b
+	return c
+}
+
+func TestPlanFacade(t *testing.T) {
+	success := &pb.PlanResponse{Responses: &pb.PlanResponse_Success{Success: &pb.Plan{Id: "test"}}}
+
+	tt := []struct {
+		Name      string
+		Timeout   time.Duration
+		Delay     time.Duration
+		ShouldErr bool
+	}{
+		{Name: "no timeout", Timeout: 1 * time.Second},
+		{Name: "timeout disabled", Timeout: 0, Delay: 10 * time.Millisecond},
+		{Name: "times out", Timeout: 10 * time.Millisecond, Delay: 100 * time.Millisecond, ShouldErr: true},
+	}
+	for _, tc := range tt {
+		t.Run(tc.Name, func(t *testing.T) {
+			mck := &mockPlanBackend{delay: tc.Delay}
+			mck.On("Create", mock.Anything).Return(success, nil)
+			mck.On("Update", mock.Anything).Return(success, nil)
+			mck.On("Get", mock.Anything).Return(success, nil)
+			mck.On("Delete", mock.Anything).Return(&pb.DeletePlanResponse{}, nil)
+			c := newTestClient(mck, tc.Timeout)
+
+			resp, err := c.Plan.Create(&pb.CreatePlanRequest{Id: "test"})
+			resp2, err2 := c.Plan.UpdateWithCtx(context.Background(), &pb.UpdatePlanRequest{Id: "test"})
+			resp3, err3 := c.Plan.GetPlan(context.Background(), &pb.GetPlanRequest{Id: "test"})
+			_, err4 := c.Plan.Delete(&pb.DeletePlanRequest{Id: "test"})
+			switch tc.ShouldErr {
+			case true:
+				assert.Error(t, err)
+				assert.Error(t, err2)
+				assert.Error(t, err3)
+				assert.Error(t, err4)
+			default:
+				assert.NoError(t, err)
+				assert.NoError(t, err2)
+				assert.NoError(t, err3)
+				assert.NoError(t, err4)
+				assert.Equal(t, "test", resp.GetSuccess().GetId())
+				assert.Equal(t, "test", resp2.GetSuccess().GetId())
+				assert.Equal(t, "test", resp3.GetSuccess().GetId())
+			}
+		})
+	}
+}
+
+func TestPlanFacadeList(t *testing.T) {
+	plans := []*pb.PlanResponse{
+		{Responses: &pb.PlanResponse_Success{Success: &pb.Plan{Id: "test1"}}},
+		{Responses: &pb.PlanResponse_Success{Success: &pb.Plan{Id: "test2"}}},
+	}
+	mck := new(mockPlanBackend)
+	mck.On("List", mock.Anything).Return(&sliceStreamer{plans: plans}, nil).Once()
+	mck.On("List", mock.Anything).Return(&sliceStreamer{plans: plans}, nil).Once()
+	c := newTestClient(mck, time.Second)
+
+	stream, err := c.Plan.List(nil)
+	assert.NoError(t, err)
+	var got []string
+	for stream.Next() {
+		got = append(got, stream.Current().GetSuccess().GetId())
+	}
+	assert.Equal(t, []string{"test1", "test2"}, got)
+
+	grpcStream, err := c.Plan.ListPlans(context.Background(), nil)
+	assert.NoError(t, err)
+	got = nil
+	for {
+		resp, err := grpcStream.Recv()
+		if err != nil {
+			break
+		}
+		got = append(got, resp.GetSuccess().GetId())
+	}
+	assert.Equal(t, []string{"test1", "test2"}, got)
+}
+
+func TestMemoryClient(t *testing.T) {
+	c, err := NewClient(MemoryClient, "")
+	if err != nil {
+		t.Fatalf("unexpected error creating client: %s", err)
+	}
+	req := &pb.CreatePlanRequest{Id: "test", Name: "test", Currency: pb.Currency_USD, Interval: pb.Interval_Month}
+	resp, err := c.Plan.Create(req)
+	assert.NoError(t, err)
+	assert.Equal(t, "test", resp.GetSuccess().GetId())
+
+	resp, err = c.Plan.Create(req)
+	assert.Error(t, err)
+	assert.Equal(t, pb.ErrorType_InvalidRequest, resp.GetError().GetType())
+
+	_, err = c.Plan.Get(&pb.GetPlanRequest{Id: "missing"})
+	assert.True(t, pb.IsNotFound(err))
+	assert.False(t, pb.IsRetryable(err))
+}
+
+func TestRegisteredBackend(t *testing.T) {
+	plans := new(mockPlanBackend)
+	plans.On("Get", mock.Anything).Return(&pb.PlanResponse{Responses: &pb.PlanResponse_Success{Success: &pb.Plan{Id: "test"}}}, nil)
+	backend.Register("recur-test", func(cfg backend.Config) (*backend.Clients, error) {
+		return &backend.Clients{Plan: plans}, nil
+	})
+
+	c, err := NewClient(ClientType("recur-test"), "key")
+	if err != nil {
+		t.Fatalf("unexpected error creating client: %s", err)
+	}
+	resp, err := c.Plan.Get(&pb.GetPlanRequest{Id: "test"})
+	assert.NoError(t, err)
+	assert.Equal(t, "test", resp.GetSuccess().GetId())
+	assert.Nil(t, c.Customer, "unsupported resources are nil")
+
+	_, err = NewClient(ClientType("unknown"), "key")
+	assert.Error(t, err)
+}
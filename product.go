@@ -0,0 +1,203 @@
+package recur
+
+import (
+	"fmt"
+	"io"
+
+	"google.golang.org/grpc"
+
+	"github.com/BTBurke/recur/backend"
+	"github.com/BTBurke/recur/pb"
+	log "github.com/sirupsen/logrus"
+	context "golang.org/x/net/context"
+)
+
+// ProductClient is the library facade for product operations.  It satisfies pb.ProductsClient so that it
+// can be used interchangeably with a GRPC client connected to a recur service.
+type ProductClient struct {
+	backend backend.ProductClient
+	client  *Client
+}
+
+var _ pb.ProductsClient = (*ProductClient)(nil)
+
+// CreateProduct is the GRPC endpoint to create a product.
+func (c *ProductClient) CreateProduct(ctx context.Context, req *pb.CreateProductRequest, opts ...grpc.CallOption) (*pb.ProductResponse, error) {
+	return c.create(ctx, req)
+}
+
+// Create creates a product with a default context
+func (c *ProductClient) Create(req *pb.CreateProductRequest) (*pb.ProductResponse, error) {
+	return c.create(context.Background(), req)
+}
+
+// CreateWithCtx creates a product with a custom context
+func (c *ProductClient) CreateWithCtx(ctx context.Context, req *pb.CreateProductRequest) (*pb.ProductResponse, error) {
+	return c.create(ctx, req)
+}
+
+func (c *ProductClient) create(ctx context.Context, req *pb.CreateProductRequest) (*pb.ProductResponse, error) {
+	ctx, cancel := c.client.withTimeout(ctx)
+	defer cancel()
+
+	resp, err := c.backend.Create(ctx, req)
+	logResponse(c.client.Logger.WithFields(log.Fields{"action": "create", "product": resp.GetSuccess().GetId()}), resp.GetError(), err)
+	return resp, pb.ResponseError(resp.GetError(), err)
+}
+
+// UpdateProduct is the GRPC endpoint to update a product.
+func (c *ProductClient) UpdateProduct(ctx context.Context, req *pb.UpdateProductRequest, opts ...grpc.CallOption) (*pb.ProductResponse, error) {
+	return c.update(ctx, req)
+}
+
+// Update updates a product with a default context
+func (c *ProductClient) Update(req *pb.UpdateProductRequest) (*pb.ProductResponse, error) {
+	return c.update(context.Background(), req)
+}
+
+// UpdateWithCtx updates a product with a custom context
+func (c *ProductClient) UpdateWithCtx(ctx context.Context, req *pb.UpdateProductRequest) (*pb.ProductResponse, error) {
+	return c.update(ctx, req)
+}
+
+func (c *ProductClient) update(ctx context.Context, req *pb.UpdateProductRequest) (*pb.ProductResponse, error) {
+	ctx, cancel := c.client.withTimeout(ctx)
+	defer cancel()
+
+	resp, err := c.backend.Update(ctx, req)
+	logResponse(c.client.Logger.WithFields(log.Fields{"action": "update", "product": req.GetId()}), resp.GetError(), err)
+	return resp, pb.ResponseError(resp.GetError(), err)
+}
+
+// DeleteProduct is the GRPC endpoint to delete a product.
+func (c *ProductClient) DeleteProduct(ctx context.Context, req *pb.DeleteProductRequest, opts ...grpc.CallOption) (*pb.DeleteProductResponse, error) {
+	return c.delete(ctx, req)
+}
+
+// Delete deletes a product with a default context.  A product that still has plans cannot be
+// deleted; deactivate it instead.
+func (c *ProductClient) Delete(req *pb.DeleteProductRequest) (*pb.DeleteProductResponse, error) {
+	return c.delete(context.Background(), req)
+}
+
+// DeleteWithCtx deletes a product with a custom context
+func (c *ProductClient) DeleteWithCtx(ctx context.Context, req *pb.DeleteProductRequest) (*pb.DeleteProductResponse, error) {
+	return c.delete(ctx, req)
+}
+
+func (c *ProductClient) delete(ctx context.Context, req *pb.DeleteProductRequest) (*pb.DeleteProductResponse, error) {
+	ctx, cancel := c.client.withTimeout(ctx)
+	defer cancel()
+
+	resp, err := c.backend.Delete(ctx, req)
+	logResponse(c.client.Logger.WithFields(log.Fields{"action": "delete", "product": req.GetId()}), resp.GetError(), err)
+	return resp, pb.ResponseError(resp.GetError(), err)
+}
+
+// GetProduct is the GRPC endpoint to get a product.
+func (c *ProductClient) GetProduct(ctx context.Context, req *pb.GetProductRequest, opts ...grpc.CallOption) (*pb.ProductResponse, error) {
+	return c.get(ctx, req)
+}
+
+// Get gets a product with a default context
+func (c *ProductClient) Get(req *pb.GetProductRequest) (*pb.ProductResponse, error) {
+	return c.get(context.Background(), req)
+}
+
+// GetWithCtx gets a product with a custom context
+func (c *ProductClient) GetWithCtx(ctx context.Context, req *pb.GetProductRequest) (*pb.ProductResponse, error) {
+	return c.get(ctx, req)
+}
+
+func (c *ProductClient) get(ctx context.Context, req *pb.GetProductRequest) (*pb.ProductResponse, error) {
+	ctx, cancel := c.client.withTimeout(ctx)
+	defer cancel()
+
+	resp, err := c.backend.Get(ctx, req)
+	logResponse(c.client.Logger.WithFields(log.Fields{"action": "get", "product": req.GetId()}), resp.GetError(), err)
+	return resp, pb.ResponseError(resp.GetError(), err)
+}
+
+// ListProducts is the GRPC endpoint to list products.
+func (c *ProductClient) ListProducts(ctx context.Context, req *pb.ListProductsRequest, opts ...grpc.CallOption) (pb.Products_ListProductsClient, error) {
+	ctx, cancel := c.client.withTimeout(ctx)
+	stream, err := c.list(ctx, req)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	return &productListClient{listClient: listClient{ctx: ctx, cancel: cancel}, stream: stream}, nil
+}
+
+// List lists products with a default context.  The client timeout applies to the entire
+// iteration of the returned stream.
+func (c *ProductClient) List(req *pb.ListProductsRequest) (backend.ProductStreamer, error) {
+	return c.ListWithCtx(context.Background(), req)
+}
+
+// ListWithCtx lists products with a custom context
+func (c *ProductClient) ListWithCtx(ctx context.Context, req *pb.ListProductsRequest) (backend.ProductStreamer, error) {
+	ctx, cancel := c.client.withTimeout(ctx)
+	stream, err := c.list(ctx, req)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	return &cancelProductStreamer{ProductStreamer: stream, cancel: cancel}, nil
+}
+
+func (c *ProductClient) list(ctx context.Context, req *pb.ListProductsRequest) (backend.ProductStreamer, error) {
+	stream, err := c.backend.List(ctx, req)
+	logList(c.client.Logger.WithFields(log.Fields{"action": "list", "resource": "product"}), err)
+	return stream, err
+}
+
+// cancelProductStreamer releases the context of a list request when the stream is exhausted
+type cancelProductStreamer struct {
+	backend.ProductStreamer
+	cancel context.CancelFunc
+}
+
+func (s *cancelProductStreamer) Next() bool {
+	if s.ProductStreamer.Next() {
+		return true
+	}
+	s.cancel()
+	return false
+}
+
+// Close stops iteration and releases the context of the list request
+func (s *cancelProductStreamer) Close() {
+	s.ProductStreamer.Close()
+	s.cancel()
+}
+
+// productListClient adapts a ProductStreamer to the GRPC client stream interface
+type productListClient struct {
+	listClient
+	stream backend.ProductStreamer
+}
+
+func (s *productListClient) Recv() (*pb.ProductResponse, error) {
+	if !s.stream.Next() {
+		s.cancel()
+		if err := s.stream.Err(); err != nil {
+			return nil, err
+		}
+		return nil, io.EOF
+	}
+	return s.stream.Current(), nil
+}
+
+func (s *productListClient) RecvMsg(m interface{}) error {
+	resp, err := s.Recv()
+	if err != nil {
+		return err
+	}
+	out, ok := m.(*pb.ProductResponse)
+	if !ok {
+		return fmt.Errorf("unexpected message type %T", m)
+	}
+	*out = *resp
+	return nil
+}
@@ -0,0 +1,98 @@
+package server
+
+import (
+	"github.com/BTBurke/recur/backend"
+	"github.com/BTBurke/recur/pb"
+	log "github.com/sirupsen/logrus"
+	context "golang.org/x/net/context"
+)
+
+// CouponServer implements the Coupons GRPC service
+type CouponServer struct {
+	backend backend.CouponClient
+	logger  *log.Logger
+}
+
+var _ pb.CouponsServer = (*CouponServer)(nil)
+
+// NewCouponServer returns a Coupons service backed by the coupon client
+func NewCouponServer(b backend.CouponClient, logger *log.Logger) *CouponServer {
+	return &CouponServer{
+		backend: b,
+		logger:  logger,
+	}
+}
+
+func (s *CouponServer) CreateCoupon(ctx context.Context, req *pb.CreateCouponRequest) (*pb.CouponResponse, error) {
+	resp, err := s.backend.Create(contextFromMetadata(ctx), req)
+	err = pb.ResponseError(resp.GetError(), err)
+	s.log("CreateCoupon", resp.GetSuccess().GetId(), err)
+	return resp, toStatus(err)
+}
+
+func (s *CouponServer) UpdateCoupon(ctx context.Context, req *pb.UpdateCouponRequest) (*pb.CouponResponse, error) {
+	resp, err := s.backend.Update(contextFromMetadata(ctx), req)
+	err = pb.ResponseError(resp.GetError(), err)
+	s.log("UpdateCoupon", req.GetId(), err)
+	return resp, toStatus(err)
+}
+
+func (s *CouponServer) DeleteCoupon(ctx context.Context, req *pb.DeleteCouponRequest) (*pb.DeleteCouponResponse, error) {
+	resp, err := s.backend.Delete(contextFromMetadata(ctx), req)
+	err = pb.ResponseError(resp.GetError(), err)
+	s.log("DeleteCoupon", req.GetId(), err)
+	return resp, toStatus(err)
+}
+
+func (s *CouponServer) GetCoupon(ctx context.Context, req *pb.GetCouponRequest) (*pb.CouponResponse, error) {
+	resp, err := s.backend.Get(contextFromMetadata(ctx), req)
+	err = pb.ResponseError(resp.GetError(), err)
+	s.log("GetCoupon", req.GetId(), err)
+	return resp, toStatus(err)
+}
+
+func (s *CouponServer) ApplyCoupon(ctx context.Context, req *pb.ApplyCouponRequest) (*pb.DiscountResponse, error) {
+	resp, err := s.backend.Apply(contextFromMetadata(ctx), req)
+	err = pb.ResponseError(resp.GetError(), err)
+	s.log("ApplyCoupon", req.GetCoupon(), err)
+	return resp, toStatus(err)
+}
+
+func (s *CouponServer) RemoveDiscount(ctx context.Context, req *pb.RemoveDiscountRequest) (*pb.RemoveDiscountResponse, error) {
+	resp, err := s.backend.RemoveDiscount(contextFromMetadata(ctx), req)
+	err = pb.ResponseError(resp.GetError(), err)
+	s.log("RemoveDiscount", "", err)
+	return resp, toStatus(err)
+}
+
+// ListCoupons streams each coupon returned by the backend to the client
+func (s *CouponServer) ListCoupons(req *pb.ListCouponsRequest, stream pb.Coupons_ListCouponsServer) error {
+	coupons, err := s.backend.List(contextFromMetadata(stream.Context()), req)
+	if err != nil {
+		s.log("ListCoupons", "", err)
+		return toStatus(err)
+	}
+	defer coupons.Close()
+	for coupons.Next() {
+		if err := stream.Send(coupons.Current()); err != nil {
+			s.log("ListCoupons", "", err)
+			return err
+		}
+	}
+	err = coupons.Err()
+	s.log("ListCoupons", "", err)
+	return toStatus(err)
+}
+
+func (s *CouponServer) log(method string, id string, err error) {
+	logger := s.logger.WithField("method", method)
+	if len(id) > 0 {
+		logger = logger.WithField("coupon", id)
+	}
+	switch {
+	case err != nil:
+		logger.Errorf("request failed: %s", err)
+	default:
+		logger.Info("request complete")
+	}
+}
@@ -0,0 +1,84 @@
+package server
+
+import (
+	"github.com/BTBurke/recur/backend"
+	"github.com/BTBurke/recur/pb"
+	log "github.com/sirupsen/logrus"
+	context "golang.org/x/net/context"
+)
+
+// CustomerServer implements the Customers GRPC service
+type CustomerServer struct {
+	backend backend.CustomerClient
+	logger  *log.Logger
+}
+
+var _ pb.CustomersServer = (*CustomerServer)(nil)
+
+// NewCustomerServer returns a Customers service backed by the customer client
+func NewCustomerServer(b backend.CustomerClient, logger *log.Logger) *CustomerServer {
+	return &CustomerServer{
+		backend: b,
+		logger:  logger,
+	}
+}
+
+func (s *CustomerServer) CreateCustomer(ctx context.Context, req *pb.CreateCustomerRequest) (*pb.CustomerResponse, error) {
+	resp, err := s.backend.Create(contextFromMetadata(ctx), req)
+	err = pb.ResponseError(resp.GetError(), err)
+	s.log("CreateCustomer", resp.GetSuccess().GetId(), err)
+	return resp, toStatus(err)
+}
+
+func (s *CustomerServer) UpdateCustomer(ctx context.Context, req *pb.UpdateCustomerRequest) (*pb.CustomerResponse, error) {
+	resp, err := s.backend.Update(contextFromMetadata(ctx), req)
+	err = pb.ResponseError(resp.GetError(), err)
+	s.log("UpdateCustomer", req.GetId(), err)
+	return resp, toStatus(err)
+}
+
+func (s *CustomerServer) DeleteCustomer(ctx context.Context, req *pb.DeleteCustomerRequest) (*pb.DeleteCustomerResponse, error) {
+	resp, err := s.backend.Delete(contextFromMetadata(ctx), req)
+	err = pb.ResponseError(resp.GetError(), err)
+	s.log("DeleteCustomer", req.GetId(), err)
+	return resp, toStatus(err)
+}
+
+func (s *CustomerServer) GetCustomer(ctx context.Context, req *pb.GetCustomerRequest) (*pb.CustomerResponse, error) {
+	resp, err := s.backend.Get(contextFromMetadata(ctx), req)
+	err = pb.ResponseError(resp.GetError(), err)
+	s.log("GetCustomer", req.GetId(), err)
+	return resp, toStatus(err)
+}
+
+// ListCustomers streams each customer returned by the backend to the client
+func (s *CustomerServer) ListCustomers(req *pb.ListCustomersRequest, stream pb.Customers_ListCustomersServer) error {
+	customers, err := s.backend.List(contextFromMetadata(stream.Context()), req)
+	if err != nil {
+		s.log("ListCustomers", "", err)
+		return toStatus(err)
+	}
+	defer customers.Close()
+	for customers.Next() {
+		if err := stream.Send(customers.Current()); err != nil {
+			s.log("ListCustomers", "", err)
+			return err
+		}
+	}
+	err = customers.Err()
+	s.log("ListCustomers", "", err)
+	return toStatus(err)
+}
+
+func (s *CustomerServer) log(method string, id string, err error) {
+	logger := s.logger.WithField("method", method)
+	if len(id) > 0 {
+		logger = logger.WithField("customer", id)
+	}
+	switch {
+	case err != nil:
+		logger.Errorf("request failed: %s", err)
+	default:
+		logger.Info("request complete")
+	}
+}
@@ -0,0 +1,108 @@
+package server
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/BTBurke/recur/backend"
+	"github.com/BTBurke/recur/pb"
+	log "github.com/sirupsen/logrus"
+	context "golang.org/x/net/context"
+)
+
+// EventServer implements the Events GRPC service
+type EventServer struct {
+	backend    backend.EventClient
+	subscriber backend.EventSubscriber
+	logger     *log.Logger
+}
+
+var _ pb.EventsServer = (*EventServer)(nil)
+
+// NewEventServer returns an Events service that retrieves past events from the event client and
+// streams new events from the subscriber.  Either may be nil, in which case the methods that
+// need it return Unimplemented.
+func NewEventServer(b backend.EventClient, subscriber backend.EventSubscriber, logger *log.Logger) *EventServer {
+	return &EventServer{
+		backend:    b,
+		subscriber: subscriber,
+		logger:     logger,
+	}
+}
+
+func (s *EventServer) GetEvent(ctx context.Context, req *pb.GetEventRequest) (*pb.EventResponse, error) {
+	if s.backend == nil {
+		return nil, errNoEventHistory
+	}
+	resp, err := s.backend.Get(contextFromMetadata(ctx), req)
+	err = pb.ResponseError(resp.GetError(), err)
+	s.log("GetEvent", req.GetId(), err)
+	return resp, toStatus(err)
+}
+
+// ListEvents streams each event returned by the backend to the client
+func (s *EventServer) ListEvents(req *pb.ListEventsRequest, stream pb.Events_ListEventsServer) error {
+	if s.backend == nil {
+		return errNoEventHistory
+	}
+	events, err := s.backend.List(contextFromMetadata(stream.Context()), req)
+	if err != nil {
+		s.log("ListEvents", "", err)
+		return toStatus(err)
+	}
+	defer events.Close()
+	for events.Next() {
+		if err := stream.Send(events.Current()); err != nil {
+			s.log("ListEvents", "", err)
+			return err
+		}
+	}
+	err = events.Err()
+	s.log("ListEvents", "", err)
+	return toStatus(err)
+}
+
+// SubscribeEvents streams events matching the requested types until the client disconnects.  A
+// client that cannot keep up, or that is connected when the event source shuts down, is
+// disconnected with Unavailable and should resubscribe.
+func (s *EventServer) SubscribeEvents(req *pb.SubscribeEventsRequest, stream pb.Events_SubscribeEventsServer) error {
+	if s.subscriber == nil {
+		return errNoEventSubscriber
+	}
+	if err := req.Validate(); err != nil {
+		s.log("SubscribeEvents", "", err)
+		return toStatus(err)
+	}
+	ctx := stream.Context()
+	for ev := range s.subscriber.Subscribe(ctx, req.GetTypes()) {
+		if err := stream.Send(ev); err != nil {
+			s.log("SubscribeEvents", "", err)
+			return err
+		}
+	}
+	if err := ctx.Err(); err != nil {
+		s.log("SubscribeEvents", "", nil)
+		return toStatus(err)
+	}
+	err := status.Error(codes.Unavailable, "event stream closed, resubscribe to continue")
+	s.log("SubscribeEvents", "", err)
+	return err
+}
+
+var (
+	errNoEventHistory    = status.Error(codes.Unimplemented, "retrieving past events is not enabled")
+	errNoEventSubscriber = status.Error(codes.Unimplemented, "subscribing to events is not enabled")
+)
+
+func (s *EventServer) log(method string, id string, err error) {
+	logger := s.logger.WithField("method", method)
+	if len(id) > 0 {
+		logger = logger.WithField("event", id)
+	}
+	switch {
+	case err != nil:
+		logger.Errorf("request failed: %s", err)
+	default:
+		logger.Info("request complete")
+	}
+}
@@ -0,0 +1,57 @@
+package server
+
+import (
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	"github.com/BTBurke/recur/pb"
+	"github.com/stretchr/testify/assert"
+	context "golang.org/x/net/context"
+)
+
+// fakeEvents sends a fixed set of events to each subscriber and then closes the channel as if
+// the subscriber had fallen behind
+type fakeEvents struct {
+	events []*pb.Event
+	types  []string
+}
+
+func (f *fakeEvents) Subscribe(ctx context.Context, types []string) <-chan *pb.Event {
+	f.types = types
+	ch := make(chan *pb.Event, len(f.events))
+	for _, ev := range f.events {
+		ch <- ev
+	}
+	close(ch)
+	return ch
+}
+
+func TestSubscribeEvents(t *testing.T) {
+	events := &fakeEvents{events: []*pb.Event{{Id: "evt_1", Type: "invoice.paid"}, {Id: "evt_2", Type: "invoice.paid"}}}
+	conn, stop := startServer(t, Backends{Events: events})
+	defer stop()
+	client := pb.NewEventsClient(conn)
+
+	stream, err := client.SubscribeEvents(context.Background(), &pb.SubscribeEventsRequest{Types: []string{"invoice.*"}})
+	if err != nil {
+		t.Fatalf("unexpected error subscribing: %s", err)
+	}
+	for _, id := range []string{"evt_1", "evt_2"} {
+		ev, err := stream.Recv()
+		if assert.NoError(t, err) {
+			assert.Equal(t, id, ev.Id)
+		}
+	}
+	_, err = stream.Recv()
+	assert.Equal(t, codes.Unavailable, grpc.Code(err))
+	assert.Equal(t, []string{"invoice.*"}, events.types)
+
+	stream, err = client.SubscribeEvents(context.Background(), &pb.SubscribeEventsRequest{Types: []string{""}})
+	if err != nil {
+		t.Fatalf("unexpected error subscribing: %s", err)
+	}
+	_, err = stream.Recv()
+	assert.Equal(t, codes.InvalidArgument, grpc.Code(err))
+}
@@ -0,0 +1,91 @@
+package server
+
+import (
+	"github.com/BTBurke/recur/backend"
+	"github.com/BTBurke/recur/pb"
+	log "github.com/sirupsen/logrus"
+	context "golang.org/x/net/context"
+)
+
+// InvoiceServer implements the Invoices GRPC service
+type InvoiceServer struct {
+	backend backend.InvoiceClient
+	logger  *log.Logger
+}
+
+var _ pb.InvoicesServer = (*InvoiceServer)(nil)
+
+// NewInvoiceServer returns an Invoices service backed by the invoice client
+func NewInvoiceServer(b backend.InvoiceClient, logger *log.Logger) *InvoiceServer {
+	return &InvoiceServer{
+		backend: b,
+		logger:  logger,
+	}
+}
+
+func (s *InvoiceServer) GetInvoice(ctx context.Context, req *pb.GetInvoiceRequest) (*pb.InvoiceResponse, error) {
+	resp, err := s.backend.Get(contextFromMetadata(ctx), req)
+	err = pb.ResponseError(resp.GetError(), err)
+	s.log("GetInvoice", req.GetId(), err)
+	return resp, toStatus(err)
+}
+
+func (s *InvoiceServer) UpcomingInvoice(ctx context.Context, req *pb.UpcomingInvoiceRequest) (*pb.InvoiceResponse, error) {
+	resp, err := s.backend.Upcoming(contextFromMetadata(ctx), req)
+	err = pb.ResponseError(resp.GetError(), err)
+	s.log("UpcomingInvoice", "", err)
+	return resp, toStatus(err)
+}
+
+func (s *InvoiceServer) PayInvoice(ctx context.Context, req *pb.PayInvoiceRequest) (*pb.InvoiceResponse, error) {
+	resp, err := s.backend.Pay(contextFromMetadata(ctx), req)
+	err = pb.ResponseError(resp.GetError(), err)
+	s.log("PayInvoice", req.GetId(), err)
+	return resp, toStatus(err)
+}
+
+func (s *InvoiceServer) VoidInvoice(ctx context.Context, req *pb.VoidInvoiceRequest) (*pb.InvoiceResponse, error) {
+	resp, err := s.backend.Void(contextFromMetadata(ctx), req)
+	err = pb.ResponseError(resp.GetError(), err)
+	s.log("VoidInvoice", req.GetId(), err)
+	return resp, toStatus(err)
+}
+
+func (s *InvoiceServer) MarkUncollectibleInvoice(ctx context.Context, req *pb.MarkUncollectibleInvoiceRequest) (*pb.InvoiceResponse, error) {
+	resp, err := s.backend.MarkUncollectible(contextFromMetadata(ctx), req)
+	err = pb.ResponseError(resp.GetError(), err)
+	s.log("MarkUncollectibleInvoice", req.GetId(), err)
+	return resp, toStatus(err)
+}
+
+// ListInvoices streams each invoice returned by the backend to the client
+func (s *InvoiceServer) ListInvoices(req *pb.ListInvoicesRequest, stream pb.Invoices_ListInvoicesServer) error {
+	invoices, err := s.backend.List(contextFromMetadata(stream.Context()), req)
+	if err != nil {
+		s.log("ListInvoices", "", err)
+		return toStatus(err)
+	}
+	defer invoices.Close()
+	for invoices.Next() {
+		if err := stream.Send(invoices.Current()); err != nil {
+			s.log("ListInvoices", "", err)
+			return err
+		}
+	}
+	err = invoices.Err()
+	s.log("ListInvoices", "", err)
+	return toStatus(err)
+}
+
+func (s *InvoiceServer) log(method string, id string, err error) {
+	logger := s.logger.WithField("method", method)
+	if len(id) > 0 {
+		logger = logger.WithField("invoice", id)
+	}
+	switch {
+	case err != nil:
+		logger.Errorf("request failed: %s", err)
+	default:
+		logger.Info("request complete")
+	}
+}
@@ -0,0 +1,63 @@
+package server
+
+import (
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+
+	"github.com/BTBurke/recur/backend"
+	context "golang.org/x/net/context"
+)
+
+// Metadata keys that callers can set on a GRPC request to control the backend request.  They are
+// defined in backend so that clients can set them without importing the server.
+const (
+	// IdempotencyKeyMD sets the idempotency key sent to the backend
+	IdempotencyKeyMD = backend.IdempotencyKeyMD
+	// StripeAccountMD makes the request on behalf of a Stripe Connect account
+	StripeAccountMD = backend.StripeAccountMD
+	// HeaderMDPrefix forwards metadata with this prefix to the backend as an HTTP header.  Only
+	// the headers in forwardedHeaders are sent; others are dropped.
+	HeaderMDPrefix = backend.HeaderMDPrefix
+)
+
+// forwardedHeaders maps the headers that callers may set with HeaderMDPrefix to the name sent to
+// the backend.  Headers that carry credentials or that recur sets itself, such as Authorization,
+// Stripe-Account and Idempotency-Key, are never forwarded.
+var forwardedHeaders = map[string]string{
+	"stripe-version": "Stripe-Version",
+}
+
+// contextFromMetadata copies recur values from the incoming GRPC metadata to the context
+func contextFromMetadata(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+	if v := first(md[IdempotencyKeyMD]); len(v) > 0 {
+		ctx = backend.WithIdempotencyKey(ctx, v)
+	}
+	if v := first(md[StripeAccountMD]); len(v) > 0 {
+		ctx = backend.WithStripeAccount(ctx, v)
+	}
+	headers := make(map[string]string)
+	for k, v := range md {
+		if !strings.HasPrefix(k, HeaderMDPrefix) {
+			continue
+		}
+		if name, ok := forwardedHeaders[strings.TrimPrefix(k, HeaderMDPrefix)]; ok {
+			headers[name] = first(v)
+		}
+	}
+	if len(headers) > 0 {
+		ctx = backend.WithHeaders(ctx, headers)
+	}
+	return ctx
+}
+
+func first(vals []string) string {
+	if len(vals) == 0 {
+		return ""
+	}
+	return vals[0]
+}
@@ -0,0 +1,84 @@
+package server
+
+import (
+	"github.com/BTBurke/recur/backend"
+	"github.com/BTBurke/recur/pb"
+	log "github.com/sirupsen/logrus"
+	context "golang.org/x/net/context"
+)
+
+// PlanServer implements the Plans GRPC service
+type PlanServer struct {
+	backend backend.PlanClient
+	logger  *log.Logger
+}
+
+var _ pb.PlansServer = (*PlanServer)(nil)
+
+// NewPlanServer returns a Plans service backed by the plan client
+func NewPlanServer(b backend.PlanClient, logger *log.Logger) *PlanServer {
+	return &PlanServer{
+		backend: b,
+		logger:  logger,
+	}
+}
+
+func (s *PlanServer) CreatePlan(ctx context.Context, req *pb.CreatePlanRequest) (*pb.PlanResponse, error) {
+	resp, err := s.backend.Create(contextFromMetadata(ctx), req)
+	err = pb.ResponseError(resp.GetError(), err)
+	s.log("CreatePlan", req.GetId(), err)
+	return resp, toStatus(err)
+}
+
+func (s *PlanServer) UpdatePlan(ctx context.Context, req *pb.UpdatePlanRequest) (*pb.PlanResponse, error) {
+	resp, err := s.backend.Update(contextFromMetadata(ctx), req)
+	err = pb.ResponseError(resp.GetError(), err)
+	s.log("UpdatePlan", req.GetId(), err)
+	return resp, toStatus(err)
+}
+
+func (s *PlanServer) DeletePlan(ctx context.Context, req *pb.DeletePlanRequest) (*pb.DeletePlanResponse, error) {
+	resp, err := s.backend.Delete(contextFromMetadata(ctx), req)
+	err = pb.ResponseError(resp.GetError(), err)
+	s.log("DeletePlan", req.GetId(), err)
+	return resp, toStatus(err)
+}
+
+func (s *PlanServer) GetPlan(ctx context.Context, req *pb.GetPlanRequest) (*pb.PlanResponse, error) {
+	resp, err := s.backend.Get(contextFromMetadata(ctx), req)
+	err = pb.ResponseError(resp.GetError(), err)
+	s.log("GetPlan", req.GetId(), err)
+	return resp, toStatus(err)
+}
+
+// ListPlans streams each plan returned by the backend to the client
+func (s *PlanServer) ListPlans(req *pb.ListPlansRequest, stream pb.Plans_ListPlansServer) error {
+	plans, err := s.backend.List(contextFromMetadata(stream.Context()), req)
+	if err != nil {
+		s.log("ListPlans", "", err)
+		return toStatus(err)
+	}
+	defer plans.Close()
+	for plans.Next() {
+		if err := stream.Send(plans.Current()); err != nil {
+			s.log("ListPlans", "", err)
+			return err
+		}
+	}
+	err = plans.Err()
+	s.log("ListPlans", "", err)
+	return toStatus(err)
+}
+
+func (s *PlanServer) log(method string, id string, err error) {
+	logger := s.logger.WithField("method", method)
+	if len(id) > 0 {
+		logger = logger.WithField("plan", id)
+	}
+	switch {
+	case err != nil:
+		logger.Errorf("request failed: %s", err)
+	default:
+		logger.Info("request complete")
+	}
+}
@@ -0,0 +1,208 @@
+package server
+
+import (
+	"io"
+	"io/ioutil"
+	"net"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/BTBurke/recur/backend"
+	"github.com/BTBurke/recur/pb"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	context "golang.org/x/net/context"
+)
+
+// fakePlans is a minimal backend.PlanClient that stores plans in a slice
+type fakePlans struct {
+	plans []*pb.Plan
+	ctx   context.Context
+}
+
+func (f *fakePlans) Create(ctx context.Context, req *pb.CreatePlanRequest) (*pb.PlanResponse, error) {
+	f.ctx = ctx
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	plan := &pb.Plan{Id: req.Id, Name: req.Name, Amount: req.Amount}
+	f.plans = append(f.plans, plan)
+	return &pb.PlanResponse{Responses: &pb.PlanResponse_Success{Success: plan}}, nil
+}
+
+func (f *fakePlans) Update(ctx context.Context, req *pb.UpdatePlanRequest) (*pb.PlanResponse, error) {
+	return nil, nil
+}
+
+func (f *fakePlans) Delete(ctx context.Context, req *pb.DeletePlanRequest) (*pb.DeletePlanResponse, error) {
+	return nil, nil
+}
+
+func (f *fakePlans) Get(ctx context.Context, req *pb.GetPlanRequest) (*pb.PlanResponse, error) {
+	return nil, nil
+}
+
+func (f *fakePlans) List(ctx context.Context, req *pb.ListPlansRequest) (backend.PlanStreamer, error) {
+	return &fakePlanStreamer{plans: f.plans}, nil
+}
+
+type fakePlanStreamer struct {
+	plans []*pb.Plan
+	idx   int
+	err   error
+}
+
+func (s *fakePlanStreamer) Next() bool {
+	s.idx++
+	return s.idx <= len(s.plans)
+}
+
+func (s *fakePlanStreamer) Current() *pb.PlanResponse {
+	return &pb.PlanResponse{Responses: &pb.PlanResponse_Success{Success: s.plans[s.idx-1]}}
+}
+
+func (s *fakePlanStreamer) Err() error {
+	return s.err
+}
+
+func (s *fakePlanStreamer) Close() {}
+
+// startServer starts a server on a random local port and returns a connected client
+func startServer(t *testing.T, b Backends) (*grpc.ClientConn, func()) {
+	logger := log.New()
+	logger.Out = ioutil.Discard
+	srv := New(b, logger)
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %s", err)
+	}
+	go srv.Serve(lis)
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("failed to dial server: %s", err)
+	}
+	return conn, func() {
+		conn.Close()
+		srv.Stop()
+	}
+}
+
+func TestPlanServer(t *testing.T) {
+	conn, stop := startServer(t, Backends{Plan: new(fakePlans)})
+	defer stop()
+	client := pb.NewPlansClient(conn)
+
+	reqs := []*pb.CreatePlanRequest{
+		{Id: "test1", Name: "test1", Amount: 1000, Currency: pb.Currency_USD, Interval: pb.Interval_Month},
+		{Id: "test2", Name: "test2", Amount: 2000, Currency: pb.Currency_USD, Interval: pb.Interval_Year},
+	}
+	for _, req := range reqs {
+		resp, err := client.CreatePlan(context.Background(), req)
+		assert.NoError(t, err)
+		assert.Equal(t, req.Id, resp.GetSuccess().GetId())
+	}
+
+	_, err := client.CreatePlan(context.Background(), &pb.CreatePlanRequest{Id: "invalid"})
+	st, _ := status.FromError(err)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+
+	stream, err := client.ListPlans(context.Background(), &pb.ListPlansRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error listing plans: %s", err)
+	}
+	var got []string
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("unexpected error receiving plans: %s", err)
+		}
+		got = append(got, resp.GetSuccess().GetId())
+	}
+	assert.Equal(t, []string{"test1", "test2"}, got)
+}
+
+func TestMetadataPropagation(t *testing.T) {
+	plans := new(fakePlans)
+	conn, stop := startServer(t, Backends{Plan: plans})
+	defer stop()
+	client := pb.NewPlansClient(conn)
+
+	ctx := metadata.NewOutgoingContext(context.Background(), metadata.Pairs(
+		IdempotencyKeyMD, "key1",
+		StripeAccountMD, "acct_1",
+		HeaderMDPrefix+"stripe-version", "2017-08-15",
+		HeaderMDPrefix+"authorization", "Bearer sk_other",
+		HeaderMDPrefix+"stripe-account", "acct_2",
+		HeaderMDPrefix+"idempotency-key", "key2",
+	))
+	_, err := client.CreatePlan(ctx, &pb.CreatePlanRequest{Id: "test", Name: "test", Currency: pb.Currency_USD, Interval: pb.Interval_Month})
+	if err != nil {
+		t.Fatalf("unexpected error creating plan: %s", err)
+	}
+	key, _ := backend.IdempotencyKey(plans.ctx)
+	acct, _ := backend.StripeAccount(plans.ctx)
+	headers, _ := backend.Headers(plans.ctx)
+	assert.Equal(t, "key1", key)
+	assert.Equal(t, "acct_1", acct)
+	assert.Equal(t, map[string]string{"Stripe-Version": "2017-08-15"}, headers, "only allowed headers are forwarded")
+}
+
+// failingPlans returns an error response for every request
+type failingPlans struct {
+	fakePlans
+}
+
+func (f *failingPlans) Get(ctx context.Context, req *pb.GetPlanRequest) (*pb.PlanResponse, error) {
+	return &pb.PlanResponse{Responses: &pb.PlanResponse_Error{Error: &pb.Error{
+		Type:           pb.ErrorType_InvalidRequest,
+		Message:        "No such plan: " + req.GetId(),
+		HttpStatusCode: 404,
+	}}}, nil
+}
+
+func (f *failingPlans) List(ctx context.Context, req *pb.ListPlansRequest) (backend.PlanStreamer, error) {
+	return &fakePlanStreamer{
+		plans: []*pb.Plan{{Id: "test1"}},
+		err:   &pb.Error{Type: pb.ErrorType_RateLimit, Message: "slow down", HttpStatusCode: 429},
+	}, nil
+}
+
+func TestErrorStatus(t *testing.T) {
+	conn, stop := startServer(t, Backends{Plan: new(failingPlans)})
+	defer stop()
+	client := pb.NewPlansClient(conn)
+
+	_, err := client.GetPlan(context.Background(), &pb.GetPlanRequest{Id: "missing"})
+	st, _ := status.FromError(err)
+	assert.Equal(t, codes.NotFound, st.Code())
+	assert.True(t, pb.IsNotFound(err))
+	e, ok := pb.AsError(err)
+	if assert.True(t, ok) {
+		assert.Equal(t, "No such plan: missing", e.GetMessage())
+	}
+}
+
+func TestListError(t *testing.T) {
+	conn, stop := startServer(t, Backends{Plan: new(failingPlans)})
+	defer stop()
+	client := pb.NewPlansClient(conn)
+
+	stream, err := client.ListPlans(context.Background(), &pb.ListPlansRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error listing plans: %s", err)
+	}
+	resp, err := stream.Recv()
+	assert.NoError(t, err)
+	assert.Equal(t, "test1", resp.GetSuccess().GetId())
+
+	_, err = stream.Recv()
+	assert.NotEqual(t, io.EOF, err)
+	assert.True(t, pb.IsRateLimited(err))
+}
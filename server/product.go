@@ -0,0 +1,84 @@
+package server
+
+import (
+	"github.com/BTBurke/recur/backend"
+	"github.com/BTBurke/recur/pb"
+	log "github.com/sirupsen/logrus"
+	context "golang.org/x/net/context"
+)
+
+// ProductServer implements the Products GRPC service
+type ProductServer struct {
+	backend backend.ProductClient
+	logger  *log.Logger
+}
+
+var _ pb.ProductsServer = (*ProductServer)(nil)
+
+// NewProductServer returns a Products service backed by the product client
+func NewProductServer(b backend.ProductClient, logger *log.Logger) *ProductServer {
+	return &ProductServer{
+		backend: b,
+		logger:  logger,
+	}
+}
+
+func (s *ProductServer) CreateProduct(ctx context.Context, req *pb.CreateProductRequest) (*pb.ProductResponse, error) {
+	resp, err := s.backend.Create(contextFromMetadata(ctx), req)
+	err = pb.ResponseError(resp.GetError(), err)
+	s.log("CreateProduct", resp.GetSuccess().GetId(), err)
+	return resp, toStatus(err)
+}
+
+func (s *ProductServer) UpdateProduct(ctx context.Context, req *pb.UpdateProductRequest) (*pb.ProductResponse, error) {
+	resp, err := s.backend.Update(contextFromMetadata(ctx), req)
+	err = pb.ResponseError(resp.GetError(), err)
+	s.log("UpdateProduct", req.GetId(), err)
+	return resp, toStatus(err)
+}
+
+func (s *ProductServer) DeleteProduct(ctx context.Context, req *pb.DeleteProductRequest) (*pb.DeleteProductResponse, error) {
+	resp, err := s.backend.Delete(contextFromMetadata(ctx), req)
+	err = pb.ResponseError(resp.GetError(), err)
+	s.log("DeleteProduct", req.GetId(), err)
+	return resp, toStatus(err)
+}
+
+func (s *ProductServer) GetProduct(ctx context.Context, req *pb.GetProductRequest) (*pb.ProductResponse, error) {
+	resp, err := s.backend.Get(contextFromMetadata(ctx), req)
+	err = pb.ResponseError(resp.GetError(), err)
+	s.log("GetProduct", req.GetId(), err)
+	return resp, toStatus(err)
+}
+
+// ListProducts streams each product returned by the backend to the client
+func (s *ProductServer) ListProducts(req *pb.ListProductsRequest, stream pb.Products_ListProductsServer) error {
+	products, err := s.backend.List(contextFromMetadata(stream.Context()), req)
+	if err != nil {
+		s.log("ListProducts", "", err)
+		return toStatus(err)
+	}
+	defer products.Close()
+	for products.Next() {
+		if err := stream.Send(products.Current()); err != nil {
+			s.log("ListProducts", "", err)
+			return err
+		}
+	}
+	err = products.Err()
+	s.log("ListProducts", "", err)
+	return toStatus(err)
+}
+
+func (s *ProductServer) log(method string, id string, err error) {
+	logger := s.logger.WithField("method", method)
+	if len(id) > 0 {
+		logger = logger.WithField("product", id)
+	}
+	switch {
+	case err != nil:
+		logger.Errorf("request failed: %s", err)
+	default:
+		logger.Info("request complete")
+	}
+}
@@ -0,0 +1,82 @@
+// Package server implements the recur GRPC services on top of the backend interfaces
+package server
+
+import (
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/BTBurke/recur/backend"
+	"github.com/BTBurke/recur/pb"
+	log "github.com/sirupsen/logrus"
+	context "golang.org/x/net/context"
+)
+
+// Backends is the set of backend clients used to serve requests
+type Backends struct {
+	Plan         backend.PlanClient
+	Product      backend.ProductClient
+	Customer     backend.CustomerClient
+	Subscription backend.SubscriptionClient
+	Invoice      backend.InvoiceClient
+	Coupon       backend.CouponClient
+	Source       backend.SourceClient
+	Usage        backend.UsageClient
+	// Event retrieves past events and Events streams new events as they are received.  Either
+	// may be set; the Events service returns Unimplemented for methods whose backend is not.
+	Event  backend.EventClient
+	Events backend.EventSubscriber
+}
+
+// New returns a GRPC server with a service registered for each backend that is set
+func New(b Backends, logger *log.Logger, opts ...grpc.ServerOption) *grpc.Server {
+	s := grpc.NewServer(opts...)
+	if b.Plan != nil {
+		pb.RegisterPlansServer(s, NewPlanServer(b.Plan, logger))
+	}
+	if b.Product != nil {
+		pb.RegisterProductsServer(s, NewProductServer(b.Product, logger))
+	}
+	if b.Customer != nil {
+		pb.RegisterCustomersServer(s, NewCustomerServer(b.Customer, logger))
+	}
+	if b.Subscription != nil {
+		pb.RegisterSubscriptionsServer(s, NewSubscriptionServer(b.Subscription, logger))
+	}
+	if b.Invoice != nil {
+		pb.RegisterInvoicesServer(s, NewInvoiceServer(b.Invoice, logger))
+	}
+	if b.Coupon != nil {
+		pb.RegisterCouponsServer(s, NewCouponServer(b.Coupon, logger))
+	}
+	if b.Source != nil {
+		pb.RegisterSourcesServer(s, NewSourceServer(b.Source, logger))
+	}
+	if b.Usage != nil {
+		pb.RegisterUsageRecordsServer(s, NewUsageServer(b.Usage, logger))
+	}
+	if b.Event != nil || b.Events != nil {
+		pb.RegisterEventsServer(s, NewEventServer(b.Event, b.Events, logger))
+	}
+	return s
+}
+
+// toStatus converts an error from the backend to a GRPC status error
+func toStatus(err error) error {
+	switch err.(type) {
+	case nil:
+		return nil
+	case pb.ValidationError:
+		return status.Error(codes.InvalidArgument, err.Error())
+	case *pb.Error:
+		return err.(*pb.Error).Status()
+	}
+	switch err {
+	case context.DeadlineExceeded:
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	case context.Canceled:
+		return status.Error(codes.Canceled, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
@@ -0,0 +1,77 @@
+package server
+
+import (
+	"github.com/BTBurke/recur/backend"
+	"github.com/BTBurke/recur/pb"
+	log "github.com/sirupsen/logrus"
+	context "golang.org/x/net/context"
+)
+
+// SourceServer implements the Sources GRPC service
+type SourceServer struct {
+	backend backend.SourceClient
+	logger  *log.Logger
+}
+
+var _ pb.SourcesServer = (*SourceServer)(nil)
+
+// NewSourceServer returns a Sources service backed by the source client
+func NewSourceServer(b backend.SourceClient, logger *log.Logger) *SourceServer {
+	return &SourceServer{
+		backend: b,
+		logger:  logger,
+	}
+}
+
+func (s *SourceServer) AttachSource(ctx context.Context, req *pb.AttachSourceRequest) (*pb.SourceResponse, error) {
+	resp, err := s.backend.Attach(contextFromMetadata(ctx), req)
+	err = pb.ResponseError(resp.GetError(), err)
+	s.log("AttachSource", resp.GetSuccess().GetId(), err)
+	return resp, toStatus(err)
+}
+
+func (s *SourceServer) SetDefaultSource(ctx context.Context, req *pb.SetDefaultSourceRequest) (*pb.CustomerResponse, error) {
+	resp, err := s.backend.SetDefault(contextFromMetadata(ctx), req)
+	err = pb.ResponseError(resp.GetError(), err)
+	s.log("SetDefaultSource", req.GetSource(), err)
+	return resp, toStatus(err)
+}
+
+func (s *SourceServer) DetachSource(ctx context.Context, req *pb.DetachSourceRequest) (*pb.DetachSourceResponse, error) {
+	resp, err := s.backend.Detach(contextFromMetadata(ctx), req)
+	err = pb.ResponseError(resp.GetError(), err)
+	s.log("DetachSource", req.GetSource(), err)
+	return resp, toStatus(err)
+}
+
+// ListSources streams each payment source of the customer returned by the backend to the client
+func (s *SourceServer) ListSources(req *pb.ListSourcesRequest, stream pb.Sources_ListSourcesServer) error {
+	sources, err := s.backend.List(contextFromMetadata(stream.Context()), req)
+	if err != nil {
+		s.log("ListSources", "", err)
+		return toStatus(err)
+	}
+	defer sources.Close()
+	for sources.Next() {
+		if err := stream.Send(sources.Current()); err != nil {
+			s.log("ListSources", "", err)
+			return err
+		}
+	}
+	err = sources.Err()
+	s.log("ListSources", "", err)
+	return toStatus(err)
+}
+
+func (s *SourceServer) log(method string, id string, err error) {
+	logger := s.logger.WithField("method", method)
+	if len(id) > 0 {
+		logger = logger.WithField("source", id)
+	}
+	switch {
+	case err != nil:
+		logger.Errorf("request failed: %s", err)
+	default:
+		logger.Info("request complete")
+	}
+}
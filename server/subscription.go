@@ -0,0 +1,91 @@
+package server
+
+import (
+	"github.com/BTBurke/recur/backend"
+	"github.com/BTBurke/recur/pb"
+	log "github.com/sirupsen/logrus"
+	context "golang.org/x/net/context"
+)
+
+// SubscriptionServer implements the Subscriptions GRPC service
+type SubscriptionServer struct {
+	backend backend.SubscriptionClient
+	logger  *log.Logger
+}
+
+var _ pb.SubscriptionsServer = (*SubscriptionServer)(nil)
+
+// NewSubscriptionServer returns a Subscriptions service backed by the subscription client
+func NewSubscriptionServer(b backend.SubscriptionClient, logger *log.Logger) *SubscriptionServer {
+	return &SubscriptionServer{
+		backend: b,
+		logger:  logger,
+	}
+}
+
+func (s *SubscriptionServer) CreateSubscription(ctx context.Context, req *pb.CreateSubscriptionRequest) (*pb.SubscriptionResponse, error) {
+	resp, err := s.backend.Create(contextFromMetadata(ctx), req)
+	err = pb.ResponseError(resp.GetError(), err)
+	s.log("CreateSubscription", resp.GetSuccess().GetId(), err)
+	return resp, toStatus(err)
+}
+
+func (s *SubscriptionServer) UpdateSubscription(ctx context.Context, req *pb.UpdateSubscriptionRequest) (*pb.SubscriptionResponse, error) {
+	resp, err := s.backend.Update(contextFromMetadata(ctx), req)
+	err = pb.ResponseError(resp.GetError(), err)
+	s.log("UpdateSubscription", req.GetId(), err)
+	return resp, toStatus(err)
+}
+
+func (s *SubscriptionServer) CancelSubscription(ctx context.Context, req *pb.CancelSubscriptionRequest) (*pb.SubscriptionResponse, error) {
+	resp, err := s.backend.Cancel(contextFromMetadata(ctx), req)
+	err = pb.ResponseError(resp.GetError(), err)
+	s.log("CancelSubscription", req.GetId(), err)
+	return resp, toStatus(err)
+}
+
+func (s *SubscriptionServer) ReactivateSubscription(ctx context.Context, req *pb.ReactivateSubscriptionRequest) (*pb.SubscriptionResponse, error) {
+	resp, err := s.backend.Reactivate(contextFromMetadata(ctx), req)
+	err = pb.ResponseError(resp.GetError(), err)
+	s.log("ReactivateSubscription", req.GetId(), err)
+	return resp, toStatus(err)
+}
+
+func (s *SubscriptionServer) GetSubscription(ctx context.Context, req *pb.GetSubscriptionRequest) (*pb.SubscriptionResponse, error) {
+	resp, err := s.backend.Get(contextFromMetadata(ctx), req)
+	err = pb.ResponseError(resp.GetError(), err)
+	s.log("GetSubscription", req.GetId(), err)
+	return resp, toStatus(err)
+}
+
+// ListSubscriptions streams each subscription returned by the backend to the client
+func (s *SubscriptionServer) ListSubscriptions(req *pb.ListSubscriptionsRequest, stream pb.Subscriptions_ListSubscriptionsServer) error {
+	subscriptions, err := s.backend.List(contextFromMetadata(stream.Context()), req)
+	if err != nil {
+		s.log("ListSubscriptions", "", err)
+		return toStatus(err)
+	}
+	defer subscriptions.Close()
+	for subscriptions.Next() {
+		if err := stream.Send(subscriptions.Current()); err != nil {
+			s.log("ListSubscriptions", "", err)
+			return err
+		}
+	}
+	err = subscriptions.Err()
+	s.log("ListSubscriptions", "", err)
+	return toStatus(err)
+}
+
+func (s *SubscriptionServer) log(method string, id string, err error) {
+	logger := s.logger.WithField("method", method)
+	if len(id) > 0 {
+		logger = logger.WithField("subscription", id)
+	}
+	switch {
+	case err != nil:
+		logger.Errorf("request failed: %s", err)
+	default:
+		logger.Info("request complete")
+	}
+}
@@ -0,0 +1,63 @@
+package server
+
+import (
+	"github.com/BTBurke/recur/backend"
+	"github.com/BTBurke/recur/pb"
+	log "github.com/sirupsen/logrus"
+	context "golang.org/x/net/context"
+)
+
+// UsageServer implements the UsageRecords GRPC service
+type UsageServer struct {
+	backend backend.UsageClient
+	logger  *log.Logger
+}
+
+var _ pb.UsageRecordsServer = (*UsageServer)(nil)
+
+// NewUsageServer returns a UsageRecords service backed by the usage client
+func NewUsageServer(b backend.UsageClient, logger *log.Logger) *UsageServer {
+	return &UsageServer{
+		backend: b,
+		logger:  logger,
+	}
+}
+
+func (s *UsageServer) CreateUsageRecord(ctx context.Context, req *pb.CreateUsageRecordRequest) (*pb.UsageRecordResponse, error) {
+	resp, err := s.backend.Create(contextFromMetadata(ctx), req)
+	err = pb.ResponseError(resp.GetError(), err)
+	s.log("CreateUsageRecord", req.GetSubscriptionItem(), err)
+	return resp, toStatus(err)
+}
+
+// ListUsageRecordSummaries streams each usage summary returned by the backend to the client
+func (s *UsageServer) ListUsageRecordSummaries(req *pb.ListUsageRecordSummariesRequest, stream pb.UsageRecords_ListUsageRecordSummariesServer) error {
+	summaries, err := s.backend.ListSummaries(contextFromMetadata(stream.Context()), req)
+	if err != nil {
+		s.log("ListUsageRecordSummaries", req.GetSubscriptionItem(), err)
+		return toStatus(err)
+	}
+	defer summaries.Close()
+	for summaries.Next() {
+		if err := stream.Send(summaries.Current()); err != nil {
+			s.log("ListUsageRecordSummaries", req.GetSubscriptionItem(), err)
+			return err
+		}
+	}
+	err = summaries.Err()
+	s.log("ListUsageRecordSummaries", req.GetSubscriptionItem(), err)
+	return toStatus(err)
+}
+
+func (s *UsageServer) log(method string, item string, err error) {
+	logger := s.logger.WithField("method", method)
+	if len(item) > 0 {
+		logger = logger.WithField("subscription_item", item)
+	}
+	switch {
+	case err != nil:
+		logger.Errorf("request failed: %s", err)
+	default:
+		logger.Info("request complete")
+	}
+}
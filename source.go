@@ -0,0 +1,179 @@
+package recur
+
+import (
+	"fmt"
+	"io"
+
+	"google.golang.org/grpc"
+
+	"github.com/BTBurke/recur/backend"
+	"github.com/BTBurke/recur/pb"
+	log "github.com/sirupsen/logrus"
+	context "golang.org/x/net/context"
+)
+
+// SourceClient is the library facade for payment source operations.  It satisfies pb.SourcesClient so that it
+// can be used interchangeably with a GRPC client connected to a recur service.  Sources are attached from
+// tokens created client side, e.g. with Stripe.js, so card numbers never pass through recur.
+type SourceClient struct {
+	backend backend.SourceClient
+	client  *Client
+}
+
+var _ pb.SourcesClient = (*SourceClient)(nil)
+
+// AttachSource is the GRPC endpoint to attach a payment source to a customer.
+func (c *SourceClient) AttachSource(ctx context.Context, req *pb.AttachSourceRequest, opts ...grpc.CallOption) (*pb.SourceResponse, error) {
+	return c.attach(ctx, req)
+}
+
+// Attach attaches a payment source to a customer with a default context
+func (c *SourceClient) Attach(req *pb.AttachSourceRequest) (*pb.SourceResponse, error) {
+	return c.attach(context.Background(), req)
+}
+
+// AttachWithCtx attaches a payment source to a customer with a custom context
+func (c *SourceClient) AttachWithCtx(ctx context.Context, req *pb.AttachSourceRequest) (*pb.SourceResponse, error) {
+	return c.attach(ctx, req)
+}
+
+func (c *SourceClient) attach(ctx context.Context, req *pb.AttachSourceRequest) (*pb.SourceResponse, error) {
+	ctx, cancel := c.client.withTimeout(ctx)
+	defer cancel()
+
+	resp, err := c.backend.Attach(ctx, req)
+	logResponse(c.client.Logger.WithFields(log.Fields{"action": "attach", "customer": req.GetCustomer(), "source": resp.GetSuccess().GetId()}), resp.GetError(), err)
+	return resp, pb.ResponseError(resp.GetError(), err)
+}
+
+// SetDefaultSource is the GRPC endpoint to set the default payment source of a customer.
+func (c *SourceClient) SetDefaultSource(ctx context.Context, req *pb.SetDefaultSourceRequest, opts ...grpc.CallOption) (*pb.CustomerResponse, error) {
+	return c.setDefault(ctx, req)
+}
+
+// SetDefault sets the default payment source of a customer with a default context
+func (c *SourceClient) SetDefault(req *pb.SetDefaultSourceRequest) (*pb.CustomerResponse, error) {
+	return c.setDefault(context.Background(), req)
+}
+
+// SetDefaultWithCtx sets the default payment source of a customer with a custom context
+func (c *SourceClient) SetDefaultWithCtx(ctx context.Context, req *pb.SetDefaultSourceRequest) (*pb.CustomerResponse, error) {
+	return c.setDefault(ctx, req)
+}
+
+func (c *SourceClient) setDefault(ctx context.Context, req *pb.SetDefaultSourceRequest) (*pb.CustomerResponse, error) {
+	ctx, cancel := c.client.withTimeout(ctx)
+	defer cancel()
+
+	resp, err := c.backend.SetDefault(ctx, req)
+	logResponse(c.client.Logger.WithFields(log.Fields{"action": "set_default", "customer": req.GetCustomer(), "source": req.GetSource()}), resp.GetError(), err)
+	return resp, pb.ResponseError(resp.GetError(), err)
+}
+
+// DetachSource is the GRPC endpoint to detach a payment source from a customer.
+func (c *SourceClient) DetachSource(ctx context.Context, req *pb.DetachSourceRequest, opts ...grpc.CallOption) (*pb.DetachSourceResponse, error) {
+	return c.detach(ctx, req)
+}
+
+// Detach detaches a payment source from a customer with a default context
+func (c *SourceClient) Detach(req *pb.DetachSourceRequest) (*pb.DetachSourceResponse, error) {
+	return c.detach(context.Background(), req)
+}
+
+// DetachWithCtx detaches a payment source from a customer with a custom context
+func (c *SourceClient) DetachWithCtx(ctx context.Context, req *pb.DetachSourceRequest) (*pb.DetachSourceResponse, error) {
+	return c.detach(ctx, req)
+}
+
+func (c *SourceClient) detach(ctx context.Context, req *pb.DetachSourceRequest) (*pb.DetachSourceResponse, error) {
+	ctx, cancel := c.client.withTimeout(ctx)
+	defer cancel()
+
+	resp, err := c.backend.Detach(ctx, req)
+	logResponse(c.client.Logger.WithFields(log.Fields{"action": "detach", "customer": req.GetCustomer(), "source": req.GetSource()}), resp.GetError(), err)
+	return resp, pb.ResponseError(resp.GetError(), err)
+}
+
+// ListSources is the GRPC endpoint to list payment sources.
+func (c *SourceClient) ListSources(ctx context.Context, req *pb.ListSourcesRequest, opts ...grpc.CallOption) (pb.Sources_ListSourcesClient, error) {
+	ctx, cancel := c.client.withTimeout(ctx)
+	stream, err := c.list(ctx, req)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	return &sourceListClient{listClient: listClient{ctx: ctx, cancel: cancel}, stream: stream}, nil
+}
+
+// List lists the payment sources of a customer with a default context.  The client timeout applies to the entire
+// iteration of the returned stream.
+func (c *SourceClient) List(req *pb.ListSourcesRequest) (backend.SourceStreamer, error) {
+	return c.ListWithCtx(context.Background(), req)
+}
+
+// ListWithCtx lists the payment sources of a customer with a custom context
+func (c *SourceClient) ListWithCtx(ctx context.Context, req *pb.ListSourcesRequest) (backend.SourceStreamer, error) {
+	ctx, cancel := c.client.withTimeout(ctx)
+	stream, err := c.list(ctx, req)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	return &cancelSourceStreamer{SourceStreamer: stream, cancel: cancel}, nil
+}
+
+func (c *SourceClient) list(ctx context.Context, req *pb.ListSourcesRequest) (backend.SourceStreamer, error) {
+	stream, err := c.backend.List(ctx, req)
+	logList(c.client.Logger.WithFields(log.Fields{"action": "list", "resource": "source"}), err)
+	return stream, err
+}
+
+// cancelSourceStreamer releases the context of a list request when the stream is exhausted
+type cancelSourceStreamer struct {
+	backend.SourceStreamer
+	cancel context.CancelFunc
+}
+
+func (s *cancelSourceStreamer) Next() bool {
+	if s.SourceStreamer.Next() {
+		return true
+	}
+	s.cancel()
+	return false
+}
+
+// Close stops iteration and releases the context of the list request
+func (s *cancelSourceStreamer) Close() {
+	s.SourceStreamer.Close()
+	s.cancel()
+}
+
+// sourceListClient adapts a SourceStreamer to the GRPC client stream interface
+type sourceListClient struct {
+	listClient
+	stream backend.SourceStreamer
+}
+
+func (s *sourceListClient) Recv() (*pb.SourceResponse, error) {
+	if !s.stream.Next() {
+		s.cancel()
+		if err := s.stream.Err(); err != nil {
+			return nil, err
+		}
+		return nil, io.EOF
+	}
+	return s.stream.Current(), nil
+}
+
+func (s *sourceListClient) RecvMsg(m interface{}) error {
+	resp, err := s.Recv()
+	if err != nil {
+		return err
+	}
+	out, ok := m.(*pb.SourceResponse)
+	if !ok {
+		return fmt.Errorf("unexpected message type %T", m)
+	}
+	*out = *resp
+	return nil
+}
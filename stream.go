@@ -0,0 +1,47 @@
+package recur
+
+import (
+	"google.golang.org/grpc/metadata"
+
+	"github.com/BTBurke/recur/pb"
+	log "github.com/sirupsen/logrus"
+	context "golang.org/x/net/context"
+)
+
+// listClient implements the parts of the GRPC client stream interface that are common to all
+// list responses when recur is used as a library.  The context is cancelled when the stream is exhausted.
+type listClient struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+func (s *listClient) Header() (metadata.MD, error) { return metadata.MD{}, nil }
+func (s *listClient) Trailer() metadata.MD         { return metadata.MD{} }
+func (s *listClient) CloseSend() error             { return nil }
+func (s *listClient) Context() context.Context     { return s.ctx }
+func (s *listClient) SendMsg(m interface{}) error  { return nil }
+
+// logResponse logs the outcome of a request to the backend
+func logResponse(logger *log.Entry, pbErr *pb.Error, err error) {
+	switch {
+	case err != nil:
+		logger.Errorf("request failed: %s", err)
+	case pbErr != nil:
+		if len(pbErr.GetIdempotencyKey()) > 0 {
+			logger = logger.WithField("idempotency_key", pbErr.GetIdempotencyKey())
+		}
+		logger.Warnf("backend returned error: %s", pbErr.GetMessage())
+	default:
+		logger.Info("request succeeded")
+	}
+}
+
+// logList logs the outcome of starting a list request
+func logList(logger *log.Entry, err error) {
+	switch {
+	case err != nil:
+		logger.Errorf("list request failed: %s", err)
+	default:
+		logger.Debug("listing")
+	}
+}
@@ -0,0 +1,226 @@
+package recur
+
+import (
+	"fmt"
+	"io"
+
+	"google.golang.org/grpc"
+
+	"github.com/BTBurke/recur/backend"
+	"github.com/BTBurke/recur/pb"
+	log "github.com/sirupsen/logrus"
+	context "golang.org/x/net/context"
+)
+
+// SubscriptionClient is the library facade for subscription operations.  It satisfies pb.SubscriptionsClient so that it
+// can be used interchangeably with a GRPC client connected to a recur service.
+type SubscriptionClient struct {
+	backend backend.SubscriptionClient
+	client  *Client
+}
+
+var _ pb.SubscriptionsClient = (*SubscriptionClient)(nil)
+
+// CreateSubscription is the GRPC endpoint to create a subscription.
+func (c *SubscriptionClient) CreateSubscription(ctx context.Context, req *pb.CreateSubscriptionRequest, opts ...grpc.CallOption) (*pb.SubscriptionResponse, error) {
+	return c.create(ctx, req)
+}
+
+// Create creates a subscription with a default context
+func (c *SubscriptionClient) Create(req *pb.CreateSubscriptionRequest) (*pb.SubscriptionResponse, error) {
+	return c.create(context.Background(), req)
+}
+
+// CreateWithCtx creates a subscription with a custom context
+func (c *SubscriptionClient) CreateWithCtx(ctx context.Context, req *pb.CreateSubscriptionRequest) (*pb.SubscriptionResponse, error) {
+	return c.create(ctx, req)
+}
+
+func (c *SubscriptionClient) create(ctx context.Context, req *pb.CreateSubscriptionRequest) (*pb.SubscriptionResponse, error) {
+	ctx, cancel := c.client.withTimeout(ctx)
+	defer cancel()
+
+	resp, err := c.backend.Create(ctx, req)
+	logResponse(c.client.Logger.WithFields(log.Fields{"action": "create", "subscription": resp.GetSuccess().GetId()}), resp.GetError(), err)
+	return resp, pb.ResponseError(resp.GetError(), err)
+}
+
+// UpdateSubscription is the GRPC endpoint to update a subscription.
+func (c *SubscriptionClient) UpdateSubscription(ctx context.Context, req *pb.UpdateSubscriptionRequest, opts ...grpc.CallOption) (*pb.SubscriptionResponse, error) {
+	return c.update(ctx, req)
+}
+
+// Update updates a subscription with a default context
+func (c *SubscriptionClient) Update(req *pb.UpdateSubscriptionRequest) (*pb.SubscriptionResponse, error) {
+	return c.update(context.Background(), req)
+}
+
+// UpdateWithCtx updates a subscription with a custom context
+func (c *SubscriptionClient) UpdateWithCtx(ctx context.Context, req *pb.UpdateSubscriptionRequest) (*pb.SubscriptionResponse, error) {
+	return c.update(ctx, req)
+}
+
+func (c *SubscriptionClient) update(ctx context.Context, req *pb.UpdateSubscriptionRequest) (*pb.SubscriptionResponse, error) {
+	ctx, cancel := c.client.withTimeout(ctx)
+	defer cancel()
+
+	resp, err := c.backend.Update(ctx, req)
+	logResponse(c.client.Logger.WithFields(log.Fields{"action": "update", "subscription": req.GetId()}), resp.GetError(), err)
+	return resp, pb.ResponseError(resp.GetError(), err)
+}
+
+// CancelSubscription is the GRPC endpoint to cancel a subscription.
+func (c *SubscriptionClient) CancelSubscription(ctx context.Context, req *pb.CancelSubscriptionRequest, opts ...grpc.CallOption) (*pb.SubscriptionResponse, error) {
+	return c.cancel(ctx, req)
+}
+
+// Cancel cancels a subscription with a default context
+func (c *SubscriptionClient) Cancel(req *pb.CancelSubscriptionRequest) (*pb.SubscriptionResponse, error) {
+	return c.cancel(context.Background(), req)
+}
+
+// CancelWithCtx cancels a subscription with a custom context
+func (c *SubscriptionClient) CancelWithCtx(ctx context.Context, req *pb.CancelSubscriptionRequest) (*pb.SubscriptionResponse, error) {
+	return c.cancel(ctx, req)
+}
+
+func (c *SubscriptionClient) cancel(ctx context.Context, req *pb.CancelSubscriptionRequest) (*pb.SubscriptionResponse, error) {
+	ctx, cancel := c.client.withTimeout(ctx)
+	defer cancel()
+
+	resp, err := c.backend.Cancel(ctx, req)
+	logResponse(c.client.Logger.WithFields(log.Fields{"action": "cancel", "subscription": req.GetId()}), resp.GetError(), err)
+	return resp, pb.ResponseError(resp.GetError(), err)
+}
+
+// ReactivateSubscription is the GRPC endpoint to reactivate a subscription.
+func (c *SubscriptionClient) ReactivateSubscription(ctx context.Context, req *pb.ReactivateSubscriptionRequest, opts ...grpc.CallOption) (*pb.SubscriptionResponse, error) {
+	return c.reactivate(ctx, req)
+}
+
+// Reactivate reactivates a subscription with a default context
+func (c *SubscriptionClient) Reactivate(req *pb.ReactivateSubscriptionRequest) (*pb.SubscriptionResponse, error) {
+	return c.reactivate(context.Background(), req)
+}
+
+// ReactivateWithCtx reactivates a subscription with a custom context
+func (c *SubscriptionClient) ReactivateWithCtx(ctx context.Context, req *pb.ReactivateSubscriptionRequest) (*pb.SubscriptionResponse, error) {
+	return c.reactivate(ctx, req)
+}
+
+func (c *SubscriptionClient) reactivate(ctx context.Context, req *pb.ReactivateSubscriptionRequest) (*pb.SubscriptionResponse, error) {
+	ctx, cancel := c.client.withTimeout(ctx)
+	defer cancel()
+
+	resp, err := c.backend.Reactivate(ctx, req)
+	logResponse(c.client.Logger.WithFields(log.Fields{"action": "reactivate", "subscription": req.GetId()}), resp.GetError(), err)
+	return resp, pb.ResponseError(resp.GetError(), err)
+}
+
+// GetSubscription is the GRPC endpoint to get a subscription.
+func (c *SubscriptionClient) GetSubscription(ctx context.Context, req *pb.GetSubscriptionRequest, opts ...grpc.CallOption) (*pb.SubscriptionResponse, error) {
+	return c.get(ctx, req)
+}
+
+// Get gets a subscription with a default context
+func (c *SubscriptionClient) Get(req *pb.GetSubscriptionRequest) (*pb.SubscriptionResponse, error) {
+	return c.get(context.Background(), req)
+}
+
+// GetWithCtx gets a subscription with a custom context
+func (c *SubscriptionClient) GetWithCtx(ctx context.Context, req *pb.GetSubscriptionRequest) (*pb.SubscriptionResponse, error) {
+	return c.get(ctx, req)
+}
+
+func (c *SubscriptionClient) get(ctx context.Context, req *pb.GetSubscriptionRequest) (*pb.SubscriptionResponse, error) {
+	ctx, cancel := c.client.withTimeout(ctx)
+	defer cancel()
+
+	resp, err := c.backend.Get(ctx, req)
+	logResponse(c.client.Logger.WithFields(log.Fields{"action": "get", "subscription": req.GetId()}), resp.GetError(), err)
+	return resp, pb.ResponseError(resp.GetError(), err)
+}
+
+// ListSubscriptions is the GRPC endpoint to list subscriptions.
+func (c *SubscriptionClient) ListSubscriptions(ctx context.Context, req *pb.ListSubscriptionsRequest, opts ...grpc.CallOption) (pb.Subscriptions_ListSubscriptionsClient, error) {
+	ctx, cancel := c.client.withTimeout(ctx)
+	stream, err := c.list(ctx, req)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	return &subscriptionListClient{listClient: listClient{ctx: ctx, cancel: cancel}, stream: stream}, nil
+}
+
+// List lists subscriptions with a default context.  The client timeout applies to the entire
+// iteration of the returned stream.
+func (c *SubscriptionClient) List(req *pb.ListSubscriptionsRequest) (backend.SubscriptionStreamer, error) {
+	return c.ListWithCtx(context.Background(), req)
+}
+
+// ListWithCtx lists subscriptions with a custom context
+func (c *SubscriptionClient) ListWithCtx(ctx context.Context, req *pb.ListSubscriptionsRequest) (backend.SubscriptionStreamer, error) {
+	ctx, cancel := c.client.withTimeout(ctx)
+	stream, err := c.list(ctx, req)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	return &cancelSubscriptionStreamer{SubscriptionStreamer: stream, cancel: cancel}, nil
+}
+
+func (c *SubscriptionClient) list(ctx context.Context, req *pb.ListSubscriptionsRequest) (backend.SubscriptionStreamer, error) {
+	stream, err := c.backend.List(ctx, req)
+	logList(c.client.Logger.WithFields(log.Fields{"action": "list", "resource": "subscription"}), err)
+	return stream, err
+}
+
+// cancelSubscriptionStreamer releases the context of a list request when the stream is exhausted
+type cancelSubscriptionStreamer struct {
+	backend.SubscriptionStreamer
+	cancel context.CancelFunc
+}
+
+func (s *cancelSubscriptionStreamer) Next() bool {
+	if s.SubscriptionStreamer.Next() {
+		return true
+	}
+	s.cancel()
+	return false
+}
+
+// Close stops iteration and releases the context of the list request
+func (s *cancelSubscriptionStreamer) Close() {
+	s.SubscriptionStreamer.Close()
+	s.cancel()
+}
+
+// subscriptionListClient adapts a SubscriptionStreamer to the GRPC client stream interface
+type subscriptionListClient struct {
+	listClient
+	stream backend.SubscriptionStreamer
+}
+
+func (s *subscriptionListClient) Recv() (*pb.SubscriptionResponse, error) {
+	if !s.stream.Next() {
+		s.cancel()
+		if err := s.stream.Err(); err != nil {
+			return nil, err
+		}
+		return nil, io.EOF
+	}
+	return s.stream.Current(), nil
+}
+
+func (s *subscriptionListClient) RecvMsg(m interface{}) error {
+	resp, err := s.Recv()
+	if err != nil {
+		return err
+	}
+	out, ok := m.(*pb.SubscriptionResponse)
+	if !ok {
+		return fmt.Errorf("unexpected message type %T", m)
+	}
+	*out = *resp
+	return nil
+}
@@ -0,0 +1,132 @@
+package recur
+
+import (
+	"fmt"
+	"io"
+
+	"google.golang.org/grpc"
+
+	"github.com/BTBurke/recur/backend"
+	"github.com/BTBurke/recur/pb"
+	log "github.com/sirupsen/logrus"
+	context "golang.org/x/net/context"
+)
+
+// UsageClient is the library facade for reporting the usage of metered subscriptions.  It
+// satisfies pb.UsageRecordsClient so that it can be used interchangeably with a GRPC client
+// connected to a recur service.  To report high frequency usage, wrap it in a usage.Buffer.
+type UsageClient struct {
+	backend backend.UsageClient
+	client  *Client
+}
+
+var _ pb.UsageRecordsClient = (*UsageClient)(nil)
+
+// CreateUsageRecord is the GRPC endpoint to report usage.
+func (c *UsageClient) CreateUsageRecord(ctx context.Context, req *pb.CreateUsageRecordRequest, opts ...grpc.CallOption) (*pb.UsageRecordResponse, error) {
+	return c.create(ctx, req)
+}
+
+// Create reports usage with a default context
+func (c *UsageClient) Create(req *pb.CreateUsageRecordRequest) (*pb.UsageRecordResponse, error) {
+	return c.create(context.Background(), req)
+}
+
+// CreateWithCtx reports usage with a custom context.  Use WithIdempotencyKey to make the report
+// safe to retry.
+func (c *UsageClient) CreateWithCtx(ctx context.Context, req *pb.CreateUsageRecordRequest) (*pb.UsageRecordResponse, error) {
+	return c.create(ctx, req)
+}
+
+func (c *UsageClient) create(ctx context.Context, req *pb.CreateUsageRecordRequest) (*pb.UsageRecordResponse, error) {
+	ctx, cancel := c.client.withTimeout(ctx)
+	defer cancel()
+
+	resp, err := c.backend.Create(ctx, req)
+	logResponse(c.client.Logger.WithFields(log.Fields{"action": "create", "usage": req.GetSubscriptionItem()}), resp.GetError(), err)
+	return resp, pb.ResponseError(resp.GetError(), err)
+}
+
+// ListUsageRecordSummaries is the GRPC endpoint to list usage summaries.
+func (c *UsageClient) ListUsageRecordSummaries(ctx context.Context, req *pb.ListUsageRecordSummariesRequest, opts ...grpc.CallOption) (pb.UsageRecords_ListUsageRecordSummariesClient, error) {
+	ctx, cancel := c.client.withTimeout(ctx)
+	stream, err := c.list(ctx, req)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	return &usageSummaryListClient{listClient: listClient{ctx: ctx, cancel: cancel}, stream: stream}, nil
+}
+
+// ListSummaries lists the usage of a subscription item in each billing period with a default
+// context.  The client timeout applies to the entire iteration of the returned stream.
+func (c *UsageClient) ListSummaries(req *pb.ListUsageRecordSummariesRequest) (backend.UsageRecordSummaryStreamer, error) {
+	return c.ListSummariesWithCtx(context.Background(), req)
+}
+
+// ListSummariesWithCtx lists usage summaries with a custom context
+func (c *UsageClient) ListSummariesWithCtx(ctx context.Context, req *pb.ListUsageRecordSummariesRequest) (backend.UsageRecordSummaryStreamer, error) {
+	ctx, cancel := c.client.withTimeout(ctx)
+	stream, err := c.list(ctx, req)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	return &cancelUsageSummaryStreamer{UsageRecordSummaryStreamer: stream, cancel: cancel}, nil
+}
+
+func (c *UsageClient) list(ctx context.Context, req *pb.ListUsageRecordSummariesRequest) (backend.UsageRecordSummaryStreamer, error) {
+	stream, err := c.backend.ListSummaries(ctx, req)
+	logList(c.client.Logger.WithFields(log.Fields{"action": "list", "resource": "usage record summary"}), err)
+	return stream, err
+}
+
+// cancelUsageSummaryStreamer releases the context of a list request when the stream is exhausted
+type cancelUsageSummaryStreamer struct {
+	backend.UsageRecordSummaryStreamer
+	cancel context.CancelFunc
+}
+
+func (s *cancelUsageSummaryStreamer) Next() bool {
+	if s.UsageRecordSummaryStreamer.Next() {
+		return true
+	}
+	s.cancel()
+	return false
+}
+
+// Close stops iteration and releases the context of the list request
+func (s *cancelUsageSummaryStreamer) Close() {
+	s.UsageRecordSummaryStreamer.Close()
+	s.cancel()
+}
+
+// usageSummaryListClient adapts a UsageRecordSummaryStreamer to the GRPC client stream interface
+type usageSummaryListClient struct {
+	listClient
+	stream backend.UsageRecordSummaryStreamer
+}
+
+func (s *usageSummaryListClient) Recv() (*pb.UsageRecordSummaryResponse, error) {
+	if !s.stream.Next() {
+		s.cancel()
+		if err := s.stream.Err(); err != nil {
+			return nil, err
+		}
+		return nil, io.EOF
+	}
+	return s.stream.Current(), nil
+}
+
+func (s *usageSummaryListClient) RecvMsg(m interface{}) error {
+	resp, err := s.Recv()
+	if err != nil {
+		return err
+	}
+	out, ok := m.(*pb.UsageRecordSummaryResponse)
+	if !ok {
+		return fmt.Errorf("unexpected message type %T", m)
+	}
+	*out = *resp
+	return nil
+}
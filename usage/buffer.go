@@ -0,0 +1,274 @@
+// Package usage batches usage of metered subscriptions before it is reported to the backend.
+//
+// Metered usage such as API calls or messages sent is often counted far more often than it can
+// be reported; Stripe limits the rate of requests and each usage record is a separate request.
+// A Buffer adds up usage for each subscription item in memory and reports the totals
+// periodically.
+//
+// Each batch is given an idempotency key when it is taken from the buffer.  A batch that fails
+// to be reported is kept and sent again with the same key and quantity, so that the backend
+// applies it exactly once even if an earlier attempt succeeded without the response being
+// received.  Usage added while a batch is waiting to be retried goes into a new batch.  Stripe
+// forgets idempotency keys after 24 hours, so a batch that has not been reported by then is
+// dropped and returned as a *RejectedError with ErrKeyExpired rather than risk counting it twice.
+//
+//	buf := usage.NewBuffer(client.Usage, logger)
+//	defer buf.Close(context.Background())
+//	buf.Add(sub.GetSubscriptionItem(), 1)
+package usage
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/BTBurke/recur/backend"
+	"github.com/BTBurke/recur/pb"
+	log "github.com/sirupsen/logrus"
+	context "golang.org/x/net/context"
+)
+
+// DefaultFlushInterval is how often buffered usage is reported
+const DefaultFlushInterval = 10 * time.Second
+
+// KeyLifetime is how long Stripe remembers an idempotency key.  A batch retried after this
+// could be applied twice.
+const KeyLifetime = 24 * time.Hour
+
+// ErrKeyExpired is the error of a RejectedError for a batch that could not be reported before
+// its idempotency key expired
+var ErrKeyExpired = errors.New("idempotency key expired before the usage was reported")
+
+// Reporter reports usage records.  It is satisfied by the recur library client, c.Usage, and by
+// a GRPC client connected to a recur service, pb.NewUsageRecordsClient(conn).
+type Reporter interface {
+	CreateUsageRecord(ctx context.Context, req *pb.CreateUsageRecordRequest, opts ...grpc.CallOption) (*pb.UsageRecordResponse, error)
+}
+
+// Option configures optional behavior of the Buffer
+type Option func(b *Buffer)
+
+// WithFlushInterval sets how often buffered usage is reported.  Zero disables periodic flushes,
+// leaving usage to be reported by calling Flush.
+func WithFlushInterval(d time.Duration) Option {
+	return func(b *Buffer) {
+		b.interval = d
+	}
+}
+
+// batch is usage of a subscription item that has been taken from the buffer to be reported
+type batch struct {
+	item      string
+	quantity  uint64
+	timestamp int64
+	key       string
+}
+
+// Buffer adds up usage for each subscription item and reports it in batches.  It is safe for
+// concurrent use.
+type Buffer struct {
+	reporter Reporter
+	logger   log.StdLogger
+	interval time.Duration
+
+	// now and newKey can be replaced in tests
+	now    func() time.Time
+	newKey func() string
+
+	mu      sync.Mutex
+	pending map[string]uint64
+	batches []*batch
+
+	// flushMu serializes flushes so that a batch is never sent by two flushes at once
+	flushMu sync.Mutex
+
+	stop      chan struct{}
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// NewBuffer returns a buffer that reports usage with the reporter.  Unless disabled with
+// WithFlushInterval, usage is reported every DefaultFlushInterval until the buffer is closed.
+func NewBuffer(r Reporter, logger log.StdLogger, opts ...Option) *Buffer {
+	b := &Buffer{
+		reporter: r,
+		logger:   logger,
+		interval: DefaultFlushInterval,
+		now:      time.Now,
+		newKey:   newKey,
+		pending:  make(map[string]uint64),
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+	for _, opt := range opts {
+		opt(b)
+	}
+	if b.interval > 0 {
+		go b.run()
+	} else {
+		close(b.done)
+	}
+	return b
+}
+
+// Add records usage of a subscription item.  It never blocks on the backend.
+func (b *Buffer) Add(item string, quantity uint64) {
+	if quantity == 0 {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.pending[item] += quantity
+}
+
+// Pending returns the usage of a subscription item that has not yet been reported, including
+// batches waiting to be retried
+func (b *Buffer) Pending(item string) uint64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	total := b.pending[item]
+	for _, bt := range b.batches {
+		if bt.item == item {
+			total += bt.quantity
+		}
+	}
+	return total
+}
+
+// Flush reports all buffered usage.  Batches that fail with an error that may succeed on retry
+// are kept for the next flush and the first such error is returned.  Batches rejected by the
+// backend, e.g. because the subscription was canceled, cannot succeed and are dropped; they are
+// returned as a *RejectedError.  So are batches whose idempotency key is older than KeyLifetime.
+func (b *Buffer) Flush(ctx context.Context) error {
+	b.flushMu.Lock()
+	defer b.flushMu.Unlock()
+
+	b.mu.Lock()
+	ts := b.now().Unix()
+	for item, quantity := range b.pending {
+		b.batches = append(b.batches, &batch{item: item, quantity: quantity, timestamp: ts, key: b.newKey()})
+	}
+	b.pending = make(map[string]uint64)
+	batches := b.batches
+	b.mu.Unlock()
+
+	var failed []*batch
+	var firstErr error
+	for _, bt := range batches {
+		if err := ctx.Err(); err != nil {
+			failed = append(failed, bt)
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		if b.now().Sub(time.Unix(bt.timestamp, 0)) >= KeyLifetime {
+			if firstErr == nil {
+				firstErr = &RejectedError{SubscriptionItem: bt.item, Quantity: bt.quantity, Timestamp: bt.timestamp, Err: ErrKeyExpired}
+			}
+			continue
+		}
+		err := b.report(ctx, bt)
+		switch {
+		case err == nil:
+		case rejected(err):
+			if firstErr == nil {
+				firstErr = &RejectedError{SubscriptionItem: bt.item, Quantity: bt.quantity, Timestamp: bt.timestamp, Err: err}
+			}
+		default:
+			failed = append(failed, bt)
+			if firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+
+	b.mu.Lock()
+	b.batches = failed
+	b.mu.Unlock()
+	return firstErr
+}
+
+// Close stops periodic flushes and reports any remaining usage.  Usage that could not be
+// reported before the context is done is lost, and the error is returned.
+func (b *Buffer) Close(ctx context.Context) error {
+	b.closeOnce.Do(func() {
+		close(b.stop)
+	})
+	<-b.done
+	return b.Flush(ctx)
+}
+
+func (b *Buffer) run() {
+	defer close(b.done)
+	ticker := time.NewTicker(b.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-b.stop:
+			return
+		case <-ticker.C:
+			if err := b.Flush(context.Background()); err != nil {
+				b.logger.Printf("usage: flush failed: %s", err)
+			}
+		}
+	}
+}
+
+// report sends a batch with its idempotency key, both on the context for the library client and
+// as GRPC metadata for a recur service
+func (b *Buffer) report(ctx context.Context, bt *batch) error {
+	ctx = backend.WithIdempotencyKey(ctx, bt.key)
+	md, _ := metadata.FromOutgoingContext(ctx)
+	ctx = metadata.NewOutgoingContext(ctx, metadata.Join(md, metadata.Pairs(backend.IdempotencyKeyMD, bt.key)))
+
+	resp, err := b.reporter.CreateUsageRecord(ctx, &pb.CreateUsageRecordRequest{
+		SubscriptionItem: bt.item,
+		Quantity:         bt.quantity,
+		Timestamp:        bt.timestamp,
+		Action:           pb.UsageAction_Increment,
+	})
+	return pb.ResponseError(resp.GetError(), err)
+}
+
+// rejected returns true when the backend refused the usage and sending it again cannot succeed
+func rejected(err error) bool {
+	if _, ok := err.(pb.ValidationError); ok {
+		return true
+	}
+	if _, ok := pb.AsError(err); ok {
+		return !pb.IsRetryable(err)
+	}
+	if st, ok := status.FromError(err); ok {
+		return st.Code() == codes.InvalidArgument
+	}
+	return false
+}
+
+// RejectedError is returned by Flush when the backend refused a batch of usage or when its
+// idempotency key expired.  The usage is not kept by the buffer.
+type RejectedError struct {
+	SubscriptionItem string
+	Quantity         uint64
+	Timestamp        int64
+	Err              error
+}
+
+func (e *RejectedError) Error() string {
+	return fmt.Sprintf("usage: %d units for %s rejected: %s", e.Quantity, e.SubscriptionItem, e.Err)
+}
+
+// newKey returns a random idempotency key for a batch
+func newKey() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return "usage_" + hex.EncodeToString(b)
+}
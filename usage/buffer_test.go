@@ -0,0 +1,152 @@
+package usage
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/BTBurke/recur/backend"
+	"github.com/BTBurke/recur/backend/memory"
+	"github.com/BTBurke/recur/pb"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	context "golang.org/x/net/context"
+)
+
+// flakyReporter reports usage to the memory backend.  When lose is set, the usage is applied but
+// the response is lost, as when a connection drops after the backend has handled the request.
+type flakyReporter struct {
+	usage *memory.UsageClient
+
+	mu    sync.Mutex
+	lose  int
+	keys  []string
+	mdKey []string
+}
+
+func (r *flakyReporter) CreateUsageRecord(ctx context.Context, req *pb.CreateUsageRecordRequest, opts ...grpc.CallOption) (*pb.UsageRecordResponse, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	key, _ := backend.IdempotencyKey(ctx)
+	r.keys = append(r.keys, key)
+	md, _ := metadata.FromOutgoingContext(ctx)
+	r.mdKey = append(r.mdKey, md[backend.IdempotencyKeyMD]...)
+
+	resp, err := r.usage.Create(ctx, req)
+	if r.lose > 0 {
+		r.lose--
+		return nil, errors.New("connection reset")
+	}
+	return resp, err
+}
+
+func setup(t *testing.T) (*flakyReporter, *memory.UsageClient, string) {
+	store := memory.NewStore()
+	ctx := context.Background()
+	memory.NewPlanClient(store).Create(ctx, &pb.CreatePlanRequest{
+		Id: "api", Name: "API calls", Amount: 100, Currency: pb.Currency_USD, Interval: pb.Interval_Month,
+		UsageType: pb.UsageType_Metered,
+	})
+	cust, _ := memory.NewCustomerClient(store).Create(ctx, &pb.CreateCustomerRequest{})
+	sub, err := memory.NewSubscriptionClient(store).Create(ctx, &pb.CreateSubscriptionRequest{Customer: cust.GetSuccess().GetId(), Plan: "api"})
+	if err != nil || sub.GetError() != nil {
+		t.Fatalf("Failed to create subscription: %v %v", err, sub.GetError())
+	}
+	usage := memory.NewUsageClient(store)
+	return &flakyReporter{usage: usage}, usage, sub.GetSuccess().GetSubscriptionItem()
+}
+
+func totalUsage(t *testing.T, usage *memory.UsageClient, item string) uint64 {
+	list, err := usage.ListSummaries(context.Background(), &pb.ListUsageRecordSummariesRequest{SubscriptionItem: item})
+	if !assert.NoError(t, err) {
+		return 0
+	}
+	var total uint64
+	for list.Next() {
+		total += list.Current().GetSuccess().GetTotalUsage()
+	}
+	return total
+}
+
+func TestBufferRetriesWithSameKey(t *testing.T) {
+	r, usage, item := setup(t)
+	buf := NewBuffer(r, log.New(), WithFlushInterval(0))
+	ctx := context.Background()
+
+	for i := 0; i < 100; i++ {
+		buf.Add(item, 1)
+	}
+	r.lose = 1
+	assert.Error(t, buf.Flush(ctx))
+	assert.Equal(t, uint64(100), buf.Pending(item), "a failed batch is kept")
+
+	buf.Add(item, 5)
+	assert.Equal(t, uint64(105), buf.Pending(item))
+	assert.NoError(t, buf.Flush(ctx))
+	assert.Equal(t, uint64(0), buf.Pending(item))
+	assert.Equal(t, uint64(105), totalUsage(t, usage, item), "the retried batch is not counted twice")
+
+	if assert.Len(t, r.keys, 3) {
+		assert.Equal(t, r.keys[0], r.keys[1], "the batch is retried with its key")
+		assert.NotEqual(t, r.keys[0], r.keys[2], "new usage is sent in a new batch")
+		assert.Equal(t, r.keys, r.mdKey, "the key is also sent as GRPC metadata")
+	}
+}
+
+func TestBufferRejected(t *testing.T) {
+	r, _, _ := setup(t)
+	buf := NewBuffer(r, log.New(), WithFlushInterval(0))
+
+	buf.Add("si_missing", 3)
+	err := buf.Flush(context.Background())
+	rejected, ok := err.(*RejectedError)
+	if assert.True(t, ok, fmt.Sprintf("%T", err)) {
+		assert.Equal(t, uint64(3), rejected.Quantity)
+		assert.True(t, pb.IsNotFound(rejected.Err))
+	}
+	assert.Equal(t, uint64(0), buf.Pending("si_missing"), "rejected usage is dropped")
+}
+
+func TestBufferKeyExpired(t *testing.T) {
+	r, usage, item := setup(t)
+	buf := NewBuffer(r, log.New(), WithFlushInterval(0))
+	now := time.Now()
+	buf.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	buf.Add(item, 7)
+	r.lose = 1
+	assert.Error(t, buf.Flush(ctx))
+	now = now.Add(KeyLifetime)
+	err := buf.Flush(ctx)
+	rejected, ok := err.(*RejectedError)
+	if assert.True(t, ok, fmt.Sprintf("%T", err)) {
+		assert.Equal(t, ErrKeyExpired, rejected.Err)
+		assert.Equal(t, uint64(7), rejected.Quantity)
+	}
+	assert.Len(t, r.keys, 1, "a batch is not sent after its key expires")
+	assert.Equal(t, uint64(0), buf.Pending(item))
+	assert.Equal(t, uint64(7), totalUsage(t, usage, item))
+}
+
+func TestBufferPeriodicFlush(t *testing.T) {
+	r, usage, item := setup(t)
+	buf := NewBuffer(r, log.New(), WithFlushInterval(5*time.Millisecond))
+
+	buf.Add(item, 2)
+	buf.Add(item, 3)
+	deadline := time.Now().Add(time.Second)
+	for buf.Pending(item) > 0 && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	assert.Equal(t, uint64(5), totalUsage(t, usage, item))
+
+	buf.Add(item, 4)
+	assert.NoError(t, buf.Close(context.Background()))
+	assert.Equal(t, uint64(9), totalUsage(t, usage, item), "close reports the remaining usage")
+}
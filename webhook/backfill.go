@@ -0,0 +1,104 @@
+package webhook
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/BTBurke/recur/backend"
+	"github.com/BTBurke/recur/pb"
+	context "golang.org/x/net/context"
+)
+
+// backfillPageSize is the page size used to list events, the maximum allowed by Stripe
+const backfillPageSize = 100
+
+// BackfillAfter replays every event created after the event with the given ID through the
+// registered handlers.  See Backfill.
+func (h *Handler) BackfillAfter(ctx context.Context, events backend.EventClient, id string) (int, error) {
+	return h.Backfill(ctx, events, &pb.ListEventsRequest{EndingBefore: id})
+}
+
+// BackfillSince replays every event created at or after t through the registered handlers.  See
+// Backfill.
+func (h *Handler) BackfillSince(ctx context.Context, events backend.EventClient, t time.Time) (int, error) {
+	return h.Backfill(ctx, events, &pb.ListEventsRequest{Created: &pb.ListFilter{Gte: t.Unix()}})
+}
+
+// Backfill replays the events matching the list request through the registered handlers, oldest
+// first, as if they had been delivered to the webhook endpoint.  Events that have already been
+// handled are skipped, and handled events are published to subscribers.  Replay stops at the
+// first handler error so that it can be resumed with BackfillAfter from the last event that
+// succeeded.  It returns the number of events handled.
+//
+// Stripe lists events newest first, so unless the request has an EndingBefore cursor the oldest
+// matching event is found first.  Events are then listed a page at a time forward from that
+// cursor and each page is replayed as it arrives.  StartingAfter is ignored.  Stripe only keeps
+// events for 30 days, so older events cannot be replayed.
+func (h *Handler) Backfill(ctx context.Context, events backend.EventClient, req *pb.ListEventsRequest) (int, error) {
+	if req.GetLimit() == 0 {
+		req.Limit = backfillPageSize
+	}
+	var n int
+	if len(req.GetEndingBefore()) == 0 {
+		oldest, err := oldestEvent(ctx, events, req)
+		if err != nil || oldest == nil {
+			return 0, err
+		}
+		if n, err = h.backfillEvent(ctx, n, oldest); err != nil {
+			return n, err
+		}
+		req.EndingBefore = oldest.Id
+	}
+	req.StartingAfter = ""
+
+	// with an EndingBefore cursor, events are listed oldest first
+	stream, err := events.List(ctx, req)
+	if err != nil {
+		return n, err
+	}
+	defer stream.Close()
+	for stream.Next() {
+		resp := stream.Current()
+		if err := pb.ResponseError(resp.GetError(), nil); err != nil {
+			return n, err
+		}
+		if n, err = h.backfillEvent(ctx, n, resp.GetSuccess()); err != nil {
+			return n, err
+		}
+	}
+	return n, stream.Err()
+}
+
+// oldestEvent walks the events matching the request, newest first, and returns the last one.  It
+// returns nil if no event matches.
+func oldestEvent(ctx context.Context, events backend.EventClient, req *pb.ListEventsRequest) (*pb.Event, error) {
+	stream, err := events.List(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	defer stream.Close()
+	var oldest *pb.Event
+	for stream.Next() {
+		resp := stream.Current()
+		if err := pb.ResponseError(resp.GetError(), nil); err != nil {
+			return nil, err
+		}
+		oldest = resp.GetSuccess()
+	}
+	return oldest, stream.Err()
+}
+
+// backfillEvent processes a backfilled event and returns n incremented if the event was handled
+func (h *Handler) backfillEvent(ctx context.Context, n int, ev *pb.Event) (int, error) {
+	if err := ctx.Err(); err != nil {
+		return n, err
+	}
+	handled, err := h.process(ctx, ev)
+	if err != nil {
+		return n, fmt.Errorf("webhook: backfill of event %s (%s) failed: %s", ev.Id, ev.Type, err)
+	}
+	if handled {
+		n++
+	}
+	return n, nil
+}
@@ -0,0 +1,80 @@
+package webhook
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/BTBurke/recur/backend/memory"
+	"github.com/BTBurke/recur/pb"
+	"github.com/stretchr/testify/assert"
+	context "golang.org/x/net/context"
+)
+
+func TestBackfill(t *testing.T) {
+	store := memory.NewStore()
+	events := memory.NewEventClient(store)
+	start := time.Now().Add(-time.Hour)
+	for i := 0; i < 5; i++ {
+		store.RecordEvent(&pb.Event{Id: fmt.Sprintf("evt_%d", i), Type: "invoice.paid", Created: start.Add(time.Duration(i) * time.Minute).Unix()})
+	}
+
+	h := testHandler()
+	var got []string
+	var failOn string
+	h.On("invoice.paid", func(ctx context.Context, ev *pb.Event) error {
+		if ev.Id == failOn {
+			return fmt.Errorf("test")
+		}
+		got = append(got, ev.Id)
+		return nil
+	})
+	sub := h.Subscribe(context.Background(), nil)
+	ctx := context.Background()
+
+	// events after evt_0 are replayed oldest first, stopping at the first failure
+	failOn = "evt_3"
+	n, err := h.BackfillAfter(ctx, events, "evt_0")
+	assert.Error(t, err)
+	assert.Equal(t, 2, n)
+	assert.Equal(t, []string{"evt_1", "evt_2"}, got)
+
+	// resuming skips the events that were already handled
+	failOn = ""
+	n, err = h.BackfillSince(ctx, events, start)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, n)
+	assert.Equal(t, []string{"evt_1", "evt_2", "evt_0", "evt_3", "evt_4"}, got)
+	assert.Len(t, sub, 5)
+
+	// events delivered to the endpoint after a backfill are not handled twice
+	payload := testEvent("evt_4", "invoice.paid")
+	w := post(h, payload, Sign(payload, testSecret, time.Now()))
+	assert.Equal(t, 200, w.Code)
+	assert.Len(t, got, 5)
+}
+
+func TestBackfillPages(t *testing.T) {
+	store := memory.NewStore()
+	events := memory.NewEventClient(store)
+	start := time.Now().Add(-time.Hour)
+	var want []string
+	for i := 0; i < 7; i++ {
+		id := fmt.Sprintf("evt_%d", i)
+		store.RecordEvent(&pb.Event{Id: id, Type: "invoice.paid", Created: start.Add(time.Duration(i) * time.Minute).Unix()})
+		want = append(want, id)
+	}
+
+	h := testHandler()
+	var got []string
+	h.On("invoice.paid", func(ctx context.Context, ev *pb.Event) error {
+		got = append(got, ev.Id)
+		return nil
+	})
+
+	// events are replayed oldest first across pages
+	n, err := h.Backfill(context.Background(), events, &pb.ListEventsRequest{Limit: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, 7, n)
+	assert.Equal(t, want, got)
+}
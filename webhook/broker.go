@@ -0,0 +1,113 @@
+package webhook
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/BTBurke/recur/pb"
+	context "golang.org/x/net/context"
+)
+
+// subscriberBuffer is the number of events buffered for each subscriber before it is dropped
+const subscriberBuffer = 64
+
+type subscriber struct {
+	types []string
+	ch    chan *pb.Event
+	// done is closed when the subscriber is removed, ending the goroutine that waits on its
+	// context
+	done chan struct{}
+}
+
+// broker fans events out to subscribers.  Publishing never blocks; a subscriber whose buffer is
+// full is removed and its channel closed so that it can resubscribe and catch up from the events
+// API.
+type broker struct {
+	mu   sync.Mutex
+	subs map[*subscriber]struct{}
+}
+
+func newBroker() *broker {
+	return &broker{subs: make(map[*subscriber]struct{})}
+}
+
+func (b *broker) subscribe(ctx context.Context, types []string) <-chan *pb.Event {
+	sub := &subscriber{types: types, ch: make(chan *pb.Event, subscriberBuffer), done: make(chan struct{})}
+	b.mu.Lock()
+	b.subs[sub] = struct{}{}
+	b.mu.Unlock()
+	go func() {
+		select {
+		case <-ctx.Done():
+			b.remove(sub)
+		case <-sub.done:
+		}
+	}()
+	return sub.ch
+}
+
+func (b *broker) publish(ev *pb.Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for sub := range b.subs {
+		if !matchAny(sub.types, ev.GetType()) {
+			continue
+		}
+		select {
+		case sub.ch <- ev:
+		default:
+			b.drop(sub)
+		}
+	}
+}
+
+func (b *broker) remove(sub *subscriber) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if _, ok := b.subs[sub]; ok {
+		b.drop(sub)
+	}
+}
+
+// drop removes the subscriber and closes its channels.  The lock must be held.
+func (b *broker) drop(sub *subscriber) {
+	delete(b.subs, sub)
+	close(sub.ch)
+	close(sub.done)
+}
+
+// matchAny returns true if the event type matches one of the patterns.  No patterns matches
+// every event.
+func matchAny(patterns []string, typ string) bool {
+	if len(patterns) == 0 {
+		return true
+	}
+	for _, p := range patterns {
+		if match(p, typ) {
+			return true
+		}
+	}
+	return false
+}
+
+// match returns true if the event type matches the pattern.  A pattern is an exact event type
+// such as "invoice.paid", a prefix ending in ".*" such as "invoice.*", or "*" for every event.
+func match(pattern string, typ string) bool {
+	switch {
+	case pattern == "*":
+		return true
+	case strings.HasSuffix(pattern, ".*"):
+		return strings.HasPrefix(typ, pattern[:len(pattern)-1])
+	default:
+		return pattern == typ
+	}
+}
+
+// closeAll removes every subscriber and closes its channel
+func (b *broker) closeAll() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for sub := range b.subs {
+		b.drop(sub)
+	}
+}
@@ -0,0 +1,189 @@
+// Package webhook receives Stripe webhook events over HTTP.
+//
+// The Handler verifies the Stripe-Signature header of each request, decodes the event into a
+// pb.Event with a typed payload for plans, customers, subscriptions and invoices, and dispatches
+// it to the handlers registered for its type.  Event IDs are remembered so that redelivered
+// events are acknowledged without being handled twice; a redelivery that arrives while the event
+// is still being handled gets a 409 so that Stripe tries it again later.  Events that are
+// handled successfully are also published to subscribers, which lets the Events GRPC service
+// stream them to other services.
+//
+// Events missed while the endpoint was unavailable can be replayed from the events API with
+// Backfill, which runs them through the same handlers.
+//
+//	h := webhook.New(os.Getenv("STRIPE_WEBHOOK_SECRET"), logger)
+//	h.On("invoice.payment_failed", func(ctx context.Context, ev *pb.Event) error {
+//		return notifyCustomer(ctx, ev.GetInvoice())
+//	})
+//	http.Handle("/webhook", h)
+package webhook
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/BTBurke/recur/backend"
+	"github.com/BTBurke/recur/backend/stripe"
+	"github.com/BTBurke/recur/pb"
+	log "github.com/sirupsen/logrus"
+	context "golang.org/x/net/context"
+)
+
+// DefaultReplayWindow is how long event IDs are remembered.  Stripe retries failed deliveries
+// for up to three days.
+const DefaultReplayWindow = 72 * time.Hour
+
+// maxBodySize limits the size of a webhook request body
+const maxBodySize = 1 << 20
+
+// errInFlight is returned for an event that is already being handled
+var errInFlight = errors.New("event is already being handled")
+
+// HandlerFunc handles a webhook event.  Returning an error causes the request to fail so that
+// Stripe will deliver the event again.
+type HandlerFunc func(ctx context.Context, ev *pb.Event) error
+
+// Option configures optional behavior of the Handler
+type Option func(h *Handler)
+
+// WithTolerance sets the maximum age of a signed request.  Zero disables the timestamp check.
+func WithTolerance(d time.Duration) Option {
+	return func(h *Handler) {
+		h.tolerance = d
+	}
+}
+
+// WithReplayWindow sets how long processed event IDs are remembered
+func WithReplayWindow(d time.Duration) Option {
+	return func(h *Handler) {
+		h.replay = newReplayCache(d)
+	}
+}
+
+type route struct {
+	pattern string
+	fn      HandlerFunc
+}
+
+// Handler is an http.Handler that receives Stripe webhook events
+type Handler struct {
+	secret    string
+	tolerance time.Duration
+	logger    log.StdLogger
+	replay    *replayCache
+	broker    *broker
+
+	mu     sync.RWMutex
+	routes []route
+}
+
+var _ http.Handler = (*Handler)(nil)
+var _ backend.EventSubscriber = (*Handler)(nil)
+
+// New returns a webhook handler that verifies requests with the endpoint signing secret
+func New(secret string, logger log.StdLogger, opts ...Option) *Handler {
+	h := &Handler{
+		secret:    secret,
+		tolerance: DefaultTolerance,
+		logger:    logger,
+		replay:    newReplayCache(DefaultReplayWindow),
+		broker:    newBroker(),
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
+}
+
+// On registers a handler for events matching the pattern.  A pattern is an exact event type such
+// as "invoice.paid", a prefix such as "invoice.*", or "*" for every event.  Handlers run in the
+// order they are registered and stop at the first error.
+func (h *Handler) On(pattern string, fn HandlerFunc) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.routes = append(h.routes, route{pattern: pattern, fn: fn})
+}
+
+// Subscribe returns a channel of events matching the patterns once they have been handled
+// successfully.  The channel is closed when the context is done or when the subscriber falls
+// too far behind.
+func (h *Handler) Subscribe(ctx context.Context, types []string) <-chan *pb.Event {
+	return h.broker.subscribe(ctx, types)
+}
+
+// Close ends every subscription.  It should be called after the HTTP server has shut down.
+func (h *Handler) Close() {
+	h.broker.closeAll()
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	payload, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxBodySize))
+	if err != nil {
+		http.Error(w, "unable to read request body", http.StatusBadRequest)
+		return
+	}
+	if err := VerifySignature(payload, r.Header.Get(SignatureHeader), h.secret, h.tolerance); err != nil {
+		h.logger.Printf("webhook rejected: %s", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	ev, err := stripe.DecodeEvent(payload)
+	if err != nil {
+		h.logger.Printf("webhook rejected: %s", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	_, err = h.process(r.Context(), ev)
+	switch {
+	case err == errInFlight:
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	case err != nil:
+		h.logger.Printf("webhook event %s (%s) failed: %s", ev.Id, ev.Type, err)
+		http.Error(w, "event handler failed", http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// process dispatches an event that has not been seen before and publishes it to subscribers.  It
+// returns false if the event was skipped because it was already handled, and errInFlight if it
+// is being handled by another request.  A failed event is forgotten so that it is processed
+// again when it is redelivered.
+func (h *Handler) process(ctx context.Context, ev *pb.Event) (bool, error) {
+	switch h.replay.begin(ev.Id) {
+	case replayDone:
+		return false, nil
+	case replayInFlight:
+		return false, errInFlight
+	}
+	if err := h.dispatch(ctx, ev); err != nil {
+		h.replay.forget(ev.Id)
+		return false, err
+	}
+	h.replay.finish(ev.Id)
+	h.broker.publish(ev)
+	return true, nil
+}
+
+func (h *Handler) dispatch(ctx context.Context, ev *pb.Event) error {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	for _, rt := range h.routes {
+		if !match(rt.pattern, ev.Type) {
+			continue
+		}
+		if err := rt.fn(ctx, ev); err != nil {
+			return err
+		}
+	}
+	return nil
+}
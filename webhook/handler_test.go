@@ -0,0 +1,232 @@
+package webhook
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/BTBurke/recur/pb"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	context "golang.org/x/net/context"
+)
+
+const testSecret = "whsec_test"
+
+func testEvent(id string, typ string) []byte {
+	return []byte(fmt.Sprintf(`{
+		"id": %q,
+		"object": "event",
+		"type": %q,
+		"created": 1500000000,
+		"livemode": false,
+		"pending_webhooks": 1,
+		"request": {"id": "req_1", "idempotency_key": "key_1"},
+		"data": {
+			"object": {"id": "in_1", "object": "invoice", "customer": "cus_1", "amount_due": 1000, "currency": "usd", "paid": true},
+			"previous_attributes": {"paid": false}
+		}
+	}`, id, typ))
+}
+
+func testHandler() *Handler {
+	logger := log.New()
+	logger.Out = ioutil.Discard
+	return New(testSecret, logger)
+}
+
+func post(h http.Handler, payload []byte, header string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewReader(payload))
+	if len(header) > 0 {
+		req.Header.Set(SignatureHeader, header)
+	}
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	return w
+}
+
+func TestHandlerDispatch(t *testing.T) {
+	h := testHandler()
+	var got []*pb.Event
+	var all int
+	h.On("invoice.*", func(ctx context.Context, ev *pb.Event) error {
+		got = append(got, ev)
+		return nil
+	})
+	h.On("customer.created", func(ctx context.Context, ev *pb.Event) error {
+		t.Errorf("unexpected dispatch of %s", ev.Type)
+		return nil
+	})
+	h.On("*", func(ctx context.Context, ev *pb.Event) error {
+		all++
+		return nil
+	})
+
+	payload := testEvent("evt_1", "invoice.payment_succeeded")
+	w := post(h, payload, Sign(payload, testSecret, time.Now()))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, 1, all)
+	if assert.Len(t, got, 1) {
+		ev := got[0]
+		assert.Equal(t, "evt_1", ev.Id)
+		assert.Equal(t, "invoice", ev.Object)
+		assert.Equal(t, "req_1", ev.RequestId)
+		assert.Equal(t, "key_1", ev.IdempotencyKey)
+		assert.Equal(t, []string{"paid"}, ev.PreviousAttributes)
+		assert.Equal(t, "in_1", ev.GetInvoice().GetId())
+		assert.Equal(t, "cus_1", ev.GetInvoice().GetCustomer())
+		assert.NotEmpty(t, ev.RawObject)
+	}
+}
+
+func TestHandlerRejects(t *testing.T) {
+	h := testHandler()
+	h.On("*", func(ctx context.Context, ev *pb.Event) error {
+		t.Errorf("unexpected dispatch of %s", ev.Type)
+		return nil
+	})
+	payload := testEvent("evt_1", "invoice.paid")
+
+	w := post(h, payload, "")
+	assert.Equal(t, http.StatusBadRequest, w.Code, "missing signature")
+
+	w = post(h, payload, Sign(payload, "whsec_other", time.Now()))
+	assert.Equal(t, http.StatusBadRequest, w.Code, "wrong secret")
+
+	w = post(h, payload, Sign(payload, testSecret, time.Now().Add(-time.Hour)))
+	assert.Equal(t, http.StatusBadRequest, w.Code, "stale timestamp")
+
+	bad := []byte(`{"object": "event"}`)
+	w = post(h, bad, Sign(bad, testSecret, time.Now()))
+	assert.Equal(t, http.StatusBadRequest, w.Code, "invalid event")
+
+	req := httptest.NewRequest(http.MethodGet, "/webhook", nil)
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+}
+
+func TestHandlerReplay(t *testing.T) {
+	h := testHandler()
+	var calls int
+	fail := true
+	h.On("invoice.paid", func(ctx context.Context, ev *pb.Event) error {
+		calls++
+		if fail {
+			return fmt.Errorf("test")
+		}
+		return nil
+	})
+	payload := testEvent("evt_1", "invoice.paid")
+
+	// a failed event is processed again when Stripe retries it
+	w := post(h, payload, Sign(payload, testSecret, time.Now()))
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	fail = false
+	w = post(h, payload, Sign(payload, testSecret, time.Now()))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, 2, calls)
+
+	// a processed event is acknowledged without being handled again
+	w = post(h, payload, Sign(payload, testSecret, time.Now()))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, 2, calls)
+}
+
+func TestHandlerInFlight(t *testing.T) {
+	h := testHandler()
+	var calls int
+	started, release := make(chan bool), make(chan bool)
+	h.On("invoice.paid", func(ctx context.Context, ev *pb.Event) error {
+		calls++
+		started <- true
+		<-release
+		return nil
+	})
+	payload := testEvent("evt_1", "invoice.paid")
+
+	first := make(chan int)
+	go func() {
+		first <- post(h, payload, Sign(payload, testSecret, time.Now())).Code
+	}()
+	<-started
+
+	// a redelivery while the first is still being handled is not acknowledged
+	w := post(h, payload, Sign(payload, testSecret, time.Now()))
+	assert.Equal(t, http.StatusConflict, w.Code)
+
+	release <- true
+	assert.Equal(t, http.StatusOK, <-first)
+	w = post(h, payload, Sign(payload, testSecret, time.Now()))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, 1, calls)
+}
+
+func TestReplayWindow(t *testing.T) {
+	now := time.Unix(1500000000, 0)
+	c := newReplayCache(time.Hour)
+	c.now = func() time.Time { return now }
+	assert.Equal(t, replayNew, c.begin("evt_1"))
+	assert.Equal(t, replayInFlight, c.begin("evt_1"))
+	c.finish("evt_1")
+	assert.Equal(t, replayDone, c.begin("evt_1"))
+	now = now.Add(2 * time.Hour)
+	assert.Equal(t, replayNew, c.begin("evt_1"))
+	c.forget("evt_1")
+	assert.Equal(t, replayNew, c.begin("evt_1"), "a failed event can be handled again")
+}
+
+func TestSubscribe(t *testing.T) {
+	h := testHandler()
+	ctx, cancel := context.WithCancel(context.Background())
+	invoices := h.Subscribe(ctx, []string{"invoice.*"})
+	customers := h.Subscribe(context.Background(), []string{"customer.created"})
+
+	for i := 0; i < 2; i++ {
+		payload := testEvent(fmt.Sprintf("evt_%d", i), "invoice.paid")
+		post(h, payload, Sign(payload, testSecret, time.Now()))
+	}
+	for i := 0; i < 2; i++ {
+		ev := <-invoices
+		assert.Equal(t, fmt.Sprintf("evt_%d", i), ev.Id)
+	}
+	assert.Len(t, customers, 0)
+
+	cancel()
+	for range invoices {
+	}
+
+	// a subscriber that falls behind is dropped
+	for i := 0; i <= subscriberBuffer; i++ {
+		payload := testEvent(fmt.Sprintf("evt_c%d", i), "customer.created")
+		post(h, payload, Sign(payload, testSecret, time.Now()))
+	}
+	var n int
+	for range customers {
+		n++
+	}
+	assert.Equal(t, subscriberBuffer, n)
+}
+
+func TestBrokerCloseAll(t *testing.T) {
+	b := newBroker()
+	b.subscribe(context.Background(), nil)
+	var subs []*subscriber
+	for sub := range b.subs {
+		subs = append(subs, sub)
+	}
+	b.closeAll()
+	if assert.Len(t, subs, 1) {
+		select {
+		case <-subs[0].done:
+		case <-time.After(time.Second):
+			t.Error("removing a subscriber should end the wait on its context")
+		}
+		_, open := <-subs[0].ch
+		assert.False(t, open)
+	}
+}
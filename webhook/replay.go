@@ -0,0 +1,87 @@
+package webhook
+
+import (
+	"sync"
+	"time"
+)
+
+// replayState is the state of an event ID in the replay cache
+type replayState int
+
+const (
+	// replayNew is an event that has not been seen, or whose earlier attempt failed
+	replayNew replayState = iota
+	// replayInFlight is an event that is being handled
+	replayInFlight
+	// replayDone is an event that has been handled successfully
+	replayDone
+)
+
+// replayCache remembers the IDs of events that have been processed so that redelivered events
+// are acknowledged without being handled twice.  Events being handled are kept apart from those
+// that are done, so that a redelivery that arrives while the first attempt is still running is
+// neither handled twice nor acknowledged before the outcome is known.  Completed entries expire
+// after the window, which should be at least as long as Stripe keeps retrying a delivery (up to
+// three days).
+type replayCache struct {
+	mu        sync.Mutex
+	window    time.Duration
+	inFlight  map[string]bool
+	done      map[string]time.Time
+	lastPrune time.Time
+	now       func() time.Time
+}
+
+func newReplayCache(window time.Duration) *replayCache {
+	return &replayCache{
+		window:   window,
+		inFlight: make(map[string]bool),
+		done:     make(map[string]time.Time),
+		now:      time.Now,
+	}
+}
+
+// begin marks the event ID as in flight if it is new.  It returns the state the event was in, so
+// the event should only be handled when it returns replayNew.
+func (c *replayCache) begin(id string) replayState {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := c.now()
+	c.prune(now)
+	if t, ok := c.done[id]; ok && now.Sub(t) < c.window {
+		return replayDone
+	}
+	if c.inFlight[id] {
+		return replayInFlight
+	}
+	c.inFlight[id] = true
+	return replayNew
+}
+
+// finish records that the event was handled successfully
+func (c *replayCache) finish(id string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.inFlight, id)
+	c.done[id] = c.now()
+}
+
+// forget removes an in flight event ID after a failure so that a redelivery is processed again
+func (c *replayCache) forget(id string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.inFlight, id)
+}
+
+// prune removes expired entries at most once per minute
+func (c *replayCache) prune(now time.Time) {
+	if now.Sub(c.lastPrune) < time.Minute {
+		return
+	}
+	for id, t := range c.done {
+		if now.Sub(t) >= c.window {
+			delete(c.done, id)
+		}
+	}
+	c.lastPrune = now
+}
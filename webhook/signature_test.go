@@ -0,0 +1,42 @@
+package webhook
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerifySignature(t *testing.T) {
+	payload := []byte(`{"id":"evt_1"}`)
+	now := time.Unix(1500000000, 0)
+	valid := Sign(payload, "whsec_test", now)
+
+	tt := []struct {
+		Name      string
+		Payload   []byte
+		Header    string
+		Secret    string
+		Tolerance time.Duration
+		Now       time.Time
+		Err       error
+	}{
+		{Name: "valid", Payload: payload, Header: valid, Secret: "whsec_test", Tolerance: DefaultTolerance, Now: now},
+		{Name: "rolled secret", Payload: payload, Header: valid + ",v1=" + Sign(payload, "whsec_old", now)[len("t=1500000000,v1="):], Secret: "whsec_test", Tolerance: DefaultTolerance, Now: now},
+		{Name: "other scheme ignored", Payload: payload, Header: valid + ",v0=abc", Secret: "whsec_test", Tolerance: DefaultTolerance, Now: now},
+		{Name: "missing header", Payload: payload, Secret: "whsec_test", Err: ErrNoSignature},
+		{Name: "no v1", Payload: payload, Header: "t=1500000000", Secret: "whsec_test", Err: ErrNoSignature},
+		{Name: "no timestamp", Payload: payload, Header: "v1=abcd", Secret: "whsec_test", Err: ErrInvalidHeader},
+		{Name: "malformed", Payload: payload, Header: "garbage", Secret: "whsec_test", Err: ErrInvalidHeader},
+		{Name: "wrong secret", Payload: payload, Header: valid, Secret: "whsec_other", Tolerance: DefaultTolerance, Now: now, Err: ErrInvalidSignature},
+		{Name: "modified payload", Payload: []byte(`{"id":"evt_2"}`), Header: valid, Secret: "whsec_test", Tolerance: DefaultTolerance, Now: now, Err: ErrInvalidSignature},
+		{Name: "too old", Payload: payload, Header: valid, Secret: "whsec_test", Tolerance: DefaultTolerance, Now: now.Add(10 * time.Minute), Err: ErrTooOld},
+		{Name: "in the future", Payload: payload, Header: valid, Secret: "whsec_test", Tolerance: DefaultTolerance, Now: now.Add(-10 * time.Minute), Err: ErrTooOld},
+		{Name: "no tolerance", Payload: payload, Header: valid, Secret: "whsec_test", Now: now.Add(24 * time.Hour)},
+	}
+	for _, tc := range tt {
+		t.Run(tc.Name, func(t *testing.T) {
+			assert.Equal(t, tc.Err, verifyAt(tc.Payload, tc.Header, tc.Secret, tc.Tolerance, tc.Now))
+		})
+	}
+}